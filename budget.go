@@ -0,0 +1,95 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"buf.build/go/hyperpb/internal/tdp"
+)
+
+// ErrMemoryBudgetExceeded is returned when compiling a type would exceed the
+// limit of a [MemoryBudget].
+var ErrMemoryBudgetExceeded = errors.New("hyperpb: memory budget exceeded")
+
+// MemoryBudget tracks the memory retained by compiled [MessageType]s, and
+// limits how much memory they may retain in aggregate.
+//
+// A single budget may be shared by many compilations, such as all of the
+// types compiled on behalf of a particular tenant. It is safe to use from
+// multiple goroutines.
+//
+// Budgets are only charged; memory is not returned to a budget when a type is
+// garbage collected. Use [MemoryBudget.Release] to return it explicitly.
+//
+// A type is charged its [MessageType.RetainedSize] once, when it is compiled.
+// The Go heap state that RetainedSize excludes, such as interned strings and
+// lazily built lookup tables, is never charged, so the types compiled with a
+// budget may retain somewhat more memory than [MemoryBudget.Used] reports.
+type MemoryBudget struct {
+	limit int64
+	used  atomic.Int64
+}
+
+// NewMemoryBudget returns a new budget which permits up to limit bytes of
+// compiled types. A limit of zero or less means the budget is unlimited, and
+// only performs accounting.
+func NewMemoryBudget(limit int) *MemoryBudget {
+	return &MemoryBudget{limit: int64(limit)}
+}
+
+// Limit returns this budget's limit, as passed to [NewMemoryBudget].
+func (b *MemoryBudget) Limit() int {
+	return int(b.limit)
+}
+
+// Used returns the number of bytes currently charged against this budget.
+func (b *MemoryBudget) Used() int {
+	return int(b.used.Load())
+}
+
+// Release returns the memory retained by ty to this budget.
+//
+// ty must have been compiled with this budget, and should no longer be used
+// by the caller. Types compiled together, such as the types in a [Registry]
+// returned by [CompileAll] or the [MessageType.Dependencies] of a type, share
+// their memory, which is charged once: releasing any one of them returns all
+// of it, and releasing the others afterwards does nothing.
+func (b *MemoryBudget) Release(ty *MessageType) {
+	lib := ty.impl.Library
+	if lib.Released.CompareAndSwap(false, true) {
+		b.used.Add(-int64(lib.Bytes))
+	}
+}
+
+// charge charges the memory retained by lib against this budget.
+//
+// If b is nil, this function does nothing.
+func (b *MemoryBudget) charge(lib *tdp.Library) error {
+	if b == nil {
+		return nil
+	}
+
+	n := int64(lib.Bytes)
+	used := b.used.Add(n)
+	if b.limit > 0 && used > b.limit {
+		b.used.Add(-n)
+		return fmt.Errorf("%w: compiled type requires %d bytes, but only %d of %d are available",
+			ErrMemoryBudgetExceeded, n, b.limit-(used-n), b.limit)
+	}
+	return nil
+}
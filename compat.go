@@ -0,0 +1,223 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CompatibilityReport is the result of [MessageType.CompatibleWith].
+type CompatibilityReport struct {
+	// Differences between the two versions of the message, and of the
+	// messages they contain.
+	Issues []CompatibilityIssue
+}
+
+// CompatibilityIssue is a difference between a field in two versions of a
+// message. See [MessageType.CompatibleWith].
+type CompatibilityIssue struct {
+	// The field in each version. Old is nil if the field was added, and New
+	// is nil if it was removed.
+	Old, New protoreflect.FieldDescriptor
+
+	// Whether data valid for the old field may be rejected or misinterpreted
+	// under the new one.
+	Breaking bool
+
+	// A human-readable description of the difference.
+	Reason string
+}
+
+// Compatible returns whether none of the issues in this report is breaking.
+func (r *CompatibilityReport) Compatible() bool {
+	for _, issue := range r.Issues {
+		if issue.Breaking {
+			return false
+		}
+	}
+	return true
+}
+
+// String implements [fmt.Stringer].
+func (r *CompatibilityReport) String() string {
+	var buf strings.Builder
+	for _, issue := range r.Issues {
+		fmt.Fprintln(&buf, issue)
+	}
+	return buf.String()
+}
+
+// String implements [fmt.Stringer].
+func (i CompatibilityIssue) String() string {
+	fd := i.New
+	if fd == nil {
+		fd = i.Old
+	}
+	severity := "note"
+	if i.Breaking {
+		severity = "breaking"
+	}
+	return fmt.Sprintf("%s: %s (#%d): %s", severity, fd.FullName(), fd.Number(), i.Reason)
+}
+
+// CompatibleWith checks whether the wire format of messages of this type can
+// be safely reinterpreted as messages of md, a different version of the same
+// message, such as one that is about to be rolled out. This can be used to
+// decide whether a compiled type can be swapped for one compiled from md
+// while data encoded for either one is still in flight.
+//
+// Fields are matched by number, including in the message types of message
+// fields. Changes that cannot affect how the wire format is interpreted, such
+// as renaming a field, are not reported. Changes that are wire compatible but
+// which may change values, such as from int64 to int32, are reported as
+// non-breaking issues. Extensions are not checked.
+//
+// Returns an error if md does not have the same full name as this type.
+func (t *MessageType) CompatibleWith(md protoreflect.MessageDescriptor) (*CompatibilityReport, error) {
+	if md == nil || md.FullName() != t.Descriptor().FullName() {
+		var name protoreflect.FullName
+		if md != nil {
+			name = md.FullName()
+		}
+		return nil, fmt.Errorf("hyperpb: cannot check compatibility of %s with %q", t.Descriptor().FullName(), name)
+	}
+
+	c := compatChecker{seen: make(map[[2]protoreflect.MessageDescriptor]struct{})}
+	c.message(t.Descriptor(), md)
+	return &c.report, nil
+}
+
+// compatChecker is the state for [MessageType.CompatibleWith].
+type compatChecker struct {
+	report CompatibilityReport
+	seen   map[[2]protoreflect.MessageDescriptor]struct{}
+}
+
+// message compares two versions of a message.
+func (c *compatChecker) message(prev, next protoreflect.MessageDescriptor) {
+	key := [2]protoreflect.MessageDescriptor{prev, next}
+	if _, ok := c.seen[key]; ok {
+		return
+	}
+	c.seen[key] = struct{}{}
+
+	oldFields, newFields := prev.Fields(), next.Fields()
+	for i := range oldFields.Len() {
+		of := oldFields.Get(i)
+		nf := newFields.ByNumber(of.Number())
+		if nf == nil {
+			c.note(of, nil, false, "removed; will be treated as an unknown field")
+			continue
+		}
+		c.field(of, nf)
+	}
+	for i := range newFields.Len() {
+		nf := newFields.Get(i)
+		if oldFields.ByNumber(nf.Number()) != nil {
+			continue
+		}
+		if nf.Cardinality() == protoreflect.Required {
+			c.note(nil, nf, true, "added as a required field; old data does not contain it")
+		}
+	}
+}
+
+// field compares two versions of a field with the same number.
+func (c *compatChecker) field(prev, next protoreflect.FieldDescriptor) {
+	was, kind := prev.Kind(), next.Kind()
+	switch {
+	case was == kind:
+	case compatClass(was) != 0 && compatClass(was) == compatClass(kind):
+		c.note(prev, next, false, fmt.Sprintf("changed from %v to %v; values may be truncated or change sign", was, kind))
+	case was == protoreflect.StringKind && kind == protoreflect.BytesKind,
+		was == protoreflect.MessageKind && kind == protoreflect.BytesKind:
+		c.note(prev, next, false, fmt.Sprintf("changed from %v to %v", was, kind))
+	case was == protoreflect.BytesKind && kind == protoreflect.StringKind:
+		c.note(prev, next, true, "changed from bytes to string; old values may not be valid UTF-8")
+	case was == protoreflect.BytesKind && kind == protoreflect.MessageKind:
+		c.note(prev, next, true, "changed from bytes to message; old values may not be valid messages")
+	default:
+		c.note(prev, next, true, fmt.Sprintf("changed from %v to %v, which is not wire compatible", was, kind))
+		return
+	}
+
+	if was == protoreflect.StringKind && !enforcesUTF8(prev) && enforcesUTF8(next) {
+		c.note(prev, next, true, "now validated as UTF-8; old values may not be valid UTF-8")
+	}
+
+	switch {
+	case prev.IsMap() != next.IsMap():
+		c.note(prev, next, false, "changed between a map and a repeated field")
+	case prev.IsList() != next.IsList() && kind == protoreflect.MessageKind:
+		c.note(prev, next, false, "changed between singular and repeated; the values of a singular field are merged")
+	case prev.IsList() != next.IsList():
+		c.note(prev, next, false, "changed between singular and repeated; all but the last value of a singular field are discarded")
+	}
+
+	if next.Cardinality() == protoreflect.Required && prev.Cardinality() != protoreflect.Required {
+		c.note(prev, next, true, "became required; old data may not contain it")
+	}
+
+	po, no := prev.ContainingOneof(), next.ContainingOneof()
+	if no != nil && !no.IsSynthetic() && no.Fields().Len() > 1 && (po == nil || po.IsSynthetic()) {
+		c.note(prev, next, true, fmt.Sprintf("moved into oneof %s; old data may set several of its members", no.Name()))
+	}
+
+	if prev.Message() != nil && next.Message() != nil {
+		c.message(prev.Message(), next.Message())
+	}
+}
+
+// note records an issue.
+func (c *compatChecker) note(prev, next protoreflect.FieldDescriptor, breaking bool, reason string) {
+	c.report.Issues = append(c.report.Issues, CompatibilityIssue{
+		Old: prev, New: next,
+		Breaking: breaking,
+		Reason:   reason,
+	})
+}
+
+// compatClass returns a nonzero value that is the same for kinds that are
+// wire compatible with each other, or zero if k is only compatible with
+// itself.
+func compatClass(k protoreflect.Kind) int {
+	switch k {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind,
+		protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+		protoreflect.BoolKind, protoreflect.EnumKind:
+		return 1
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		return 2
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind:
+		return 3
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind:
+		return 4
+	default:
+		return 0
+	}
+}
+
+// enforcesUTF8 returns whether fd is a string field that is validated as
+// UTF-8.
+func enforcesUTF8(fd protoreflect.FieldDescriptor) bool {
+	if fd.Kind() != protoreflect.StringKind {
+		return false
+	}
+	fd2, ok := fd.(interface{ EnforceUTF8() bool })
+	return fd.Syntax() == protoreflect.Proto3 || (ok && fd2.EnforceUTF8())
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestCompatibleWith(t *testing.T) {
+	t.Parallel()
+
+	type field struct {
+		name   string
+		number int32
+		typ    descriptorpb.FieldDescriptorProto_Type
+		label  descriptorpb.FieldDescriptorProto_Label
+	}
+	const (
+		optional = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	)
+	build := func(fields ...field) protoreflect.MessageDescriptor {
+		msg := &descriptorpb.DescriptorProto{Name: proto.String("Versioned")}
+		for _, f := range fields {
+			fdp := &descriptorpb.FieldDescriptorProto{
+				Name:   proto.String(f.name),
+				Number: proto.Int32(f.number),
+				Label:  f.label.Enum(),
+				Type:   f.typ.Enum(),
+			}
+			if f.typ == descriptorpb.FieldDescriptorProto_TYPE_MESSAGE {
+				fdp.TypeName = proto.String(".hyperpb.test.Versioned")
+			}
+			msg.Field = append(msg.Field, fdp)
+		}
+		fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+			Name:        proto.String("versioned.proto"),
+			Package:     proto.String("hyperpb.test"),
+			Syntax:      proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{msg},
+		}, nil)
+		require.NoError(t, err)
+		return fd.Messages().Get(0)
+	}
+
+	v1 := build(
+		field{"a", 1, descriptorpb.FieldDescriptorProto_TYPE_INT64, optional},
+		field{"b", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional},
+		field{"c", 3, descriptorpb.FieldDescriptorProto_TYPE_BYTES, optional},
+		field{"d", 4, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, optional},
+	)
+	ty := hyperpb.CompileMessageDescriptor(v1)
+
+	report, err := ty.CompatibleWith(v1)
+	require.NoError(t, err)
+	assert.Empty(t, report.Issues)
+	assert.True(t, report.Compatible())
+
+	// Renames, additions, removals and compatible type changes.
+	report, err = ty.CompatibleWith(build(
+		field{"a2", 1, descriptorpb.FieldDescriptorProto_TYPE_INT32, optional},
+		field{"b", 2, descriptorpb.FieldDescriptorProto_TYPE_BYTES, optional},
+		field{"d", 4, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, repeated},
+		field{"e", 5, descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, optional},
+	))
+	require.NoError(t, err)
+	assert.True(t, report.Compatible(), "%v", report)
+	assert.Len(t, report.Issues, 4, "%v", report)
+
+	// Incompatible type changes.
+	report, err = ty.CompatibleWith(build(
+		field{"a", 1, descriptorpb.FieldDescriptorProto_TYPE_SINT64, optional},
+		field{"b", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional},
+		field{"c", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional},
+		field{"d", 4, descriptorpb.FieldDescriptorProto_TYPE_FIXED64, optional},
+	))
+	require.NoError(t, err)
+	assert.False(t, report.Compatible())
+	var breaking []string
+	for _, issue := range report.Issues {
+		if issue.Breaking {
+			breaking = append(breaking, string(issue.Old.Name()))
+		}
+	}
+	assert.Equal(t, []string{"a", "c", "d"}, breaking)
+
+	_, err = ty.CompatibleWith((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	require.Error(t, err)
+	_, err = ty.CompatibleWith(nil)
+	require.Error(t, err)
+}
@@ -15,15 +15,22 @@
 package hyperpb
 
 import (
+	"cmp"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/runtime/protoiface"
 	"google.golang.org/protobuf/types/descriptorpb"
 
+	"buf.build/go/hyperpb/internal/tdp"
 	"buf.build/go/hyperpb/internal/tdp/compiler"
 	"buf.build/go/hyperpb/internal/tdp/profile"
 	"buf.build/go/hyperpb/internal/tdp/thunks"
+	"buf.build/go/hyperpb/internal/xsync"
 )
 
 // CompileFileDescriptorSet unmarshals a google.protobuf.FileDescriptorSet from schema,
@@ -45,27 +52,244 @@ func CompileFileDescriptorSet(fds *descriptorpb.FileDescriptorSet, messageName p
 	// Allow the caller to override the extension registry by placing our
 	// default registry first.
 	options = append([]CompileOption{WithExtensionsFromFiles(files)}, options...)
-	return CompileMessageDescriptor(msgDesc, options...), nil
+	return compile(msgDesc, options)
+}
+
+// CompileAll compiles every message in files for which filter returns true,
+// or every message if filter is nil, and returns a [Registry] of the compiled
+// types. Map entry messages are never compiled on their own.
+//
+// All of the messages are compiled together, so a message that is used by
+// several of them, such as a common header, is only compiled once and its
+// compiled form is shared between them. This is both faster and uses less
+// memory than compiling each message separately.
+//
+// As with [CompileFileDescriptorSet], extensions are resolved from files by
+// default. [WithMemoryBudget] is charged for all of the types at once.
+func CompileAll(files *protoregistry.Files, filter func(protoreflect.MessageDescriptor) bool, options ...CompileOption) (*Registry, error) {
+	var mds []protoreflect.MessageDescriptor
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		mds = appendMessages(mds, fd.Messages(), filter)
+		return true
+	})
+	if len(mds) == 0 {
+		return newRegistry(), nil
+	}
+	slices.SortFunc(mds, func(a, b protoreflect.MessageDescriptor) int {
+		return cmp.Compare(a.FullName(), b.FullName())
+	})
+
+	obs := make([]observation, len(mds))
+	for i, md := range mds {
+		obs[i] = observeCompile(md)
+	}
+
+	options = append([]CompileOption{WithExtensionsFromFiles(files)}, options...)
+	lib, err := compileLibrary(mds, options)
+
+	types := make([]*MessageType, len(mds))
+	for i, md := range mds {
+		var ty *tdp.Type
+		if lib != nil {
+			ty = lib.Types[md]
+			types[i] = wrapType(ty)
+		}
+		obs[i].compiled(md, ty, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newRegistry(types...), nil
+}
+
+// appendMessages appends the messages in mds, and all of the messages nested
+// within them, for which filter returns true.
+func appendMessages(out []protoreflect.MessageDescriptor, mds protoreflect.MessageDescriptors, filter func(protoreflect.MessageDescriptor) bool) []protoreflect.MessageDescriptor {
+	for i := range mds.Len() {
+		md := mds.Get(i)
+		if !md.IsMapEntry() && (filter == nil || filter(md)) {
+			out = append(out, md)
+		}
+		out = appendMessages(out, md.Messages(), filter)
+	}
+	return out
 }
 
 // CompileMessageDescriptor compiles a descriptor into a [MessageType], for optimized parsing.
 //
-// Panics if md is too complicated (i.e. it exceeds internal limitations for the compiler).
+// Panics if md is too complicated (i.e. it exceeds internal limitations for the compiler),
+// or with the error [TryCompileMessageDescriptor] would return.
+//
+// Concurrent calls for the same descriptor without any options are
+// deduplicated: only one of them compiles md, and the rest wait for it and
+// return the same type. Options cannot be compared, so calls with options are
+// only deduplicated if they pass the same key to [WithCompileKey].
 func CompileMessageDescriptor(md protoreflect.MessageDescriptor, options ...CompileOption) *MessageType {
-	opts := compiler.Options{
-		Backend: (*backend)(nil),
+	ty, err := TryCompileMessageDescriptor(md, options...)
+	if err != nil {
+		panic(err)
+	}
+	return ty
+}
+
+// TryCompileMessageDescriptor is like [CompileMessageDescriptor], but returns
+// an error rather than panicking if md uses features that are not supported,
+// with an [*UnsupportedError], or if the compiled type does not fit in a
+// budget provided with [WithMemoryBudget].
+//
+// This allows checking a schema that is only known at runtime for
+// compatibility, without recovering from a panic.
+func TryCompileMessageDescriptor(md protoreflect.MessageDescriptor, options ...CompileOption) (*MessageType, error) {
+	if key, ok := compileKeyOf(md, options); ok {
+		return inflight.Do(key, func() (*MessageType, error) {
+			return compile(md, options)
+		})
 	}
+	return compile(md, options)
+}
+
+// CompileFor compiles the descriptor of the generated message type M, which
+// must be a pointer type, into a [MessageType]. It panics under the same
+// conditions as [CompileMessageDescriptor].
+//
+// Only M's descriptor is used; the layout of the generated struct is
+// irrelevant. This means that types generated for the open, hybrid and opaque
+// Go APIs, and from editions, are all supported.
+func CompileFor[M proto.Message](options ...CompileOption) *MessageType {
+	var m M
+	return CompileMessageDescriptor(m.ProtoReflect().Descriptor(), options...)
+}
 
+// inflight deduplicates concurrent calls to [CompileMessageDescriptor].
+var inflight xsync.Group[compileKey, *MessageType]
+
+// compileKey identifies the calls to [CompileMessageDescriptor] that
+// inflight deduplicates: those for the same descriptor with the same
+// [WithCompileKey] key.
+type compileKey struct {
+	md  protoreflect.MessageDescriptor
+	key any
+}
+
+// compileKeyOf returns the key that a call to [CompileMessageDescriptor] for
+// md with options is deduplicated by, if it may be deduplicated at all.
+func compileKeyOf(md protoreflect.MessageDescriptor, options []CompileOption) (compileKey, bool) {
+	var opts compileOptions
 	for _, opt := range options {
 		if opt.apply != nil {
 			opt.apply(&opts)
 		}
 	}
+	return compileKey{md, opts.key}, len(options) == 0 || opts.key != nil
+}
 
-	ty := compiler.Compile(md, opts)
-	ty.Library.Metadata = options
+// Compilation is a [MessageType] being compiled in the background, returned by
+// [CompileAsync].
+type Compilation struct {
+	done chan struct{}
+	ty   *MessageType
+	err  error
+}
 
-	return wrapType(ty)
+// CompileAsync starts compiling md in a new goroutine, as if by
+// [CompileMessageDescriptor], and returns a handle for waiting on the result.
+//
+// This allows a program to begin compiling the types it needs at startup, and
+// only block on a type once it is first needed.
+func CompileAsync(md protoreflect.MessageDescriptor, options ...CompileOption) *Compilation {
+	c := &Compilation{done: make(chan struct{})}
+	go func() {
+		defer close(c.done)
+		defer func() {
+			switch p := recover().(type) {
+			case nil:
+			case error:
+				c.err = p
+			default:
+				c.err = fmt.Errorf("hyperpb: failed to compile %s: %v", md.FullName(), p)
+			}
+		}()
+		c.ty = CompileMessageDescriptor(md, options...)
+	}()
+	return c
+}
+
+// Done returns a channel that is closed once compilation finishes.
+func (c *Compilation) Done() <-chan struct{} {
+	return c.done
+}
+
+// Wait blocks until compilation finishes, and returns the compiled type.
+//
+// Returns an error if [CompileMessageDescriptor] would have panicked.
+func (c *Compilation) Wait() (*MessageType, error) {
+	<-c.done
+	return c.ty, c.err
+}
+
+// compile is the shared implementation of the Compile* functions.
+func compile(md protoreflect.MessageDescriptor, options []CompileOption) (*MessageType, error) {
+	ob := observeCompile(md)
+	ty, err := compileOptioned(md, options)
+	ob.compiled(md, ty, err)
+	if err != nil {
+		return nil, err
+	}
+	return wrapType(ty), nil
+}
+
+// compileOptioned compiles md with the given options.
+func compileOptioned(md protoreflect.MessageDescriptor, options []CompileOption) (*tdp.Type, error) {
+	lib, err := compileLibrary([]protoreflect.MessageDescriptor{md}, options)
+	if err != nil {
+		return nil, err
+	}
+	return lib.Types[md], nil
+}
+
+// compileLibrary compiles mds into a single library with the given options.
+func compileLibrary(mds []protoreflect.MessageDescriptor, options []CompileOption) (*tdp.Library, error) {
+	opts := compileOptions{
+		Options: compiler.Options{
+			Backend: (*backend)(nil),
+		},
+	}
+
+	for _, opt := range options {
+		if opt.apply != nil {
+			opt.apply(&opts)
+		}
+	}
+
+	lib, err := compileChecked(mds, opts.Options)
+	if err != nil {
+		return nil, err
+	}
+	lib.Metadata = options
+
+	if err := cacheOptions(lib, opts.options); err != nil {
+		return nil, err
+	}
+
+	if err := opts.budget.charge(lib); err != nil {
+		return nil, err
+	}
+
+	return lib, nil
+}
+
+// compileOptions is the state [CompileOption]s are applied to.
+//
+// It embeds the options for the compiler proper, alongside settings that are
+// only handled by this package.
+type compileOptions struct {
+	// Must be the first field: internal tests construct CompileOptions that
+	// operate on a *compiler.Options.
+	compiler.Options
+
+	budget  *MemoryBudget
+	options []protoreflect.ExtensionType
+	key     any
 }
 
 // backend implements the compiler backend interface.
@@ -75,8 +299,13 @@ func (*backend) SelectArchetype(fd protoreflect.FieldDescriptor, prof profile.Fi
 	return thunks.SelectArchetype(fd, prof)
 }
 
+func (*backend) SelectTransformArchetype(fd protoreflect.FieldDescriptor, prof profile.Field) *compiler.Archetype {
+	return thunks.SelectTransformArchetype(fd, prof)
+}
+
 func (*backend) PopulateMethods(methods *protoiface.Methods) {
 	methods.Flags = protoiface.SupportUnmarshalDiscardUnknown
 	methods.Unmarshal = unmarshalShim
 	methods.CheckInitialized = requiredShim
+	methods.Equal = equalShim
 }
@@ -0,0 +1,563 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+	"buf.build/go/hyperpb/internal/prototest"
+)
+
+func TestMemoryBudget(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{protodesc.ToFileDescriptorProto(md.ParentFile())},
+	}
+
+	ty := hyperpb.CompileMessageDescriptor(md)
+	size := ty.RetainedSize()
+	assert.Positive(t, size)
+
+	budget := hyperpb.NewMemoryBudget(size + size/2)
+	ty, err := hyperpb.CompileFileDescriptorSet(fds, md.FullName(), hyperpb.WithMemoryBudget(budget))
+	require.NoError(t, err)
+	assert.Equal(t, ty.RetainedSize(), budget.Used())
+
+	_, err = hyperpb.CompileFileDescriptorSet(fds, md.FullName(), hyperpb.WithMemoryBudget(budget))
+	require.ErrorIs(t, err, hyperpb.ErrMemoryBudgetExceeded)
+	assert.Equal(t, ty.RetainedSize(), budget.Used())
+
+	budget.Release(ty)
+	assert.Zero(t, budget.Used())
+}
+
+func TestCompileFor(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Scalars]()
+	assert.Equal(t, (*testpb.Scalars)(nil).ProtoReflect().Descriptor(), ty.Descriptor())
+
+	// Types generated from editions files work the same way.
+	ty = hyperpb.CompileFor[*testpb.Proto2Strings]()
+	data, err := proto.Marshal(&testpb.Proto2Strings{S1: proto.String("hello")})
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.Equal(t, "hello", m.Get(ty.Descriptor().Fields().ByName("s1")).String())
+}
+
+func TestCompileAsync(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	compiles := make([]*hyperpb.Compilation, 8)
+	for i := range compiles {
+		compiles[i] = hyperpb.CompileAsync(md)
+	}
+	for _, c := range compiles {
+		<-c.Done()
+		ty, err := c.Wait()
+		require.NoError(t, err)
+		assert.Equal(t, md, ty.Descriptor())
+	}
+
+	// Compilation failures are reported by Wait instead of panicking.
+	c := hyperpb.CompileAsync(md, hyperpb.WithMemoryBudget(hyperpb.NewMemoryBudget(1)))
+	_, err := c.Wait()
+	require.ErrorIs(t, err, hyperpb.ErrMemoryBudgetExceeded)
+}
+
+func TestCompileKey(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	intern := hyperpb.WithInternStrings(true)
+
+	_, ok := hyperpb.CompileKeyOf(md)
+	assert.True(t, ok)
+	_, ok = hyperpb.CompileKeyOf(md, intern)
+	assert.False(t, ok)
+
+	k1, ok := hyperpb.CompileKeyOf(md, intern, hyperpb.WithCompileKey("interned"))
+	assert.True(t, ok)
+	k2, _ := hyperpb.CompileKeyOf(md, hyperpb.WithCompileKey("interned"), intern)
+	k3, _ := hyperpb.CompileKeyOf(md, intern, hyperpb.WithCompileKey("other"))
+	assert.Equal(t, k1, k2)
+	assert.NotEqual(t, k1, k3)
+
+	_, ok = hyperpb.CompileKeyOf(md, intern, hyperpb.WithCompileKey(nil))
+	assert.False(t, ok)
+	assert.Panics(t, func() { hyperpb.WithCompileKey([]string{"interned"}) })
+
+	ty := hyperpb.CompileMessageDescriptor(md, intern, hyperpb.WithCompileKey("interned"))
+	assert.Equal(t, md, ty.Descriptor())
+}
+
+func TestCompileAll(t *testing.T) {
+	t.Parallel()
+
+	file := (*testpb.Scalars)(nil).ProtoReflect().Descriptor().ParentFile()
+	files := new(protoregistry.Files)
+	require.NoError(t, files.RegisterFile(file))
+
+	registry, err := hyperpb.CompileAll(files, func(md protoreflect.MessageDescriptor) bool {
+		return md.Name() == "Scalars" || md.Name() == "MessageMaps"
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, registry.Len())
+
+	scalars, err := registry.FindMessageByName("hyperpb.test.Scalars")
+	require.NoError(t, err)
+	maps, err := registry.FindMessageByName("hyperpb.test.MessageMaps")
+	require.NoError(t, err)
+
+	// Both types were compiled together, so MessageMaps uses the same
+	// Scalars type as the registry does.
+	assert.Equal(t, scalars.RetainedSize(), maps.RetainedSize())
+	assert.Contains(t, slices.Collect(maps.Dependencies()), scalars)
+
+	data, err := proto.Marshal(&testpb.MessageMaps{Scalars: &testpb.Scalars{A1: 42}})
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(maps)
+	require.NoError(t, m.Unmarshal(data))
+	inner := m.Get(maps.Descriptor().Fields().ByName("scalars")).Message()
+	assert.Equal(t, int64(42), inner.Get(scalars.Descriptor().Fields().ByName("a1")).Int())
+
+	// A nil filter compiles everything, except map entries.
+	registry, err = hyperpb.CompileAll(files, nil)
+	require.NoError(t, err)
+	for ty := range registry.All() {
+		assert.False(t, ty.Descriptor().IsMapEntry())
+	}
+	assert.Greater(t, registry.Len(), 2)
+
+	// The types share one charge against a budget, which is only returned
+	// once, however many of them are released.
+	budget := hyperpb.NewMemoryBudget(0)
+	registry, err = hyperpb.CompileAll(files, nil, hyperpb.WithMemoryBudget(budget))
+	require.NoError(t, err)
+	for ty := range registry.All() {
+		assert.Equal(t, ty.RetainedSize(), budget.Used())
+	}
+	for ty := range registry.All() {
+		budget.Release(ty)
+	}
+	assert.Zero(t, budget.Used())
+}
+
+func TestUnsupported(t *testing.T) {
+	t.Parallel()
+
+	// protodesc never produces map fields with delimited values, so fake some
+	// by wrapping the descriptor.
+	md := delimitedMaps{(*testpb.MessageMaps)(nil).ProtoReflect().Descriptor()}
+	fds := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{protodesc.ToFileDescriptorProto(md.ParentFile())},
+	}
+	_, err := hyperpb.CompileFileDescriptorSet(fds, md.FullName())
+	require.NoError(t, err)
+
+	var unsupported *hyperpb.UnsupportedError
+	_, err = hyperpb.TryCompileMessageDescriptor(md)
+	require.ErrorAs(t, err, &unsupported)
+	c := hyperpb.CompileAsync(md)
+	_, asyncErr := c.Wait()
+	require.Equal(t, err, asyncErr)
+	require.Len(t, unsupported.Fields, 2)
+	assert.Equal(t, md.Fields().ByName("m1"), unsupported.Fields[0].Field)
+	assert.Equal(t, md.Fields().ByName("m2"), unsupported.Fields[1].Field)
+	assert.Contains(t, err.Error(), "hyperpb.test.MessageMaps.m1: map values with delimited encoding are not supported")
+
+	assert.PanicsWithError(t, err.Error(), func() { hyperpb.CompileMessageDescriptor(md) })
+}
+
+type delimitedMaps struct{ protoreflect.MessageDescriptor }
+
+func (m delimitedMaps) Fields() protoreflect.FieldDescriptors {
+	return delimitedMapFields{m.MessageDescriptor.Fields()}
+}
+
+type delimitedMapFields struct{ protoreflect.FieldDescriptors }
+
+func (f delimitedMapFields) Get(i int) protoreflect.FieldDescriptor {
+	fd := f.FieldDescriptors.Get(i)
+	if fd.Name() == "m1" || fd.Name() == "m2" {
+		return delimitedMap{fd}
+	}
+	return fd
+}
+
+func (f delimitedMapFields) ByName(name protoreflect.Name) protoreflect.FieldDescriptor {
+	return f.Get(f.FieldDescriptors.ByName(name).Index())
+}
+
+type delimitedMap struct{ protoreflect.FieldDescriptor }
+
+func (f delimitedMap) MapValue() protoreflect.FieldDescriptor {
+	return groupKind{f.FieldDescriptor.MapValue()}
+}
+
+type groupKind struct{ protoreflect.FieldDescriptor }
+
+func (groupKind) Kind() protoreflect.Kind { return protoreflect.GroupKind }
+
+func TestSparseNumbers(t *testing.T) {
+	t.Parallel()
+
+	// Field numbers which are far apart and mostly do not fit in the one-byte
+	// tag LUT, so the compiler selects hashed dispatch.
+	numbers := []int32{1, 2, 500000, 999999, 123456, 7777777}
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("sparse.proto"),
+		Package: proto.String("hyperpb.test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Sparse"),
+		}},
+	}
+	for _, n := range numbers {
+		fdp.MessageType[0].Field = append(fdp.MessageType[0].Field, &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(fmt.Sprintf("f%d", n)),
+			Number:   proto.Int32(n),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+			JsonName: proto.String(fmt.Sprintf("f%d", n)),
+		})
+	}
+	fd, err := protodesc.NewFile(fdp, nil)
+	require.NoError(t, err)
+	md := fd.Messages().Get(0)
+	ty := hyperpb.CompileMessageDescriptor(md)
+
+	// Encode the fields out of order, with some unknown fields mixed in.
+	var data []byte
+	for i, n := range []int32{7777777, 2, 42, 999999, 1, 500000, 123456, 8} {
+		data = protowire.AppendTag(data, protowire.Number(n), protowire.VarintType)
+		data = protowire.AppendVarint(data, uint64(i+1))
+	}
+
+	want := dynamicpb.NewMessage(md)
+	require.NoError(t, proto.Unmarshal(data, want))
+	got := hyperpb.NewMessage(ty)
+	require.NoError(t, got.Unmarshal(data))
+	prototest.Equal(t, want, got)
+}
+
+func TestCompileFileDescriptorSetBytes(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	schema, err := proto.Marshal(&descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(structpb.File_google_protobuf_struct_proto),
+			protodesc.ToFileDescriptorProto(md.ParentFile()),
+		},
+	})
+	require.NoError(t, err)
+
+	ty, err := hyperpb.CompileFileDescriptorSetBytes(schema, md.FullName())
+	require.NoError(t, err)
+	assert.Equal(t, md.FullName(), ty.Descriptor().FullName())
+
+	data, err := proto.Marshal(&testpb.Scalars{A1: 42})
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.Equal(t, int32(42), m.Get(ty.Descriptor().Fields().ByName("a1")).Interface())
+
+	_, err = hyperpb.CompileFileDescriptorSetBytes(schema, "hyperpb.test.Missing")
+	require.ErrorIs(t, err, protoregistry.NotFound)
+	_, err = hyperpb.CompileFileDescriptorSetBytes(schema, "google.protobuf.Struct.FieldsEntry")
+	require.NoError(t, err)
+}
+
+func BenchmarkCompileFileDescriptorSetBytes(b *testing.B) {
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+
+	// A large schema, of which only one small file is needed.
+	fds := new(descriptorpb.FileDescriptorSet)
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		fds.File = append(fds.File, protodesc.ToFileDescriptorProto(fd))
+		return true
+	})
+	slices.SortFunc(fds.File, func(a, b *descriptorpb.FileDescriptorProto) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	// protodesc requires dependencies to come first.
+	files, err := protodesc.NewFiles(fds)
+	require.NoError(b, err)
+	fds.File = fds.File[:0]
+	files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		fds.File = append(fds.File, protodesc.ToFileDescriptorProto(fd))
+		return true
+	})
+	schema, err := proto.Marshal(fds)
+	require.NoError(b, err)
+	b.Logf("%d files, %d bytes", len(fds.File), len(schema))
+
+	b.Run("bytes", func(b *testing.B) {
+		b.SetBytes(int64(len(schema)))
+		for b.Loop() {
+			_, err := hyperpb.CompileFileDescriptorSetBytes(schema, md.FullName())
+			require.NoError(b, err)
+		}
+	})
+	b.Run("set", func(b *testing.B) {
+		b.SetBytes(int64(len(schema)))
+		for b.Loop() {
+			fds := new(descriptorpb.FileDescriptorSet)
+			require.NoError(b, proto.Unmarshal(schema, fds))
+			_, err := hyperpb.CompileFileDescriptorSet(fds, md.FullName())
+			require.NoError(b, err)
+		}
+	})
+}
+
+func TestCachedOptions(t *testing.T) {
+	t.Parallel()
+
+	// Options extensions that are not registered globally end up in the
+	// unknown fields of the options messages.
+	msgOpts := new(descriptorpb.MessageOptions)
+	msgOpts.ProtoReflect().SetUnknown(protowire.AppendString(
+		protowire.AppendTag(nil, 50000, protowire.BytesType), "/v1/widgets"))
+	fieldOpts := new(descriptorpb.FieldOptions)
+	fieldOpts.ProtoReflect().SetUnknown(protowire.AppendVarint(
+		protowire.AppendTag(nil, 50001, protowire.VarintType), 1))
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("options.proto"),
+		Package:    proto.String("hyperpb.test"),
+		Dependency: []string{"google/protobuf/descriptor.proto"},
+		Syntax:     proto.String("proto2"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:    proto.String("Widget"),
+			Options: msgOpts,
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:    proto.String("id"),
+					Number:  proto.Int32(1),
+					Label:   descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:    descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+					Options: fieldOpts,
+				},
+				{
+					Name:   proto.String("name"),
+					Number: proto.Int32(2),
+					Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				},
+			},
+		}},
+		Extension: []*descriptorpb.FieldDescriptorProto{
+			{
+				Name:     proto.String("route"),
+				Number:   proto.Int32(50000),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Extendee: proto.String(".google.protobuf.MessageOptions"),
+			},
+			{
+				Name:     proto.String("key"),
+				Number:   proto.Int32(50001),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum(),
+				Extendee: proto.String(".google.protobuf.FieldOptions"),
+			},
+		},
+	}
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	route := dynamicpb.NewExtensionType(fd.Extensions().ByName("route"))
+	key := dynamicpb.NewExtensionType(fd.Extensions().ByName("key"))
+
+	ty := hyperpb.CompileMessageDescriptor(fd.Messages().Get(0), hyperpb.WithCachedOptions(route, key))
+
+	v, ok := ty.Option("hyperpb.test.route")
+	require.True(t, ok)
+	assert.Equal(t, "/v1/widgets", v.String())
+	_, ok = ty.Option("hyperpb.test.key")
+	assert.False(t, ok)
+
+	fields := ty.Fields()
+	v, ok = fields[0].Option("hyperpb.test.key")
+	require.True(t, ok)
+	assert.True(t, v.Bool())
+	_, ok = fields[1].Option("hyperpb.test.key")
+	assert.False(t, ok)
+
+	// Options that were not requested are not available.
+	ty = hyperpb.CompileMessageDescriptor(fd.Messages().Get(0), hyperpb.WithCachedOptions(key))
+	_, ok = ty.Option("hyperpb.test.route")
+	assert.False(t, ok)
+	_, ok = ty.Fields()[0].Option("hyperpb.test.key")
+	assert.True(t, ok)
+}
+
+func TestElideDeprecatedFields(t *testing.T) {
+	t.Parallel()
+
+	deprecated := &descriptorpb.FieldOptions{Deprecated: proto.Bool(true)}
+	field := func(name string, n int32, label descriptorpb.FieldDescriptorProto_Label, opts *descriptorpb.FieldOptions) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(n),
+			Label:    label.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+			JsonName: proto.String(name),
+			Options:  opts,
+		}
+	}
+	const (
+		optional = descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+		repeated = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	)
+	sub := field("sub", 4, optional, deprecated)
+	sub.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+	sub.TypeName = proto.String(".hyperpb.test.Deprecated")
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("deprecated.proto"),
+		Package: proto.String("hyperpb.test"),
+		Syntax:  proto.String("proto2"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Deprecated"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("live", 1, optional, nil),
+				field("old", 2, optional, deprecated),
+				field("olds", 3, repeated, deprecated),
+				sub,
+			},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	md := fd.Messages().Get(0)
+	fields := md.Fields()
+
+	ty := hyperpb.CompileMessageDescriptor(md)
+	assert.Empty(t, ty.ElidedFields())
+
+	ty = hyperpb.CompileMessageDescriptor(md, hyperpb.WithElideDeprecatedFields(true))
+	assert.Equal(t, []protoreflect.FieldDescriptor{
+		fields.ByName("old"), fields.ByName("olds"), fields.ByName("sub"),
+	}, ty.ElidedFields())
+
+	var data []byte
+	for n := range protowire.Number(4) {
+		data = protowire.AppendTag(data, n+1, protowire.VarintType)
+		data = protowire.AppendVarint(data, uint64(n+1))
+	}
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.Equal(t, int64(1), m.Get(fields.ByName("live")).Int())
+	for _, name := range []protoreflect.Name{"old", "olds", "sub"} {
+		assert.False(t, m.Has(fields.ByName(name)), name)
+	}
+	assert.Equal(t, int64(0), m.Get(fields.ByName("old")).Int())
+	assert.Equal(t, 0, m.Get(fields.ByName("olds")).List().Len())
+	assert.False(t, m.Get(fields.ByName("sub")).Message().Has(fields.ByName("live")))
+	assert.Equal(t, data[2:], []byte(m.GetUnknown()))
+}
+
+func TestDedupParsers(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, number int32, ty descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   ty.Enum(),
+		}
+	}
+	shape := func(name string, number int32) *descriptorpb.DescriptorProto {
+		ids := field("ids", 3, descriptorpb.FieldDescriptorProto_TYPE_INT64)
+		ids.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		return &descriptorpb.DescriptorProto{
+			Name: proto.String(name),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("x", 1, descriptorpb.FieldDescriptorProto_TYPE_INT32),
+				field("s", number, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				ids,
+			},
+		}
+	}
+	sub := func(name string, number int32, typeName string) *descriptorpb.FieldDescriptorProto {
+		fdp := field(name, number, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE)
+		fdp.TypeName = proto.String(typeName)
+		return fdp
+	}
+
+	// A and B have the same shape; C differs from them in one field number.
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("dedup.proto"),
+		Package: proto.String("hyperpb.test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			shape("A", 2), shape("B", 2), shape("C", 4),
+			{
+				Name: proto.String("Pair"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					sub("a", 1, ".hyperpb.test.A"),
+					sub("b", 2, ".hyperpb.test.B"),
+					sub("c", 3, ".hyperpb.test.C"),
+				},
+			},
+		},
+	}, nil)
+	require.NoError(t, err)
+	md := fd.Messages().ByName("Pair")
+	fields := md.Fields()
+
+	ty := hyperpb.CompileMessageDescriptor(md)
+
+	// Messages parsed with a shared parser still have their own type.
+	var data []byte
+	for n := range protowire.Number(3) {
+		inner := protowire.AppendTag(nil, 1, protowire.VarintType)
+		inner = protowire.AppendVarint(inner, uint64(n+1))
+		data = protowire.AppendTag(data, n+1, protowire.BytesType)
+		data = protowire.AppendBytes(data, inner)
+	}
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	types := make(map[protoreflect.Name]*hyperpb.MessageType)
+	for i, name := range []protoreflect.Name{"a", "b", "c"} {
+		sub := m.Get(fields.ByName(name)).Message().(*hyperpb.Message)
+		types[name] = sub.HyperType()
+		assert.Equal(t, fd.Messages().ByName(protoreflect.Name(strings.ToUpper(string(name)))), sub.Descriptor())
+		assert.Equal(t, int64(i+1), sub.Get(sub.Descriptor().Fields().ByName("x")).Int())
+	}
+	assert.Same(t, hyperpb.ParserOf(types["a"]), hyperpb.ParserOf(types["b"]))
+	assert.NotSame(t, hyperpb.ParserOf(types["a"]), hyperpb.ParserOf(types["c"]))
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"buf.build/go/hyperpb/internal/tdp"
+)
+
+// optionCache is the value of [tdp.Aux].Options.
+type optionCache struct {
+	message options
+	fields  []options // Indexed by field index.
+}
+
+// options is the set of cached options of a single descriptor that are set.
+type options map[protoreflect.FullName]protoreflect.Value
+
+// Option returns the value of the custom message option with the given full
+// name on this type's descriptor.
+//
+// Only options requested with [WithCachedOptions] when this type was compiled
+// are available; returns false if the option was not requested, or is not set.
+func (t *MessageType) Option(name protoreflect.FullName) (protoreflect.Value, bool) {
+	c, _ := t.impl.Options.(*optionCache)
+	if c == nil {
+		return protoreflect.Value{}, false
+	}
+	v, ok := c.message[name]
+	return v, ok
+}
+
+// Option returns the value of the custom field option with the given full
+// name on this field's descriptor, like [MessageType.Option].
+func (f *FieldInfo) Option(name protoreflect.FullName) (protoreflect.Value, bool) {
+	c, _ := f.ty.Options.(*optionCache)
+	if c == nil || c.fields[f.Index] == nil {
+		return protoreflect.Value{}, false
+	}
+	v, ok := c.fields[f.Index][name]
+	return v, ok
+}
+
+// cacheOptions populates the option caches for every type in lib.
+func cacheOptions(lib *tdp.Library, xts []protoreflect.ExtensionType) error {
+	if len(xts) == 0 {
+		return nil
+	}
+
+	types := new(protoregistry.Types)
+	for _, xt := range xts {
+		if err := types.RegisterExtension(xt); err != nil {
+			return fmt.Errorf("hyperpb: cannot cache option %s: %w", xt.TypeDescriptor().FullName(), err)
+		}
+	}
+
+	for _, ty := range lib.Types {
+		c := &optionCache{fields: make([]options, len(ty.FieldDescriptors))}
+		var err error
+		if c.message, err = readOptions(ty.Descriptor.Options(), types); err != nil {
+			return err
+		}
+		for i, fd := range ty.FieldDescriptors {
+			if c.fields[i], err = readOptions(fd.Options(), types); err != nil {
+				return err
+			}
+		}
+		ty.Options = c
+	}
+	return nil
+}
+
+// readOptions extracts the values of the extensions in types that are set in
+// opts.
+//
+// opts is round-tripped through the wire format, because extensions that were
+// not known when the descriptor was built are stored as unknown fields, and
+// known ones may use a different Go type than the ones we were given.
+func readOptions(opts proto.Message, types *protoregistry.Types) (options, error) {
+	if opts == nil || !opts.ProtoReflect().IsValid() {
+		return nil, nil
+	}
+
+	data, err := proto.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+	parsed := opts.ProtoReflect().New()
+	if err := (proto.UnmarshalOptions{Resolver: types}).Unmarshal(data, parsed.Interface()); err != nil {
+		return nil, err
+	}
+
+	var out options
+	parsed.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !fd.IsExtension() {
+			return true
+		}
+		if out == nil {
+			out = make(options)
+		}
+		out[fd.FullName()] = v
+		return true
+	})
+	return out, nil
+}
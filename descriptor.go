@@ -0,0 +1,206 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Field numbers in descriptor.proto.
+const (
+	fieldSetFile = 1
+
+	fieldFileName       = 1
+	fieldFilePackage    = 2
+	fieldFileDependency = 3
+	fieldFileMessages   = 4
+	fieldFileExtensions = 7
+
+	fieldMessageName       = 1
+	fieldMessageNested     = 3
+	fieldMessageExtensions = 6
+)
+
+var fileDescriptorSetType = sync.OnceValue(func() *MessageType {
+	return CompileMessageDescriptor((*descriptorpb.FileDescriptorSet)(nil).ProtoReflect().Descriptor())
+})
+
+// FileDescriptorSetType returns a compiled type for
+// google.protobuf.FileDescriptorSet, which can be used to parse descriptor sets
+// with hyperpb.
+//
+// The descriptors of this type and the types of its fields are those of
+// package descriptorpb, so messages of this type can be merged into
+// descriptorpb messages with [proto.Merge].
+func FileDescriptorSetType() *MessageType {
+	return fileDescriptorSetType()
+}
+
+// CompileFileDescriptorSetBytes is like [CompileFileDescriptorSet], but it
+// takes an encoded google.protobuf.FileDescriptorSet, which it parses with
+// hyperpb.
+//
+// Only the files that are needed to compile the message are decoded into
+// descriptors: the file that defines it, files that declare extensions, and
+// their transitive dependencies. For large descriptor sets that contain many
+// unrelated files, this is faster than decoding the whole set and calling
+// [CompileFileDescriptorSet]; how much faster depends on the proportion of
+// files that are needed.
+func CompileFileDescriptorSetBytes(schema []byte, messageName protoreflect.FullName, options ...CompileOption) (*MessageType, error) {
+	set := NewMessage(FileDescriptorSetType())
+	if err := set.Unmarshal(schema); err != nil {
+		return nil, err
+	}
+
+	// Slice out the encoding of each file, so that the files that are needed
+	// can be unmarshaled directly, rather than converted with reflection.
+	var raw [][]byte
+	for b := schema; len(b) > 0; {
+		n, typ, m := protowire.ConsumeField(b)
+		if m < 0 {
+			return nil, protowire.ParseError(m)
+		}
+		if n == fieldSetFile && typ == protowire.BytesType {
+			_, _, k := protowire.ConsumeTag(b)
+			v, _ := protowire.ConsumeBytes(b[k:m])
+			raw = append(raw, v)
+		}
+		b = b[m:]
+	}
+
+	files := set.Get(fieldByNumber(set, fieldSetFile)).List()
+	if files.Len() != len(raw) {
+		return nil, fmt.Errorf("hyperpb: malformed FileDescriptorSet")
+	}
+	byName := make(map[string]int, files.Len())
+	var roots []int
+	found := false
+	for i := range files.Len() {
+		file := files.Get(i).Message()
+		byName[getString(file, fieldFileName)] = i
+
+		switch {
+		case !found && definesMessage(file, string(messageName)):
+			found = true
+			roots = append(roots, i)
+		case hasExtensions(file):
+			roots = append(roots, i)
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("hyperpb: %s: %w", messageName, protoregistry.NotFound)
+	}
+
+	// Convert the roots and their transitive dependencies, in dependency
+	// order.
+	fds := new(descriptorpb.FileDescriptorSet)
+	seen := make([]bool, len(raw))
+	var visit func(int) error
+	visit = func(i int) error {
+		if seen[i] {
+			return nil
+		}
+		seen[i] = true
+
+		file := files.Get(i).Message()
+		deps := file.Get(fieldByNumber(file, fieldFileDependency)).List()
+		for j := range deps.Len() {
+			// Missing dependencies are diagnosed by protodesc.
+			if dep, ok := byName[deps.Get(j).String()]; ok {
+				if err := visit(dep); err != nil {
+					return err
+				}
+			}
+		}
+
+		fdp := new(descriptorpb.FileDescriptorProto)
+		if err := proto.Unmarshal(raw[i], fdp); err != nil {
+			return err
+		}
+		fds.File = append(fds.File, fdp)
+		return nil
+	}
+	for _, i := range roots {
+		if err := visit(i); err != nil {
+			return nil, err
+		}
+	}
+
+	return CompileFileDescriptorSet(fds, messageName, options...)
+}
+
+// definesMessage returns whether file defines a message with the given name.
+func definesMessage(file protoreflect.Message, name string) bool {
+	if pkg := getString(file, fieldFilePackage); pkg != "" {
+		rest, ok := strings.CutPrefix(name, pkg+".")
+		if !ok {
+			return false
+		}
+		name = rest
+	}
+
+	var search func(protoreflect.List, string) bool
+	search = func(messages protoreflect.List, name string) bool {
+		for i := range messages.Len() {
+			m := messages.Get(i).Message()
+			n := getString(m, fieldMessageName)
+			if n == name {
+				return true
+			}
+			if rest, ok := strings.CutPrefix(name, n+"."); ok &&
+				search(m.Get(fieldByNumber(m, fieldMessageNested)).List(), rest) {
+				return true
+			}
+		}
+		return false
+	}
+	return search(file.Get(fieldByNumber(file, fieldFileMessages)).List(), name)
+}
+
+// hasExtensions returns whether file declares any extensions.
+func hasExtensions(file protoreflect.Message) bool {
+	if file.Get(fieldByNumber(file, fieldFileExtensions)).List().Len() > 0 {
+		return true
+	}
+
+	var search func(protoreflect.List) bool
+	search = func(messages protoreflect.List) bool {
+		for i := range messages.Len() {
+			m := messages.Get(i).Message()
+			if m.Get(fieldByNumber(m, fieldMessageExtensions)).List().Len() > 0 ||
+				search(m.Get(fieldByNumber(m, fieldMessageNested)).List()) {
+				return true
+			}
+		}
+		return false
+	}
+	return search(file.Get(fieldByNumber(file, fieldFileMessages)).List())
+}
+
+func fieldByNumber(m protoreflect.Message, n protoreflect.FieldNumber) protoreflect.FieldDescriptor {
+	return m.Descriptor().Fields().ByNumber(n)
+}
+
+func getString(m protoreflect.Message, n protoreflect.FieldNumber) string {
+	return m.Get(fieldByNumber(m, n)).String()
+}
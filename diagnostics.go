@@ -0,0 +1,106 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp"
+)
+
+// Diagnostic is a pattern in the schema of a message that defeats one of
+// hyperpb's parser optimizations. See [MessageType.Diagnostics].
+type Diagnostic struct {
+	Kind DiagnosticKind
+
+	// The message whose schema this diagnostic is about.
+	Message protoreflect.MessageDescriptor
+	// The oneof this diagnostic is about, for [DiagnosticLargeOneof].
+	Oneof protoreflect.OneofDescriptor
+
+	// A human-readable description of the problem.
+	Reason string
+}
+
+// String implements [fmt.Stringer].
+func (d Diagnostic) String() string {
+	name := d.Message.FullName()
+	if d.Oneof != nil {
+		name = d.Oneof.FullName()
+	}
+	return fmt.Sprintf("%v: %s: %s", d.Kind, name, d.Reason)
+}
+
+// DiagnosticKind is the kind of a [Diagnostic].
+type DiagnosticKind int
+
+const (
+	// DiagnosticSparseNumbers is reported for messages whose field numbers are
+	// so large and sparse that the parser cannot predict which field comes
+	// next by walking them in order, and falls back to hashing tags instead.
+	// Renumbering the fields to be small and dense, or declaring them in the
+	// order they are usually encoded, avoids this.
+	DiagnosticSparseNumbers = DiagnosticKind(tdp.DiagnosticSparseNumbers)
+
+	// DiagnosticDeepNesting is reported for messages that contain a long
+	// chain of distinct nested message types, counting every type in a
+	// recursive cycle. Each level of nesting costs a frame on the parser's
+	// stack, and an indirection when accessing the innermost fields.
+	DiagnosticDeepNesting = DiagnosticKind(tdp.DiagnosticDeepNesting)
+
+	// DiagnosticLargeOneof is reported for oneofs with thousands of members.
+	// Each member needs its own field parser, so the parser tables for the
+	// message grow with the oneof, even though at most one member is set.
+	DiagnosticLargeOneof = DiagnosticKind(tdp.DiagnosticLargeOneof)
+)
+
+// String implements [fmt.Stringer].
+func (k DiagnosticKind) String() string {
+	switch k {
+	case DiagnosticSparseNumbers:
+		return "sparse-numbers"
+	case DiagnosticDeepNesting:
+		return "deep-nesting"
+	case DiagnosticLargeOneof:
+		return "large-oneof"
+	default:
+		return fmt.Sprintf("DiagnosticKind(%d)", int(k))
+	}
+}
+
+// Diagnostics returns the patterns in the schema of this type that defeat
+// hyperpb's parser optimizations, as found by the compiler. Messages of such
+// types still parse correctly, but more slowly than they would otherwise;
+// this is intended for finding hot types whose schemas are worth changing.
+//
+// It does not include diagnostics for other types, such as those of message
+// fields; use [MessageType.Dependencies] to find those.
+func (t *MessageType) Diagnostics() []Diagnostic {
+	if len(t.impl.Diagnostics) == 0 {
+		return nil
+	}
+	diags := make([]Diagnostic, len(t.impl.Diagnostics))
+	for i, d := range t.impl.Diagnostics {
+		diags[i] = Diagnostic{
+			Kind:    DiagnosticKind(d.Kind),
+			Message: t.Descriptor(),
+			Oneof:   d.Oneof,
+			Reason:  d.Reason,
+		}
+	}
+	return diags
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, number int32) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+		}
+	}
+
+	sparse := &descriptorpb.DescriptorProto{
+		Name:  proto.String("Sparse"),
+		Field: []*descriptorpb.FieldDescriptorProto{field("a", 1000), field("b", 100000), field("c", 5000000)},
+	}
+
+	oneof := &descriptorpb.DescriptorProto{
+		Name:      proto.String("Oneof"),
+		OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("kind")}},
+	}
+	for i := range 1001 {
+		fdp := field(fmt.Sprintf("f%d", i), int32(i+1))
+		fdp.OneofIndex = proto.Int32(0)
+		oneof.Field = append(oneof.Field, fdp)
+	}
+
+	// Nested0 contains Nested1, which contains Nested2, and so on.
+	const depth = 40
+	var nested []*descriptorpb.DescriptorProto
+	for i := range depth {
+		msg := &descriptorpb.DescriptorProto{Name: proto.String(fmt.Sprintf("Nested%d", i))}
+		if i+1 < depth {
+			fdp := field("next", 1)
+			fdp.Type = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum()
+			fdp.TypeName = proto.String(fmt.Sprintf(".hyperpb.test.Nested%d", i+1))
+			msg.Field = append(msg.Field, fdp)
+		}
+		nested = append(nested, msg)
+	}
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("diagnostics.proto"),
+		Package:     proto.String("hyperpb.test"),
+		Syntax:      proto.String("proto3"),
+		MessageType: append([]*descriptorpb.DescriptorProto{sparse, oneof}, nested...),
+	}, nil)
+	require.NoError(t, err)
+
+	diagnose := func(name string) []hyperpb.Diagnostic {
+		md := fd.Messages().ByName(protoreflect.Name(name))
+		return hyperpb.CompileMessageDescriptor(md).Diagnostics()
+	}
+
+	diags := diagnose("Sparse")
+	require.Len(t, diags, 1)
+	assert.Equal(t, hyperpb.DiagnosticSparseNumbers, diags[0].Kind)
+	assert.Equal(t, protoreflect.FullName("hyperpb.test.Sparse"), diags[0].Message.FullName())
+
+	diags = diagnose("Oneof")
+	require.Len(t, diags, 1)
+	assert.Equal(t, hyperpb.DiagnosticLargeOneof, diags[0].Kind)
+	assert.Equal(t, protoreflect.Name("kind"), diags[0].Oneof.Name())
+	assert.Contains(t, diags[0].String(), "large-oneof: hyperpb.test.Oneof.kind: ")
+
+	diags = diagnose("Nested0")
+	require.Len(t, diags, 1)
+	assert.Equal(t, hyperpb.DiagnosticDeepNesting, diags[0].Kind)
+	assert.Empty(t, diagnose(fmt.Sprintf("Nested%d", depth-32)))
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	assert.Empty(t, ty.Diagnostics())
+}
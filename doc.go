@@ -86,7 +86,10 @@
 //
 // Mutation is currently not supported; any operation which would mutate an
 // already-parsed message will panic. Which methods of [Message] panic
-// is included in the documentation.
+// is included in the documentation. The exceptions are appending to repeated
+// scalar fields, through the list returned by [Message.Mutable], and replacing
+// or clearing unknown fields with [Message.SetUnknown], except in messages
+// parsed with [WithDedupMessages].
 //
 // # Memory Reuse
 //
@@ -123,7 +126,9 @@
 // `hyperpb` supports online PGO for squeezing extra performance out of the parser
 // by optimizing the parser with knowledge of what the average message actually
 // looks like. For example, using PGO, the parser can predict the expected size of
-// repeated fields and allocate more intelligently.
+// repeated fields and allocate more intelligently, and store maps with 32-bit
+// integer keys and scalar values in an array indexed by key, rather than a hash
+// table, when their keys are observed to be small, non-negative and dense.
 //
 // For example, suppose you have a corpus of messages for a particular type. You
 // can build an optimized type, using that corpus as the profile, using
@@ -155,9 +160,11 @@
 // implement mutation of parsed messages, however.
 //
 // hyperpb is currently only supported on 64-bit x86 and ARM targets (Go calls
-// these amd64 and arm64). The library will not build on other architectures,
-// and PRs to add new architectures without a way to run tests for them in CI will
-// be rejected.
+// these amd64 and arm64), and on WebAssembly (wasm). The library will not build
+// on other architectures, and PRs to add new architectures without a way to run
+// tests for them in CI will be rejected. Because the parser assumes
+// little-endian, it never builds on big-endian architectures, even if the
+// build restriction is overridden.
 //
 // [the UPB project]: https://github.com/protocolbuffers/protobuf/tree/main/upb
 package hyperpb
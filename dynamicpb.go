@@ -0,0 +1,33 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// AsDynamic copies m into a new [dynamicpb.Message] with the same descriptor,
+// including extensions and unknown fields.
+//
+// This is intended for interoperating with code that type-asserts for
+// *dynamicpb.Message. Because dynamicpb messages own their storage, the
+// conversion is not lazy: it copies every field of m, so it should be avoided
+// on hot paths.
+func AsDynamic(m *Message) *dynamicpb.Message {
+	dm := dynamicpb.NewMessage(m.Descriptor())
+	proto.Merge(dm, m)
+	return dm
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp/vm"
+)
+
+// ErrUnknownEnumValue is wrapped by the errors returned by parses with
+// [WithRejectUnknownEnums] that encounter enum values that are not declared by
+// their enum.
+var ErrUnknownEnumValue = errors.New("hyperpb: unknown enum value")
+
+// WithRejectUnknownEnums sets whether parsing fails if the input contains a
+// value for an enum field that its enum does not declare, for strict endpoints
+// that should not accept values from newer versions of a schema.
+//
+// By default, such values of open enums, the default in proto3 and editions,
+// are kept and reported by [Message.Get], and are emitted as numbers when
+// converting to JSON. hyperpb does not distinguish closed enums from open
+// ones, so values of closed enums are also kept, rather than being treated as
+// unknown fields.
+//
+// The check happens after the rest of the input has been parsed, so failures
+// are reported as occurring at the end of the input. It only visits the fields
+// that may contain enums, so parsing types without enum fields costs nothing
+// extra. Unknown enum values in
+// unknown fields and in extensions that the type was not compiled with are
+// not checked.
+func WithRejectUnknownEnums(reject bool) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) { opts.RejectUnknownEnums = reject }}
+}
+
+// checkEnums returns an error if m or any of its submessages contains an
+// undeclared enum value.
+//
+// Only the fields that the compiler found may contain enums are visited, so
+// this does nothing for types without any.
+func checkEnums(m *Message) error {
+	ty := m.impl.Type()
+	for _, idx := range ty.Enums {
+		fd := ty.FieldDescriptors[idx]
+		if !m.Has(fd) {
+			continue
+		}
+
+		var err error
+		v := m.Get(fd)
+		switch {
+		case fd.IsMap():
+			vd := fd.MapValue()
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				err = checkEnumValue(vd, v)
+				return err == nil
+			})
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = checkEnumValue(fd, list.Get(i))
+			}
+		default:
+			err = checkEnumValue(fd, v)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEnumValue is like [checkEnums], for a single value of fd.
+func checkEnumValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) error {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if n := v.Enum(); fd.Enum().Values().ByNumber(n) == nil {
+			return fmt.Errorf("%w %d for %s", ErrUnknownEnumValue, n, fd.FullName())
+		}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if m, ok := v.Message().Interface().(*Message); ok {
+			return checkEnums(m)
+		}
+	}
+	return nil
+}
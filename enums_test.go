@@ -0,0 +1,80 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/typepb"
+
+	"buf.build/go/hyperpb"
+)
+
+func TestRejectUnknownEnums(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*typepb.Type]()
+	known, err := proto.Marshal(&typepb.Type{
+		Name:   "known",
+		Fields: []*typepb.Field{{Kind: typepb.Field_TYPE_INT32}},
+		Syntax: typepb.Syntax_SYNTAX_PROTO3,
+	})
+	require.NoError(t, err)
+	unknown, err := proto.Marshal(&typepb.Type{
+		Name:   "unknown",
+		Fields: []*typepb.Field{{Kind: typepb.Field_TYPE_INT32}, {Kind: 99}},
+	})
+	require.NoError(t, err)
+
+	// Unknown values of open enums are kept by default, and are emitted as
+	// numbers in JSON.
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(unknown))
+	json, err := protojson.Marshal(m)
+	require.NoError(t, err)
+	assert.Contains(t, string(json), `"kind":99`)
+	assert.Contains(t, string(json), `"kind":"TYPE_INT32"`)
+
+	strict := hyperpb.WithRejectUnknownEnums(true)
+	m = hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(known, strict))
+
+	m = hyperpb.NewMessage(ty)
+	err = m.Unmarshal(unknown, strict)
+	require.ErrorIs(t, err, hyperpb.ErrUnknownEnumValue)
+	assert.Contains(t, err.Error(), "99 for google.protobuf.Field.kind")
+
+	m = hyperpb.NewMessage(ty)
+	res := m.TryUnmarshal(unknown, strict)
+	require.ErrorIs(t, res.Err, hyperpb.ErrUnknownEnumValue)
+	assert.Equal(t, len(unknown), res.Offset)
+
+	msgs, err := new(hyperpb.Shared).UnmarshalBatch(ty, [][]byte{known, unknown, known}, strict)
+	require.ErrorIs(t, err, hyperpb.ErrUnknownEnumValue)
+	assert.Len(t, msgs, 1)
+
+	// Only fields that may contain enums are checked: Type.fields, whose
+	// messages have enum fields, and Type.syntax, but not Type.name.
+	fields := ty.Descriptor().Fields()
+	assert.Equal(t, []int32{
+		int32(fields.ByName("fields").Index()),
+		int32(fields.ByName("syntax").Index()),
+	}, hyperpb.EnumFieldsOf(ty))
+	assert.Nil(t, hyperpb.EnumFieldsOf(hyperpb.CompileFor[*typepb.Option]()))
+}
@@ -0,0 +1,192 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/maps"
+)
+
+// Equal reports whether two messages are equal, with the same semantics as
+// [proto.Equal]: they must have the same descriptor, the same populated fields,
+// including extensions, with equal values, and the same unknown fields.
+//
+// Unlike proto.Equal in general, map fields whose values are messages are
+// compared directly on their parsed representations, without wrapping each
+// entry in a [protoreflect.Value]. proto.Equal uses this function when both
+// of its arguments are [*Message]s.
+func Equal(a, b *Message) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	return equalMessage(a, b, nil)
+}
+
+// EqualFields is like [Equal], but it only compares the fields named by the
+// paths in mask, ignoring all other fields and unknown fields. This can be used
+// to compare a projection of two messages.
+//
+// As with google.protobuf.FieldMask, each path is a sequence of field names
+// separated by dots, where every field but the last is a singular message
+// field. A field that is unset in one message and set to an empty message in
+// the other is treated as equal when comparing fields inside of it.
+//
+// Panics if a path does not name a field of a's type.
+func EqualFields(a, b *Message, mask *fieldmaskpb.FieldMask) bool {
+	if !a.IsValid() || !b.IsValid() {
+		return a.IsValid() == b.IsValid()
+	}
+	return equalMessage(a, b, newFieldMask(a.Descriptor(), mask.GetPaths()))
+}
+
+// equalShim implements [protoiface.Methods].Equal.
+func equalShim(in protoiface.EqualInput) protoiface.EqualOutput {
+	return protoiface.EqualOutput{Equal: equalMessage(in.MessageA, in.MessageB, nil)}
+}
+
+// fieldMask is a parsed google.protobuf.FieldMask. Fields which map to nil
+// are compared in their entirety.
+type fieldMask map[protoreflect.FieldDescriptor]fieldMask
+
+// newFieldMask parses the given paths relative to md.
+func newFieldMask(md protoreflect.MessageDescriptor, paths []string) fieldMask {
+	mask := fieldMask{}
+	for _, path := range paths {
+		node, desc := mask, md
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			fd := desc.Fields().ByName(protoreflect.Name(name))
+			if fd == nil {
+				panic(fmt.Sprintf("hyperpb: invalid field mask path %q: %s has no field %q", path, desc.FullName(), name))
+			}
+			if i == len(names)-1 {
+				node[fd] = nil
+				break
+			}
+			if fd.Message() == nil || fd.Cardinality() == protoreflect.Repeated {
+				panic(fmt.Sprintf("hyperpb: invalid field mask path %q: %s is not a singular message field", path, fd.FullName()))
+			}
+
+			next, ok := node[fd]
+			if ok && next == nil {
+				break // The whole field is already being compared.
+			}
+			if next == nil {
+				next = fieldMask{}
+				node[fd] = next
+			}
+			node, desc = next, fd.Message()
+		}
+	}
+	return mask
+}
+
+// equalMessage compares two messages, restricted to the fields in mask if it
+// is not nil.
+func equalMessage(a, b protoreflect.Message, mask fieldMask) bool {
+	if a.Descriptor() != b.Descriptor() {
+		return false
+	}
+
+	if mask != nil {
+		for fd, sub := range mask {
+			if sub != nil {
+				// Unset message fields are returned as empty messages.
+				if !equalMessage(a.Get(fd).Message(), b.Get(fd).Message(), sub) {
+					return false
+				}
+				continue
+			}
+			if a.Has(fd) != b.Has(fd) || !equalField(fd, a.Get(fd), b.Get(fd)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	na := 0
+	equal := true
+	a.Range(func(fd protoreflect.FieldDescriptor, va protoreflect.Value) bool {
+		na++
+		equal = b.Has(fd) && equalField(fd, va, b.Get(fd))
+		return equal
+	})
+	if !equal {
+		return false
+	}
+	nb := 0
+	b.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		nb++
+		return true
+	})
+	return na == nb && bytes.Equal(a.GetUnknown(), b.GetUnknown())
+}
+
+// equalField compares two values of the field fd.
+func equalField(fd protoreflect.FieldDescriptor, a, b protoreflect.Value) bool {
+	switch {
+	case fd.IsMap():
+		if fd.MapValue().Message() == nil {
+			return a.Equal(b)
+		}
+		if equal, ok := maps.EqualMessages(a.Map(), b.Map(), equalDynamic); ok {
+			return equal
+		}
+		ma, mb := a.Map(), b.Map()
+		if ma.Len() != mb.Len() {
+			return false
+		}
+		equal := true
+		ma.Range(func(k protoreflect.MapKey, va protoreflect.Value) bool {
+			equal = mb.Has(k) && equalMessage(va.Message(), mb.Get(k).Message(), nil)
+			return equal
+		})
+		return equal
+
+	case fd.IsList():
+		if fd.Message() == nil {
+			return a.Equal(b)
+		}
+		la, lb := a.List(), b.List()
+		if la.Len() != lb.Len() {
+			return false
+		}
+		for i := range la.Len() {
+			if !equalMessage(la.Get(i).Message(), lb.Get(i).Message(), nil) {
+				return false
+			}
+		}
+		return true
+
+	case fd.Message() != nil:
+		return equalMessage(a.Message(), b.Message(), nil)
+
+	default:
+		return a.Equal(b)
+	}
+}
+
+// equalDynamic compares two values of a map<K, M> field.
+func equalDynamic(a, b *dynamic.Message) bool {
+	return equalMessage(wrapMessage(a), wrapMessage(b), nil)
+}
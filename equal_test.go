@@ -0,0 +1,124 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.MessageMaps]()
+	leaf := func(a1 int32) *testpb.MessageMaps {
+		return &testpb.MessageMaps{Scalars: &testpb.Scalars{A1: a1}}
+	}
+	base := func() *testpb.MessageMaps {
+		return &testpb.MessageMaps{
+			Scalars: &testpb.Scalars{A1: 1, A14: "x", A12: math.NaN()},
+			M1:      map[int32]*testpb.MessageMaps{1: leaf(1), -2: {M4: map[uint64]*testpb.MessageMaps{3: leaf(3)}}},
+			M4:      map[uint64]*testpb.MessageMaps{5: {}},
+			Mc:      map[string]*testpb.MessageMaps{"a": leaf(4), "b": {Mc: map[string]*testpb.MessageMaps{"c": leaf(5)}}},
+		}
+	}
+	parse := func(m *testpb.MessageMaps) *hyperpb.Message {
+		data, err := proto.Marshal(m)
+		require.NoError(t, err)
+		msg := hyperpb.NewMessage(ty)
+		require.NoError(t, msg.Unmarshal(data))
+		return msg
+	}
+
+	variants := []func(*testpb.MessageMaps){
+		func(*testpb.MessageMaps) {},
+		func(m *testpb.MessageMaps) { m.M1[1].Scalars.A1 = 2 },
+		func(m *testpb.MessageMaps) { m.M1[2] = leaf(1) },
+		func(m *testpb.MessageMaps) { delete(m.M4, 5) },
+		func(m *testpb.MessageMaps) { m.Mc["b"].Mc["c"] = leaf(6) },
+		func(m *testpb.MessageMaps) { m.Mc["d"] = m.Mc["b"]; delete(m.Mc, "b") },
+		func(m *testpb.MessageMaps) { m.Scalars.A12 = 0 },
+		func(m *testpb.MessageMaps) { m.M2 = map[int64]*testpb.MessageMaps{0: {}} },
+	}
+	for i, f := range variants {
+		for j, g := range variants {
+			x, y := base(), base()
+			f(x)
+			g(y)
+			want := proto.Equal(x, y)
+			assert.Equal(t, i == j, want, "%d, %d", i, j)
+
+			hx, hy := parse(x), parse(y)
+			assert.Equal(t, want, hyperpb.Equal(hx, hy), "%d, %d", i, j)
+			assert.Equal(t, want, proto.Equal(hx, hy), "%d, %d", i, j)
+		}
+	}
+
+	assert.True(t, hyperpb.Equal(nil, nil))
+	assert.False(t, hyperpb.Equal(parse(base()), nil))
+	assert.False(t, hyperpb.Equal(parse(base()), hyperpb.NewMessage(hyperpb.CompileFor[*testpb.Scalars]())))
+
+	// Unknown fields are compared too.
+	hx := parse(base())
+	hy := hyperpb.NewMessage(ty)
+	require.NoError(t, hy.Unmarshal(append(hx.WireBytes(), 0xf8, 0x07, 0x01)))
+	assert.False(t, hyperpb.Equal(hx, hy))
+}
+
+func TestEqualFields(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.MessageMaps]()
+	parse := func(m *testpb.MessageMaps) *hyperpb.Message {
+		data, err := proto.Marshal(m)
+		require.NoError(t, err)
+		msg := hyperpb.NewMessage(ty)
+		require.NoError(t, msg.Unmarshal(data))
+		return msg
+	}
+	x := parse(&testpb.MessageMaps{
+		Scalars: &testpb.Scalars{A1: 1, A2: 2},
+		M1:      map[int32]*testpb.MessageMaps{1: {}},
+	})
+	y := parse(&testpb.MessageMaps{
+		Scalars: &testpb.Scalars{A1: 1, A2: 3},
+		M1:      map[int32]*testpb.MessageMaps{1: {}},
+		Mc:      map[string]*testpb.MessageMaps{"a": {}},
+	})
+	z := parse(&testpb.MessageMaps{})
+
+	mask := func(paths ...string) *fieldmaskpb.FieldMask {
+		return &fieldmaskpb.FieldMask{Paths: paths}
+	}
+	assert.True(t, hyperpb.EqualFields(x, y, mask()))
+	assert.True(t, hyperpb.EqualFields(x, y, mask("scalars.a1", "m1")))
+	assert.False(t, hyperpb.EqualFields(x, y, mask("scalars.a1", "mc")))
+	assert.False(t, hyperpb.EqualFields(x, y, mask("scalars.a2")))
+	assert.False(t, hyperpb.EqualFields(x, y, mask("scalars.a2", "scalars")))
+	assert.False(t, hyperpb.EqualFields(x, y, mask("scalars", "scalars.a1")))
+	assert.True(t, hyperpb.EqualFields(x, z, mask("scalars.a3", "mc")))
+	assert.False(t, hyperpb.EqualFields(x, z, mask("scalars.a1")))
+
+	assert.Panics(t, func() { hyperpb.EqualFields(x, y, mask("scalars.nope")) })
+	assert.Panics(t, func() { hyperpb.EqualFields(x, y, mask("m1.scalars")) })
+}
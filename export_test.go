@@ -0,0 +1,38 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp"
+)
+
+// ParserOf returns the parser that ty's messages are parsed with.
+func ParserOf(ty *MessageType) *tdp.TypeParser {
+	return ty.impl.Parser
+}
+
+// EnumFieldsOf returns the fields of ty that are checked by
+// WithRejectUnknownEnums.
+func EnumFieldsOf(ty *MessageType) []int32 {
+	return ty.impl.Enums
+}
+
+// CompileKeyOf returns the key that calls to CompileMessageDescriptor are
+// deduplicated by, and whether they are deduplicated at all.
+func CompileKeyOf(md protoreflect.MessageDescriptor, options ...CompileOption) (any, bool) {
+	return compileKeyOf(md, options)
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"buf.build/go/hyperpb/internal/tdp/compiler"
+)
+
+var _ compiler.ExtensionResolver = (*ExtensionSnapshot)(nil)
+
+// ExtensionSnapshot records exactly which extensions were compiled into a
+// [MessageType], and into every other type compiled alongside it.
+//
+// A snapshot can be passed to [WithExtensions] to recompile a type with the
+// same extensions, regardless of changes to the registry it was originally
+// compiled with.
+type ExtensionSnapshot struct {
+	// Contains an entry for every message type in the snapshot, even if it
+	// has no extensions.
+	extensions compiler.ExtensionMap
+}
+
+// Extensions returns a snapshot of the extensions compiled into this type.
+func (t *MessageType) Extensions() *ExtensionSnapshot {
+	s := &ExtensionSnapshot{extensions: make(compiler.ExtensionMap)}
+	for md, ty := range t.impl.Library.Types {
+		var extns []protoreflect.ExtensionDescriptor
+		for _, fd := range ty.FieldDescriptors {
+			if fd.IsExtension() {
+				extns = append(extns, fd)
+			}
+		}
+		s.extensions[md.FullName()] = extns
+	}
+	return s
+}
+
+// FindExtensionsByMessage returns the extensions of the message with the given
+// name in this snapshot, sorted by field number.
+//
+// FindExtensionsByMessage implements the interface required by
+// [WithExtensions].
+func (s *ExtensionSnapshot) FindExtensionsByMessage(name protoreflect.FullName) []protoreflect.ExtensionDescriptor {
+	return s.extensions.FindExtensionsByMessage(name)
+}
+
+// Messages returns the names of every message type in this snapshot, in
+// sorted order.
+func (s *ExtensionSnapshot) Messages() []protoreflect.FullName {
+	return slices.Sorted(maps.Keys(s.extensions))
+}
+
+// Diff compares this snapshot with the extensions that resolver provides for
+// the same messages. It returns the extensions that resolver has but this
+// snapshot does not, and the extensions that this snapshot has but resolver
+// does not, each sorted by full name.
+//
+// Extensions are compared by full name, so a change to an extension's
+// definition that keeps its name is not reported.
+func (s *ExtensionSnapshot) Diff(resolver compiler.ExtensionResolver) (added, removed []protoreflect.ExtensionDescriptor) {
+	for name, old := range s.extensions {
+		current := resolver.FindExtensionsByMessage(name)
+		added = append(added, missing(current, old)...)
+		removed = append(removed, missing(old, current)...)
+	}
+
+	byName := func(a, b protoreflect.ExtensionDescriptor) int {
+		return cmp.Compare(a.FullName(), b.FullName())
+	}
+	slices.SortFunc(added, byName)
+	slices.SortFunc(removed, byName)
+	return added, removed
+}
+
+// DiffTypes is like [ExtensionSnapshot.Diff], but compares against the
+// extensions in a type registry.
+func (s *ExtensionSnapshot) DiffTypes(types *protoregistry.Types) (added, removed []protoreflect.ExtensionDescriptor) {
+	return s.Diff((*compiler.ExtensionsFromRegistry)(types))
+}
+
+// DiffFiles is like [ExtensionSnapshot.Diff], but compares against the
+// extensions in a file registry.
+func (s *ExtensionSnapshot) DiffFiles(files *protoregistry.Files) (added, removed []protoreflect.ExtensionDescriptor) {
+	return s.Diff(compiler.ExtensionsFromFile(files))
+}
+
+// missing returns the extensions in a whose names do not appear in b.
+func missing(a, b []protoreflect.ExtensionDescriptor) []protoreflect.ExtensionDescriptor {
+	var out []protoreflect.ExtensionDescriptor
+	for _, x := range a {
+		if !slices.ContainsFunc(b, func(y protoreflect.ExtensionDescriptor) bool {
+			return x.FullName() == y.FullName()
+		}) {
+			out = append(out, x)
+		}
+	}
+	return out
+}
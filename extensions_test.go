@@ -0,0 +1,72 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestExtensionSnapshot(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Extensions)(nil).ProtoReflect().Descriptor()
+
+	old := new(protoregistry.Types)
+	require.NoError(t, old.RegisterExtension(testpb.E_B1))
+	require.NoError(t, old.RegisterExtension(testpb.E_B2))
+	ty := hyperpb.CompileMessageDescriptor(md, hyperpb.WithExtensionsFromTypes(old))
+
+	snapshot := ty.Extensions()
+	assert.Equal(t, []protoreflect.FullName{md.FullName()}, snapshot.Messages())
+	names := func(extns []protoreflect.ExtensionDescriptor) []protoreflect.FullName {
+		var out []protoreflect.FullName
+		for _, xd := range extns {
+			out = append(out, xd.FullName())
+		}
+		return out
+	}
+	assert.Equal(t,
+		[]protoreflect.FullName{"hyperpb.test.b1", "hyperpb.test.b2"},
+		names(snapshot.FindExtensionsByMessage(md.FullName())),
+	)
+
+	current := new(protoregistry.Types)
+	require.NoError(t, current.RegisterExtension(testpb.E_B2))
+	require.NoError(t, current.RegisterExtension(testpb.E_B3))
+	added, removed := snapshot.DiffTypes(current)
+	assert.Equal(t, []protoreflect.FullName{"hyperpb.test.b3"}, names(added))
+	assert.Equal(t, []protoreflect.FullName{"hyperpb.test.b1"}, names(removed))
+
+	// Recompiling from the snapshot reproduces the original extensions.
+	ty = hyperpb.CompileMessageDescriptor(md, hyperpb.WithExtensions(snapshot))
+	data, err := proto.Marshal(func() proto.Message {
+		m := new(testpb.Extensions)
+		proto.SetExtension(m, testpb.E_B1, int32(42))
+		return m
+	}())
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.Equal(t, int32(42), m.Get(testpb.E_B1.TypeDescriptor()).Interface())
+}
@@ -0,0 +1,212 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/swiss"
+	"buf.build/go/hyperpb/internal/tdp"
+	"buf.build/go/hyperpb/internal/xunsafe"
+)
+
+// FieldPresence describes how a [Message] tracks whether a field is populated.
+type FieldPresence uint8
+
+const (
+	// PresenceImplicit fields are populated if and only if they are not the
+	// zero value, like proto3 scalar fields without the optional keyword.
+	PresenceImplicit FieldPresence = iota
+	// PresenceExplicit fields record whether they were set, independently of
+	// their value, like message fields and fields with the optional keyword.
+	PresenceExplicit
+	// PresenceOneof fields are members of a oneof, and are populated if they
+	// are the oneof's selected member.
+	PresenceOneof
+	// PresenceRepeated fields are repeated or map fields, and are populated
+	// if and only if they are non-empty.
+	PresenceRepeated
+)
+
+// FieldLocation describes where a field's data is located within a
+// [Message].
+type FieldLocation uint8
+
+const (
+	// LocationHot fields are stored inline in the message.
+	LocationHot FieldLocation = iota
+	// LocationCold fields are stored out-of-line, because the compiler expects
+	// them to be rarely present, based on the profile the type was compiled
+	// with. Reading an absent cold field does not touch the out-of-line
+	// storage.
+	LocationCold
+)
+
+// FieldInfo is precomputed information about a field of a [MessageType],
+// suitable for building readers that do not need to inspect descriptors on
+// every access.
+//
+// See [MessageType.Fields].
+type FieldInfo struct {
+	// The field's descriptor, and its index in [MessageType.Fields].
+	Descriptor protoreflect.FieldDescriptor
+	Index      int
+
+	Kind        protoreflect.Kind
+	Cardinality protoreflect.Cardinality
+	Map         bool // Set for map fields, which have a Cardinality of Repeated.
+
+	Presence FieldPresence
+	Location FieldLocation
+
+	ty *tdp.Type
+}
+
+// Fields returns information about each of the fields of this type, in field
+// index order, followed by any extensions the type was compiled with.
+//
+// The returned slice is computed once and shared by all callers; it must not
+// be modified.
+func (t *MessageType) Fields() []FieldInfo {
+	return t.fieldTable().fields
+}
+
+// FieldByName returns information about the field of this type with the given
+// name, or nil if there is no such field. Extensions are not included.
+//
+// Unlike looking up a field with [protoreflect.FieldDescriptors.ByName], this
+// does not hash the name into a Go map, making it suitable for code that is
+// driven by field names but cannot hardcode field indices.
+func (t *MessageType) FieldByName(name protoreflect.Name) *FieldInfo {
+	ft := t.fieldTable()
+	idx := swiss.LookupFuncU32xU32(ft.names, xunsafe.StringToSlice[[]byte](string(name)), ft.name)
+	if idx == nil {
+		return nil
+	}
+	return &ft.fields[*idx]
+}
+
+// FieldByJSONName returns information about the field of this type with the
+// given JSON name, or nil if there is no such field. Extensions are not
+// included.
+//
+// This is intended for mapping the paths of field masks received as JSON, such
+// as "userId", onto fields, without building a separate index from the
+// descriptor.
+func (t *MessageType) FieldByJSONName(name string) *FieldInfo {
+	ft := t.fieldTable()
+	idx := swiss.LookupFuncU32xU32(ft.jsonNames, xunsafe.StringToSlice[[]byte](name), ft.jsonName)
+	if idx == nil {
+		return nil
+	}
+	return &ft.fields[*idx]
+}
+
+// fieldTable is the value of [tdp.Aux].FieldInfo.
+type fieldTable struct {
+	fields []FieldInfo
+	// Maps the names of non-extension fields to indices in fields. Keys are
+	// also indices in fields, whose names are obtained with name.
+	names *swiss.Table[uint32, uint32]
+	// Like names, but for JSON names.
+	jsonNames *swiss.Table[uint32, uint32]
+}
+
+// name returns the name of the ith field, for use as a key in names.
+func (ft *fieldTable) name(i uint32) []byte {
+	return xunsafe.StringToSlice[[]byte](string(ft.fields[i].Descriptor.Name()))
+}
+
+// jsonName returns the JSON name of the ith field, for use as a key in
+// jsonNames.
+func (ft *fieldTable) jsonName(i uint32) []byte {
+	return xunsafe.StringToSlice[[]byte](ft.fields[i].Descriptor.JSONName())
+}
+
+// fieldTable returns this type's field table, building it if necessary.
+func (t *MessageType) fieldTable() *fieldTable {
+	if ft, ok := t.impl.FieldInfo.Load().(*fieldTable); ok {
+		return ft
+	}
+
+	ft := &fieldTable{fields: make([]FieldInfo, len(t.impl.FieldDescriptors))}
+	var names, jsonNames []swiss.Entry[uint32, uint32]
+	seenJSON := make(map[string]bool)
+	for i, fd := range t.impl.FieldDescriptors {
+		info := FieldInfo{
+			Descriptor:  fd,
+			Index:       i,
+			Kind:        fd.Kind(),
+			Cardinality: fd.Cardinality(),
+			Map:         fd.IsMap(),
+			ty:          &t.impl,
+		}
+
+		switch od := fd.ContainingOneof(); {
+		case fd.IsList() || fd.IsMap():
+			info.Presence = PresenceRepeated
+		case od != nil && !od.IsSynthetic():
+			info.Presence = PresenceOneof
+		case fd.HasPresence():
+			info.Presence = PresenceExplicit
+		}
+
+		if t.impl.ByIndex(i).Offset.Data < 0 {
+			info.Location = LocationCold
+		}
+
+		ft.fields[i] = info
+		if !fd.IsExtension() {
+			names = append(names, swiss.KV(uint32(i), uint32(i)))
+
+			// proto2 allows JSON names to collide; the first field wins.
+			if !seenJSON[fd.JSONName()] {
+				seenJSON[fd.JSONName()] = true
+				jsonNames = append(jsonNames, swiss.KV(uint32(i), uint32(i)))
+			}
+		}
+	}
+	_, ft.names = swiss.New(nil, ft.name, names...)
+	_, ft.jsonNames = swiss.New(nil, ft.jsonName, jsonNames...)
+
+	t.impl.FieldInfo.CompareAndSwap(nil, ft)
+	return t.impl.FieldInfo.Load().(*fieldTable) //nolint:errcheck // Always a *fieldTable.
+}
+
+// Get returns the value of this field in m, like [Message.Get], but without
+// looking up the field by its descriptor.
+//
+// Panics if m is not of the type this FieldInfo was obtained from.
+func (f *FieldInfo) Get(m *Message) protoreflect.Value {
+	f.check(m)
+	return m.impl.GetByIndex(f.Index)
+}
+
+// Has reports whether this field is populated in m, like [Message.Has], but
+// without looking up the field by its descriptor.
+//
+// Panics if m is not of the type this FieldInfo was obtained from.
+func (f *FieldInfo) Has(m *Message) bool {
+	f.check(m)
+	return m.impl.HasByIndex(f.Index)
+}
+
+func (f *FieldInfo) check(m *Message) {
+	if ty := m.impl.Type(); ty != f.ty {
+		panic(fmt.Errorf("hyperpb: field %s used with message of unrelated type %s", f.Descriptor.FullName(), ty.Descriptor.FullName()))
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestFloatMode(t *testing.T) {
+	t.Parallel()
+
+	const (
+		sNaN32    = 0x7f800001
+		sNaN64    = 0x7ff0000000000001
+		qNaN32    = 0x7fc00123
+		qNaN64    = 0x7ff8000000000123
+		subnorm32 = 0x80000001 // Negative.
+		subnorm64 = 0x0000000000000001
+	)
+	const (
+		canon32 uint32 = 0x7fc00000
+		canon64 uint64 = 0x7ff8000000000000
+	)
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("floats.proto"),
+		Package: proto.String("hyperpb.test"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Floats"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name: proto.String("f"), Number: proto.Int32(1), JsonName: proto.String("f"),
+					Type:  descriptorpb.FieldDescriptorProto_TYPE_FLOAT.Enum(),
+					Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				},
+				{
+					Name: proto.String("d"), Number: proto.Int32(2), JsonName: proto.String("d"),
+					Type:  descriptorpb.FieldDescriptorProto_TYPE_DOUBLE.Enum(),
+					Label: descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				},
+				{
+					Name: proto.String("rf"), Number: proto.Int32(3), JsonName: proto.String("rf"),
+					Type:  descriptorpb.FieldDescriptorProto_TYPE_FLOAT.Enum(),
+					Label: descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+				},
+				{
+					Name: proto.String("rd"), Number: proto.Int32(4), JsonName: proto.String("rd"),
+					Type:  descriptorpb.FieldDescriptorProto_TYPE_DOUBLE.Enum(),
+					Label: descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+				},
+			},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	md := fd.Messages().Get(0)
+	ty := hyperpb.CompileMessageDescriptor(md)
+
+	packed32 := func(vs ...uint32) []byte {
+		var b []byte
+		for _, v := range vs {
+			b = protowire.AppendFixed32(b, v)
+		}
+		return b
+	}
+	packed64 := func(vs ...uint64) []byte {
+		var b []byte
+		for _, v := range vs {
+			b = protowire.AppendFixed64(b, v)
+		}
+		return b
+	}
+
+	var data []byte
+	data = protowire.AppendTag(data, 1, protowire.Fixed32Type)
+	data = protowire.AppendFixed32(data, qNaN32)
+	data = protowire.AppendTag(data, 2, protowire.Fixed64Type)
+	data = protowire.AppendFixed64(data, subnorm64)
+	data = protowire.AppendTag(data, 3, protowire.BytesType)
+	data = protowire.AppendBytes(data, packed32(qNaN32, subnorm32, 0x3f800000))
+	data = protowire.AppendTag(data, 3, protowire.Fixed32Type)
+	data = protowire.AppendFixed32(data, qNaN32)
+	data = protowire.AppendTag(data, 4, protowire.BytesType)
+	data = protowire.AppendBytes(data, packed64(qNaN64, subnorm64))
+
+	get32 := func(m *hyperpb.Message, name string, i int) uint32 {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd.IsList() {
+			return math.Float32bits(float32(m.Get(fd).List().Get(i).Float()))
+		}
+		return math.Float32bits(float32(m.Get(fd).Float()))
+	}
+	get64 := func(m *hyperpb.Message, name string, i int) uint64 {
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd.IsList() {
+			return math.Float64bits(m.Get(fd).List().Get(i).Float())
+		}
+		return math.Float64bits(m.Get(fd).Float())
+	}
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.Equal(t, uint32(qNaN32), get32(m, "f", 0))
+	assert.Equal(t, uint64(subnorm64), get64(m, "d", 0))
+	assert.Equal(t, uint32(subnorm32), get32(m, "rf", 1))
+
+	m = hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithFloatMode(hyperpb.FloatCanonicalNaN|hyperpb.FloatFlushSubnormal)))
+	assert.Equal(t, canon32, get32(m, "f", 0))
+	assert.Equal(t, uint64(0), get64(m, "d", 0))
+	assert.Equal(t, canon32, get32(m, "rf", 0))
+	assert.Equal(t, uint32(0x80000000), get32(m, "rf", 1))
+	assert.Equal(t, uint32(0x3f800000), get32(m, "rf", 2))
+	assert.Equal(t, canon32, get32(m, "rf", 3))
+	assert.Equal(t, canon64, get64(m, "rd", 0))
+	assert.Equal(t, uint64(0), get64(m, "rd", 1))
+
+	// Signaling NaNs.
+	for _, data := range [][]byte{
+		protowire.AppendFixed32(protowire.AppendTag(nil, 1, protowire.Fixed32Type), sNaN32),
+		protowire.AppendFixed64(protowire.AppendTag(nil, 2, protowire.Fixed64Type), sNaN64),
+		protowire.AppendBytes(protowire.AppendTag(nil, 3, protowire.BytesType), packed32(0, sNaN32)),
+	} {
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithFloatMode(hyperpb.FloatCanonicalNaN)))
+		m = hyperpb.NewMessage(ty)
+		require.Error(t, m.Unmarshal(data, hyperpb.WithFloatMode(hyperpb.FloatRejectSignalingNaN)))
+	}
+
+	// Map values.
+	maps := (*testpb.Maps)(nil).ProtoReflect().Descriptor()
+	data = protowire.AppendTag(nil, 27, protowire.BytesType)
+	data = protowire.AppendBytes(data, protowire.AppendFixed64(
+		protowire.AppendTag(protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 5), 2, protowire.Fixed64Type),
+		qNaN64,
+	))
+	m = hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(maps))
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithFloatMode(hyperpb.FloatCanonicalNaN)))
+	v := m.Get(maps.Fields().ByName("m1b")).Map().Get(protoreflect.ValueOfInt32(5).MapKey())
+	assert.Equal(t, canon64, math.Float64bits(v.Float()))
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"errors"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"buf.build/go/hyperpb/internal/tdp/vm"
+)
+
+// GroupError describes a group which was not correctly terminated while
+// unmarshaling, either because an end tag for a different group was found, or
+// because the input ended first. See [GroupErrorOf].
+type GroupError struct {
+	// The offset at which parsing failed.
+	Offset int
+	// The offset of the start tag of the group that was expected to end, and
+	// its field number. These are -1 and zero if no group was being parsed.
+	Start    int
+	Expected protowire.Number
+	// The field number of the end tag that was found instead, or zero if the
+	// input ended.
+	Actual protowire.Number
+}
+
+// GroupErrorOf returns information about the unterminated group that caused
+// err, an error returned by [Message.Unmarshal].
+//
+// Returns false if err was not caused by a mismatched or missing end group
+// marker. Note that, if [WithTruncatedGroups] is set, a missing end group
+// marker is reported as [io.ErrUnexpectedEOF] rather than as a mismatch, but
+// this function still returns the group.
+func GroupErrorOf(err error) (GroupError, bool) {
+	var perr *vm.ParseError
+	if !errors.As(err, &perr) {
+		return GroupError{}, false
+	}
+	start, expected, actual, ok := perr.Group()
+	if !ok {
+		return GroupError{}, false
+	}
+	return GroupError{
+		Offset:   perr.Offset(),
+		Start:    start,
+		Expected: expected,
+		Actual:   actual,
+	}, true
+}
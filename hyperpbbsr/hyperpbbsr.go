@@ -0,0 +1,277 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hyperpbbsr compiles hyperpb types from schemas hosted on the Buf
+// Schema Registry, or from Buf images.
+//
+// This is a convenience for services which download types off the network:
+//
+//	ty, err := hyperpbbsr.Compile(ctx, "buf.build/acme/weather", "acme.weather.v1.WeatherReport")
+//	if err != nil {
+//		// ...
+//	}
+//	msg := hyperpb.NewMessage(ty)
+//
+// Schemas are fetched using the Buf Reflection API. This package does not
+// cache anything; callers that compile the same type repeatedly should cache
+// the resulting [hyperpb.MessageType].
+package hyperpbbsr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"buf.build/go/hyperpb"
+)
+
+// getFileDescriptorSet is the path of the Buf Reflection API's method for
+// fetching schemas.
+const getFileDescriptorSet = "/buf.reflect.v1beta1.FileDescriptorSetService/GetFileDescriptorSet"
+
+// DefaultRegistry is the registry host that [Client] sends its token to, if
+// [Client].BaseURL and [Client].Registry are both empty.
+const DefaultRegistry = "buf.build"
+
+// DefaultMaxResponseSize is the default for [Client].MaxResponseSize. It is
+// comfortably larger than the schemas of all but the very largest modules.
+const DefaultMaxResponseSize = 64 << 20
+
+// DefaultClient is the [Client] used by [Compile].
+var DefaultClient = new(Client)
+
+// Client fetches schemas from a Buf Schema Registry.
+//
+// The zero value is ready to use.
+type Client struct {
+	// The HTTP client used to make requests. If nil, [http.DefaultClient] is
+	// used.
+	HTTPClient *http.Client
+
+	// The URL of the registry, such as "https://buf.build". If empty, this is
+	// derived from the remote of the module reference.
+	BaseURL string
+
+	// The token used to authenticate with the registry. If empty, the
+	// BUF_TOKEN environment variable is used, if set.
+	//
+	// The token is only sent to the registry host; see Registry.
+	Token string
+
+	// The host that Token is sent to, such as "buf.build". If empty, this is
+	// the host of BaseURL, or [DefaultRegistry] if BaseURL is also empty.
+	//
+	// Without a BaseURL, requests go to the remote named in the module
+	// reference, which may come from an untrusted source. Requests to any
+	// host other than the registry host are sent without a token.
+	Registry string
+
+	// The largest response body to accept, in bytes. If zero,
+	// [DefaultMaxResponseSize] is used.
+	MaxResponseSize int64
+}
+
+// Compile fetches the schema for the given module reference using
+// [DefaultClient], and compiles the given message type from it.
+//
+// See [Client.Compile].
+func Compile(
+	ctx context.Context,
+	ref string,
+	message protoreflect.FullName,
+	options ...hyperpb.CompileOption,
+) (*hyperpb.MessageType, error) {
+	return DefaultClient.Compile(ctx, ref, message, options...)
+}
+
+// Compile fetches the schema for the given module reference, and compiles the
+// given message type from it.
+//
+// ref is a module reference of the form remote/owner/module, optionally
+// followed by a colon and a version, such as a commit, label or tag: for
+// example, "buf.build/acme/weather:main".
+//
+// Extensions to message are compiled only if they are defined in files that
+// message's file depends on.
+func (c *Client) Compile(
+	ctx context.Context,
+	ref string,
+	message protoreflect.FullName,
+	options ...hyperpb.CompileOption,
+) (*hyperpb.MessageType, error) {
+	fds, err := c.FileDescriptorSet(ctx, ref, string(message))
+	if err != nil {
+		return nil, err
+	}
+	return hyperpb.CompileFileDescriptorSet(fds, message, options...)
+}
+
+// FileDescriptorSet fetches the schema for the given module reference.
+//
+// If any symbols are given, the result is pruned down to the files needed to
+// define those symbols.
+func (c *Client) FileDescriptorSet(
+	ctx context.Context,
+	ref string,
+	symbols ...string,
+) (*descriptorpb.FileDescriptorSet, error) {
+	module, version, _ := strings.Cut(ref, ":")
+	remote, _, ok := strings.Cut(module, "/")
+	if !ok || remote == "" {
+		return nil, fmt.Errorf("hyperpbbsr: invalid module reference %q", ref)
+	}
+
+	base := c.BaseURL
+	if base == "" {
+		base = "https://" + remote
+	}
+
+	// GetFileDescriptorSetRequest is simple enough that we encode it by hand,
+	// rather than depend on generated code for it.
+	var req []byte
+	req = protowire.AppendTag(req, 1, protowire.BytesType)
+	req = protowire.AppendString(req, module)
+	if version != "" {
+		req = protowire.AppendTag(req, 2, protowire.BytesType)
+		req = protowire.AppendString(req, version)
+	}
+	for _, sym := range symbols {
+		req = protowire.AppendTag(req, 3, protowire.BytesType)
+		req = protowire.AppendString(req, sym)
+	}
+
+	httpReq, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, strings.TrimSuffix(base, "/")+getFileDescriptorSet, bytes.NewReader(req))
+	if err != nil {
+		return nil, fmt.Errorf("hyperpbbsr: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/proto")
+	httpReq.Header.Set("Connect-Protocol-Version", "1")
+
+	if token := c.token(httpReq.URL.Host); token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("hyperpbbsr: fetching %q: %w", ref, err)
+	}
+	defer resp.Body.Close()
+
+	limit := c.MaxResponseSize
+	if limit <= 0 {
+		limit = DefaultMaxResponseSize
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("hyperpbbsr: fetching %q: %w", ref, err)
+	}
+	if int64(len(body)) > limit {
+		return nil, fmt.Errorf("hyperpbbsr: fetching %q: response exceeds %d bytes", ref, limit)
+	}
+	if resp.StatusCode != http.StatusOK {
+		var connectErr struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(body, &connectErr) == nil && connectErr.Code != "" {
+			return nil, fmt.Errorf("hyperpbbsr: fetching %q: %s: %s", ref, connectErr.Code, connectErr.Message)
+		}
+		return nil, fmt.Errorf("hyperpbbsr: fetching %q: %s", ref, resp.Status)
+	}
+
+	// GetFileDescriptorSetResponse stores the FileDescriptorSet in field 1.
+	fds := new(descriptorpb.FileDescriptorSet)
+	for len(body) > 0 {
+		n, typ, m := protowire.ConsumeTag(body)
+		if m < 0 {
+			return nil, fmt.Errorf("hyperpbbsr: fetching %q: %w", ref, protowire.ParseError(m))
+		}
+		body = body[m:]
+
+		if n == 1 && typ == protowire.BytesType {
+			v, m := protowire.ConsumeBytes(body)
+			if m < 0 {
+				return nil, fmt.Errorf("hyperpbbsr: fetching %q: %w", ref, protowire.ParseError(m))
+			}
+			if err := (proto.UnmarshalOptions{Merge: true}).Unmarshal(v, fds); err != nil {
+				return nil, fmt.Errorf("hyperpbbsr: fetching %q: %w", ref, err)
+			}
+			body = body[m:]
+			continue
+		}
+
+		m = protowire.ConsumeFieldValue(n, typ, body)
+		if m < 0 {
+			return nil, fmt.Errorf("hyperpbbsr: fetching %q: %w", ref, protowire.ParseError(m))
+		}
+		body = body[m:]
+	}
+
+	return fds, nil
+}
+
+// token returns the token to send with a request to host, if any.
+func (c *Client) token(host string) string {
+	registry := c.Registry
+	if registry == "" && c.BaseURL != "" {
+		u, err := url.Parse(c.BaseURL)
+		if err != nil {
+			return ""
+		}
+		registry = u.Host
+	}
+	if registry == "" {
+		registry = DefaultRegistry
+	}
+	if host != registry {
+		return ""
+	}
+
+	if c.Token != "" {
+		return c.Token
+	}
+	return os.Getenv("BUF_TOKEN")
+}
+
+// CompileImage compiles the given message type from a binary-encoded Buf
+// image, such as the output of buf build -o image.binpb.
+func CompileImage(
+	image []byte,
+	message protoreflect.FullName,
+	options ...hyperpb.CompileOption,
+) (*hyperpb.MessageType, error) {
+	// Buf images are wire-compatible with FileDescriptorSet; Buf-specific
+	// metadata is stored in fields that are unknown to descriptor.proto.
+	fds := new(descriptorpb.FileDescriptorSet)
+	if err := (proto.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(image, fds); err != nil {
+		return nil, fmt.Errorf("hyperpbbsr: invalid image: %w", err)
+	}
+	return hyperpb.CompileFileDescriptorSet(fds, message, options...)
+}
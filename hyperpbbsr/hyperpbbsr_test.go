@@ -0,0 +1,142 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpbbsr_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"buf.build/go/hyperpb/hyperpbbsr"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func schema() *descriptorpb.FileDescriptorSet {
+	file := (*testpb.Scalars)(nil).ProtoReflect().Descriptor().ParentFile()
+	return &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{protodesc.ToFileDescriptorProto(file)},
+	}
+}
+
+func TestCompile(t *testing.T) {
+	t.Parallel()
+
+	var gotModule, gotVersion, gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/buf.reflect.v1beta1.FileDescriptorSetService/GetFileDescriptorSet", r.URL.Path)
+		gotAuth = r.Header.Get("Authorization")
+
+		body, _ := io.ReadAll(r.Body)
+		for len(body) > 0 {
+			n, _, m := protowire.ConsumeTag(body)
+			body = body[m:]
+			v, m := protowire.ConsumeString(body)
+			body = body[m:]
+			switch n {
+			case 1:
+				gotModule = v
+			case 2:
+				gotVersion = v
+			}
+		}
+
+		fds, _ := proto.Marshal(schema())
+		resp := protowire.AppendTag(nil, 1, protowire.BytesType)
+		resp = protowire.AppendBytes(resp, fds)
+		_, _ = w.Write(resp)
+	}))
+	defer srv.Close()
+
+	client := &hyperpbbsr.Client{BaseURL: srv.URL, Token: "hunter2"}
+	ty, err := client.Compile(context.Background(), "buf.build/acme/test:v1", "hyperpb.test.Scalars")
+	require.NoError(t, err)
+	assert.Equal(t, "hyperpb.test.Scalars", string(ty.Descriptor().FullName()))
+	assert.Equal(t, "buf.build/acme/test", gotModule)
+	assert.Equal(t, "v1", gotVersion)
+	assert.Equal(t, "Bearer hunter2", gotAuth)
+
+	_, err = client.Compile(context.Background(), "acme", "hyperpb.test.Scalars")
+	require.Error(t, err)
+
+	client.MaxResponseSize = 16
+	_, err = client.Compile(context.Background(), "buf.build/acme/test:v1", "hyperpb.test.Scalars")
+	require.ErrorContains(t, err, "response exceeds 16 bytes")
+}
+
+func TestToken(t *testing.T) {
+	t.Parallel()
+
+	var gotHost, gotAuth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		fds, _ := proto.Marshal(schema())
+		resp := protowire.AppendTag(nil, 1, protowire.BytesType)
+		resp = protowire.AppendBytes(resp, fds)
+		_, _ = w.Write(resp)
+	}))
+	defer srv.Close()
+
+	// Send every request to srv, whatever host it was meant for.
+	httpClient := &http.Client{Transport: roundTripper(func(r *http.Request) (*http.Response, error) {
+		gotHost = r.URL.Host
+		r.URL.Scheme, r.URL.Host = "http", strings.TrimPrefix(srv.URL, "http://")
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+
+	client := &hyperpbbsr.Client{HTTPClient: httpClient, Token: "hunter2"}
+	_, err := client.Compile(context.Background(), "buf.build/acme/test", "hyperpb.test.Scalars")
+	require.NoError(t, err)
+	assert.Equal(t, "buf.build", gotHost)
+	assert.Equal(t, "Bearer hunter2", gotAuth)
+
+	// The module reference names a host other than the registry, so the token
+	// must not be sent to it.
+	_, err = client.Compile(context.Background(), "evil.example/acme/test", "hyperpb.test.Scalars")
+	require.NoError(t, err)
+	assert.Equal(t, "evil.example", gotHost)
+	assert.Empty(t, gotAuth)
+
+	client.Registry = "evil.example"
+	_, err = client.Compile(context.Background(), "evil.example/acme/test", "hyperpb.test.Scalars")
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer hunter2", gotAuth)
+}
+
+type roundTripper func(*http.Request) (*http.Response, error)
+
+func (f roundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestCompileImage(t *testing.T) {
+	t.Parallel()
+
+	image, err := proto.Marshal(schema())
+	require.NoError(t, err)
+
+	ty, err := hyperpbbsr.CompileImage(image, "hyperpb.test.Scalars")
+	require.NoError(t, err)
+	assert.Equal(t, "hyperpb.test.Scalars", string(ty.Descriptor().FullName()))
+}
@@ -0,0 +1,356 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hyperpbconnect integrates hyperpb with Connect and with
+// Vanguard-style transcoding gateways.
+//
+// [Codec] implements connect.Codec structurally, [VanguardCodec] does the same
+// for Vanguard's codec interface, and [Resolver] implements the type resolver
+// interface used by both, so this package does not depend on either of them.
+//
+// Messages can be tied to the lifetime of an HTTP request by wrapping the
+// handler with [Middleware] and allocating them with [NewMessage]; their
+// memory is reclaimed once the handler returns. Messages that Connect or
+// Vanguard allocate themselves, through the types returned by [Resolver], are
+// not tied to any request, and are reclaimed by the garbage collector.
+package hyperpbconnect
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/hyperpbgrpc"
+)
+
+// Resolver resolves message types to compiled hyperpb types, and extensions
+// to dynamicpb types.
+//
+// Resolver implements protoregistry.MessageTypeResolver and
+// protoregistry.ExtensionTypeResolver, which together make up the type
+// resolver interfaces used by Connect and Vanguard.
+//
+// Messages created with the New method of a resolved type are allocated as if
+// by [hyperpb.NewMessage], outside of any request handled by [Middleware],
+// because New has no access to the request's context. Transcoding gateways,
+// which allocate messages this way, therefore do not re-use memory across
+// requests; handlers that want them to should allocate with [NewMessage].
+type Resolver struct {
+	registry   *hyperpbgrpc.Registry
+	extensions *dynamicpb.Types
+}
+
+// NewResolver returns a resolver for the types in files. The options are used
+// for compiling every type.
+//
+// If files is nil, [protoregistry.GlobalFiles] is used.
+func NewResolver(files *protoregistry.Files, options ...hyperpb.CompileOption) *Resolver {
+	if files == nil {
+		files = protoregistry.GlobalFiles
+	}
+	return &Resolver{
+		registry:   hyperpbgrpc.NewRegistry(files, options...),
+		extensions: dynamicpb.NewTypes(files),
+	}
+}
+
+// FindMessageByName implements [protoregistry.MessageTypeResolver].
+//
+// The returned type is always a [*hyperpb.MessageType].
+func (r *Resolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	ty, err := r.registry.FindMessageType(name)
+	if err != nil {
+		return nil, err
+	}
+	return ty, nil
+}
+
+// FindMessageByURL implements [protoregistry.MessageTypeResolver].
+func (r *Resolver) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	name := url
+	for i := len(url) - 1; i >= 0; i-- {
+		if url[i] == '/' {
+			name = url[i+1:]
+			break
+		}
+	}
+	return r.FindMessageByName(protoreflect.FullName(name))
+}
+
+// FindExtensionByName implements [protoregistry.ExtensionTypeResolver].
+func (r *Resolver) FindExtensionByName(field protoreflect.FullName) (protoreflect.ExtensionType, error) {
+	return r.extensions.FindExtensionByName(field)
+}
+
+// FindExtensionByNumber implements [protoregistry.ExtensionTypeResolver].
+func (r *Resolver) FindExtensionByNumber(message protoreflect.FullName, field protoreflect.FieldNumber) (protoreflect.ExtensionType, error) {
+	return r.extensions.FindExtensionByNumber(message, field)
+}
+
+// Event describes a single call to [Codec.Marshal] or [Codec.Unmarshal]. It
+// is passed to the hook installed with [WithMetrics].
+type Event struct {
+	// The type of the message being marshaled or unmarshaled.
+	Type protoreflect.FullName
+	// Whether this was a call to Marshal, rather than Unmarshal.
+	Marshal bool
+	// Whether the message was a hyperpb message.
+	Hyper bool
+	// The size of the encoded message.
+	Bytes int
+	// How long the operation took.
+	Duration time.Duration
+	// The error returned by the operation, if any.
+	Err error
+}
+
+// Option is an option for [NewCodec].
+type Option struct {
+	apply func(*Codec)
+}
+
+// WithUnmarshalOptions sets the options used when unmarshaling hyperpb
+// messages.
+//
+// Input buffers are never aliased, because the caller may re-use them once the
+// codec returns; [hyperpb.WithAllowAlias] is ignored.
+func WithUnmarshalOptions(options ...hyperpb.UnmarshalOption) Option {
+	return Option{func(c *Codec) { c.options = append(c.options, options...) }}
+}
+
+// WithMetrics sets a function to call after every marshal and unmarshal
+// operation.
+//
+// The hook is called synchronously, and must not retain the event.
+func WithMetrics(hook func(*Event)) Option {
+	return Option{func(c *Codec) { c.metrics = hook }}
+}
+
+// Codec is a Connect codec for the binary Protobuf encoding which parses into
+// hyperpb messages.
+//
+// Messages which are not [*hyperpb.Message]s are marshaled and unmarshaled
+// with package proto, so this codec can be used for every procedure on a
+// handler.
+type Codec struct {
+	options []hyperpb.UnmarshalOption
+	metrics func(*Event)
+}
+
+// NewCodec returns a new codec with the given options.
+func NewCodec(options ...Option) *Codec {
+	c := new(Codec)
+	for _, opt := range options {
+		opt.apply(c)
+	}
+	c.options = append(c.options, hyperpb.WithAllowAlias(false))
+	return c
+}
+
+// Name implements connect.Codec.
+func (c *Codec) Name() string {
+	return hyperpbgrpc.Name
+}
+
+// IsBinary implements connect.StableCodec.
+func (c *Codec) IsBinary() bool {
+	return true
+}
+
+// Marshal implements connect.Codec.
+func (c *Codec) Marshal(v any) ([]byte, error) {
+	return c.marshal(nil, v, proto.MarshalOptions{})
+}
+
+// MarshalStable implements connect.StableCodec.
+func (c *Codec) MarshalStable(v any) ([]byte, error) {
+	return c.marshal(nil, v, proto.MarshalOptions{Deterministic: true})
+}
+
+// Unmarshal implements connect.Codec.
+//
+// If v is a [*hyperpb.Message], it is parsed with the codec's options;
+// otherwise, it is parsed with [proto.Unmarshal].
+func (c *Codec) Unmarshal(data []byte, v any) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("hyperpbconnect: cannot unmarshal into %T: not a proto.Message", v)
+	}
+
+	var start time.Time
+	if c.metrics != nil {
+		start = time.Now()
+	}
+
+	var err error
+	hm, hyper := m.(*hyperpb.Message)
+	if hyper {
+		err = hm.Unmarshal(data, c.options...)
+	} else {
+		err = proto.Unmarshal(data, m)
+	}
+
+	if c.metrics != nil {
+		c.metrics(&Event{
+			Type:     m.ProtoReflect().Descriptor().FullName(),
+			Hyper:    hyper,
+			Bytes:    len(data),
+			Duration: time.Since(start),
+			Err:      err,
+		})
+	}
+	return err
+}
+
+// Vanguard returns an adapter of this codec for Vanguard.
+func (c *Codec) Vanguard() VanguardCodec {
+	return VanguardCodec{c}
+}
+
+func (c *Codec) marshal(b []byte, v any, options proto.MarshalOptions) ([]byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("hyperpbconnect: cannot marshal %T: not a proto.Message", v)
+	}
+
+	var start time.Time
+	if c.metrics != nil {
+		start = time.Now()
+	}
+
+	out, err := options.MarshalAppend(b, m)
+
+	if c.metrics != nil {
+		_, hyper := m.(*hyperpb.Message)
+		c.metrics(&Event{
+			Type:     m.ProtoReflect().Descriptor().FullName(),
+			Marshal:  true,
+			Hyper:    hyper,
+			Bytes:    len(out) - len(b),
+			Duration: time.Since(start),
+			Err:      err,
+		})
+	}
+	return out, err
+}
+
+// VanguardCodec is a [Codec] for Vanguard, returned by [Codec.Vanguard].
+//
+// Vanguard's codec methods take a proto.Message rather than any, so a single
+// type cannot implement both its codec interface and connect.Codec.
+// VanguardCodec implements Vanguard's Codec and StableCodec interfaces
+// structurally, and otherwise behaves exactly like the codec it adapts.
+type VanguardCodec struct {
+	codec *Codec
+}
+
+// Name implements vanguard.Codec.
+func (v VanguardCodec) Name() string {
+	return v.codec.Name()
+}
+
+// IsBinary implements vanguard.StableCodec.
+func (v VanguardCodec) IsBinary() bool {
+	return v.codec.IsBinary()
+}
+
+// MarshalAppend implements vanguard.Codec.
+func (v VanguardCodec) MarshalAppend(b []byte, m proto.Message) ([]byte, error) {
+	return v.codec.marshal(b, m, proto.MarshalOptions{})
+}
+
+// MarshalAppendStable implements vanguard.StableCodec.
+func (v VanguardCodec) MarshalAppendStable(b []byte, m proto.Message) ([]byte, error) {
+	return v.codec.marshal(b, m, proto.MarshalOptions{Deterministic: true})
+}
+
+// Unmarshal implements vanguard.Codec.
+//
+// See [Codec.Unmarshal].
+func (v VanguardCodec) Unmarshal(data []byte, m proto.Message) error {
+	return v.codec.Unmarshal(data, m)
+}
+
+// session is the per-request state installed by [Middleware].
+type session struct {
+	mu     sync.Mutex
+	shared *hyperpb.Shared
+}
+
+type sessionKey struct{}
+
+// sharedPool holds the Shareds of finished requests for re-use.
+var sharedPool sync.Pool // *hyperpb.Shared
+
+// Middleware wraps an HTTP handler so that messages allocated with
+// [NewMessage] from the request's context share memory, which is reclaimed
+// once next returns.
+//
+// Each message is allocated from its own shard of the request's
+// [hyperpb.Shared] (see [hyperpb.Shared.Shard]), so that a streaming handler
+// can keep every message it receives while parsing the next one.
+//
+// Neither those messages nor any values obtained from them may be used after
+// the handler returns.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s := new(session)
+		defer s.free()
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), sessionKey{}, s)))
+	})
+}
+
+// NewMessage allocates a new message of the given type.
+//
+// If ctx belongs to a request handled by [Middleware], the message is
+// allocated from memory owned by the whole request. Otherwise, it is
+// allocated as if by [hyperpb.NewMessage].
+//
+// Messages allocated from the same request do not share a [hyperpb.Shared],
+// so each of them may be parsed into independently.
+func NewMessage(ctx context.Context, ty *hyperpb.MessageType) *hyperpb.Message {
+	s, _ := ctx.Value(sessionKey{}).(*session)
+	if s == nil {
+		return hyperpb.NewMessage(ty)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.shared == nil {
+		s.shared, _ = sharedPool.Get().(*hyperpb.Shared)
+		if s.shared == nil {
+			s.shared = new(hyperpb.Shared)
+		}
+	}
+	return s.shared.Shard().NewMessage(ty)
+}
+
+func (s *session) free() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.shared == nil {
+		return
+	}
+	s.shared.Free()
+	sharedPool.Put(s.shared)
+	s.shared = nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpbconnect_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/hyperpbconnect"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+// vanguardCodec mirrors vanguard.Codec and vanguard.StableCodec.
+type vanguardCodec interface {
+	Name() string
+	IsBinary() bool
+	MarshalAppend([]byte, proto.Message) ([]byte, error)
+	MarshalAppendStable([]byte, proto.Message) ([]byte, error)
+	Unmarshal([]byte, proto.Message) error
+}
+
+var _ vanguardCodec = hyperpbconnect.VanguardCodec{}
+
+func TestCodec(t *testing.T) {
+	t.Parallel()
+
+	var events []hyperpbconnect.Event
+	codec := hyperpbconnect.NewCodec(hyperpbconnect.WithMetrics(func(e *hyperpbconnect.Event) {
+		events = append(events, *e)
+	}))
+	resolver := hyperpbconnect.NewResolver(nil)
+
+	want := &testpb.Scalars{A1: 42, A11: 1.5}
+	name := want.ProtoReflect().Descriptor().FullName()
+	data, err := codec.MarshalStable(want)
+	require.NoError(t, err)
+
+	ty, err := resolver.FindMessageByURL("type.googleapis.com/" + string(name))
+	require.NoError(t, err)
+	require.IsType(t, (*hyperpb.MessageType)(nil), ty)
+
+	m := ty.New().Interface()
+	require.NoError(t, codec.Unmarshal(data, m))
+	data2, err := codec.Vanguard().MarshalAppend([]byte("xyz"), m)
+	require.NoError(t, err)
+
+	got := new(testpb.Scalars)
+	require.NoError(t, codec.Vanguard().Unmarshal(data2[3:], got))
+	assert.True(t, proto.Equal(want, got), "got %v, want %v", got, want)
+
+	require.Len(t, events, 4)
+	assert.Equal(t, []bool{true, false, true, false}, []bool{
+		events[0].Marshal, events[1].Marshal, events[2].Marshal, events[3].Marshal,
+	})
+	assert.Equal(t, []bool{false, true, true, false}, []bool{
+		events[0].Hyper, events[1].Hyper, events[2].Hyper, events[3].Hyper,
+	})
+	for _, e := range events {
+		assert.Equal(t, name, e.Type)
+		assert.Equal(t, len(data), e.Bytes)
+		assert.NoError(t, e.Err)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ty, err := hyperpbconnect.NewResolver(nil).FindMessageByName(
+		(*testpb.Scalars)(nil).ProtoReflect().Descriptor().FullName())
+	require.NoError(t, err)
+	data, err := proto.Marshal(&testpb.Scalars{A1: 42})
+	require.NoError(t, err)
+
+	var shared *hyperpb.Shared
+	handler := hyperpbconnect.Middleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		m1 := hyperpbconnect.NewMessage(r.Context(), ty.(*hyperpb.MessageType))
+		m2 := hyperpbconnect.NewMessage(r.Context(), ty.(*hyperpb.MessageType))
+		assert.NotSame(t, m1.Shared(), m2.Shared())
+		shared = m1.Shared()
+
+		assert.NoError(t, m1.Unmarshal(data))
+		assert.Equal(t, int32(42), m1.Get(m1.Descriptor().Fields().ByName("a1")).Interface())
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+	assert.NotNil(t, shared)
+}
+
+func TestMiddlewareStreaming(t *testing.T) {
+	t.Parallel()
+
+	ty, err := hyperpbconnect.NewResolver(nil).FindMessageByName(
+		(*testpb.Scalars)(nil).ProtoReflect().Descriptor().FullName())
+	require.NoError(t, err)
+	codec := hyperpbconnect.NewCodec()
+
+	// Simulate a client stream: each received message is allocated from the
+	// request's context and kept until the handler returns.
+	const n = 4
+	var stream [][]byte
+	for i := range n {
+		data, err := proto.Marshal(&testpb.Scalars{A1: int32(i + 1)})
+		require.NoError(t, err)
+		stream = append(stream, data)
+	}
+
+	handler := hyperpbconnect.Middleware(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		var received []*hyperpb.Message
+		for _, data := range stream {
+			m := hyperpbconnect.NewMessage(r.Context(), ty.(*hyperpb.MessageType))
+			if !assert.NoError(t, codec.Unmarshal(data, m)) {
+				return
+			}
+			received = append(received, m)
+		}
+		for i, m := range received {
+			assert.Equal(t, int32(i+1), m.Get(m.Descriptor().Fields().ByName("a1")).Interface())
+		}
+	}))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+}
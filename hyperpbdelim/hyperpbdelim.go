@@ -0,0 +1,135 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hyperpbdelim reads varint size-delimited messages with hyperpb.
+//
+// The framing is the same as that of
+// google.golang.org/protobuf/encoding/protodelim, so streams written with
+// [protodelim.MarshalTo] can be read by switching the parser and nothing
+// else:
+//
+//	r := bufio.NewReader(conn)
+//	shared := new(hyperpb.Shared)
+//	for {
+//		msg := shared.NewMessage(ty)
+//		err := hyperpbdelim.UnmarshalFrom(r, msg)
+//		if err == io.EOF {
+//			break
+//		}
+//		...
+//		shared.Free()
+//	}
+//
+// The bytes of each message are read directly into the arena of the message's
+// [hyperpb.Shared] and parsed without copying, so they are freed along with
+// the message. As with [hyperpb.Message.Unmarshal], a Shared can only parse
+// one message at a time; calling [hyperpb.Shared.Free] between messages, or
+// taking them from a [hyperpb.MessagePool], re-uses the same memory for the
+// whole stream.
+package hyperpbdelim
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"buf.build/go/hyperpb"
+)
+
+// DefaultMaxSize is the maximum size of a message when
+// [UnmarshalOptions.MaxSize] is zero. It matches protodelim's default.
+const DefaultMaxSize = 4 << 20 // 4 MiB.
+
+// Reader is the interface expected by [UnmarshalFrom]. It is implemented by
+// *[bufio.Reader].
+//
+// This is the same interface as [protodelim.Reader].
+type Reader = protodelim.Reader
+
+// UnmarshalOptions is a configurable varint size-delimited unmarshaler.
+type UnmarshalOptions struct {
+	// MaxSize is the maximum size in wire-format bytes of a single message.
+	// Reading a message larger than this returns a
+	// [*protodelim.SizeTooLargeError]. Zero means [DefaultMaxSize], and -1
+	// disables the limit.
+	MaxSize int64
+
+	// Options are passed to [hyperpb.Message.Unmarshal] for each message.
+	// [hyperpb.WithAllowAlias] is always set, since the input is owned by the
+	// message's arena.
+	Options []hyperpb.UnmarshalOption
+}
+
+// UnmarshalFrom reads a varint size-delimited message from r and parses it
+// into m with the default options.
+//
+// See [UnmarshalOptions.UnmarshalFrom].
+func UnmarshalFrom(r Reader, m *hyperpb.Message) error {
+	return UnmarshalOptions{}.UnmarshalFrom(r, m)
+}
+
+// UnmarshalFrom reads a varint size-delimited message from r and parses it
+// into m.
+//
+// Errors are reported the same way as [protodelim.UnmarshalOptions.UnmarshalFrom]:
+// the error is [io.EOF] only if no bytes were read, and reaching the end of r
+// partway through a message returns [io.ErrUnexpectedEOF]. Other errors from
+// r are returned unchanged.
+func (o UnmarshalOptions) UnmarshalFrom(r Reader, m *hyperpb.Message) error {
+	var sizeArr [binary.MaxVarintLen64]byte
+	sizeBuf := sizeArr[:0]
+	for i := range sizeArr {
+		b, err := r.ReadByte()
+		if err != nil {
+			if errors.Is(err, io.EOF) && i > 0 {
+				return io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		sizeBuf = append(sizeBuf, b)
+		if b < 0x80 {
+			break
+		}
+	}
+	size, n := protowire.ConsumeVarint(sizeBuf)
+	if n < 0 {
+		return protowire.ParseError(n)
+	}
+
+	maxSize := o.MaxSize
+	if maxSize == 0 {
+		maxSize = DefaultMaxSize
+	}
+	if maxSize == -1 {
+		maxSize = math.MaxInt
+	}
+	if size > uint64(maxSize) {
+		return &protodelim.SizeTooLargeError{Size: size, MaxSize: uint64(maxSize)}
+	}
+
+	buf := m.Shared().Alloc(int(size), 1)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	options := append(o.Options[:len(o.Options):len(o.Options)], hyperpb.WithAllowAlias(true))
+	return m.Unmarshal(buf, options...)
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpbdelim_test
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/proto"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/hyperpbdelim"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestUnmarshalFrom(t *testing.T) {
+	t.Parallel()
+
+	want := []*testpb.Scalars{
+		{A1: 42, A11: 1.5},
+		{},
+		{A1: -1, A14: "hello"},
+	}
+	buf := new(bytes.Buffer)
+	for _, m := range want {
+		_, err := protodelim.MarshalTo(buf, m)
+		require.NoError(t, err)
+	}
+	data := buf.Bytes()
+
+	ty := hyperpb.CompileMessageDescriptor(want[0].ProtoReflect().Descriptor())
+	shared := new(hyperpb.Shared)
+	r := bufio.NewReader(bytes.NewReader(data))
+	for i := 0; ; i++ {
+		m := shared.NewMessage(ty)
+		err := hyperpbdelim.UnmarshalFrom(r, m)
+		if err == io.EOF {
+			assert.Equal(t, len(want), i)
+			break
+		}
+		require.NoError(t, err)
+		require.Less(t, i, len(want))
+		assert.True(t, proto.Equal(want[i], m), "got %v, want %v", m, want[i])
+		shared.Free()
+	}
+
+	// Truncated streams.
+	m := shared.NewMessage(ty)
+	err := hyperpbdelim.UnmarshalFrom(bufio.NewReader(bytes.NewReader(data[:1])), m)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	err = hyperpbdelim.UnmarshalFrom(bufio.NewReader(bytes.NewReader([]byte{0x80})), m)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	// Size limits.
+	err = hyperpbdelim.UnmarshalOptions{MaxSize: 1}.UnmarshalFrom(bufio.NewReader(bytes.NewReader(data)), m)
+	var tooLarge *protodelim.SizeTooLargeError
+	require.ErrorAs(t, err, &tooLarge)
+	assert.Equal(t, uint64(1), tooLarge.MaxSize)
+}
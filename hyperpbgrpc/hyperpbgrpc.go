@@ -0,0 +1,185 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hyperpbgrpc provides a gRPC codec which parses messages with
+// hyperpb.
+//
+// [Codec] implements the encoding.Codec interface from google.golang.org/grpc
+// structurally, so this package does not depend on gRPC. To use it, register
+// it in place of the default codec:
+//
+//	codec := hyperpbgrpc.NewCodec(hyperpbgrpc.NewRegistry(files))
+//	encoding.RegisterCodec(codec)
+//
+// Handlers then allocate messages with [Codec.NewMessage] and pass them to
+// RecvMsg, releasing them with [Codec.Release] at the end of the RPC so that
+// their memory can be re-used.
+package hyperpbgrpc
+
+import (
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"buf.build/go/hyperpb"
+)
+
+// Name is the name of the codec, as registered with gRPC. It replaces gRPC's
+// default codec for Protobuf.
+const Name = "proto"
+
+// Registry resolves message names to compiled hyperpb types.
+//
+// Types are compiled on first use and cached. A Registry is safe to use from
+// multiple goroutines.
+type Registry struct {
+	files   *protoregistry.Files
+	options []hyperpb.CompileOption
+	types   sync.Map // map[protoreflect.FullName]*hyperpb.MessageType
+}
+
+// NewRegistry returns a registry for the messages in files. The options are
+// used for compiling every type.
+//
+// If files is nil, [protoregistry.GlobalFiles] is used.
+func NewRegistry(files *protoregistry.Files, options ...hyperpb.CompileOption) *Registry {
+	if files == nil {
+		files = protoregistry.GlobalFiles
+	}
+	r := &Registry{files: files}
+	// Extensions are taken from the same place as the messages; callers may
+	// override this with their own options. Every type is compiled with the
+	// same options, so concurrent compilations of a type can be deduplicated
+	// by keying them on r.
+	r.options = append([]hyperpb.CompileOption{
+		hyperpb.WithExtensionsFromFiles(files),
+		hyperpb.WithCompileKey(r),
+	}, options...)
+	return r
+}
+
+// FindMessageType looks up and compiles the message type with the given name.
+//
+// Returns an error if the type cannot be compiled, such as an
+// [*hyperpb.UnsupportedError] or an error wrapping
+// [hyperpb.ErrMemoryBudgetExceeded]. Failures are not cached.
+func (r *Registry) FindMessageType(name protoreflect.FullName) (*hyperpb.MessageType, error) {
+	if ty, ok := r.types.Load(name); ok {
+		return ty.(*hyperpb.MessageType), nil //nolint:errcheck // Always a *MessageType.
+	}
+
+	desc, err := r.files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("hyperpbgrpc: %s is not a message: %w", name, protoregistry.NotFound)
+	}
+
+	compiled, err := hyperpb.TryCompileMessageDescriptor(md, r.options...)
+	if err != nil {
+		return nil, err
+	}
+
+	// Concurrent compilations are deduplicated, but a lookup that misses
+	// just before another stores its type may still compile it again; only
+	// one of them is kept.
+	ty, _ := r.types.LoadOrStore(name, compiled)
+	return ty.(*hyperpb.MessageType), nil //nolint:errcheck // Always a *MessageType.
+}
+
+// Codec is a gRPC codec which parses into hyperpb messages.
+//
+// Messages which are not [*hyperpb.Message]s are marshaled and unmarshaled
+// with package proto, so this codec can be used for every RPC on a server.
+type Codec struct {
+	registry *Registry
+	options  []hyperpb.UnmarshalOption
+	shared   sync.Pool // *hyperpb.Shared
+}
+
+// NewCodec returns a new codec, which uses registry to find the types for
+// [Codec.NewMessage], and parses with the given options.
+//
+// Input buffers are never aliased, because gRPC may re-use them once the codec
+// returns; [hyperpb.WithAllowAlias] is ignored.
+func NewCodec(registry *Registry, options ...hyperpb.UnmarshalOption) *Codec {
+	return &Codec{
+		registry: registry,
+		// Appending our own option last ensures it takes precedence.
+		options: append(append([]hyperpb.UnmarshalOption(nil), options...), hyperpb.WithAllowAlias(false)),
+	}
+}
+
+// Name implements encoding.Codec.
+func (c *Codec) Name() string {
+	return Name
+}
+
+// Marshal implements encoding.Codec.
+func (c *Codec) Marshal(v any) ([]byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("hyperpbgrpc: cannot marshal %T: not a proto.Message", v)
+	}
+	return proto.Marshal(m)
+}
+
+// Unmarshal implements encoding.Codec.
+//
+// If v is a [*hyperpb.Message], it is parsed with the codec's options;
+// otherwise, it is parsed with [proto.Unmarshal].
+func (c *Codec) Unmarshal(data []byte, v any) error {
+	switch m := v.(type) {
+	case *hyperpb.Message:
+		return m.Unmarshal(data, c.options...)
+	case proto.Message:
+		return proto.Unmarshal(data, m)
+	default:
+		return fmt.Errorf("hyperpbgrpc: cannot unmarshal into %T: not a proto.Message", v)
+	}
+}
+
+// NewMessage allocates an empty message of the given type, using memory
+// pooled by this codec.
+//
+// The message should be released with [Codec.Release] once the RPC that
+// uses it is finished.
+func (c *Codec) NewMessage(name protoreflect.FullName) (*hyperpb.Message, error) {
+	ty, err := c.registry.FindMessageType(name)
+	if err != nil {
+		return nil, err
+	}
+
+	shared, _ := c.shared.Get().(*hyperpb.Shared)
+	if shared == nil {
+		shared = new(hyperpb.Shared)
+	}
+	return shared.NewMessage(ty), nil
+}
+
+// Release returns the memory for a message allocated with [Codec.NewMessage]
+// to this codec's pool.
+//
+// Neither m nor any value obtained from it may be used after calling this
+// function.
+func (c *Codec) Release(m *hyperpb.Message) {
+	shared := m.Shared()
+	shared.Free()
+	c.shared.Put(shared)
+}
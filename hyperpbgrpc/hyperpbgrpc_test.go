@@ -0,0 +1,73 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpbgrpc_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/hyperpbgrpc"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestCodec(t *testing.T) {
+	t.Parallel()
+
+	codec := hyperpbgrpc.NewCodec(hyperpbgrpc.NewRegistry(nil))
+	assert.Equal(t, "proto", codec.Name())
+
+	want := &testpb.Scalars{A1: 42, A11: 1.5}
+	data, err := codec.Marshal(want)
+	require.NoError(t, err)
+
+	name := want.ProtoReflect().Descriptor().FullName()
+	for range 3 {
+		m, err := codec.NewMessage(name)
+		require.NoError(t, err)
+		require.NoError(t, codec.Unmarshal(data, m))
+
+		// Round-trip the hyperpb message back through the codec.
+		data2, err := codec.Marshal(m)
+		require.NoError(t, err)
+		got := new(testpb.Scalars)
+		require.NoError(t, codec.Unmarshal(data2, got))
+		assert.True(t, proto.Equal(want, got), "got %v, want %v", got, want)
+
+		codec.Release(m)
+	}
+
+	_, err = codec.NewMessage("hyperpb.test.DoesNotExist")
+	require.Error(t, err)
+	_, err = codec.NewMessage(protoreflect.FullName(name.Parent()))
+	require.Error(t, err)
+	require.Error(t, codec.Unmarshal(data, 42))
+}
+
+func TestRegistryCompileError(t *testing.T) {
+	t.Parallel()
+
+	// Compilation failures are returned rather than panicking.
+	registry := hyperpbgrpc.NewRegistry(nil, hyperpb.WithMemoryBudget(hyperpb.NewMemoryBudget(1)))
+	name := (*testpb.Scalars)(nil).ProtoReflect().Descriptor().FullName()
+	for range 2 {
+		_, err := registry.FindMessageType(name)
+		require.ErrorIs(t, err, hyperpb.ErrMemoryBudgetExceeded)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+
+// Package hyperpbjs provides helpers for using hyperpb from JavaScript, when
+// compiled with GOOS=js.
+package hyperpbjs
+
+import (
+	"syscall/js"
+
+	"buf.build/go/hyperpb"
+)
+
+// padding is the number of bytes of spare capacity the parser needs past the
+// end of its input to avoid copying it. See vm.RelocatePageBoundary.
+const padding = 9
+
+var (
+	arrayBuffer = js.Global().Get("ArrayBuffer")
+	uint8Array  = js.Global().Get("Uint8Array")
+)
+
+// Bytes copies the contents of a JavaScript ArrayBuffer, typed array, or
+// DataView into Go memory.
+//
+// The returned slice has enough spare capacity that the parser can use it
+// in-place when parsing with [hyperpb.WithAllowAlias], so passing it to
+// [hyperpb.Message.Unmarshal] performs no further copies.
+//
+// Panics if buf is not one of the above types.
+func Bytes(buf js.Value) []byte {
+	switch {
+	case buf.InstanceOf(arrayBuffer):
+		buf = uint8Array.New(buf)
+	case arrayBuffer.Call("isView", buf).Bool():
+		if !buf.InstanceOf(uint8Array) {
+			buf = uint8Array.New(buf.Get("buffer"), buf.Get("byteOffset"), buf.Get("byteLength"))
+		}
+	default:
+		panic("hyperpb: expected an ArrayBuffer or ArrayBuffer view, got " + buf.Type().String())
+	}
+
+	n := buf.Get("byteLength").Int()
+	data := make([]byte, n, n+padding)
+	js.CopyBytesToGo(data, buf)
+	return data
+}
+
+// Unmarshal parses the contents of a JavaScript ArrayBuffer, typed array, or
+// DataView into m.
+//
+// The contents of buf are copied exactly once, using [Bytes]. Because the copy
+// is owned by m, this implies [hyperpb.WithAllowAlias], which is appended to
+// options.
+func Unmarshal(m *hyperpb.Message, buf js.Value, options ...hyperpb.UnmarshalOption) error {
+	return m.Unmarshal(Bytes(buf), append(options, hyperpb.WithAllowAlias(true))...)
+}
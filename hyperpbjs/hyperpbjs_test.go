@@ -0,0 +1,61 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build js && wasm
+
+package hyperpbjs_test
+
+import (
+	"syscall/js"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/hyperpbjs"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	want := &testpb.Scalars{A1: 42, A11: 1.5}
+	data, err := proto.Marshal(want)
+	require.NoError(t, err)
+
+	// Surround the message with junk, to check that views are respected.
+	buf := js.Global().Get("ArrayBuffer").New(len(data) + 8)
+	u8 := js.Global().Get("Uint8Array").New(buf)
+	u8.Call("fill", 0xff)
+	js.CopyBytesToJS(u8.Call("subarray", 4, 4+len(data)), data)
+
+	ty := hyperpb.CompileMessageDescriptor(want.ProtoReflect().Descriptor())
+	for _, view := range []js.Value{
+		js.Global().Get("Uint8Array").New(buf, 4, len(data)),
+		js.Global().Get("DataView").New(buf, 4, len(data)),
+		buf.Call("slice", 4, 4+len(data)),
+	} {
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, hyperpbjs.Unmarshal(m, view))
+
+		got := new(testpb.Scalars)
+		require.NoError(t, proto.Unmarshal(hyperpbjs.Bytes(view), got))
+		assert.True(t, proto.Equal(want, got), "got %v, want %v", got, want)
+		assert.Equal(t, int32(42), int32(m.Get(m.Descriptor().Fields().ByName("a1")).Int()))
+	}
+
+	assert.Panics(t, func() { hyperpbjs.Bytes(js.ValueOf("not a buffer")) })
+}
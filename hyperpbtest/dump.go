@@ -0,0 +1,223 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpbtest
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"unsafe"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/xunsafe"
+)
+
+// Dump returns a description of how m's contents are stored, for use in
+// golden tests of parser behavior.
+//
+// Unlike formatting m with prototext, Dump shows where each value lives:
+// strings and bytes which alias the input buffer are annotated with the range
+// of the input they alias, and unknown fields are printed as ranges of the
+// input.
+//
+// The output does not depend on where m was allocated, so it is the same
+// across runs. Messages are named @1, @2, and so on in the order they are
+// first printed rather than by address, fields are printed in field number
+// order, and map entries are printed in key order.
+//
+// The format of the output is not stable across versions of hyperpb.
+func Dump(m *hyperpb.Message) string {
+	d := &dumper{ids: make(map[*hyperpb.Message]int)}
+	d.message(m)
+	d.buf.WriteByte('\n')
+	return d.buf.String()
+}
+
+// dumper is state for [Dump].
+type dumper struct {
+	buf    strings.Builder
+	indent int
+	ids    map[*hyperpb.Message]int
+	src    []byte
+}
+
+func (d *dumper) message(m *hyperpb.Message) {
+	id, ok := d.ids[m]
+	if ok {
+		fmt.Fprintf(&d.buf, "@%d", id)
+		return
+	}
+	id = len(d.ids) + 1
+	d.ids[m] = id
+
+	impl := xunsafe.Cast[dynamic.Message](m)
+	if d.src == nil && impl.Shared.Src != nil {
+		d.src = unsafe.Slice(impl.Shared.Src, impl.Shared.Len)
+	}
+
+	fmt.Fprintf(&d.buf, "@%d %s {", id, m.Descriptor().FullName())
+
+	type entry struct {
+		fd protoreflect.FieldDescriptor
+		v  protoreflect.Value
+	}
+	var fields []entry
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields = append(fields, entry{fd, v})
+		return true
+	})
+	slices.SortFunc(fields, func(a, b entry) int {
+		return cmp.Compare(a.fd.Number(), b.fd.Number())
+	})
+
+	d.indent++
+	for _, f := range fields {
+		d.newline()
+		if f.fd.IsExtension() {
+			fmt.Fprintf(&d.buf, "[%s]/%d: ", f.fd.FullName(), f.fd.Number())
+		} else {
+			fmt.Fprintf(&d.buf, "%s/%d: ", f.fd.Name(), f.fd.Number())
+		}
+
+		switch {
+		case f.fd.IsList():
+			d.list(f.fd, f.v.List())
+		case f.fd.IsMap():
+			d.mapping(f.fd, f.v.Map())
+		default:
+			d.value(f.fd, f.v)
+		}
+	}
+
+	empty := len(fields) == 0
+	if cold := impl.Cold(); cold != nil {
+		for _, r := range cold.Unknown.Raw() {
+			d.newline()
+			fmt.Fprintf(&d.buf, "unknown: src[%d:%d] %x", r.Start(), r.End(), r.Bytes(impl.Shared.Src))
+			empty = false
+		}
+		if cold.OwnedUnknown.Len() > 0 {
+			d.newline()
+			fmt.Fprintf(&d.buf, "unknown: %x", cold.OwnedUnknown.Raw())
+			empty = false
+		}
+	}
+	d.indent--
+
+	if !empty {
+		d.newline()
+	}
+	d.buf.WriteByte('}')
+}
+
+func (d *dumper) list(fd protoreflect.FieldDescriptor, list protoreflect.List) {
+	d.buf.WriteByte('[')
+	d.indent++
+	for i := range list.Len() {
+		d.newline()
+		d.value(fd, list.Get(i))
+	}
+	d.indent--
+	d.newline()
+	d.buf.WriteByte(']')
+}
+
+func (d *dumper) mapping(fd protoreflect.FieldDescriptor, m protoreflect.Map) {
+	var keys []protoreflect.MapKey
+	m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	slices.SortFunc(keys, compareKeys)
+
+	d.buf.WriteByte('{')
+	d.indent++
+	for _, k := range keys {
+		d.newline()
+		d.value(fd.MapKey(), k.Value())
+		d.buf.WriteString(": ")
+		d.value(fd.MapValue(), m.Get(k))
+	}
+	d.indent--
+	d.newline()
+	d.buf.WriteByte('}')
+}
+
+func (d *dumper) value(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if m, ok := v.Message().(*hyperpb.Message); ok {
+			d.message(m)
+			return
+		}
+		fmt.Fprintf(&d.buf, "%s {}", fd.Message().FullName())
+	case protoreflect.EnumKind:
+		fmt.Fprintf(&d.buf, "%d", v.Enum())
+	case protoreflect.StringKind:
+		s := v.String()
+		fmt.Fprintf(&d.buf, "%q", s)
+		d.location(unsafe.StringData(s), len(s))
+	case protoreflect.BytesKind:
+		b := v.Bytes()
+		fmt.Fprintf(&d.buf, "%q", b)
+		d.location(unsafe.SliceData(b), len(b))
+	default:
+		fmt.Fprintf(&d.buf, "%v", v.Interface())
+	}
+}
+
+// location prints the range of the input that the n bytes at p alias, if
+// they do.
+func (d *dumper) location(p *byte, n int) {
+	if n == 0 || len(d.src) == 0 {
+		return
+	}
+	start := xunsafe.Sub(p, unsafe.SliceData(d.src))
+	if start >= 0 && start+n <= len(d.src) {
+		fmt.Fprintf(&d.buf, " src[%d:%d]", start, start+n)
+	}
+}
+
+func (d *dumper) newline() {
+	d.buf.WriteByte('\n')
+	for range d.indent {
+		d.buf.WriteString("  ")
+	}
+}
+
+// compareKeys orders map keys of the same kind.
+func compareKeys(a, b protoreflect.MapKey) int {
+	switch v := a.Interface().(type) {
+	case bool:
+		switch {
+		case v == b.Bool():
+			return 0
+		case v:
+			return 1
+		default:
+			return -1
+		}
+	case int32, int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case uint32, uint64:
+		return cmp.Compare(a.Uint(), b.Uint())
+	default:
+		return strings.Compare(a.String(), b.String())
+	}
+}
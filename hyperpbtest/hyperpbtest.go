@@ -0,0 +1,111 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hyperpbtest provides utilities for testing code that uses hyperpb.
+package hyperpbtest
+
+import (
+	"testing"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/internal/debug"
+)
+
+// warmup is the number of times a workload is run before measuring it, which
+// is enough for an arena to learn the size of the largest block it needs.
+const warmup = 16
+
+// AllocsPerRun returns the average number of heap allocations made by parsing
+// data as a message of type ty and then calling read on the result, like
+// [testing.AllocsPerRun] does. read may be nil.
+//
+// Unlike calling testing.AllocsPerRun directly, this accounts for hyperpb's
+// internal pooling: every run parses into a message allocated by the same
+// [hyperpb.Shared], which is freed after each run, and the workload is run
+// several times before measuring, so that the Shared's arena and hyperpb's
+// internal pools reach their steady-state sizes. This reflects a service that
+// re-uses Shareds, such as with a [hyperpb.MessagePool].
+//
+// Parsing copies data unless [hyperpb.WithAllowAlias] is passed as one of the
+// options, which costs an allocation per run.
+//
+// Returns an error if parsing fails. Like testing.AllocsPerRun, this must not
+// be called from parallel tests.
+func AllocsPerRun(
+	runs int,
+	ty *hyperpb.MessageType,
+	data []byte,
+	read func(*hyperpb.Message),
+	options ...hyperpb.UnmarshalOption,
+) (float64, error) {
+	s := new(hyperpb.Shared)
+	var err error
+	run := func() {
+		m := s.NewMessage(ty)
+		if err = m.Unmarshal(data, options...); err == nil && read != nil {
+			read(m)
+		}
+		s.Free()
+	}
+
+	for range warmup {
+		if run(); err != nil {
+			return 0, err
+		}
+	}
+	allocs := testing.AllocsPerRun(runs, run)
+	return allocs, err
+}
+
+// AssertMaxAllocs fails t if parsing data as a message of type ty and calling
+// read on the result allocates more than limit times on average, as measured
+// by [AllocsPerRun]. This is intended for codifying that a particular use of
+// hyperpb does not regress in allocations when hyperpb is upgraded.
+//
+// Skips t if hyperpb is built with debugging enabled, since debug logging
+// allocates.
+func AssertMaxAllocs(
+	t testing.TB,
+	limit float64,
+	ty *hyperpb.MessageType,
+	data []byte,
+	read func(*hyperpb.Message),
+	options ...hyperpb.UnmarshalOption,
+) {
+	t.Helper()
+	if debug.Enabled {
+		t.Skip("hyperpb allocates when debugging is enabled")
+	}
+
+	allocs, err := AllocsPerRun(100, ty, data, read, options...)
+	if err != nil {
+		t.Fatalf("hyperpbtest: parsing %s failed: %v", ty.Descriptor().FullName(), err)
+	}
+	if allocs > limit {
+		t.Errorf("hyperpbtest: parsing %s allocated %v times per run, want at most %v",
+			ty.Descriptor().FullName(), allocs, limit)
+	}
+}
+
+// AssertNoAlloc is like [AssertMaxAllocs] with a limit of zero.
+func AssertNoAlloc(
+	t testing.TB,
+	ty *hyperpb.MessageType,
+	data []byte,
+	read func(*hyperpb.Message),
+	options ...hyperpb.UnmarshalOption,
+) {
+	t.Helper()
+	AssertMaxAllocs(t, 0, ty, data, read, options...)
+}
@@ -0,0 +1,134 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpbtest_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/hyperpbtest"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+//nolint:paralleltest // AllocsPerRun panics in parallel tests.
+func TestAllocsPerRun(t *testing.T) {
+	md := (*testpb.Graph)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md)
+	data, err := proto.Marshal(&testpb.Graph{V: 1, S: &testpb.Graph{V: 2}, R: []*testpb.Graph{{V: 3}, {V: 4}}})
+	require.NoError(t, err)
+
+	v := md.Fields().ByName("v")
+	r := md.Fields().ByName("r")
+	var sum int64
+	read := func(m *hyperpb.Message) {
+		for sub := range hyperpb.Messages(m.Get(r).List()) {
+			sum += sub.Get(v).Int()
+		}
+	}
+
+	hyperpbtest.AssertNoAlloc(t, ty, data, read, hyperpb.WithAllowAlias(true))
+	assert.Positive(t, sum)
+
+	// Copying the input allocates.
+	allocs, err := hyperpbtest.AllocsPerRun(10, ty, data, read)
+	require.NoError(t, err)
+	assert.Positive(t, allocs)
+	hyperpbtest.AssertMaxAllocs(t, allocs, ty, data, read)
+
+	_, err = hyperpbtest.AllocsPerRun(10, ty, []byte{0xff}, read)
+	require.Error(t, err)
+}
+
+func TestDump(t *testing.T) {
+	t.Parallel()
+
+	marshal := func(msgs ...proto.Message) []byte {
+		var data []byte
+		for _, msg := range msgs {
+			var err error
+			data, err = proto.MarshalOptions{}.MarshalAppend(data, msg)
+			require.NoError(t, err)
+		}
+		return data
+	}
+
+	graph := &testpb.Graph{V: 1, S: &testpb.Graph{V: 2}, R: []*testpb.Graph{{V: 3}, {}}}
+	graph.ProtoReflect().SetUnknown(protowire.AppendVarint(protowire.AppendTag(nil, 100, protowire.VarintType), 5))
+
+	tests := []struct {
+		md   protoreflect.MessageDescriptor
+		data []byte
+		want string
+	}{
+		{
+			md:   graph.ProtoReflect().Descriptor(),
+			data: marshal(graph),
+			want: `@1 hyperpb.test.Graph {
+  v/1: 1
+  s/2: @2 hyperpb.test.Graph {
+    v/1: 2
+  }
+  r/3: [
+    @3 hyperpb.test.Graph {
+      v/1: 3
+    }
+    @4 hyperpb.test.Graph {}
+  ]
+  unknown: src[12:15] a00605
+}
+`,
+		},
+		{
+			md: (*testpb.Maps)(nil).ProtoReflect().Descriptor(),
+			data: marshal(
+				&testpb.Maps{M1E: map[int32]string{3: "c"}},
+				&testpb.Maps{M1E: map[int32]string{-1: "a"}},
+				&testpb.Maps{M1E: map[int32]string{2: "b"}},
+			),
+			want: `@1 hyperpb.test.Maps {
+  m1e/30: {
+    -1: "a" src[24:25]
+    2: "b" src[32:33]
+    3: "c" src[7:8]
+  }
+}
+`,
+		},
+		{
+			md:   (*testpb.Scalars)(nil).ProtoReflect().Descriptor(),
+			data: marshal(&testpb.Scalars{A14: "hello", A15: []byte("world")}),
+			want: `@1 hyperpb.test.Scalars {
+  a14/14: "hello" src[2:7]
+  a15/15: "world" src[9:14]
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		ty := hyperpb.CompileMessageDescriptor(tt.md)
+		for _, alias := range []bool{false, true} {
+			m := hyperpb.NewMessage(ty)
+			require.NoError(t, m.Unmarshal(tt.data, hyperpb.WithAllowAlias(alias)))
+			assert.Equal(t, tt.want, hyperpbtest.Dump(m))
+		}
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command hyperpbvet runs the [hyperpbvet.Analyzer] over the given packages.
+//
+// Usage:
+//
+//	hyperpbvet [flags] packages...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/singlechecker"
+
+	"buf.build/go/hyperpb/hyperpbvet"
+)
+
+func main() {
+	singlechecker.Main(hyperpbvet.Analyzer)
+}
@@ -0,0 +1,468 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hyperpbvet provides a static analyzer that reports common misuses of
+// hyperpb, which are easy to make because hyperpb messages are read-only and
+// are only valid for the lifetime of their [hyperpb.Shared].
+//
+// The analyzer reports:
+//
+//   - Calls to methods that mutate a [*hyperpb.Message], such as Set and
+//     Clear, and merging into one with proto.Merge. These panic. So does
+//     SetUnknown, on a message that was unmarshaled with WithDedupMessages.
+//   - Compiling a type inside of a loop, when what is being compiled does not
+//     depend on the loop. Compiling is expensive, and compiled types should be
+//     cached. This is not reported in tests, which may do so deliberately.
+//   - Using a message after the Shared it was allocated with is freed, or
+//     returning a message from a function that frees its Shared in a deferred
+//     call.
+//
+// The lifetime checks only follow messages allocated with [Shared.NewMessage]
+// that are stored in local variables, so they will not catch every
+// use-after-free; see [Shared.TrackMessages] for catching them at runtime.
+//
+// The analyzer can be run with the hyperpbvet command:
+//
+//	go run buf.build/go/hyperpb/hyperpbvet/cmd/hyperpbvet ./...
+//
+// [Shared.NewMessage]: https://pkg.go.dev/buf.build/go/hyperpb#Shared.NewMessage
+// [Shared.TrackMessages]: https://pkg.go.dev/buf.build/go/hyperpb#Shared.TrackMessages
+package hyperpbvet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+const (
+	hyperpbPath = "buf.build/go/hyperpb"
+	protoPath   = "google.golang.org/protobuf/proto"
+)
+
+// Analyzer reports misuses of hyperpb. See the package documentation for
+// details.
+var Analyzer = &analysis.Analyzer{
+	Name:     "hyperpbvet",
+	Doc:      "report misuse of hyperpb messages and types",
+	URL:      "https://pkg.go.dev/buf.build/go/hyperpb/hyperpbvet",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	if pass.Pkg.Path() == hyperpbPath {
+		return nil, nil // hyperpb itself is allowed to do anything.
+	}
+
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector) //nolint:errcheck // Guaranteed by Requires.
+
+	ins.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if push {
+			checkCall(pass, n.(*ast.CallExpr), stack) //nolint:errcheck // Guaranteed by the filter.
+		}
+		return true
+	})
+
+	ins.Preorder([]ast.Node{(*ast.FuncDecl)(nil), (*ast.FuncLit)(nil)}, func(n ast.Node) {
+		var body *ast.BlockStmt
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			body = n.Body
+		case *ast.FuncLit:
+			body = n.Body
+		}
+		if body != nil {
+			newLifetimes(pass).walk(body)
+		}
+	})
+
+	return nil, nil
+}
+
+// checkCall checks for calls to mutation methods and compilation in loops.
+// stack ends with call.
+func checkCall(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node) {
+	fn, _ := typeutil.Callee(pass.TypesInfo, call).(*types.Func)
+	switch {
+	case isMethod(fn, "Message", "Set"):
+		pass.Reportf(call.Pos(), "Message.Set panics: hyperpb messages are read-only")
+
+	case isMethod(fn, "Message", "SetUnknown"):
+		if unmarshaledWithDedup(pass, call, stack) {
+			pass.Reportf(call.Pos(), "Message.SetUnknown panics on messages unmarshaled with WithDedupMessages")
+		}
+
+	case isMethod(fn, "Message", "Clear"), isMethod(fn, "Message", "Reset"):
+		pass.Reportf(call.Pos(), "Message.%s panics once a message is unmarshaled: hyperpb messages are read-only", fn.Name())
+
+	case fn != nil && fn.Pkg() != nil && fn.Pkg().Path() == protoPath && fn.Name() == "Merge":
+		if len(call.Args) == 2 && isMessagePtr(pass.TypesInfo.TypeOf(call.Args[0])) {
+			pass.Reportf(call.Pos(), "proto.Merge into a *hyperpb.Message panics: hyperpb messages are read-only")
+		}
+
+	case isFunc(fn, "CompileFor"), isFunc(fn, "CompileMessageDescriptor"),
+		isFunc(fn, "CompileFileDescriptorSet"), isFunc(fn, "CompileFileDescriptorSetBytes"),
+		isFunc(fn, "CompileAsync"):
+		if strings.HasSuffix(pass.Fset.File(call.Pos()).Name(), "_test.go") {
+			break
+		}
+		if loop := enclosingLoop(stack); loop != nil && !usesLoopVars(pass, call, loop) {
+			pass.Reportf(call.Pos(), "%s is called on every iteration of a loop: compiling is expensive, and compiled types should be cached", fn.Name())
+		}
+	}
+}
+
+// unmarshaledWithDedup returns whether call, a method call on a message in a
+// local variable, is in the same function as a call to Unmarshal on that
+// variable that passes WithDedupMessages. stack ends with call.
+func unmarshaledWithDedup(pass *analysis.Pass, call *ast.CallExpr, stack []ast.Node) bool {
+	recv := receiverVar(pass, call)
+	if recv == nil {
+		return false
+	}
+
+	var body ast.Node
+	for i := len(stack) - 1; i >= 0 && body == nil; i-- {
+		switch n := stack[i].(type) {
+		case *ast.FuncDecl:
+			body = n.Body
+		case *ast.FuncLit:
+			body = n.Body
+		}
+	}
+	if body == nil {
+		return false
+	}
+
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		unmarshal, ok := n.(*ast.CallExpr)
+		if !ok || found {
+			return !found
+		}
+		fn, _ := typeutil.Callee(pass.TypesInfo, unmarshal).(*types.Func)
+		if !isMethod(fn, "Message", "Unmarshal") || receiverVar(pass, unmarshal) != recv {
+			return true
+		}
+		for _, arg := range unmarshal.Args {
+			if opt, ok := arg.(*ast.CallExpr); ok {
+				fn, _ := typeutil.Callee(pass.TypesInfo, opt).(*types.Func)
+				found = found || isFunc(fn, "WithDedupMessages")
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// receiverVar returns the variable that call, a method call, is made on, if
+// it is made directly on a variable.
+func receiverVar(pass *analysis.Pass, call *ast.CallExpr) *types.Var {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	id, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	v, _ := pass.TypesInfo.Uses[id].(*types.Var)
+	return v
+}
+
+// isFunc returns whether fn is the package-level hyperpb function with the
+// given name.
+func isFunc(fn *types.Func, name string) bool {
+	return fn != nil && fn.Pkg() != nil && fn.Pkg().Path() == hyperpbPath &&
+		fn.Name() == name && fn.Signature().Recv() == nil
+}
+
+// isMethod returns whether fn is the method of the given hyperpb type with the
+// given name.
+func isMethod(fn *types.Func, recv, name string) bool {
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != hyperpbPath || fn.Name() != name {
+		return false
+	}
+	r := fn.Signature().Recv()
+	if r == nil {
+		return false
+	}
+	return isNamed(r.Type(), recv)
+}
+
+// isMessagePtr returns whether t is *hyperpb.Message.
+func isMessagePtr(t types.Type) bool {
+	ptr, ok := t.(*types.Pointer)
+	return ok && isNamed(ptr.Elem(), "Message")
+}
+
+// isNamed returns whether t is the hyperpb type with the given name, or a
+// pointer to it.
+func isNamed(t types.Type, name string) bool {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == hyperpbPath &&
+		named.Obj().Name() == name
+}
+
+// enclosingLoop returns the innermost loop whose body contains the last node
+// of stack, stopping at function boundaries.
+func enclosingLoop(stack []ast.Node) ast.Node {
+	for i := len(stack) - 2; i >= 0; i-- {
+		switch n := stack[i].(type) {
+		case *ast.FuncLit, *ast.FuncDecl:
+			return nil
+		case *ast.ForStmt:
+			if stack[i+1] == n.Body {
+				return n
+			}
+		case *ast.RangeStmt:
+			if stack[i+1] == n.Body {
+				return n
+			}
+		}
+	}
+	return nil
+}
+
+// usesLoopVars returns whether call refers to any variable declared inside of
+// loop, including its loop variables.
+func usesLoopVars(pass *analysis.Pass, call *ast.CallExpr, loop ast.Node) bool {
+	found := false
+	ast.Inspect(call, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			if v, ok := pass.TypesInfo.Uses[id].(*types.Var); ok && v.Pos() >= loop.Pos() && v.Pos() < loop.End() {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// lifetimes tracks the messages allocated from a Shared in local variables
+// of a single function, in source order.
+type lifetimes struct {
+	pass *analysis.Pass
+
+	owner    map[types.Object]types.Object // Message variable to Shared variable.
+	freed    map[types.Object]bool         // Message variables whose Shared was freed.
+	deferred map[types.Object]bool         // Shared variables freed by a defer.
+	reported map[types.Object]bool
+
+	// The nodes being visited; nil for nodes other than blocks.
+	stack []*block
+}
+
+// block is a block of statements being visited.
+type block struct {
+	// Whether this block ends by leaving it early, such as on an error path.
+	exits bool
+	// Messages which were freed inside of this block, and which can still be
+	// used after it if it exits.
+	freed []types.Object
+}
+
+func newLifetimes(pass *analysis.Pass) *lifetimes {
+	return &lifetimes{
+		pass:     pass,
+		owner:    make(map[types.Object]types.Object),
+		freed:    make(map[types.Object]bool),
+		deferred: make(map[types.Object]bool),
+		reported: make(map[types.Object]bool),
+	}
+}
+
+// walk visits the nodes of body in source order, except for nested function
+// literals, which are checked separately.
+func (l *lifetimes) walk(body ast.Node) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil {
+			l.leave()
+			return true
+		}
+		if !l.visit(n) {
+			return false
+		}
+		l.enter(n)
+		return true
+	})
+}
+
+// enter pushes n onto the stack of nodes being visited.
+func (l *lifetimes) enter(n ast.Node) {
+	var stmts []ast.Stmt
+	switch n := n.(type) {
+	case *ast.BlockStmt:
+		stmts = n.List
+	case *ast.CaseClause:
+		stmts = n.Body
+	case *ast.CommClause:
+		stmts = n.Body
+	default:
+		l.stack = append(l.stack, nil)
+		return
+	}
+
+	// The function body always exits; it is not early.
+	b := new(block)
+	b.exits = len(l.stack) > 0 && len(stmts) > 0 && exits(stmts[len(stmts)-1])
+	l.stack = append(l.stack, b)
+}
+
+// leave pops the node being visited.
+func (l *lifetimes) leave() {
+	b := l.stack[len(l.stack)-1]
+	l.stack = l.stack[:len(l.stack)-1]
+	if b != nil && b.exits {
+		// Code after this block is only reached if it did not run.
+		for _, v := range b.freed {
+			l.freed[v] = false
+		}
+	}
+}
+
+// free records that the Shared s was freed.
+func (l *lifetimes) free(s types.Object) {
+	var exit *block
+	for i := len(l.stack) - 1; i >= 0; i-- {
+		if b := l.stack[i]; b != nil && b.exits {
+			exit = b
+			break
+		}
+	}
+
+	for v, owner := range l.owner {
+		if owner != s || l.freed[v] {
+			continue
+		}
+		l.freed[v] = true
+		if exit != nil {
+			exit.freed = append(exit.freed, v)
+		}
+	}
+}
+
+// visit checks n, and returns whether its children should be visited.
+func (l *lifetimes) visit(n ast.Node) bool {
+	switch n := n.(type) {
+	case *ast.FuncLit:
+		return false
+
+	case *ast.AssignStmt:
+		// The right-hand side is evaluated before anything is assigned.
+		for _, rhs := range n.Rhs {
+			l.walk(rhs)
+		}
+		for i, lhs := range n.Lhs {
+			id, ok := lhs.(*ast.Ident)
+			if !ok {
+				l.walk(lhs)
+				continue
+			}
+			v := l.pass.TypesInfo.ObjectOf(id)
+			if v == nil {
+				continue
+			}
+			delete(l.owner, v)
+			delete(l.freed, v)
+			if len(n.Lhs) == len(n.Rhs) {
+				if s := l.sharedOf(n.Rhs[i], "NewMessage"); s != nil {
+					l.owner[v] = s
+				}
+			}
+		}
+		return false
+
+	case *ast.DeferStmt:
+		if s := l.sharedOf(n.Call, "Free"); s != nil {
+			l.deferred[s] = true
+			return false
+		}
+
+	case *ast.ReturnStmt:
+		for _, result := range n.Results {
+			id, ok := ast.Unparen(result).(*ast.Ident)
+			if !ok {
+				continue
+			}
+			v := l.pass.TypesInfo.Uses[id]
+			if s := l.owner[v]; s != nil && l.deferred[s] {
+				l.pass.Reportf(id.Pos(), "%s is returned, but %s, which it was allocated with, is freed by a deferred call", id.Name, s.Name())
+			}
+		}
+
+	case *ast.CallExpr:
+		if s := l.sharedOf(n, "Free"); s != nil {
+			l.free(s)
+		}
+
+	case *ast.Ident:
+		v := l.pass.TypesInfo.Uses[n]
+		if l.freed[v] && !l.reported[v] {
+			l.reported[v] = true
+			l.pass.Reportf(n.Pos(), "%s is used after %s, which it was allocated with, is freed", n.Name, l.owner[v].Name())
+		}
+	}
+	return true
+}
+
+// exits returns whether stmt unconditionally leaves the current block.
+func exits(stmt ast.Stmt) bool {
+	switch stmt := stmt.(type) {
+	case *ast.ReturnStmt:
+		return true
+	case *ast.BranchStmt:
+		return stmt.Tok != token.FALLTHROUGH
+	case *ast.ExprStmt:
+		call, ok := stmt.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		id, ok := ast.Unparen(call.Fun).(*ast.Ident)
+		return ok && id.Name == "panic"
+	}
+	return false
+}
+
+// sharedOf returns the variable s if expr is a call of the form s.name(...),
+// where s is a *hyperpb.Shared.
+func (l *lifetimes) sharedOf(expr ast.Expr, name string) types.Object {
+	call, ok := ast.Unparen(expr).(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	fn, _ := typeutil.Callee(l.pass.TypesInfo, call).(*types.Func)
+	if !isMethod(fn, "Shared", name) {
+		return nil
+	}
+	sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	id, ok := ast.Unparen(sel.X).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	return l.pass.TypesInfo.Uses[id]
+}
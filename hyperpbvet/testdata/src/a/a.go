@@ -0,0 +1,84 @@
+package a
+
+import (
+	"errors"
+
+	"buf.build/go/hyperpb"
+	"google.golang.org/protobuf/proto"
+)
+
+type T struct{}
+
+func mutate(m *hyperpb.Message, src proto.Message) {
+	m.Set(nil, nil)         // want `Message.Set panics`
+	m.Clear(nil)            // want `Message.Clear panics once a message is unmarshaled`
+	m.Reset()               // want `Message.Reset panics once a message is unmarshaled`
+	m.SetUnknown([]byte{1}) // OK: replaces the unknown fields.
+	proto.Merge(m, src)     // want `proto.Merge into a \*hyperpb.Message panics`
+	proto.Merge(src, m)     // OK.
+	_ = m.Get(nil)
+}
+
+func mutateDeduped(m, n *hyperpb.Message, data []byte) {
+	_ = m.Unmarshal(data, hyperpb.WithDedupMessages(64))
+	_ = n.Unmarshal(data)
+	m.SetUnknown(nil) // want `Message.SetUnknown panics on messages unmarshaled with WithDedupMessages`
+	n.SetUnknown(nil) // OK: not deduplicated.
+}
+
+func compile(mds []any) {
+	for range 10 {
+		_ = hyperpb.CompileFor[T]() // want `CompileFor is called on every iteration of a loop`
+	}
+	for _, md := range mds {
+		_ = hyperpb.CompileMessageDescriptor(md) // OK: depends on the loop.
+	}
+	for i := 0; i < len(mds); i++ {
+		md := mds[i]
+		_ = hyperpb.CompileMessageDescriptor(md)     // OK: depends on the loop.
+		_ = hyperpb.CompileMessageDescriptor(mds[0]) // want `CompileMessageDescriptor is called on every iteration of a loop`
+	}
+	for range 10 {
+		go func() {
+			_ = hyperpb.CompileFor[T]() // OK: not directly inside of the loop.
+		}()
+	}
+	_ = hyperpb.CompileFor[T]()
+}
+
+func useAfterFree(ty *hyperpb.MessageType, data [][]byte) error {
+	s := new(hyperpb.Shared)
+	m := s.NewMessage(ty)
+	if err := m.Unmarshal(data[0]); err != nil {
+		s.Free() // OK: the function returns right after.
+		return err
+	}
+	s.Free()
+	_ = m.Get(nil) // want `m is used after s, which it was allocated with, is freed`
+	_ = m.Get(nil) // Only reported once.
+
+	for _, b := range data {
+		m := s.NewMessage(ty)
+		_ = m.Unmarshal(b)
+		s.Free()
+	}
+
+	m = s.NewMessage(ty)
+	_ = m.Get(nil) // OK: reallocated.
+	return nil
+}
+
+func returnAfterFree(ty *hyperpb.MessageType, data []byte) (*hyperpb.Message, error) {
+	s := new(hyperpb.Shared)
+	defer s.Free()
+	m := s.NewMessage(ty)
+	if err := m.Unmarshal(data); err != nil {
+		return nil, errors.New("bad")
+	}
+	return m, nil // want `m is returned, but s, which it was allocated with, is freed by a deferred call`
+}
+
+func returnOK(ty *hyperpb.MessageType, data []byte) (*hyperpb.Message, error) {
+	m := hyperpb.NewMessage(ty)
+	return m, m.Unmarshal(data)
+}
@@ -0,0 +1,13 @@
+package a
+
+import (
+	"testing"
+
+	"buf.build/go/hyperpb"
+)
+
+func BenchmarkCompile(b *testing.B) {
+	for range b.N {
+		_ = hyperpb.CompileFor[T]() // OK: in a test.
+	}
+}
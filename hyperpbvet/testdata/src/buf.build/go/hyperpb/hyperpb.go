@@ -0,0 +1,27 @@
+// Package hyperpb is a stub of the real package, for testing the analyzer.
+package hyperpb
+
+type MessageType struct{}
+
+type Message struct{}
+
+func (*Message) Set(fd, v any)                                           {}
+func (*Message) Clear(fd any)                                            {}
+func (*Message) Reset()                                                  {}
+func (*Message) SetUnknown(raw []byte)                                   {}
+func (*Message) Get(fd any) any                                          { return nil }
+func (*Message) Unmarshal(data []byte, options ...UnmarshalOption) error { return nil }
+
+type Shared struct{}
+
+func (*Shared) NewMessage(ty *MessageType) *Message { return nil }
+func (*Shared) Free()                               {}
+
+func NewMessage(ty *MessageType) *Message { return nil }
+
+type UnmarshalOption struct{}
+
+func WithDedupMessages(maxSize int) UnmarshalOption { return UnmarshalOption{} }
+
+func CompileFor[M any](options ...any) *MessageType                { return nil }
+func CompileMessageDescriptor(md any, options ...any) *MessageType { return nil }
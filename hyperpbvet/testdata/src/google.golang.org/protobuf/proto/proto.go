@@ -0,0 +1,6 @@
+// Package proto is a stub of the real package, for testing the analyzer.
+package proto
+
+type Message = any
+
+func Merge(dst, src Message) {}
@@ -0,0 +1,104 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hyperunsafe provides access to the internal storage of hyperpb
+// messages, for building zero-copy views over them.
+//
+// The functions in this package return slices which alias memory owned by a
+// message, either on its [hyperpb.Shared] arena or, when parsing with
+// [hyperpb.WithAllowAlias], the input buffer. Callers must uphold the following
+// invariants, or else risk memory corruption:
+//
+//   - Returned slices must not be written to.
+//   - Returned slices must not be used after calling [hyperpb.Shared.Free] on
+//     the message's [hyperpb.Shared].
+//   - If the message was parsed with [hyperpb.WithAllowAlias], the input buffer
+//     must not be modified while returned slices are in use.
+//
+// Holding a returned slice keeps the message's memory alive, in the same way
+// that holding the message itself does.
+package hyperunsafe
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/internal/tdp/repeated"
+)
+
+// Scalar is a type that the elements of a repeated scalar field may be
+// stored as.
+type Scalar interface {
+	int32 | uint32 | int64 | uint64 | float32 | float64 | protoreflect.EnumNumber
+}
+
+// RepeatedScalars returns the elements of a repeated scalar field without
+// copying them.
+//
+// E must be the Go type for fd's kind, e.g. int32 for an int32 or sfixed32
+// field, or [protoreflect.EnumNumber] for an enum field. sint32 and sint64
+// fields are not supported, since their elements are stored zigzag-encoded.
+//
+// Returns false if E is the wrong type for fd, or if the elements are not
+// stored as a slice of E. Fields of varint type are stored as a slice of
+// bytes when every element is a one-byte varint; see [CompactVarints].
+func RepeatedScalars[E Scalar](m *hyperpb.Message, fd protoreflect.FieldDescriptor) ([]E, bool) {
+	if !fd.IsList() || !isKindOf[E](fd.Kind()) {
+		return nil, false
+	}
+
+	list := m.Get(fd).List()
+	if list.Len() == 0 {
+		return nil, true
+	}
+	return repeated.ScalarSlice[E](list)
+}
+
+// CompactVarints returns the elements of a repeated int32, int64, uint32,
+// uint64 or enum field without copying them, if every element was encoded as
+// a one-byte varint.
+//
+// In this case, each byte of the returned slice is the value of the
+// corresponding element. Otherwise, returns false; use [RepeatedScalars]
+// instead.
+func CompactVarints(m *hyperpb.Message, fd protoreflect.FieldDescriptor) ([]byte, bool) {
+	if !fd.IsList() {
+		return nil, false
+	}
+	return repeated.CompactVarints(m.Get(fd).List())
+}
+
+// isKindOf returns whether E is the storage type for repeated fields of
+// kind k.
+func isKindOf[E Scalar](k protoreflect.Kind) bool {
+	var z E
+	switch any(z).(type) {
+	case int32:
+		return k == protoreflect.Int32Kind || k == protoreflect.Sfixed32Kind
+	case uint32:
+		return k == protoreflect.Uint32Kind || k == protoreflect.Fixed32Kind
+	case int64:
+		return k == protoreflect.Int64Kind || k == protoreflect.Sfixed64Kind
+	case uint64:
+		return k == protoreflect.Uint64Kind || k == protoreflect.Fixed64Kind
+	case float32:
+		return k == protoreflect.FloatKind
+	case float64:
+		return k == protoreflect.DoubleKind
+	case protoreflect.EnumNumber:
+		return k == protoreflect.EnumKind
+	default:
+		return false
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperunsafe_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/hyperunsafe"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestRepeatedScalars(t *testing.T) {
+	t.Parallel()
+
+	data, err := proto.Marshal(&testpb.Repeated{
+		R1: []int32{1, 2, 3},
+		R2: []int64{1, 1 << 40, -1},
+		R5: []uint32{5, 6, 7},
+		R6: []uint64{8, 9},
+	})
+	require.NoError(t, err)
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Repeated)(nil).ProtoReflect().Descriptor())
+	fields := ty.Descriptor().Fields()
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+
+	r1, ok := hyperunsafe.CompactVarints(m, fields.ByName("r1"))
+	assert.True(t, ok)
+	assert.Equal(t, []byte{1, 2, 3}, r1)
+	_, ok = hyperunsafe.RepeatedScalars[int32](m, fields.ByName("r1"))
+	assert.False(t, ok)
+
+	r2, ok := hyperunsafe.RepeatedScalars[int64](m, fields.ByName("r2"))
+	assert.True(t, ok)
+	assert.Equal(t, []int64{1, 1 << 40, -1}, r2)
+	_, ok = hyperunsafe.CompactVarints(m, fields.ByName("r2"))
+	assert.False(t, ok)
+
+	r5, ok := hyperunsafe.RepeatedScalars[uint32](m, fields.ByName("r5"))
+	assert.True(t, ok)
+	assert.Equal(t, []uint32{5, 6, 7}, r5)
+
+	r6, ok := hyperunsafe.RepeatedScalars[uint64](m, fields.ByName("r6"))
+	assert.True(t, ok)
+	assert.Equal(t, []uint64{8, 9}, r6)
+
+	_, ok = hyperunsafe.RepeatedScalars[int32](m, fields.ByName("r5"))
+	assert.False(t, ok, "wrong element type")
+	_, ok = hyperunsafe.RepeatedScalars[int32](m, fields.ByName("r3"))
+	assert.False(t, ok, "zigzag field")
+}
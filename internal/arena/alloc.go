@@ -48,8 +48,15 @@ func (a *Arena) allocChunk(size int) (*byte, int) {
 	if int(log) < len(a.blocks) {
 		if a.blocks[log] == nil {
 			a.blocks[log] = AllocTraceable(n, unsafe.Pointer(a))
+			return a.blocks[log], n
 		}
-		return a.blocks[log], n
+
+		// A block of this size is already in use, which happens when the
+		// policy does not grow blocks. Only one block of each size is recycled
+		// by Free, so keep this one alive until then.
+		p := AllocTraceable(n, unsafe.Pointer(a))
+		a.spares = append(a.spares, unsafe.Slice(p, n))
+		return p, n
 	}
 
 	p := AllocTraceable(n, unsafe.Pointer(a))
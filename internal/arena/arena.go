@@ -49,6 +49,7 @@
 package arena
 
 import (
+	"math/bits"
 	"unsafe"
 
 	"buf.build/go/hyperpb/internal/debug"
@@ -71,9 +72,48 @@ type Arena struct {
 	// Blocks of memory allocated by this arena. Indexed by their size log 2.
 	blocks []*byte
 
+	// Blocks of a size that is already in blocks, which are discarded by
+	// Free rather than re-used.
+	spares [][]byte
+
 	// Data to keep around for the GC to mark whenever it marks an arena.
 	// Holding any pointer to the arena will keep anything here alive, too.
 	keep []unsafe.Pointer
+
+	// Controls the sizes of blocks allocated by Grow.
+	Policy Policy
+}
+
+// Policy controls how an [Arena] grows. The zero value is the default policy:
+// block sizes start small and double each time the arena grows.
+//
+// All block sizes are rounded up to a power of two.
+type Policy struct {
+	// The minimum size of the first block allocated by the arena.
+	Initial int
+	// The factor by which each block is larger than the previous one. Values
+	// smaller than one are treated as two, the default.
+	Growth int
+	// If positive, the maximum size of a block, except for blocks that need
+	// to fit a single larger allocation.
+	Max int
+}
+
+// next returns the size of the block to allocate after one of size prev, to
+// fit an allocation of the given size.
+func (p Policy) next(prev, size int) int {
+	next := p.Initial
+	if prev > 0 {
+		growth := p.Growth
+		if growth < 1 {
+			growth = 2
+		}
+		next = prev << bits.Len(uint(growth-1))
+		if p.Max > 0 {
+			next = min(next, p.Max)
+		}
+	}
+	return max(next, size)
 }
 
 // Align is the alignment of all objects on the arena.
@@ -123,6 +163,12 @@ func (a *Arena) Alloc(size int) *byte {
 	return p
 }
 
+// Available returns the number of bytes that can be allocated without calling
+// [Arena.Grow].
+func (a *Arena) Available() int {
+	return a.End.Sub(a.Next)
+}
+
 // Reserve ensures that at least size bytes can be allocated without calling
 // [Arena.Grow].
 func (a *Arena) Reserve(size int) {
@@ -142,6 +188,9 @@ func (a *Arena) Free() {
 	// an arena is re-used, we will eventually wind up learning the size of the
 	// largest block we need to allocate, and use only that one, meaning that
 	// "average" calls should never have to call Grow().
+	if len(a.blocks) == 0 {
+		return // Nothing has been allocated yet.
+	}
 	end := len(a.blocks) - 1
 	clear(a.blocks[:end])
 	xunsafe.Clear(a.blocks[end], 1<<end)
@@ -163,6 +212,25 @@ func (a *Arena) Free() {
 	// In profiling, it turns out that doing clear(a.keep) is several times
 	// more expensive than the noscan clear that happens below.
 	a.keep = nil
+	a.spares = nil
+}
+
+// Wipe zeroes all memory allocated by this arena, including the blocks that
+// [Arena.Free] discards without clearing. This is intended for arenas that
+// have held sensitive data, so that it does not linger in memory that is
+// awaiting garbage collection.
+//
+// Like Free, this invalidates all memory allocated by the arena, but unlike
+// Free, it does not make the arena ready for re-use; call Free afterwards.
+func (a *Arena) Wipe() {
+	for log, block := range a.blocks {
+		if block != nil {
+			xunsafe.Clear(block, 1<<log)
+		}
+	}
+	for _, block := range a.spares {
+		clear(block)
+	}
 }
 
 // Grow allocates fresh memory onto next of at least the given size.
@@ -170,7 +238,7 @@ func (a *Arena) Free() {
 // //go:nosplit // TODO(#30): Enable once upstream is fixed.
 func (a *Arena) Grow(size int) {
 	xunsafe.Escape(a)
-	p, n := a.allocChunk(max(size, a.Cap*2))
+	p, n := a.allocChunk(a.Policy.next(a.Cap, size))
 	// No need to KeepAlive(p) this pointer, since allocChunk sticks it in the
 	// dedicated memory block array.
 
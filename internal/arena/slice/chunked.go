@@ -0,0 +1,147 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+
+	"buf.build/go/hyperpb/internal/arena"
+	"buf.build/go/hyperpb/internal/xunsafe"
+	"buf.build/go/hyperpb/internal/xunsafe/layout"
+)
+
+// Chunked is an append-only sequence that points into an arena.
+//
+// Unlike a [Slice], appending to a Chunked never copies its elements. Instead,
+// the elements are stored in a list of chunks, each twice the size of the
+// previous. Chunk k holds 1<<(log+k) elements, so the chunk containing a
+// particular index can be found in constant time.
+//
+// A zero Chunked is empty and ready to use.
+type Chunked[T any] struct {
+	chunks Slice[xunsafe.Addr[T]] // Addresses of each chunk, in order.
+	len    uint32
+	log    uint32 // Log2 of the number of elements in the first chunk.
+}
+
+// chunkBytes is the size of the smallest chunk, unless overridden with
+// [MakeChunked].
+const chunkBytes = 64
+
+// MakeChunked returns an empty Chunked whose first chunk can hold at least n
+// elements.
+func MakeChunked[T any](n int) Chunked[T] {
+	return Chunked[T]{log: uint32(bits.Len(uint(max(n, 1) - 1)))}
+}
+
+// Len returns the number of elements in c.
+func (c Chunked[T]) Len() int {
+	return int(c.len)
+}
+
+// locate returns the chunk and index within that chunk of the nth element.
+func (c Chunked[T]) locate(n int) (chunk, idx int) {
+	// Chunk k begins at element (2^k - 1) << log.
+	q := uint(n)>>c.log + 1
+	chunk = bits.Len(q) - 1
+	idx = n - ((1<<chunk - 1) << c.log)
+	return chunk, idx
+}
+
+// Ptr returns a pointer to the nth element.
+//
+// Panics if the index is out-of-bounds.
+func (c Chunked[T]) Ptr(n int) *T {
+	xunsafe.BoundsCheck(n, int(c.len))
+	chunk, idx := c.locate(n)
+	return c.chunks.Load(chunk).Add(idx).AssertValid()
+}
+
+// Load loads the nth element.
+//
+// Panics if the index is out-of-bounds.
+func (c Chunked[T]) Load(n int) T {
+	return *c.Ptr(n)
+}
+
+// AppendOne appends an element, allocating a new chunk on the given arena if
+// necessary.
+func (c Chunked[T]) AppendOne(a *arena.Arena, elem T) Chunked[T] {
+	if c.chunks.Len() == 0 && c.log == 0 {
+		// Choose a first chunk size that matches the smallest arena slice.
+		c.log = uint32(bits.Len(uint(max(chunkBytes/layout.Size[T](), 1) - 1)))
+	}
+
+	chunk, idx := c.locate(int(c.len))
+	if chunk == c.chunks.Len() {
+		c = c.grow(a)
+	}
+
+	p := c.chunks.Load(chunk).Add(idx).AssertValid()
+	*p = elem
+	c.len++
+	return c
+}
+
+// grow allocates the next chunk.
+//
+//go:noinline
+func (c Chunked[T]) grow(a *arena.Arena) Chunked[T] {
+	n := 1 << (int(c.log) + c.chunks.Len())
+	var z T
+	a.Log("chunk", "%v, %d x %T", c.chunks.Addr(), n, z)
+
+	p := xunsafe.Cast[T](a.Alloc(n * layout.Size[T]()))
+	c.chunks = c.chunks.AppendOne(a, xunsafe.AddrOf(p))
+	return c
+}
+
+// All returns an iterator over the indices and elements of c.
+func (c Chunked[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for k, chunk := range c.chunks.Raw() {
+			n := min(1<<(int(c.log)+k), int(c.len)-i)
+			for j := range n {
+				if !yield(i, *chunk.Add(j).AssertValid()) {
+					return
+				}
+				i++
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the elements of c.
+func (c Chunked[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range c.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Format implements [fmt.Formatter].
+func (c Chunked[T]) Format(state fmt.State, v rune) {
+	out := make([]T, 0, c.len)
+	for v := range c.Values() {
+		out = append(out, v)
+	}
+	fmt.Fprintf(state, fmt.FormatString(state, v), out)
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slice_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"buf.build/go/hyperpb/internal/arena"
+	"buf.build/go/hyperpb/internal/arena/slice"
+)
+
+func TestChunked(t *testing.T) {
+	t.Parallel()
+
+	for _, preload := range []int{0, 1, 3, 100} {
+		a := new(arena.Arena)
+		c := slice.MakeChunked[int32](preload)
+		for i := range 1000 {
+			c = c.AppendOne(a, int32(i))
+		}
+
+		assert.Equal(t, 1000, c.Len())
+		for i := range 1000 {
+			assert.Equal(t, int32(i), c.Load(i))
+		}
+		for i, v := range c.All() {
+			assert.Equal(t, int32(i), v)
+		}
+		assert.Panics(t, func() { c.Load(1000) })
+	}
+}
@@ -0,0 +1,33331 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: test/test.proto
+
+package test
+
+import (
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Enum int32
+
+const (
+	Enum_ENUM_UNSPECIFIED Enum = 0
+	Enum_ENUM_1           Enum = 1
+	Enum_ENUM_2           Enum = 2
+	Enum_ENUM_3           Enum = 3
+)
+
+var Enum_name = map[int32]string{
+	0: "ENUM_UNSPECIFIED",
+	1: "ENUM_1",
+	2: "ENUM_2",
+	3: "ENUM_3",
+}
+
+var Enum_value = map[string]int32{
+	"ENUM_UNSPECIFIED": 0,
+	"ENUM_1":           1,
+	"ENUM_2":           2,
+	"ENUM_3":           3,
+}
+
+func (x Enum) String() string {
+	return proto.EnumName(Enum_name, int32(x))
+}
+
+func (Enum) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_84eb23d74a64bdab, []int{0}
+}
+
+type Scalars struct {
+	A1  int32   `protobuf:"varint,1,opt,name=a1,proto3" json:"a1,omitempty"`
+	A2  int64   `protobuf:"varint,2,opt,name=a2,proto3" json:"a2,omitempty"`
+	A3  uint32  `protobuf:"varint,3,opt,name=a3,proto3" json:"a3,omitempty"`
+	A4  uint64  `protobuf:"varint,4,opt,name=a4,proto3" json:"a4,omitempty"`
+	A5  int32   `protobuf:"zigzag32,5,opt,name=a5,proto3" json:"a5,omitempty"`
+	A6  int64   `protobuf:"zigzag64,6,opt,name=a6,proto3" json:"a6,omitempty"`
+	A7  uint32  `protobuf:"fixed32,7,opt,name=a7,proto3" json:"a7,omitempty"`
+	A8  uint64  `protobuf:"fixed64,8,opt,name=a8,proto3" json:"a8,omitempty"`
+	A9  int32   `protobuf:"fixed32,9,opt,name=a9,proto3" json:"a9,omitempty"`
+	A10 int64   `protobuf:"fixed64,10,opt,name=a10,proto3" json:"a10,omitempty"`
+	A11 float32 `protobuf:"fixed32,11,opt,name=a11,proto3" json:"a11,omitempty"`
+	A12 float64 `protobuf:"fixed64,12,opt,name=a12,proto3" json:"a12,omitempty"`
+	A13 bool    `protobuf:"varint,13,opt,name=a13,proto3" json:"a13,omitempty"`
+	A14 string  `protobuf:"bytes,14,opt,name=a14,proto3" json:"a14,omitempty"`
+	A15 []byte  `protobuf:"bytes,15,opt,name=a15,proto3" json:"a15,omitempty"`
+	// Types that are valid to be assigned to XB1:
+	//	*Scalars_B1
+	XB1 isScalars_XB1 `protobuf_oneof:"_b1"`
+	// Types that are valid to be assigned to XB2:
+	//	*Scalars_B2
+	XB2 isScalars_XB2 `protobuf_oneof:"_b2"`
+	// Types that are valid to be assigned to XB3:
+	//	*Scalars_B3
+	XB3 isScalars_XB3 `protobuf_oneof:"_b3"`
+	// Types that are valid to be assigned to XB4:
+	//	*Scalars_B4
+	XB4 isScalars_XB4 `protobuf_oneof:"_b4"`
+	// Types that are valid to be assigned to XB5:
+	//	*Scalars_B5
+	XB5 isScalars_XB5 `protobuf_oneof:"_b5"`
+	// Types that are valid to be assigned to XB6:
+	//	*Scalars_B6
+	XB6 isScalars_XB6 `protobuf_oneof:"_b6"`
+	// Types that are valid to be assigned to XB7:
+	//	*Scalars_B7
+	XB7 isScalars_XB7 `protobuf_oneof:"_b7"`
+	// Types that are valid to be assigned to XB8:
+	//	*Scalars_B8
+	XB8 isScalars_XB8 `protobuf_oneof:"_b8"`
+	// Types that are valid to be assigned to XB9:
+	//	*Scalars_B9
+	XB9 isScalars_XB9 `protobuf_oneof:"_b9"`
+	// Types that are valid to be assigned to XB10:
+	//	*Scalars_B10
+	XB10 isScalars_XB10 `protobuf_oneof:"_b10"`
+	// Types that are valid to be assigned to XB11:
+	//	*Scalars_B11
+	XB11 isScalars_XB11 `protobuf_oneof:"_b11"`
+	// Types that are valid to be assigned to XB12:
+	//	*Scalars_B12
+	XB12 isScalars_XB12 `protobuf_oneof:"_b12"`
+	// Types that are valid to be assigned to XB13:
+	//	*Scalars_B13
+	XB13 isScalars_XB13 `protobuf_oneof:"_b13"`
+	// Types that are valid to be assigned to XB14:
+	//	*Scalars_B14
+	XB14 isScalars_XB14 `protobuf_oneof:"_b14"`
+	// Types that are valid to be assigned to XB15:
+	//	*Scalars_B15
+	XB15 isScalars_XB15 `protobuf_oneof:"_b15"`
+}
+
+func (m *Scalars) Reset()         { *m = Scalars{} }
+func (m *Scalars) String() string { return proto.CompactTextString(m) }
+func (*Scalars) ProtoMessage()    {}
+func (*Scalars) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84eb23d74a64bdab, []int{0}
+}
+func (m *Scalars) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Scalars) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Scalars.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Scalars) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Scalars.Merge(m, src)
+}
+func (m *Scalars) XXX_Size() int {
+	return m.Size()
+}
+func (m *Scalars) XXX_DiscardUnknown() {
+	xxx_messageInfo_Scalars.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Scalars proto.InternalMessageInfo
+
+type isScalars_XB1 interface {
+	isScalars_XB1()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB2 interface {
+	isScalars_XB2()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB3 interface {
+	isScalars_XB3()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB4 interface {
+	isScalars_XB4()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB5 interface {
+	isScalars_XB5()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB6 interface {
+	isScalars_XB6()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB7 interface {
+	isScalars_XB7()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB8 interface {
+	isScalars_XB8()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB9 interface {
+	isScalars_XB9()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB10 interface {
+	isScalars_XB10()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB11 interface {
+	isScalars_XB11()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB12 interface {
+	isScalars_XB12()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB13 interface {
+	isScalars_XB13()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB14 interface {
+	isScalars_XB14()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScalars_XB15 interface {
+	isScalars_XB15()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Scalars_B1 struct {
+	B1 int32 `protobuf:"varint,21,opt,name=b1,proto3,oneof" json:"b1,omitempty"`
+}
+type Scalars_B2 struct {
+	B2 int64 `protobuf:"varint,22,opt,name=b2,proto3,oneof" json:"b2,omitempty"`
+}
+type Scalars_B3 struct {
+	B3 uint32 `protobuf:"varint,23,opt,name=b3,proto3,oneof" json:"b3,omitempty"`
+}
+type Scalars_B4 struct {
+	B4 uint64 `protobuf:"varint,24,opt,name=b4,proto3,oneof" json:"b4,omitempty"`
+}
+type Scalars_B5 struct {
+	B5 int32 `protobuf:"zigzag32,25,opt,name=b5,proto3,oneof" json:"b5,omitempty"`
+}
+type Scalars_B6 struct {
+	B6 int64 `protobuf:"zigzag64,26,opt,name=b6,proto3,oneof" json:"b6,omitempty"`
+}
+type Scalars_B7 struct {
+	B7 uint32 `protobuf:"fixed32,27,opt,name=b7,proto3,oneof" json:"b7,omitempty"`
+}
+type Scalars_B8 struct {
+	B8 uint64 `protobuf:"fixed64,28,opt,name=b8,proto3,oneof" json:"b8,omitempty"`
+}
+type Scalars_B9 struct {
+	B9 int32 `protobuf:"fixed32,29,opt,name=b9,proto3,oneof" json:"b9,omitempty"`
+}
+type Scalars_B10 struct {
+	B10 int64 `protobuf:"fixed64,30,opt,name=b10,proto3,oneof" json:"b10,omitempty"`
+}
+type Scalars_B11 struct {
+	B11 float32 `protobuf:"fixed32,31,opt,name=b11,proto3,oneof" json:"b11,omitempty"`
+}
+type Scalars_B12 struct {
+	B12 float64 `protobuf:"fixed64,32,opt,name=b12,proto3,oneof" json:"b12,omitempty"`
+}
+type Scalars_B13 struct {
+	B13 bool `protobuf:"varint,33,opt,name=b13,proto3,oneof" json:"b13,omitempty"`
+}
+type Scalars_B14 struct {
+	B14 string `protobuf:"bytes,34,opt,name=b14,proto3,oneof" json:"b14,omitempty"`
+}
+type Scalars_B15 struct {
+	B15 []byte `protobuf:"bytes,35,opt,name=b15,proto3,oneof" json:"b15,omitempty"`
+}
+
+func (*Scalars_B1) isScalars_XB1()   {}
+func (*Scalars_B2) isScalars_XB2()   {}
+func (*Scalars_B3) isScalars_XB3()   {}
+func (*Scalars_B4) isScalars_XB4()   {}
+func (*Scalars_B5) isScalars_XB5()   {}
+func (*Scalars_B6) isScalars_XB6()   {}
+func (*Scalars_B7) isScalars_XB7()   {}
+func (*Scalars_B8) isScalars_XB8()   {}
+func (*Scalars_B9) isScalars_XB9()   {}
+func (*Scalars_B10) isScalars_XB10() {}
+func (*Scalars_B11) isScalars_XB11() {}
+func (*Scalars_B12) isScalars_XB12() {}
+func (*Scalars_B13) isScalars_XB13() {}
+func (*Scalars_B14) isScalars_XB14() {}
+func (*Scalars_B15) isScalars_XB15() {}
+
+func (m *Scalars) GetXB1() isScalars_XB1 {
+	if m != nil {
+		return m.XB1
+	}
+	return nil
+}
+func (m *Scalars) GetXB2() isScalars_XB2 {
+	if m != nil {
+		return m.XB2
+	}
+	return nil
+}
+func (m *Scalars) GetXB3() isScalars_XB3 {
+	if m != nil {
+		return m.XB3
+	}
+	return nil
+}
+func (m *Scalars) GetXB4() isScalars_XB4 {
+	if m != nil {
+		return m.XB4
+	}
+	return nil
+}
+func (m *Scalars) GetXB5() isScalars_XB5 {
+	if m != nil {
+		return m.XB5
+	}
+	return nil
+}
+func (m *Scalars) GetXB6() isScalars_XB6 {
+	if m != nil {
+		return m.XB6
+	}
+	return nil
+}
+func (m *Scalars) GetXB7() isScalars_XB7 {
+	if m != nil {
+		return m.XB7
+	}
+	return nil
+}
+func (m *Scalars) GetXB8() isScalars_XB8 {
+	if m != nil {
+		return m.XB8
+	}
+	return nil
+}
+func (m *Scalars) GetXB9() isScalars_XB9 {
+	if m != nil {
+		return m.XB9
+	}
+	return nil
+}
+func (m *Scalars) GetXB10() isScalars_XB10 {
+	if m != nil {
+		return m.XB10
+	}
+	return nil
+}
+func (m *Scalars) GetXB11() isScalars_XB11 {
+	if m != nil {
+		return m.XB11
+	}
+	return nil
+}
+func (m *Scalars) GetXB12() isScalars_XB12 {
+	if m != nil {
+		return m.XB12
+	}
+	return nil
+}
+func (m *Scalars) GetXB13() isScalars_XB13 {
+	if m != nil {
+		return m.XB13
+	}
+	return nil
+}
+func (m *Scalars) GetXB14() isScalars_XB14 {
+	if m != nil {
+		return m.XB14
+	}
+	return nil
+}
+func (m *Scalars) GetXB15() isScalars_XB15 {
+	if m != nil {
+		return m.XB15
+	}
+	return nil
+}
+
+func (m *Scalars) GetA1() int32 {
+	if m != nil {
+		return m.A1
+	}
+	return 0
+}
+
+func (m *Scalars) GetA2() int64 {
+	if m != nil {
+		return m.A2
+	}
+	return 0
+}
+
+func (m *Scalars) GetA3() uint32 {
+	if m != nil {
+		return m.A3
+	}
+	return 0
+}
+
+func (m *Scalars) GetA4() uint64 {
+	if m != nil {
+		return m.A4
+	}
+	return 0
+}
+
+func (m *Scalars) GetA5() int32 {
+	if m != nil {
+		return m.A5
+	}
+	return 0
+}
+
+func (m *Scalars) GetA6() int64 {
+	if m != nil {
+		return m.A6
+	}
+	return 0
+}
+
+func (m *Scalars) GetA7() uint32 {
+	if m != nil {
+		return m.A7
+	}
+	return 0
+}
+
+func (m *Scalars) GetA8() uint64 {
+	if m != nil {
+		return m.A8
+	}
+	return 0
+}
+
+func (m *Scalars) GetA9() int32 {
+	if m != nil {
+		return m.A9
+	}
+	return 0
+}
+
+func (m *Scalars) GetA10() int64 {
+	if m != nil {
+		return m.A10
+	}
+	return 0
+}
+
+func (m *Scalars) GetA11() float32 {
+	if m != nil {
+		return m.A11
+	}
+	return 0
+}
+
+func (m *Scalars) GetA12() float64 {
+	if m != nil {
+		return m.A12
+	}
+	return 0
+}
+
+func (m *Scalars) GetA13() bool {
+	if m != nil {
+		return m.A13
+	}
+	return false
+}
+
+func (m *Scalars) GetA14() string {
+	if m != nil {
+		return m.A14
+	}
+	return ""
+}
+
+func (m *Scalars) GetA15() []byte {
+	if m != nil {
+		return m.A15
+	}
+	return nil
+}
+
+func (m *Scalars) GetB1() int32 {
+	if x, ok := m.GetXB1().(*Scalars_B1); ok {
+		return x.B1
+	}
+	return 0
+}
+
+func (m *Scalars) GetB2() int64 {
+	if x, ok := m.GetXB2().(*Scalars_B2); ok {
+		return x.B2
+	}
+	return 0
+}
+
+func (m *Scalars) GetB3() uint32 {
+	if x, ok := m.GetXB3().(*Scalars_B3); ok {
+		return x.B3
+	}
+	return 0
+}
+
+func (m *Scalars) GetB4() uint64 {
+	if x, ok := m.GetXB4().(*Scalars_B4); ok {
+		return x.B4
+	}
+	return 0
+}
+
+func (m *Scalars) GetB5() int32 {
+	if x, ok := m.GetXB5().(*Scalars_B5); ok {
+		return x.B5
+	}
+	return 0
+}
+
+func (m *Scalars) GetB6() int64 {
+	if x, ok := m.GetXB6().(*Scalars_B6); ok {
+		return x.B6
+	}
+	return 0
+}
+
+func (m *Scalars) GetB7() uint32 {
+	if x, ok := m.GetXB7().(*Scalars_B7); ok {
+		return x.B7
+	}
+	return 0
+}
+
+func (m *Scalars) GetB8() uint64 {
+	if x, ok := m.GetXB8().(*Scalars_B8); ok {
+		return x.B8
+	}
+	return 0
+}
+
+func (m *Scalars) GetB9() int32 {
+	if x, ok := m.GetXB9().(*Scalars_B9); ok {
+		return x.B9
+	}
+	return 0
+}
+
+func (m *Scalars) GetB10() int64 {
+	if x, ok := m.GetXB10().(*Scalars_B10); ok {
+		return x.B10
+	}
+	return 0
+}
+
+func (m *Scalars) GetB11() float32 {
+	if x, ok := m.GetXB11().(*Scalars_B11); ok {
+		return x.B11
+	}
+	return 0
+}
+
+func (m *Scalars) GetB12() float64 {
+	if x, ok := m.GetXB12().(*Scalars_B12); ok {
+		return x.B12
+	}
+	return 0
+}
+
+func (m *Scalars) GetB13() bool {
+	if x, ok := m.GetXB13().(*Scalars_B13); ok {
+		return x.B13
+	}
+	return false
+}
+
+func (m *Scalars) GetB14() string {
+	if x, ok := m.GetXB14().(*Scalars_B14); ok {
+		return x.B14
+	}
+	return ""
+}
+
+func (m *Scalars) GetB15() []byte {
+	if x, ok := m.GetXB15().(*Scalars_B15); ok {
+		return x.B15
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Scalars) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Scalars_B1)(nil),
+		(*Scalars_B2)(nil),
+		(*Scalars_B3)(nil),
+		(*Scalars_B4)(nil),
+		(*Scalars_B5)(nil),
+		(*Scalars_B6)(nil),
+		(*Scalars_B7)(nil),
+		(*Scalars_B8)(nil),
+		(*Scalars_B9)(nil),
+		(*Scalars_B10)(nil),
+		(*Scalars_B11)(nil),
+		(*Scalars_B12)(nil),
+		(*Scalars_B13)(nil),
+		(*Scalars_B14)(nil),
+		(*Scalars_B15)(nil),
+	}
+}
+
+type Numbers struct {
+	A1 int32 `protobuf:"varint,1,opt,name=a1,proto3" json:"a1,omitempty"`
+	A2 int32 `protobuf:"varint,15,opt,name=a2,proto3" json:"a2,omitempty"`
+	A3 int32 `protobuf:"varint,16,opt,name=a3,proto3" json:"a3,omitempty"`
+	A4 int32 `protobuf:"varint,2047,opt,name=a4,proto3" json:"a4,omitempty"`
+	A5 int32 `protobuf:"varint,2048,opt,name=a5,proto3" json:"a5,omitempty"`
+	A6 int32 `protobuf:"varint,262143,opt,name=a6,proto3" json:"a6,omitempty"`
+	A7 int32 `protobuf:"varint,262144,opt,name=a7,proto3" json:"a7,omitempty"`
+	A8 int32 `protobuf:"varint,33554431,opt,name=a8,proto3" json:"a8,omitempty"`
+	A9 int32 `protobuf:"varint,33554432,opt,name=a9,proto3" json:"a9,omitempty"`
+	Aa int32 `protobuf:"varint,536870911,opt,name=aa,proto3" json:"aa,omitempty"`
+}
+
+func (m *Numbers) Reset()         { *m = Numbers{} }
+func (m *Numbers) String() string { return proto.CompactTextString(m) }
+func (*Numbers) ProtoMessage()    {}
+func (*Numbers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84eb23d74a64bdab, []int{1}
+}
+func (m *Numbers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Numbers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Numbers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Numbers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Numbers.Merge(m, src)
+}
+func (m *Numbers) XXX_Size() int {
+	return m.Size()
+}
+func (m *Numbers) XXX_DiscardUnknown() {
+	xxx_messageInfo_Numbers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Numbers proto.InternalMessageInfo
+
+func (m *Numbers) GetA1() int32 {
+	if m != nil {
+		return m.A1
+	}
+	return 0
+}
+
+func (m *Numbers) GetA2() int32 {
+	if m != nil {
+		return m.A2
+	}
+	return 0
+}
+
+func (m *Numbers) GetA3() int32 {
+	if m != nil {
+		return m.A3
+	}
+	return 0
+}
+
+func (m *Numbers) GetA4() int32 {
+	if m != nil {
+		return m.A4
+	}
+	return 0
+}
+
+func (m *Numbers) GetA5() int32 {
+	if m != nil {
+		return m.A5
+	}
+	return 0
+}
+
+func (m *Numbers) GetA6() int32 {
+	if m != nil {
+		return m.A6
+	}
+	return 0
+}
+
+func (m *Numbers) GetA7() int32 {
+	if m != nil {
+		return m.A7
+	}
+	return 0
+}
+
+func (m *Numbers) GetA8() int32 {
+	if m != nil {
+		return m.A8
+	}
+	return 0
+}
+
+func (m *Numbers) GetA9() int32 {
+	if m != nil {
+		return m.A9
+	}
+	return 0
+}
+
+func (m *Numbers) GetAa() int32 {
+	if m != nil {
+		return m.Aa
+	}
+	return 0
+}
+
+type Repeated struct {
+	R1 []int32  `protobuf:"varint,1,rep,packed,name=r1,proto3" json:"r1,omitempty"`
+	R2 []int64  `protobuf:"varint,2,rep,packed,name=r2,proto3" json:"r2,omitempty"`
+	R3 []int32  `protobuf:"zigzag32,3,rep,packed,name=r3,proto3" json:"r3,omitempty"`
+	R4 []int64  `protobuf:"zigzag64,4,rep,packed,name=r4,proto3" json:"r4,omitempty"`
+	R5 []uint32 `protobuf:"fixed32,5,rep,packed,name=r5,proto3" json:"r5,omitempty"`
+	R6 []uint64 `protobuf:"fixed64,6,rep,packed,name=r6,proto3" json:"r6,omitempty"`
+	R7 []string `protobuf:"bytes,7,rep,name=r7,proto3" json:"r7,omitempty"`
+	R8 [][]byte `protobuf:"bytes,8,rep,name=r8,proto3" json:"r8,omitempty"`
+}
+
+func (m *Repeated) Reset()         { *m = Repeated{} }
+func (m *Repeated) String() string { return proto.CompactTextString(m) }
+func (*Repeated) ProtoMessage()    {}
+func (*Repeated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84eb23d74a64bdab, []int{2}
+}
+func (m *Repeated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Repeated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Repeated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Repeated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Repeated.Merge(m, src)
+}
+func (m *Repeated) XXX_Size() int {
+	return m.Size()
+}
+func (m *Repeated) XXX_DiscardUnknown() {
+	xxx_messageInfo_Repeated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Repeated proto.InternalMessageInfo
+
+func (m *Repeated) GetR1() []int32 {
+	if m != nil {
+		return m.R1
+	}
+	return nil
+}
+
+func (m *Repeated) GetR2() []int64 {
+	if m != nil {
+		return m.R2
+	}
+	return nil
+}
+
+func (m *Repeated) GetR3() []int32 {
+	if m != nil {
+		return m.R3
+	}
+	return nil
+}
+
+func (m *Repeated) GetR4() []int64 {
+	if m != nil {
+		return m.R4
+	}
+	return nil
+}
+
+func (m *Repeated) GetR5() []uint32 {
+	if m != nil {
+		return m.R5
+	}
+	return nil
+}
+
+func (m *Repeated) GetR6() []uint64 {
+	if m != nil {
+		return m.R6
+	}
+	return nil
+}
+
+func (m *Repeated) GetR7() []string {
+	if m != nil {
+		return m.R7
+	}
+	return nil
+}
+
+func (m *Repeated) GetR8() [][]byte {
+	if m != nil {
+		return m.R8
+	}
+	return nil
+}
+
+type Graph struct {
+	V int32    `protobuf:"varint,1,opt,name=v,proto3" json:"v,omitempty"`
+	S *Graph   `protobuf:"bytes,2,opt,name=s,proto3" json:"s,omitempty"`
+	R []*Graph `protobuf:"bytes,3,rep,name=r,proto3" json:"r,omitempty"`
+}
+
+func (m *Graph) Reset()         { *m = Graph{} }
+func (m *Graph) String() string { return proto.CompactTextString(m) }
+func (*Graph) ProtoMessage()    {}
+func (*Graph) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84eb23d74a64bdab, []int{3}
+}
+func (m *Graph) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Graph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Graph.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Graph) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Graph.Merge(m, src)
+}
+func (m *Graph) XXX_Size() int {
+	return m.Size()
+}
+func (m *Graph) XXX_DiscardUnknown() {
+	xxx_messageInfo_Graph.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Graph proto.InternalMessageInfo
+
+func (m *Graph) GetV() int32 {
+	if m != nil {
+		return m.V
+	}
+	return 0
+}
+
+func (m *Graph) GetS() *Graph {
+	if m != nil {
+		return m.S
+	}
+	return nil
+}
+
+func (m *Graph) GetR() []*Graph {
+	if m != nil {
+		return m.R
+	}
+	return nil
+}
+
+type Oneof struct {
+	// Types that are valid to be assigned to Single:
+	//	*Oneof_S1
+	Single isOneof_Single `protobuf_oneof:"single"`
+	// Types that are valid to be assigned to Multi:
+	//	*Oneof_M1
+	//	*Oneof_M2
+	//	*Oneof_M3
+	//	*Oneof_M4
+	//	*Oneof_M5
+	//	*Oneof_M6
+	//	*Oneof_M7
+	//	*Oneof_M8
+	//	*Oneof_M9
+	//	*Oneof_M10
+	Multi isOneof_Multi `protobuf_oneof:"multi"`
+	Tail  int32         `protobuf:"varint,30,opt,name=tail,proto3" json:"tail,omitempty"`
+}
+
+func (m *Oneof) Reset()         { *m = Oneof{} }
+func (m *Oneof) String() string { return proto.CompactTextString(m) }
+func (*Oneof) ProtoMessage()    {}
+func (*Oneof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84eb23d74a64bdab, []int{4}
+}
+func (m *Oneof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Oneof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Oneof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Oneof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Oneof.Merge(m, src)
+}
+func (m *Oneof) XXX_Size() int {
+	return m.Size()
+}
+func (m *Oneof) XXX_DiscardUnknown() {
+	xxx_messageInfo_Oneof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Oneof proto.InternalMessageInfo
+
+type isOneof_Single interface {
+	isOneof_Single()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isOneof_Multi interface {
+	isOneof_Multi()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Oneof_S1 struct {
+	S1 int32 `protobuf:"varint,1,opt,name=s1,proto3,oneof" json:"s1,omitempty"`
+}
+type Oneof_M1 struct {
+	M1 int32 `protobuf:"varint,11,opt,name=m1,proto3,oneof" json:"m1,omitempty"`
+}
+type Oneof_M2 struct {
+	M2 int64 `protobuf:"varint,12,opt,name=m2,proto3,oneof" json:"m2,omitempty"`
+}
+type Oneof_M3 struct {
+	M3 int32 `protobuf:"zigzag32,13,opt,name=m3,proto3,oneof" json:"m3,omitempty"`
+}
+type Oneof_M4 struct {
+	M4 int64 `protobuf:"zigzag64,14,opt,name=m4,proto3,oneof" json:"m4,omitempty"`
+}
+type Oneof_M5 struct {
+	M5 uint32 `protobuf:"fixed32,15,opt,name=m5,proto3,oneof" json:"m5,omitempty"`
+}
+type Oneof_M6 struct {
+	M6 uint64 `protobuf:"fixed64,16,opt,name=m6,proto3,oneof" json:"m6,omitempty"`
+}
+type Oneof_M7 struct {
+	M7 bool `protobuf:"varint,17,opt,name=m7,proto3,oneof" json:"m7,omitempty"`
+}
+type Oneof_M8 struct {
+	M8 string `protobuf:"bytes,18,opt,name=m8,proto3,oneof" json:"m8,omitempty"`
+}
+type Oneof_M9 struct {
+	M9 []byte `protobuf:"bytes,19,opt,name=m9,proto3,oneof" json:"m9,omitempty"`
+}
+type Oneof_M10 struct {
+	M10 *Oneof `protobuf:"bytes,20,opt,name=m10,proto3,oneof" json:"m10,omitempty"`
+}
+
+func (*Oneof_S1) isOneof_Single() {}
+func (*Oneof_M1) isOneof_Multi()  {}
+func (*Oneof_M2) isOneof_Multi()  {}
+func (*Oneof_M3) isOneof_Multi()  {}
+func (*Oneof_M4) isOneof_Multi()  {}
+func (*Oneof_M5) isOneof_Multi()  {}
+func (*Oneof_M6) isOneof_Multi()  {}
+func (*Oneof_M7) isOneof_Multi()  {}
+func (*Oneof_M8) isOneof_Multi()  {}
+func (*Oneof_M9) isOneof_Multi()  {}
+func (*Oneof_M10) isOneof_Multi() {}
+
+func (m *Oneof) GetSingle() isOneof_Single {
+	if m != nil {
+		return m.Single
+	}
+	return nil
+}
+func (m *Oneof) GetMulti() isOneof_Multi {
+	if m != nil {
+		return m.Multi
+	}
+	return nil
+}
+
+func (m *Oneof) GetS1() int32 {
+	if x, ok := m.GetSingle().(*Oneof_S1); ok {
+		return x.S1
+	}
+	return 0
+}
+
+func (m *Oneof) GetM1() int32 {
+	if x, ok := m.GetMulti().(*Oneof_M1); ok {
+		return x.M1
+	}
+	return 0
+}
+
+func (m *Oneof) GetM2() int64 {
+	if x, ok := m.GetMulti().(*Oneof_M2); ok {
+		return x.M2
+	}
+	return 0
+}
+
+func (m *Oneof) GetM3() int32 {
+	if x, ok := m.GetMulti().(*Oneof_M3); ok {
+		return x.M3
+	}
+	return 0
+}
+
+func (m *Oneof) GetM4() int64 {
+	if x, ok := m.GetMulti().(*Oneof_M4); ok {
+		return x.M4
+	}
+	return 0
+}
+
+func (m *Oneof) GetM5() uint32 {
+	if x, ok := m.GetMulti().(*Oneof_M5); ok {
+		return x.M5
+	}
+	return 0
+}
+
+func (m *Oneof) GetM6() uint64 {
+	if x, ok := m.GetMulti().(*Oneof_M6); ok {
+		return x.M6
+	}
+	return 0
+}
+
+func (m *Oneof) GetM7() bool {
+	if x, ok := m.GetMulti().(*Oneof_M7); ok {
+		return x.M7
+	}
+	return false
+}
+
+func (m *Oneof) GetM8() string {
+	if x, ok := m.GetMulti().(*Oneof_M8); ok {
+		return x.M8
+	}
+	return ""
+}
+
+func (m *Oneof) GetM9() []byte {
+	if x, ok := m.GetMulti().(*Oneof_M9); ok {
+		return x.M9
+	}
+	return nil
+}
+
+func (m *Oneof) GetM10() *Oneof {
+	if x, ok := m.GetMulti().(*Oneof_M10); ok {
+		return x.M10
+	}
+	return nil
+}
+
+func (m *Oneof) GetTail() int32 {
+	if m != nil {
+		return m.Tail
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Oneof) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Oneof_S1)(nil),
+		(*Oneof_M1)(nil),
+		(*Oneof_M2)(nil),
+		(*Oneof_M3)(nil),
+		(*Oneof_M4)(nil),
+		(*Oneof_M5)(nil),
+		(*Oneof_M6)(nil),
+		(*Oneof_M7)(nil),
+		(*Oneof_M8)(nil),
+		(*Oneof_M9)(nil),
+		(*Oneof_M10)(nil),
+	}
+}
+
+type Maps struct {
+	M10 map[int32]int32    `protobuf:"bytes,16,rep,name=m10,proto3" json:"m10,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M11 map[int32]int64    `protobuf:"bytes,17,rep,name=m11,proto3" json:"m11,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M12 map[int32]uint32   `protobuf:"bytes,18,rep,name=m12,proto3" json:"m12,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M13 map[int32]uint64   `protobuf:"bytes,19,rep,name=m13,proto3" json:"m13,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M14 map[int32]int32    `protobuf:"bytes,20,rep,name=m14,proto3" json:"m14,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	M15 map[int32]int64    `protobuf:"bytes,21,rep,name=m15,proto3" json:"m15,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	M16 map[int32]uint32   `protobuf:"bytes,22,rep,name=m16,proto3" json:"m16,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M17 map[int32]uint64   `protobuf:"bytes,23,rep,name=m17,proto3" json:"m17,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M18 map[int32]int32    `protobuf:"bytes,24,rep,name=m18,proto3" json:"m18,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M19 map[int32]int64    `protobuf:"bytes,25,rep,name=m19,proto3" json:"m19,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M1A map[int32]float32  `protobuf:"bytes,26,rep,name=m1a,proto3" json:"m1a,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M1B map[int32]float64  `protobuf:"bytes,27,rep,name=m1b,proto3" json:"m1b,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M1C map[int32]bool     `protobuf:"bytes,28,rep,name=m1c,proto3" json:"m1c,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M1D map[int32]Enum     `protobuf:"bytes,29,rep,name=m1d,proto3" json:"m1d,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	M1E map[int32]string   `protobuf:"bytes,30,rep,name=m1e,proto3" json:"m1e,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M1F map[int32][]byte   `protobuf:"bytes,31,rep,name=m1f,proto3" json:"m1f,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M20 map[int64]int32    `protobuf:"bytes,32,rep,name=m20,proto3" json:"m20,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M21 map[int64]int64    `protobuf:"bytes,33,rep,name=m21,proto3" json:"m21,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M22 map[int64]uint32   `protobuf:"bytes,34,rep,name=m22,proto3" json:"m22,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M23 map[int64]uint64   `protobuf:"bytes,35,rep,name=m23,proto3" json:"m23,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M24 map[int64]int32    `protobuf:"bytes,36,rep,name=m24,proto3" json:"m24,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	M25 map[int64]int64    `protobuf:"bytes,37,rep,name=m25,proto3" json:"m25,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	M26 map[int64]uint32   `protobuf:"bytes,38,rep,name=m26,proto3" json:"m26,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M27 map[int64]uint64   `protobuf:"bytes,39,rep,name=m27,proto3" json:"m27,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M28 map[int64]int32    `protobuf:"bytes,40,rep,name=m28,proto3" json:"m28,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M29 map[int64]int64    `protobuf:"bytes,41,rep,name=m29,proto3" json:"m29,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M2A map[int64]float32  `protobuf:"bytes,42,rep,name=m2a,proto3" json:"m2a,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M2B map[int64]float64  `protobuf:"bytes,43,rep,name=m2b,proto3" json:"m2b,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M2C map[int64]bool     `protobuf:"bytes,44,rep,name=m2c,proto3" json:"m2c,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M2D map[int64]Enum     `protobuf:"bytes,45,rep,name=m2d,proto3" json:"m2d,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	M2E map[int64]string   `protobuf:"bytes,46,rep,name=m2e,proto3" json:"m2e,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M2F map[int64][]byte   `protobuf:"bytes,47,rep,name=m2f,proto3" json:"m2f,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M30 map[uint32]int32   `protobuf:"bytes,48,rep,name=m30,proto3" json:"m30,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M31 map[uint32]int64   `protobuf:"bytes,49,rep,name=m31,proto3" json:"m31,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M32 map[uint32]uint32  `protobuf:"bytes,50,rep,name=m32,proto3" json:"m32,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M33 map[uint32]uint64  `protobuf:"bytes,51,rep,name=m33,proto3" json:"m33,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M34 map[uint32]int32   `protobuf:"bytes,52,rep,name=m34,proto3" json:"m34,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	M35 map[uint32]int64   `protobuf:"bytes,53,rep,name=m35,proto3" json:"m35,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	M36 map[uint32]uint32  `protobuf:"bytes,54,rep,name=m36,proto3" json:"m36,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M37 map[uint32]uint64  `protobuf:"bytes,55,rep,name=m37,proto3" json:"m37,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M38 map[uint32]int32   `protobuf:"bytes,56,rep,name=m38,proto3" json:"m38,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M39 map[uint32]int64   `protobuf:"bytes,57,rep,name=m39,proto3" json:"m39,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M3A map[uint32]float32 `protobuf:"bytes,58,rep,name=m3a,proto3" json:"m3a,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M3B map[uint32]float64 `protobuf:"bytes,59,rep,name=m3b,proto3" json:"m3b,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M3C map[uint32]bool    `protobuf:"bytes,60,rep,name=m3c,proto3" json:"m3c,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M3D map[uint32]Enum    `protobuf:"bytes,61,rep,name=m3d,proto3" json:"m3d,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	M3E map[uint32]string  `protobuf:"bytes,62,rep,name=m3e,proto3" json:"m3e,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M3F map[uint32][]byte  `protobuf:"bytes,63,rep,name=m3f,proto3" json:"m3f,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M40 map[uint64]int32   `protobuf:"bytes,64,rep,name=m40,proto3" json:"m40,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M41 map[uint64]int64   `protobuf:"bytes,65,rep,name=m41,proto3" json:"m41,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M42 map[uint64]uint32  `protobuf:"bytes,66,rep,name=m42,proto3" json:"m42,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M43 map[uint64]uint64  `protobuf:"bytes,67,rep,name=m43,proto3" json:"m43,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M44 map[uint64]int32   `protobuf:"bytes,68,rep,name=m44,proto3" json:"m44,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	M45 map[uint64]int64   `protobuf:"bytes,69,rep,name=m45,proto3" json:"m45,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	M46 map[uint64]uint32  `protobuf:"bytes,70,rep,name=m46,proto3" json:"m46,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M47 map[uint64]uint64  `protobuf:"bytes,71,rep,name=m47,proto3" json:"m47,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M48 map[uint64]int32   `protobuf:"bytes,72,rep,name=m48,proto3" json:"m48,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M49 map[uint64]int64   `protobuf:"bytes,73,rep,name=m49,proto3" json:"m49,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M4A map[uint64]float32 `protobuf:"bytes,74,rep,name=m4a,proto3" json:"m4a,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M4B map[uint64]float64 `protobuf:"bytes,75,rep,name=m4b,proto3" json:"m4b,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M4C map[uint64]bool    `protobuf:"bytes,76,rep,name=m4c,proto3" json:"m4c,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M4D map[uint64]Enum    `protobuf:"bytes,77,rep,name=m4d,proto3" json:"m4d,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	M4E map[uint64]string  `protobuf:"bytes,78,rep,name=m4e,proto3" json:"m4e,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M4F map[uint64][]byte  `protobuf:"bytes,79,rep,name=m4f,proto3" json:"m4f,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M50 map[int32]int32    `protobuf:"bytes,80,rep,name=m50,proto3" json:"m50,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M51 map[int32]int64    `protobuf:"bytes,81,rep,name=m51,proto3" json:"m51,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M52 map[int32]uint32   `protobuf:"bytes,82,rep,name=m52,proto3" json:"m52,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M53 map[int32]uint64   `protobuf:"bytes,83,rep,name=m53,proto3" json:"m53,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M54 map[int32]int32    `protobuf:"bytes,84,rep,name=m54,proto3" json:"m54,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	M55 map[int32]int64    `protobuf:"bytes,85,rep,name=m55,proto3" json:"m55,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	M56 map[int32]uint32   `protobuf:"bytes,86,rep,name=m56,proto3" json:"m56,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M57 map[int32]uint64   `protobuf:"bytes,87,rep,name=m57,proto3" json:"m57,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M58 map[int32]int32    `protobuf:"bytes,88,rep,name=m58,proto3" json:"m58,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M59 map[int32]int64    `protobuf:"bytes,89,rep,name=m59,proto3" json:"m59,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M5A map[int32]float32  `protobuf:"bytes,90,rep,name=m5a,proto3" json:"m5a,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M5B map[int32]float64  `protobuf:"bytes,91,rep,name=m5b,proto3" json:"m5b,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M5C map[int32]bool     `protobuf:"bytes,92,rep,name=m5c,proto3" json:"m5c,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M5D map[int32]Enum     `protobuf:"bytes,93,rep,name=m5d,proto3" json:"m5d,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	M5E map[int32]string   `protobuf:"bytes,94,rep,name=m5e,proto3" json:"m5e,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M5F map[int32][]byte   `protobuf:"bytes,95,rep,name=m5f,proto3" json:"m5f,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M60 map[int64]int32    `protobuf:"bytes,96,rep,name=m60,proto3" json:"m60,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M61 map[int64]int64    `protobuf:"bytes,97,rep,name=m61,proto3" json:"m61,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M62 map[int64]uint32   `protobuf:"bytes,98,rep,name=m62,proto3" json:"m62,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M63 map[int64]uint64   `protobuf:"bytes,99,rep,name=m63,proto3" json:"m63,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M64 map[int64]int32    `protobuf:"bytes,100,rep,name=m64,proto3" json:"m64,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	M65 map[int64]int64    `protobuf:"bytes,101,rep,name=m65,proto3" json:"m65,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	M66 map[int64]uint32   `protobuf:"bytes,102,rep,name=m66,proto3" json:"m66,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M67 map[int64]uint64   `protobuf:"bytes,103,rep,name=m67,proto3" json:"m67,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M68 map[int64]int32    `protobuf:"bytes,104,rep,name=m68,proto3" json:"m68,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M69 map[int64]int64    `protobuf:"bytes,105,rep,name=m69,proto3" json:"m69,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M6A map[int64]float32  `protobuf:"bytes,106,rep,name=m6a,proto3" json:"m6a,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M6B map[int64]float64  `protobuf:"bytes,107,rep,name=m6b,proto3" json:"m6b,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M6C map[int64]bool     `protobuf:"bytes,108,rep,name=m6c,proto3" json:"m6c,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M6D map[int64]Enum     `protobuf:"bytes,109,rep,name=m6d,proto3" json:"m6d,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	M6E map[int64]string   `protobuf:"bytes,110,rep,name=m6e,proto3" json:"m6e,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M6F map[int64][]byte   `protobuf:"bytes,111,rep,name=m6f,proto3" json:"m6f,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M70 map[uint32]int32   `protobuf:"bytes,112,rep,name=m70,proto3" json:"m70,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M71 map[uint32]int64   `protobuf:"bytes,113,rep,name=m71,proto3" json:"m71,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M72 map[uint32]uint32  `protobuf:"bytes,114,rep,name=m72,proto3" json:"m72,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M73 map[uint32]uint64  `protobuf:"bytes,115,rep,name=m73,proto3" json:"m73,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M74 map[uint32]int32   `protobuf:"bytes,116,rep,name=m74,proto3" json:"m74,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	M75 map[uint32]int64   `protobuf:"bytes,117,rep,name=m75,proto3" json:"m75,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	M76 map[uint32]uint32  `protobuf:"bytes,118,rep,name=m76,proto3" json:"m76,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M77 map[uint32]uint64  `protobuf:"bytes,119,rep,name=m77,proto3" json:"m77,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M78 map[uint32]int32   `protobuf:"bytes,120,rep,name=m78,proto3" json:"m78,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M79 map[uint32]int64   `protobuf:"bytes,121,rep,name=m79,proto3" json:"m79,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M7A map[uint32]float32 `protobuf:"bytes,122,rep,name=m7a,proto3" json:"m7a,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M7B map[uint32]float64 `protobuf:"bytes,123,rep,name=m7b,proto3" json:"m7b,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M7C map[uint32]bool    `protobuf:"bytes,124,rep,name=m7c,proto3" json:"m7c,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M7D map[uint32]Enum    `protobuf:"bytes,125,rep,name=m7d,proto3" json:"m7d,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	M7E map[uint32]string  `protobuf:"bytes,126,rep,name=m7e,proto3" json:"m7e,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M7F map[uint32][]byte  `protobuf:"bytes,127,rep,name=m7f,proto3" json:"m7f,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M80 map[uint64]int32   `protobuf:"bytes,128,rep,name=m80,proto3" json:"m80,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M81 map[uint64]int64   `protobuf:"bytes,129,rep,name=m81,proto3" json:"m81,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M82 map[uint64]uint32  `protobuf:"bytes,130,rep,name=m82,proto3" json:"m82,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M83 map[uint64]uint64  `protobuf:"bytes,131,rep,name=m83,proto3" json:"m83,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M84 map[uint64]int32   `protobuf:"bytes,132,rep,name=m84,proto3" json:"m84,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	M85 map[uint64]int64   `protobuf:"bytes,133,rep,name=m85,proto3" json:"m85,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	M86 map[uint64]uint32  `protobuf:"bytes,134,rep,name=m86,proto3" json:"m86,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M87 map[uint64]uint64  `protobuf:"bytes,135,rep,name=m87,proto3" json:"m87,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M88 map[uint64]int32   `protobuf:"bytes,136,rep,name=m88,proto3" json:"m88,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M89 map[uint64]int64   `protobuf:"bytes,137,rep,name=m89,proto3" json:"m89,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M8A map[uint64]float32 `protobuf:"bytes,138,rep,name=m8a,proto3" json:"m8a,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M8B map[uint64]float64 `protobuf:"bytes,139,rep,name=m8b,proto3" json:"m8b,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M8C map[uint64]bool    `protobuf:"bytes,140,rep,name=m8c,proto3" json:"m8c,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M8D map[uint64]Enum    `protobuf:"bytes,141,rep,name=m8d,proto3" json:"m8d,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	M8E map[uint64]string  `protobuf:"bytes,142,rep,name=m8e,proto3" json:"m8e,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M8F map[uint64][]byte  `protobuf:"bytes,143,rep,name=m8f,proto3" json:"m8f,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M90 map[int32]int32    `protobuf:"bytes,144,rep,name=m90,proto3" json:"m90,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M91 map[int32]int64    `protobuf:"bytes,145,rep,name=m91,proto3" json:"m91,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M92 map[int32]uint32   `protobuf:"bytes,146,rep,name=m92,proto3" json:"m92,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M93 map[int32]uint64   `protobuf:"bytes,147,rep,name=m93,proto3" json:"m93,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M94 map[int32]int32    `protobuf:"bytes,148,rep,name=m94,proto3" json:"m94,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	M95 map[int32]int64    `protobuf:"bytes,149,rep,name=m95,proto3" json:"m95,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	M96 map[int32]uint32   `protobuf:"bytes,150,rep,name=m96,proto3" json:"m96,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M97 map[int32]uint64   `protobuf:"bytes,151,rep,name=m97,proto3" json:"m97,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M98 map[int32]int32    `protobuf:"bytes,152,rep,name=m98,proto3" json:"m98,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M99 map[int32]int64    `protobuf:"bytes,153,rep,name=m99,proto3" json:"m99,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M9A map[int32]float32  `protobuf:"bytes,154,rep,name=m9a,proto3" json:"m9a,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	M9B map[int32]float64  `protobuf:"bytes,155,rep,name=m9b,proto3" json:"m9b,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	M9C map[int32]bool     `protobuf:"bytes,156,rep,name=m9c,proto3" json:"m9c,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	M9D map[int32]Enum     `protobuf:"bytes,157,rep,name=m9d,proto3" json:"m9d,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	M9E map[int32]string   `protobuf:"bytes,158,rep,name=m9e,proto3" json:"m9e,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M9F map[int32][]byte   `protobuf:"bytes,159,rep,name=m9f,proto3" json:"m9f,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ma0 map[int64]int32    `protobuf:"bytes,160,rep,name=ma0,proto3" json:"ma0,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Ma1 map[int64]int64    `protobuf:"bytes,161,rep,name=ma1,proto3" json:"ma1,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Ma2 map[int64]uint32   `protobuf:"bytes,162,rep,name=ma2,proto3" json:"ma2,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Ma3 map[int64]uint64   `protobuf:"bytes,163,rep,name=ma3,proto3" json:"ma3,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Ma4 map[int64]int32    `protobuf:"bytes,164,rep,name=ma4,proto3" json:"ma4,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	Ma5 map[int64]int64    `protobuf:"bytes,165,rep,name=ma5,proto3" json:"ma5,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	Ma6 map[int64]uint32   `protobuf:"bytes,166,rep,name=ma6,proto3" json:"ma6,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Ma7 map[int64]uint64   `protobuf:"bytes,167,rep,name=ma7,proto3" json:"ma7,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Ma8 map[int64]int32    `protobuf:"bytes,168,rep,name=ma8,proto3" json:"ma8,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Ma9 map[int64]int64    `protobuf:"bytes,169,rep,name=ma9,proto3" json:"ma9,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Maa map[int64]float32  `protobuf:"bytes,170,rep,name=maa,proto3" json:"maa,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Mab map[int64]float64  `protobuf:"bytes,171,rep,name=mab,proto3" json:"mab,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Mac map[int64]bool     `protobuf:"bytes,172,rep,name=mac,proto3" json:"mac,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Mad map[int64]Enum     `protobuf:"bytes,173,rep,name=mad,proto3" json:"mad,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	Mae map[int64]string   `protobuf:"bytes,174,rep,name=mae,proto3" json:"mae,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Maf map[int64][]byte   `protobuf:"bytes,175,rep,name=maf,proto3" json:"maf,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Mb0 map[bool]int32     `protobuf:"bytes,176,rep,name=mb0,proto3" json:"mb0,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Mb1 map[bool]int64     `protobuf:"bytes,177,rep,name=mb1,proto3" json:"mb1,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Mb2 map[bool]uint32    `protobuf:"bytes,178,rep,name=mb2,proto3" json:"mb2,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Mb3 map[bool]uint64    `protobuf:"bytes,179,rep,name=mb3,proto3" json:"mb3,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Mb4 map[bool]int32     `protobuf:"bytes,180,rep,name=mb4,proto3" json:"mb4,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	Mb5 map[bool]int64     `protobuf:"bytes,181,rep,name=mb5,proto3" json:"mb5,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	Mb6 map[bool]uint32    `protobuf:"bytes,182,rep,name=mb6,proto3" json:"mb6,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Mb7 map[bool]uint64    `protobuf:"bytes,183,rep,name=mb7,proto3" json:"mb7,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Mb8 map[bool]int32     `protobuf:"bytes,184,rep,name=mb8,proto3" json:"mb8,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Mb9 map[bool]int64     `protobuf:"bytes,185,rep,name=mb9,proto3" json:"mb9,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Mba map[bool]float32   `protobuf:"bytes,186,rep,name=mba,proto3" json:"mba,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Mbb map[bool]float64   `protobuf:"bytes,187,rep,name=mbb,proto3" json:"mbb,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Mbc map[bool]bool      `protobuf:"bytes,188,rep,name=mbc,proto3" json:"mbc,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Mbd map[bool]Enum      `protobuf:"bytes,189,rep,name=mbd,proto3" json:"mbd,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	Mbe map[bool]string    `protobuf:"bytes,190,rep,name=mbe,proto3" json:"mbe,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Mbf map[bool][]byte    `protobuf:"bytes,191,rep,name=mbf,proto3" json:"mbf,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Mc0 map[string]int32   `protobuf:"bytes,192,rep,name=mc0,proto3" json:"mc0,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Mc1 map[string]int64   `protobuf:"bytes,193,rep,name=mc1,proto3" json:"mc1,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Mc2 map[string]uint32  `protobuf:"bytes,194,rep,name=mc2,proto3" json:"mc2,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Mc3 map[string]uint64  `protobuf:"bytes,195,rep,name=mc3,proto3" json:"mc3,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Mc4 map[string]int32   `protobuf:"bytes,196,rep,name=mc4,proto3" json:"mc4,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"zigzag32,2,opt,name=value,proto3"`
+	Mc5 map[string]int64   `protobuf:"bytes,197,rep,name=mc5,proto3" json:"mc5,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"zigzag64,2,opt,name=value,proto3"`
+	Mc6 map[string]uint32  `protobuf:"bytes,198,rep,name=mc6,proto3" json:"mc6,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Mc7 map[string]uint64  `protobuf:"bytes,199,rep,name=mc7,proto3" json:"mc7,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Mc8 map[string]int32   `protobuf:"bytes,200,rep,name=mc8,proto3" json:"mc8,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Mc9 map[string]int64   `protobuf:"bytes,201,rep,name=mc9,proto3" json:"mc9,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Mca map[string]float32 `protobuf:"bytes,202,rep,name=mca,proto3" json:"mca,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed32,2,opt,name=value,proto3"`
+	Mcb map[string]float64 `protobuf:"bytes,203,rep,name=mcb,proto3" json:"mcb,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Mcc map[string]bool    `protobuf:"bytes,204,rep,name=mcc,proto3" json:"mcc,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Mcd map[string]Enum    `protobuf:"bytes,205,rep,name=mcd,proto3" json:"mcd,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=hyperpb.test.Enum"`
+	Mce map[string]string  `protobuf:"bytes,206,rep,name=mce,proto3" json:"mce,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Mcf map[string][]byte  `protobuf:"bytes,207,rep,name=mcf,proto3" json:"mcf,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Maps) Reset()         { *m = Maps{} }
+func (m *Maps) String() string { return proto.CompactTextString(m) }
+func (*Maps) ProtoMessage()    {}
+func (*Maps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84eb23d74a64bdab, []int{5}
+}
+func (m *Maps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Maps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Maps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Maps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Maps.Merge(m, src)
+}
+func (m *Maps) XXX_Size() int {
+	return m.Size()
+}
+func (m *Maps) XXX_DiscardUnknown() {
+	xxx_messageInfo_Maps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Maps proto.InternalMessageInfo
+
+func (m *Maps) GetM10() map[int32]int32 {
+	if m != nil {
+		return m.M10
+	}
+	return nil
+}
+
+func (m *Maps) GetM11() map[int32]int64 {
+	if m != nil {
+		return m.M11
+	}
+	return nil
+}
+
+func (m *Maps) GetM12() map[int32]uint32 {
+	if m != nil {
+		return m.M12
+	}
+	return nil
+}
+
+func (m *Maps) GetM13() map[int32]uint64 {
+	if m != nil {
+		return m.M13
+	}
+	return nil
+}
+
+func (m *Maps) GetM14() map[int32]int32 {
+	if m != nil {
+		return m.M14
+	}
+	return nil
+}
+
+func (m *Maps) GetM15() map[int32]int64 {
+	if m != nil {
+		return m.M15
+	}
+	return nil
+}
+
+func (m *Maps) GetM16() map[int32]uint32 {
+	if m != nil {
+		return m.M16
+	}
+	return nil
+}
+
+func (m *Maps) GetM17() map[int32]uint64 {
+	if m != nil {
+		return m.M17
+	}
+	return nil
+}
+
+func (m *Maps) GetM18() map[int32]int32 {
+	if m != nil {
+		return m.M18
+	}
+	return nil
+}
+
+func (m *Maps) GetM19() map[int32]int64 {
+	if m != nil {
+		return m.M19
+	}
+	return nil
+}
+
+func (m *Maps) GetM1A() map[int32]float32 {
+	if m != nil {
+		return m.M1A
+	}
+	return nil
+}
+
+func (m *Maps) GetM1B() map[int32]float64 {
+	if m != nil {
+		return m.M1B
+	}
+	return nil
+}
+
+func (m *Maps) GetM1C() map[int32]bool {
+	if m != nil {
+		return m.M1C
+	}
+	return nil
+}
+
+func (m *Maps) GetM1D() map[int32]Enum {
+	if m != nil {
+		return m.M1D
+	}
+	return nil
+}
+
+func (m *Maps) GetM1E() map[int32]string {
+	if m != nil {
+		return m.M1E
+	}
+	return nil
+}
+
+func (m *Maps) GetM1F() map[int32][]byte {
+	if m != nil {
+		return m.M1F
+	}
+	return nil
+}
+
+func (m *Maps) GetM20() map[int64]int32 {
+	if m != nil {
+		return m.M20
+	}
+	return nil
+}
+
+func (m *Maps) GetM21() map[int64]int64 {
+	if m != nil {
+		return m.M21
+	}
+	return nil
+}
+
+func (m *Maps) GetM22() map[int64]uint32 {
+	if m != nil {
+		return m.M22
+	}
+	return nil
+}
+
+func (m *Maps) GetM23() map[int64]uint64 {
+	if m != nil {
+		return m.M23
+	}
+	return nil
+}
+
+func (m *Maps) GetM24() map[int64]int32 {
+	if m != nil {
+		return m.M24
+	}
+	return nil
+}
+
+func (m *Maps) GetM25() map[int64]int64 {
+	if m != nil {
+		return m.M25
+	}
+	return nil
+}
+
+func (m *Maps) GetM26() map[int64]uint32 {
+	if m != nil {
+		return m.M26
+	}
+	return nil
+}
+
+func (m *Maps) GetM27() map[int64]uint64 {
+	if m != nil {
+		return m.M27
+	}
+	return nil
+}
+
+func (m *Maps) GetM28() map[int64]int32 {
+	if m != nil {
+		return m.M28
+	}
+	return nil
+}
+
+func (m *Maps) GetM29() map[int64]int64 {
+	if m != nil {
+		return m.M29
+	}
+	return nil
+}
+
+func (m *Maps) GetM2A() map[int64]float32 {
+	if m != nil {
+		return m.M2A
+	}
+	return nil
+}
+
+func (m *Maps) GetM2B() map[int64]float64 {
+	if m != nil {
+		return m.M2B
+	}
+	return nil
+}
+
+func (m *Maps) GetM2C() map[int64]bool {
+	if m != nil {
+		return m.M2C
+	}
+	return nil
+}
+
+func (m *Maps) GetM2D() map[int64]Enum {
+	if m != nil {
+		return m.M2D
+	}
+	return nil
+}
+
+func (m *Maps) GetM2E() map[int64]string {
+	if m != nil {
+		return m.M2E
+	}
+	return nil
+}
+
+func (m *Maps) GetM2F() map[int64][]byte {
+	if m != nil {
+		return m.M2F
+	}
+	return nil
+}
+
+func (m *Maps) GetM30() map[uint32]int32 {
+	if m != nil {
+		return m.M30
+	}
+	return nil
+}
+
+func (m *Maps) GetM31() map[uint32]int64 {
+	if m != nil {
+		return m.M31
+	}
+	return nil
+}
+
+func (m *Maps) GetM32() map[uint32]uint32 {
+	if m != nil {
+		return m.M32
+	}
+	return nil
+}
+
+func (m *Maps) GetM33() map[uint32]uint64 {
+	if m != nil {
+		return m.M33
+	}
+	return nil
+}
+
+func (m *Maps) GetM34() map[uint32]int32 {
+	if m != nil {
+		return m.M34
+	}
+	return nil
+}
+
+func (m *Maps) GetM35() map[uint32]int64 {
+	if m != nil {
+		return m.M35
+	}
+	return nil
+}
+
+func (m *Maps) GetM36() map[uint32]uint32 {
+	if m != nil {
+		return m.M36
+	}
+	return nil
+}
+
+func (m *Maps) GetM37() map[uint32]uint64 {
+	if m != nil {
+		return m.M37
+	}
+	return nil
+}
+
+func (m *Maps) GetM38() map[uint32]int32 {
+	if m != nil {
+		return m.M38
+	}
+	return nil
+}
+
+func (m *Maps) GetM39() map[uint32]int64 {
+	if m != nil {
+		return m.M39
+	}
+	return nil
+}
+
+func (m *Maps) GetM3A() map[uint32]float32 {
+	if m != nil {
+		return m.M3A
+	}
+	return nil
+}
+
+func (m *Maps) GetM3B() map[uint32]float64 {
+	if m != nil {
+		return m.M3B
+	}
+	return nil
+}
+
+func (m *Maps) GetM3C() map[uint32]bool {
+	if m != nil {
+		return m.M3C
+	}
+	return nil
+}
+
+func (m *Maps) GetM3D() map[uint32]Enum {
+	if m != nil {
+		return m.M3D
+	}
+	return nil
+}
+
+func (m *Maps) GetM3E() map[uint32]string {
+	if m != nil {
+		return m.M3E
+	}
+	return nil
+}
+
+func (m *Maps) GetM3F() map[uint32][]byte {
+	if m != nil {
+		return m.M3F
+	}
+	return nil
+}
+
+func (m *Maps) GetM40() map[uint64]int32 {
+	if m != nil {
+		return m.M40
+	}
+	return nil
+}
+
+func (m *Maps) GetM41() map[uint64]int64 {
+	if m != nil {
+		return m.M41
+	}
+	return nil
+}
+
+func (m *Maps) GetM42() map[uint64]uint32 {
+	if m != nil {
+		return m.M42
+	}
+	return nil
+}
+
+func (m *Maps) GetM43() map[uint64]uint64 {
+	if m != nil {
+		return m.M43
+	}
+	return nil
+}
+
+func (m *Maps) GetM44() map[uint64]int32 {
+	if m != nil {
+		return m.M44
+	}
+	return nil
+}
+
+func (m *Maps) GetM45() map[uint64]int64 {
+	if m != nil {
+		return m.M45
+	}
+	return nil
+}
+
+func (m *Maps) GetM46() map[uint64]uint32 {
+	if m != nil {
+		return m.M46
+	}
+	return nil
+}
+
+func (m *Maps) GetM47() map[uint64]uint64 {
+	if m != nil {
+		return m.M47
+	}
+	return nil
+}
+
+func (m *Maps) GetM48() map[uint64]int32 {
+	if m != nil {
+		return m.M48
+	}
+	return nil
+}
+
+func (m *Maps) GetM49() map[uint64]int64 {
+	if m != nil {
+		return m.M49
+	}
+	return nil
+}
+
+func (m *Maps) GetM4A() map[uint64]float32 {
+	if m != nil {
+		return m.M4A
+	}
+	return nil
+}
+
+func (m *Maps) GetM4B() map[uint64]float64 {
+	if m != nil {
+		return m.M4B
+	}
+	return nil
+}
+
+func (m *Maps) GetM4C() map[uint64]bool {
+	if m != nil {
+		return m.M4C
+	}
+	return nil
+}
+
+func (m *Maps) GetM4D() map[uint64]Enum {
+	if m != nil {
+		return m.M4D
+	}
+	return nil
+}
+
+func (m *Maps) GetM4E() map[uint64]string {
+	if m != nil {
+		return m.M4E
+	}
+	return nil
+}
+
+func (m *Maps) GetM4F() map[uint64][]byte {
+	if m != nil {
+		return m.M4F
+	}
+	return nil
+}
+
+func (m *Maps) GetM50() map[int32]int32 {
+	if m != nil {
+		return m.M50
+	}
+	return nil
+}
+
+func (m *Maps) GetM51() map[int32]int64 {
+	if m != nil {
+		return m.M51
+	}
+	return nil
+}
+
+func (m *Maps) GetM52() map[int32]uint32 {
+	if m != nil {
+		return m.M52
+	}
+	return nil
+}
+
+func (m *Maps) GetM53() map[int32]uint64 {
+	if m != nil {
+		return m.M53
+	}
+	return nil
+}
+
+func (m *Maps) GetM54() map[int32]int32 {
+	if m != nil {
+		return m.M54
+	}
+	return nil
+}
+
+func (m *Maps) GetM55() map[int32]int64 {
+	if m != nil {
+		return m.M55
+	}
+	return nil
+}
+
+func (m *Maps) GetM56() map[int32]uint32 {
+	if m != nil {
+		return m.M56
+	}
+	return nil
+}
+
+func (m *Maps) GetM57() map[int32]uint64 {
+	if m != nil {
+		return m.M57
+	}
+	return nil
+}
+
+func (m *Maps) GetM58() map[int32]int32 {
+	if m != nil {
+		return m.M58
+	}
+	return nil
+}
+
+func (m *Maps) GetM59() map[int32]int64 {
+	if m != nil {
+		return m.M59
+	}
+	return nil
+}
+
+func (m *Maps) GetM5A() map[int32]float32 {
+	if m != nil {
+		return m.M5A
+	}
+	return nil
+}
+
+func (m *Maps) GetM5B() map[int32]float64 {
+	if m != nil {
+		return m.M5B
+	}
+	return nil
+}
+
+func (m *Maps) GetM5C() map[int32]bool {
+	if m != nil {
+		return m.M5C
+	}
+	return nil
+}
+
+func (m *Maps) GetM5D() map[int32]Enum {
+	if m != nil {
+		return m.M5D
+	}
+	return nil
+}
+
+func (m *Maps) GetM5E() map[int32]string {
+	if m != nil {
+		return m.M5E
+	}
+	return nil
+}
+
+func (m *Maps) GetM5F() map[int32][]byte {
+	if m != nil {
+		return m.M5F
+	}
+	return nil
+}
+
+func (m *Maps) GetM60() map[int64]int32 {
+	if m != nil {
+		return m.M60
+	}
+	return nil
+}
+
+func (m *Maps) GetM61() map[int64]int64 {
+	if m != nil {
+		return m.M61
+	}
+	return nil
+}
+
+func (m *Maps) GetM62() map[int64]uint32 {
+	if m != nil {
+		return m.M62
+	}
+	return nil
+}
+
+func (m *Maps) GetM63() map[int64]uint64 {
+	if m != nil {
+		return m.M63
+	}
+	return nil
+}
+
+func (m *Maps) GetM64() map[int64]int32 {
+	if m != nil {
+		return m.M64
+	}
+	return nil
+}
+
+func (m *Maps) GetM65() map[int64]int64 {
+	if m != nil {
+		return m.M65
+	}
+	return nil
+}
+
+func (m *Maps) GetM66() map[int64]uint32 {
+	if m != nil {
+		return m.M66
+	}
+	return nil
+}
+
+func (m *Maps) GetM67() map[int64]uint64 {
+	if m != nil {
+		return m.M67
+	}
+	return nil
+}
+
+func (m *Maps) GetM68() map[int64]int32 {
+	if m != nil {
+		return m.M68
+	}
+	return nil
+}
+
+func (m *Maps) GetM69() map[int64]int64 {
+	if m != nil {
+		return m.M69
+	}
+	return nil
+}
+
+func (m *Maps) GetM6A() map[int64]float32 {
+	if m != nil {
+		return m.M6A
+	}
+	return nil
+}
+
+func (m *Maps) GetM6B() map[int64]float64 {
+	if m != nil {
+		return m.M6B
+	}
+	return nil
+}
+
+func (m *Maps) GetM6C() map[int64]bool {
+	if m != nil {
+		return m.M6C
+	}
+	return nil
+}
+
+func (m *Maps) GetM6D() map[int64]Enum {
+	if m != nil {
+		return m.M6D
+	}
+	return nil
+}
+
+func (m *Maps) GetM6E() map[int64]string {
+	if m != nil {
+		return m.M6E
+	}
+	return nil
+}
+
+func (m *Maps) GetM6F() map[int64][]byte {
+	if m != nil {
+		return m.M6F
+	}
+	return nil
+}
+
+func (m *Maps) GetM70() map[uint32]int32 {
+	if m != nil {
+		return m.M70
+	}
+	return nil
+}
+
+func (m *Maps) GetM71() map[uint32]int64 {
+	if m != nil {
+		return m.M71
+	}
+	return nil
+}
+
+func (m *Maps) GetM72() map[uint32]uint32 {
+	if m != nil {
+		return m.M72
+	}
+	return nil
+}
+
+func (m *Maps) GetM73() map[uint32]uint64 {
+	if m != nil {
+		return m.M73
+	}
+	return nil
+}
+
+func (m *Maps) GetM74() map[uint32]int32 {
+	if m != nil {
+		return m.M74
+	}
+	return nil
+}
+
+func (m *Maps) GetM75() map[uint32]int64 {
+	if m != nil {
+		return m.M75
+	}
+	return nil
+}
+
+func (m *Maps) GetM76() map[uint32]uint32 {
+	if m != nil {
+		return m.M76
+	}
+	return nil
+}
+
+func (m *Maps) GetM77() map[uint32]uint64 {
+	if m != nil {
+		return m.M77
+	}
+	return nil
+}
+
+func (m *Maps) GetM78() map[uint32]int32 {
+	if m != nil {
+		return m.M78
+	}
+	return nil
+}
+
+func (m *Maps) GetM79() map[uint32]int64 {
+	if m != nil {
+		return m.M79
+	}
+	return nil
+}
+
+func (m *Maps) GetM7A() map[uint32]float32 {
+	if m != nil {
+		return m.M7A
+	}
+	return nil
+}
+
+func (m *Maps) GetM7B() map[uint32]float64 {
+	if m != nil {
+		return m.M7B
+	}
+	return nil
+}
+
+func (m *Maps) GetM7C() map[uint32]bool {
+	if m != nil {
+		return m.M7C
+	}
+	return nil
+}
+
+func (m *Maps) GetM7D() map[uint32]Enum {
+	if m != nil {
+		return m.M7D
+	}
+	return nil
+}
+
+func (m *Maps) GetM7E() map[uint32]string {
+	if m != nil {
+		return m.M7E
+	}
+	return nil
+}
+
+func (m *Maps) GetM7F() map[uint32][]byte {
+	if m != nil {
+		return m.M7F
+	}
+	return nil
+}
+
+func (m *Maps) GetM80() map[uint64]int32 {
+	if m != nil {
+		return m.M80
+	}
+	return nil
+}
+
+func (m *Maps) GetM81() map[uint64]int64 {
+	if m != nil {
+		return m.M81
+	}
+	return nil
+}
+
+func (m *Maps) GetM82() map[uint64]uint32 {
+	if m != nil {
+		return m.M82
+	}
+	return nil
+}
+
+func (m *Maps) GetM83() map[uint64]uint64 {
+	if m != nil {
+		return m.M83
+	}
+	return nil
+}
+
+func (m *Maps) GetM84() map[uint64]int32 {
+	if m != nil {
+		return m.M84
+	}
+	return nil
+}
+
+func (m *Maps) GetM85() map[uint64]int64 {
+	if m != nil {
+		return m.M85
+	}
+	return nil
+}
+
+func (m *Maps) GetM86() map[uint64]uint32 {
+	if m != nil {
+		return m.M86
+	}
+	return nil
+}
+
+func (m *Maps) GetM87() map[uint64]uint64 {
+	if m != nil {
+		return m.M87
+	}
+	return nil
+}
+
+func (m *Maps) GetM88() map[uint64]int32 {
+	if m != nil {
+		return m.M88
+	}
+	return nil
+}
+
+func (m *Maps) GetM89() map[uint64]int64 {
+	if m != nil {
+		return m.M89
+	}
+	return nil
+}
+
+func (m *Maps) GetM8A() map[uint64]float32 {
+	if m != nil {
+		return m.M8A
+	}
+	return nil
+}
+
+func (m *Maps) GetM8B() map[uint64]float64 {
+	if m != nil {
+		return m.M8B
+	}
+	return nil
+}
+
+func (m *Maps) GetM8C() map[uint64]bool {
+	if m != nil {
+		return m.M8C
+	}
+	return nil
+}
+
+func (m *Maps) GetM8D() map[uint64]Enum {
+	if m != nil {
+		return m.M8D
+	}
+	return nil
+}
+
+func (m *Maps) GetM8E() map[uint64]string {
+	if m != nil {
+		return m.M8E
+	}
+	return nil
+}
+
+func (m *Maps) GetM8F() map[uint64][]byte {
+	if m != nil {
+		return m.M8F
+	}
+	return nil
+}
+
+func (m *Maps) GetM90() map[int32]int32 {
+	if m != nil {
+		return m.M90
+	}
+	return nil
+}
+
+func (m *Maps) GetM91() map[int32]int64 {
+	if m != nil {
+		return m.M91
+	}
+	return nil
+}
+
+func (m *Maps) GetM92() map[int32]uint32 {
+	if m != nil {
+		return m.M92
+	}
+	return nil
+}
+
+func (m *Maps) GetM93() map[int32]uint64 {
+	if m != nil {
+		return m.M93
+	}
+	return nil
+}
+
+func (m *Maps) GetM94() map[int32]int32 {
+	if m != nil {
+		return m.M94
+	}
+	return nil
+}
+
+func (m *Maps) GetM95() map[int32]int64 {
+	if m != nil {
+		return m.M95
+	}
+	return nil
+}
+
+func (m *Maps) GetM96() map[int32]uint32 {
+	if m != nil {
+		return m.M96
+	}
+	return nil
+}
+
+func (m *Maps) GetM97() map[int32]uint64 {
+	if m != nil {
+		return m.M97
+	}
+	return nil
+}
+
+func (m *Maps) GetM98() map[int32]int32 {
+	if m != nil {
+		return m.M98
+	}
+	return nil
+}
+
+func (m *Maps) GetM99() map[int32]int64 {
+	if m != nil {
+		return m.M99
+	}
+	return nil
+}
+
+func (m *Maps) GetM9A() map[int32]float32 {
+	if m != nil {
+		return m.M9A
+	}
+	return nil
+}
+
+func (m *Maps) GetM9B() map[int32]float64 {
+	if m != nil {
+		return m.M9B
+	}
+	return nil
+}
+
+func (m *Maps) GetM9C() map[int32]bool {
+	if m != nil {
+		return m.M9C
+	}
+	return nil
+}
+
+func (m *Maps) GetM9D() map[int32]Enum {
+	if m != nil {
+		return m.M9D
+	}
+	return nil
+}
+
+func (m *Maps) GetM9E() map[int32]string {
+	if m != nil {
+		return m.M9E
+	}
+	return nil
+}
+
+func (m *Maps) GetM9F() map[int32][]byte {
+	if m != nil {
+		return m.M9F
+	}
+	return nil
+}
+
+func (m *Maps) GetMa0() map[int64]int32 {
+	if m != nil {
+		return m.Ma0
+	}
+	return nil
+}
+
+func (m *Maps) GetMa1() map[int64]int64 {
+	if m != nil {
+		return m.Ma1
+	}
+	return nil
+}
+
+func (m *Maps) GetMa2() map[int64]uint32 {
+	if m != nil {
+		return m.Ma2
+	}
+	return nil
+}
+
+func (m *Maps) GetMa3() map[int64]uint64 {
+	if m != nil {
+		return m.Ma3
+	}
+	return nil
+}
+
+func (m *Maps) GetMa4() map[int64]int32 {
+	if m != nil {
+		return m.Ma4
+	}
+	return nil
+}
+
+func (m *Maps) GetMa5() map[int64]int64 {
+	if m != nil {
+		return m.Ma5
+	}
+	return nil
+}
+
+func (m *Maps) GetMa6() map[int64]uint32 {
+	if m != nil {
+		return m.Ma6
+	}
+	return nil
+}
+
+func (m *Maps) GetMa7() map[int64]uint64 {
+	if m != nil {
+		return m.Ma7
+	}
+	return nil
+}
+
+func (m *Maps) GetMa8() map[int64]int32 {
+	if m != nil {
+		return m.Ma8
+	}
+	return nil
+}
+
+func (m *Maps) GetMa9() map[int64]int64 {
+	if m != nil {
+		return m.Ma9
+	}
+	return nil
+}
+
+func (m *Maps) GetMaa() map[int64]float32 {
+	if m != nil {
+		return m.Maa
+	}
+	return nil
+}
+
+func (m *Maps) GetMab() map[int64]float64 {
+	if m != nil {
+		return m.Mab
+	}
+	return nil
+}
+
+func (m *Maps) GetMac() map[int64]bool {
+	if m != nil {
+		return m.Mac
+	}
+	return nil
+}
+
+func (m *Maps) GetMad() map[int64]Enum {
+	if m != nil {
+		return m.Mad
+	}
+	return nil
+}
+
+func (m *Maps) GetMae() map[int64]string {
+	if m != nil {
+		return m.Mae
+	}
+	return nil
+}
+
+func (m *Maps) GetMaf() map[int64][]byte {
+	if m != nil {
+		return m.Maf
+	}
+	return nil
+}
+
+func (m *Maps) GetMb0() map[bool]int32 {
+	if m != nil {
+		return m.Mb0
+	}
+	return nil
+}
+
+func (m *Maps) GetMb1() map[bool]int64 {
+	if m != nil {
+		return m.Mb1
+	}
+	return nil
+}
+
+func (m *Maps) GetMb2() map[bool]uint32 {
+	if m != nil {
+		return m.Mb2
+	}
+	return nil
+}
+
+func (m *Maps) GetMb3() map[bool]uint64 {
+	if m != nil {
+		return m.Mb3
+	}
+	return nil
+}
+
+func (m *Maps) GetMb4() map[bool]int32 {
+	if m != nil {
+		return m.Mb4
+	}
+	return nil
+}
+
+func (m *Maps) GetMb5() map[bool]int64 {
+	if m != nil {
+		return m.Mb5
+	}
+	return nil
+}
+
+func (m *Maps) GetMb6() map[bool]uint32 {
+	if m != nil {
+		return m.Mb6
+	}
+	return nil
+}
+
+func (m *Maps) GetMb7() map[bool]uint64 {
+	if m != nil {
+		return m.Mb7
+	}
+	return nil
+}
+
+func (m *Maps) GetMb8() map[bool]int32 {
+	if m != nil {
+		return m.Mb8
+	}
+	return nil
+}
+
+func (m *Maps) GetMb9() map[bool]int64 {
+	if m != nil {
+		return m.Mb9
+	}
+	return nil
+}
+
+func (m *Maps) GetMba() map[bool]float32 {
+	if m != nil {
+		return m.Mba
+	}
+	return nil
+}
+
+func (m *Maps) GetMbb() map[bool]float64 {
+	if m != nil {
+		return m.Mbb
+	}
+	return nil
+}
+
+func (m *Maps) GetMbc() map[bool]bool {
+	if m != nil {
+		return m.Mbc
+	}
+	return nil
+}
+
+func (m *Maps) GetMbd() map[bool]Enum {
+	if m != nil {
+		return m.Mbd
+	}
+	return nil
+}
+
+func (m *Maps) GetMbe() map[bool]string {
+	if m != nil {
+		return m.Mbe
+	}
+	return nil
+}
+
+func (m *Maps) GetMbf() map[bool][]byte {
+	if m != nil {
+		return m.Mbf
+	}
+	return nil
+}
+
+func (m *Maps) GetMc0() map[string]int32 {
+	if m != nil {
+		return m.Mc0
+	}
+	return nil
+}
+
+func (m *Maps) GetMc1() map[string]int64 {
+	if m != nil {
+		return m.Mc1
+	}
+	return nil
+}
+
+func (m *Maps) GetMc2() map[string]uint32 {
+	if m != nil {
+		return m.Mc2
+	}
+	return nil
+}
+
+func (m *Maps) GetMc3() map[string]uint64 {
+	if m != nil {
+		return m.Mc3
+	}
+	return nil
+}
+
+func (m *Maps) GetMc4() map[string]int32 {
+	if m != nil {
+		return m.Mc4
+	}
+	return nil
+}
+
+func (m *Maps) GetMc5() map[string]int64 {
+	if m != nil {
+		return m.Mc5
+	}
+	return nil
+}
+
+func (m *Maps) GetMc6() map[string]uint32 {
+	if m != nil {
+		return m.Mc6
+	}
+	return nil
+}
+
+func (m *Maps) GetMc7() map[string]uint64 {
+	if m != nil {
+		return m.Mc7
+	}
+	return nil
+}
+
+func (m *Maps) GetMc8() map[string]int32 {
+	if m != nil {
+		return m.Mc8
+	}
+	return nil
+}
+
+func (m *Maps) GetMc9() map[string]int64 {
+	if m != nil {
+		return m.Mc9
+	}
+	return nil
+}
+
+func (m *Maps) GetMca() map[string]float32 {
+	if m != nil {
+		return m.Mca
+	}
+	return nil
+}
+
+func (m *Maps) GetMcb() map[string]float64 {
+	if m != nil {
+		return m.Mcb
+	}
+	return nil
+}
+
+func (m *Maps) GetMcc() map[string]bool {
+	if m != nil {
+		return m.Mcc
+	}
+	return nil
+}
+
+func (m *Maps) GetMcd() map[string]Enum {
+	if m != nil {
+		return m.Mcd
+	}
+	return nil
+}
+
+func (m *Maps) GetMce() map[string]string {
+	if m != nil {
+		return m.Mce
+	}
+	return nil
+}
+
+func (m *Maps) GetMcf() map[string][]byte {
+	if m != nil {
+		return m.Mcf
+	}
+	return nil
+}
+
+type MessageMaps struct {
+	Scalars *Scalars                `protobuf:"bytes,1,opt,name=scalars,proto3" json:"scalars,omitempty"`
+	M1      map[int32]*MessageMaps  `protobuf:"bytes,17,rep,name=m1,proto3" json:"m1,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M2      map[int64]*MessageMaps  `protobuf:"bytes,18,rep,name=m2,proto3" json:"m2,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M3      map[uint32]*MessageMaps `protobuf:"bytes,19,rep,name=m3,proto3" json:"m3,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M4      map[uint64]*MessageMaps `protobuf:"bytes,20,rep,name=m4,proto3" json:"m4,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M5      map[int32]*MessageMaps  `protobuf:"bytes,21,rep,name=m5,proto3" json:"m5,omitempty" protobuf_key:"zigzag32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M6      map[int64]*MessageMaps  `protobuf:"bytes,22,rep,name=m6,proto3" json:"m6,omitempty" protobuf_key:"zigzag64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M7      map[uint32]*MessageMaps `protobuf:"bytes,23,rep,name=m7,proto3" json:"m7,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M8      map[uint64]*MessageMaps `protobuf:"bytes,24,rep,name=m8,proto3" json:"m8,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	M9      map[int32]*MessageMaps  `protobuf:"bytes,25,rep,name=m9,proto3" json:"m9,omitempty" protobuf_key:"fixed32,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ma      map[int64]*MessageMaps  `protobuf:"bytes,26,rep,name=ma,proto3" json:"ma,omitempty" protobuf_key:"fixed64,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Mc      map[string]*MessageMaps `protobuf:"bytes,28,rep,name=mc,proto3" json:"mc,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *MessageMaps) Reset()         { *m = MessageMaps{} }
+func (m *MessageMaps) String() string { return proto.CompactTextString(m) }
+func (*MessageMaps) ProtoMessage()    {}
+func (*MessageMaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84eb23d74a64bdab, []int{6}
+}
+func (m *MessageMaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageMaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageMaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageMaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageMaps.Merge(m, src)
+}
+func (m *MessageMaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageMaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageMaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageMaps proto.InternalMessageInfo
+
+func (m *MessageMaps) GetScalars() *Scalars {
+	if m != nil {
+		return m.Scalars
+	}
+	return nil
+}
+
+func (m *MessageMaps) GetM1() map[int32]*MessageMaps {
+	if m != nil {
+		return m.M1
+	}
+	return nil
+}
+
+func (m *MessageMaps) GetM2() map[int64]*MessageMaps {
+	if m != nil {
+		return m.M2
+	}
+	return nil
+}
+
+func (m *MessageMaps) GetM3() map[uint32]*MessageMaps {
+	if m != nil {
+		return m.M3
+	}
+	return nil
+}
+
+func (m *MessageMaps) GetM4() map[uint64]*MessageMaps {
+	if m != nil {
+		return m.M4
+	}
+	return nil
+}
+
+func (m *MessageMaps) GetM5() map[int32]*MessageMaps {
+	if m != nil {
+		return m.M5
+	}
+	return nil
+}
+
+func (m *MessageMaps) GetM6() map[int64]*MessageMaps {
+	if m != nil {
+		return m.M6
+	}
+	return nil
+}
+
+func (m *MessageMaps) GetM7() map[uint32]*MessageMaps {
+	if m != nil {
+		return m.M7
+	}
+	return nil
+}
+
+func (m *MessageMaps) GetM8() map[uint64]*MessageMaps {
+	if m != nil {
+		return m.M8
+	}
+	return nil
+}
+
+func (m *MessageMaps) GetM9() map[int32]*MessageMaps {
+	if m != nil {
+		return m.M9
+	}
+	return nil
+}
+
+func (m *MessageMaps) GetMa() map[int64]*MessageMaps {
+	if m != nil {
+		return m.Ma
+	}
+	return nil
+}
+
+func (m *MessageMaps) GetMc() map[string]*MessageMaps {
+	if m != nil {
+		return m.Mc
+	}
+	return nil
+}
+
+type Pathological struct {
+	X []*Pathological_Inner `protobuf:"bytes,1,rep,name=x,proto3" json:"x,omitempty"`
+}
+
+func (m *Pathological) Reset()         { *m = Pathological{} }
+func (m *Pathological) String() string { return proto.CompactTextString(m) }
+func (*Pathological) ProtoMessage()    {}
+func (*Pathological) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84eb23d74a64bdab, []int{7}
+}
+func (m *Pathological) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pathological) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pathological.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pathological) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pathological.Merge(m, src)
+}
+func (m *Pathological) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pathological) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pathological.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pathological proto.InternalMessageInfo
+
+func (m *Pathological) GetX() []*Pathological_Inner {
+	if m != nil {
+		return m.X
+	}
+	return nil
+}
+
+type Pathological_Inner struct {
+	X1     int32  `protobuf:"varint,1,opt,name=x1,proto3" json:"x1,omitempty"`
+	X2     int32  `protobuf:"varint,2,opt,name=x2,proto3" json:"x2,omitempty"`
+	X3     int32  `protobuf:"varint,3,opt,name=x3,proto3" json:"x3,omitempty"`
+	X4     int32  `protobuf:"varint,4,opt,name=x4,proto3" json:"x4,omitempty"`
+	X5     int32  `protobuf:"varint,5,opt,name=x5,proto3" json:"x5,omitempty"`
+	X6     int32  `protobuf:"varint,6,opt,name=x6,proto3" json:"x6,omitempty"`
+	X7     int32  `protobuf:"varint,7,opt,name=x7,proto3" json:"x7,omitempty"`
+	X8     int32  `protobuf:"varint,8,opt,name=x8,proto3" json:"x8,omitempty"`
+	X9     int32  `protobuf:"varint,9,opt,name=x9,proto3" json:"x9,omitempty"`
+	Xa     int32  `protobuf:"varint,10,opt,name=xa,proto3" json:"xa,omitempty"`
+	Xb     int32  `protobuf:"varint,11,opt,name=xb,proto3" json:"xb,omitempty"`
+	Xc     int32  `protobuf:"varint,12,opt,name=xc,proto3" json:"xc,omitempty"`
+	Xd     int32  `protobuf:"varint,13,opt,name=xd,proto3" json:"xd,omitempty"`
+	Xe     int32  `protobuf:"varint,14,opt,name=xe,proto3" json:"xe,omitempty"`
+	Xf     int32  `protobuf:"varint,15,opt,name=xf,proto3" json:"xf,omitempty"`
+	Actual string `protobuf:"bytes,100,opt,name=actual,proto3" json:"actual,omitempty"`
+}
+
+func (m *Pathological_Inner) Reset()         { *m = Pathological_Inner{} }
+func (m *Pathological_Inner) String() string { return proto.CompactTextString(m) }
+func (*Pathological_Inner) ProtoMessage()    {}
+func (*Pathological_Inner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84eb23d74a64bdab, []int{7, 0}
+}
+func (m *Pathological_Inner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pathological_Inner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pathological_Inner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pathological_Inner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pathological_Inner.Merge(m, src)
+}
+func (m *Pathological_Inner) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pathological_Inner) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pathological_Inner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pathological_Inner proto.InternalMessageInfo
+
+func (m *Pathological_Inner) GetX1() int32 {
+	if m != nil {
+		return m.X1
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetX2() int32 {
+	if m != nil {
+		return m.X2
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetX3() int32 {
+	if m != nil {
+		return m.X3
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetX4() int32 {
+	if m != nil {
+		return m.X4
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetX5() int32 {
+	if m != nil {
+		return m.X5
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetX6() int32 {
+	if m != nil {
+		return m.X6
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetX7() int32 {
+	if m != nil {
+		return m.X7
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetX8() int32 {
+	if m != nil {
+		return m.X8
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetX9() int32 {
+	if m != nil {
+		return m.X9
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetXa() int32 {
+	if m != nil {
+		return m.Xa
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetXb() int32 {
+	if m != nil {
+		return m.Xb
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetXc() int32 {
+	if m != nil {
+		return m.Xc
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetXd() int32 {
+	if m != nil {
+		return m.Xd
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetXe() int32 {
+	if m != nil {
+		return m.Xe
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetXf() int32 {
+	if m != nil {
+		return m.Xf
+	}
+	return 0
+}
+
+func (m *Pathological_Inner) GetActual() string {
+	if m != nil {
+		return m.Actual
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("hyperpb.test.Enum", Enum_name, Enum_value)
+	proto.RegisterType((*Scalars)(nil), "hyperpb.test.Scalars")
+	proto.RegisterType((*Numbers)(nil), "hyperpb.test.Numbers")
+	proto.RegisterType((*Repeated)(nil), "hyperpb.test.Repeated")
+	proto.RegisterType((*Graph)(nil), "hyperpb.test.Graph")
+	proto.RegisterType((*Oneof)(nil), "hyperpb.test.Oneof")
+	proto.RegisterType((*Maps)(nil), "hyperpb.test.Maps")
+	proto.RegisterMapType((map[int32]int32)(nil), "hyperpb.test.Maps.M10Entry")
+	proto.RegisterMapType((map[int32]int64)(nil), "hyperpb.test.Maps.M11Entry")
+	proto.RegisterMapType((map[int32]uint32)(nil), "hyperpb.test.Maps.M12Entry")
+	proto.RegisterMapType((map[int32]uint64)(nil), "hyperpb.test.Maps.M13Entry")
+	proto.RegisterMapType((map[int32]int32)(nil), "hyperpb.test.Maps.M14Entry")
+	proto.RegisterMapType((map[int32]int64)(nil), "hyperpb.test.Maps.M15Entry")
+	proto.RegisterMapType((map[int32]uint32)(nil), "hyperpb.test.Maps.M16Entry")
+	proto.RegisterMapType((map[int32]uint64)(nil), "hyperpb.test.Maps.M17Entry")
+	proto.RegisterMapType((map[int32]int32)(nil), "hyperpb.test.Maps.M18Entry")
+	proto.RegisterMapType((map[int32]int64)(nil), "hyperpb.test.Maps.M19Entry")
+	proto.RegisterMapType((map[int32]float32)(nil), "hyperpb.test.Maps.M1aEntry")
+	proto.RegisterMapType((map[int32]float64)(nil), "hyperpb.test.Maps.M1bEntry")
+	proto.RegisterMapType((map[int32]bool)(nil), "hyperpb.test.Maps.M1cEntry")
+	proto.RegisterMapType((map[int32]Enum)(nil), "hyperpb.test.Maps.M1dEntry")
+	proto.RegisterMapType((map[int32]string)(nil), "hyperpb.test.Maps.M1eEntry")
+	proto.RegisterMapType((map[int32][]byte)(nil), "hyperpb.test.Maps.M1fEntry")
+	proto.RegisterMapType((map[int64]int32)(nil), "hyperpb.test.Maps.M20Entry")
+	proto.RegisterMapType((map[int64]int64)(nil), "hyperpb.test.Maps.M21Entry")
+	proto.RegisterMapType((map[int64]uint32)(nil), "hyperpb.test.Maps.M22Entry")
+	proto.RegisterMapType((map[int64]uint64)(nil), "hyperpb.test.Maps.M23Entry")
+	proto.RegisterMapType((map[int64]int32)(nil), "hyperpb.test.Maps.M24Entry")
+	proto.RegisterMapType((map[int64]int64)(nil), "hyperpb.test.Maps.M25Entry")
+	proto.RegisterMapType((map[int64]uint32)(nil), "hyperpb.test.Maps.M26Entry")
+	proto.RegisterMapType((map[int64]uint64)(nil), "hyperpb.test.Maps.M27Entry")
+	proto.RegisterMapType((map[int64]int32)(nil), "hyperpb.test.Maps.M28Entry")
+	proto.RegisterMapType((map[int64]int64)(nil), "hyperpb.test.Maps.M29Entry")
+	proto.RegisterMapType((map[int64]float32)(nil), "hyperpb.test.Maps.M2aEntry")
+	proto.RegisterMapType((map[int64]float64)(nil), "hyperpb.test.Maps.M2bEntry")
+	proto.RegisterMapType((map[int64]bool)(nil), "hyperpb.test.Maps.M2cEntry")
+	proto.RegisterMapType((map[int64]Enum)(nil), "hyperpb.test.Maps.M2dEntry")
+	proto.RegisterMapType((map[int64]string)(nil), "hyperpb.test.Maps.M2eEntry")
+	proto.RegisterMapType((map[int64][]byte)(nil), "hyperpb.test.Maps.M2fEntry")
+	proto.RegisterMapType((map[uint32]int32)(nil), "hyperpb.test.Maps.M30Entry")
+	proto.RegisterMapType((map[uint32]int64)(nil), "hyperpb.test.Maps.M31Entry")
+	proto.RegisterMapType((map[uint32]uint32)(nil), "hyperpb.test.Maps.M32Entry")
+	proto.RegisterMapType((map[uint32]uint64)(nil), "hyperpb.test.Maps.M33Entry")
+	proto.RegisterMapType((map[uint32]int32)(nil), "hyperpb.test.Maps.M34Entry")
+	proto.RegisterMapType((map[uint32]int64)(nil), "hyperpb.test.Maps.M35Entry")
+	proto.RegisterMapType((map[uint32]uint32)(nil), "hyperpb.test.Maps.M36Entry")
+	proto.RegisterMapType((map[uint32]uint64)(nil), "hyperpb.test.Maps.M37Entry")
+	proto.RegisterMapType((map[uint32]int32)(nil), "hyperpb.test.Maps.M38Entry")
+	proto.RegisterMapType((map[uint32]int64)(nil), "hyperpb.test.Maps.M39Entry")
+	proto.RegisterMapType((map[uint32]float32)(nil), "hyperpb.test.Maps.M3aEntry")
+	proto.RegisterMapType((map[uint32]float64)(nil), "hyperpb.test.Maps.M3bEntry")
+	proto.RegisterMapType((map[uint32]bool)(nil), "hyperpb.test.Maps.M3cEntry")
+	proto.RegisterMapType((map[uint32]Enum)(nil), "hyperpb.test.Maps.M3dEntry")
+	proto.RegisterMapType((map[uint32]string)(nil), "hyperpb.test.Maps.M3eEntry")
+	proto.RegisterMapType((map[uint32][]byte)(nil), "hyperpb.test.Maps.M3fEntry")
+	proto.RegisterMapType((map[uint64]int32)(nil), "hyperpb.test.Maps.M40Entry")
+	proto.RegisterMapType((map[uint64]int64)(nil), "hyperpb.test.Maps.M41Entry")
+	proto.RegisterMapType((map[uint64]uint32)(nil), "hyperpb.test.Maps.M42Entry")
+	proto.RegisterMapType((map[uint64]uint64)(nil), "hyperpb.test.Maps.M43Entry")
+	proto.RegisterMapType((map[uint64]int32)(nil), "hyperpb.test.Maps.M44Entry")
+	proto.RegisterMapType((map[uint64]int64)(nil), "hyperpb.test.Maps.M45Entry")
+	proto.RegisterMapType((map[uint64]uint32)(nil), "hyperpb.test.Maps.M46Entry")
+	proto.RegisterMapType((map[uint64]uint64)(nil), "hyperpb.test.Maps.M47Entry")
+	proto.RegisterMapType((map[uint64]int32)(nil), "hyperpb.test.Maps.M48Entry")
+	proto.RegisterMapType((map[uint64]int64)(nil), "hyperpb.test.Maps.M49Entry")
+	proto.RegisterMapType((map[uint64]float32)(nil), "hyperpb.test.Maps.M4aEntry")
+	proto.RegisterMapType((map[uint64]float64)(nil), "hyperpb.test.Maps.M4bEntry")
+	proto.RegisterMapType((map[uint64]bool)(nil), "hyperpb.test.Maps.M4cEntry")
+	proto.RegisterMapType((map[uint64]Enum)(nil), "hyperpb.test.Maps.M4dEntry")
+	proto.RegisterMapType((map[uint64]string)(nil), "hyperpb.test.Maps.M4eEntry")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "hyperpb.test.Maps.M4fEntry")
+	proto.RegisterMapType((map[int32]int32)(nil), "hyperpb.test.Maps.M50Entry")
+	proto.RegisterMapType((map[int32]int64)(nil), "hyperpb.test.Maps.M51Entry")
+	proto.RegisterMapType((map[int32]uint32)(nil), "hyperpb.test.Maps.M52Entry")
+	proto.RegisterMapType((map[int32]uint64)(nil), "hyperpb.test.Maps.M53Entry")
+	proto.RegisterMapType((map[int32]int32)(nil), "hyperpb.test.Maps.M54Entry")
+	proto.RegisterMapType((map[int32]int64)(nil), "hyperpb.test.Maps.M55Entry")
+	proto.RegisterMapType((map[int32]uint32)(nil), "hyperpb.test.Maps.M56Entry")
+	proto.RegisterMapType((map[int32]uint64)(nil), "hyperpb.test.Maps.M57Entry")
+	proto.RegisterMapType((map[int32]int32)(nil), "hyperpb.test.Maps.M58Entry")
+	proto.RegisterMapType((map[int32]int64)(nil), "hyperpb.test.Maps.M59Entry")
+	proto.RegisterMapType((map[int32]float32)(nil), "hyperpb.test.Maps.M5aEntry")
+	proto.RegisterMapType((map[int32]float64)(nil), "hyperpb.test.Maps.M5bEntry")
+	proto.RegisterMapType((map[int32]bool)(nil), "hyperpb.test.Maps.M5cEntry")
+	proto.RegisterMapType((map[int32]Enum)(nil), "hyperpb.test.Maps.M5dEntry")
+	proto.RegisterMapType((map[int32]string)(nil), "hyperpb.test.Maps.M5eEntry")
+	proto.RegisterMapType((map[int32][]byte)(nil), "hyperpb.test.Maps.M5fEntry")
+	proto.RegisterMapType((map[int64]int32)(nil), "hyperpb.test.Maps.M60Entry")
+	proto.RegisterMapType((map[int64]int64)(nil), "hyperpb.test.Maps.M61Entry")
+	proto.RegisterMapType((map[int64]uint32)(nil), "hyperpb.test.Maps.M62Entry")
+	proto.RegisterMapType((map[int64]uint64)(nil), "hyperpb.test.Maps.M63Entry")
+	proto.RegisterMapType((map[int64]int32)(nil), "hyperpb.test.Maps.M64Entry")
+	proto.RegisterMapType((map[int64]int64)(nil), "hyperpb.test.Maps.M65Entry")
+	proto.RegisterMapType((map[int64]uint32)(nil), "hyperpb.test.Maps.M66Entry")
+	proto.RegisterMapType((map[int64]uint64)(nil), "hyperpb.test.Maps.M67Entry")
+	proto.RegisterMapType((map[int64]int32)(nil), "hyperpb.test.Maps.M68Entry")
+	proto.RegisterMapType((map[int64]int64)(nil), "hyperpb.test.Maps.M69Entry")
+	proto.RegisterMapType((map[int64]float32)(nil), "hyperpb.test.Maps.M6aEntry")
+	proto.RegisterMapType((map[int64]float64)(nil), "hyperpb.test.Maps.M6bEntry")
+	proto.RegisterMapType((map[int64]bool)(nil), "hyperpb.test.Maps.M6cEntry")
+	proto.RegisterMapType((map[int64]Enum)(nil), "hyperpb.test.Maps.M6dEntry")
+	proto.RegisterMapType((map[int64]string)(nil), "hyperpb.test.Maps.M6eEntry")
+	proto.RegisterMapType((map[int64][]byte)(nil), "hyperpb.test.Maps.M6fEntry")
+	proto.RegisterMapType((map[uint32]int32)(nil), "hyperpb.test.Maps.M70Entry")
+	proto.RegisterMapType((map[uint32]int64)(nil), "hyperpb.test.Maps.M71Entry")
+	proto.RegisterMapType((map[uint32]uint32)(nil), "hyperpb.test.Maps.M72Entry")
+	proto.RegisterMapType((map[uint32]uint64)(nil), "hyperpb.test.Maps.M73Entry")
+	proto.RegisterMapType((map[uint32]int32)(nil), "hyperpb.test.Maps.M74Entry")
+	proto.RegisterMapType((map[uint32]int64)(nil), "hyperpb.test.Maps.M75Entry")
+	proto.RegisterMapType((map[uint32]uint32)(nil), "hyperpb.test.Maps.M76Entry")
+	proto.RegisterMapType((map[uint32]uint64)(nil), "hyperpb.test.Maps.M77Entry")
+	proto.RegisterMapType((map[uint32]int32)(nil), "hyperpb.test.Maps.M78Entry")
+	proto.RegisterMapType((map[uint32]int64)(nil), "hyperpb.test.Maps.M79Entry")
+	proto.RegisterMapType((map[uint32]float32)(nil), "hyperpb.test.Maps.M7aEntry")
+	proto.RegisterMapType((map[uint32]float64)(nil), "hyperpb.test.Maps.M7bEntry")
+	proto.RegisterMapType((map[uint32]bool)(nil), "hyperpb.test.Maps.M7cEntry")
+	proto.RegisterMapType((map[uint32]Enum)(nil), "hyperpb.test.Maps.M7dEntry")
+	proto.RegisterMapType((map[uint32]string)(nil), "hyperpb.test.Maps.M7eEntry")
+	proto.RegisterMapType((map[uint32][]byte)(nil), "hyperpb.test.Maps.M7fEntry")
+	proto.RegisterMapType((map[uint64]int32)(nil), "hyperpb.test.Maps.M80Entry")
+	proto.RegisterMapType((map[uint64]int64)(nil), "hyperpb.test.Maps.M81Entry")
+	proto.RegisterMapType((map[uint64]uint32)(nil), "hyperpb.test.Maps.M82Entry")
+	proto.RegisterMapType((map[uint64]uint64)(nil), "hyperpb.test.Maps.M83Entry")
+	proto.RegisterMapType((map[uint64]int32)(nil), "hyperpb.test.Maps.M84Entry")
+	proto.RegisterMapType((map[uint64]int64)(nil), "hyperpb.test.Maps.M85Entry")
+	proto.RegisterMapType((map[uint64]uint32)(nil), "hyperpb.test.Maps.M86Entry")
+	proto.RegisterMapType((map[uint64]uint64)(nil), "hyperpb.test.Maps.M87Entry")
+	proto.RegisterMapType((map[uint64]int32)(nil), "hyperpb.test.Maps.M88Entry")
+	proto.RegisterMapType((map[uint64]int64)(nil), "hyperpb.test.Maps.M89Entry")
+	proto.RegisterMapType((map[uint64]float32)(nil), "hyperpb.test.Maps.M8aEntry")
+	proto.RegisterMapType((map[uint64]float64)(nil), "hyperpb.test.Maps.M8bEntry")
+	proto.RegisterMapType((map[uint64]bool)(nil), "hyperpb.test.Maps.M8cEntry")
+	proto.RegisterMapType((map[uint64]Enum)(nil), "hyperpb.test.Maps.M8dEntry")
+	proto.RegisterMapType((map[uint64]string)(nil), "hyperpb.test.Maps.M8eEntry")
+	proto.RegisterMapType((map[uint64][]byte)(nil), "hyperpb.test.Maps.M8fEntry")
+	proto.RegisterMapType((map[int32]int32)(nil), "hyperpb.test.Maps.M90Entry")
+	proto.RegisterMapType((map[int32]int64)(nil), "hyperpb.test.Maps.M91Entry")
+	proto.RegisterMapType((map[int32]uint32)(nil), "hyperpb.test.Maps.M92Entry")
+	proto.RegisterMapType((map[int32]uint64)(nil), "hyperpb.test.Maps.M93Entry")
+	proto.RegisterMapType((map[int32]int32)(nil), "hyperpb.test.Maps.M94Entry")
+	proto.RegisterMapType((map[int32]int64)(nil), "hyperpb.test.Maps.M95Entry")
+	proto.RegisterMapType((map[int32]uint32)(nil), "hyperpb.test.Maps.M96Entry")
+	proto.RegisterMapType((map[int32]uint64)(nil), "hyperpb.test.Maps.M97Entry")
+	proto.RegisterMapType((map[int32]int32)(nil), "hyperpb.test.Maps.M98Entry")
+	proto.RegisterMapType((map[int32]int64)(nil), "hyperpb.test.Maps.M99Entry")
+	proto.RegisterMapType((map[int32]float32)(nil), "hyperpb.test.Maps.M9aEntry")
+	proto.RegisterMapType((map[int32]float64)(nil), "hyperpb.test.Maps.M9bEntry")
+	proto.RegisterMapType((map[int32]bool)(nil), "hyperpb.test.Maps.M9cEntry")
+	proto.RegisterMapType((map[int32]Enum)(nil), "hyperpb.test.Maps.M9dEntry")
+	proto.RegisterMapType((map[int32]string)(nil), "hyperpb.test.Maps.M9eEntry")
+	proto.RegisterMapType((map[int32][]byte)(nil), "hyperpb.test.Maps.M9fEntry")
+	proto.RegisterMapType((map[int64]int32)(nil), "hyperpb.test.Maps.Ma0Entry")
+	proto.RegisterMapType((map[int64]int64)(nil), "hyperpb.test.Maps.Ma1Entry")
+	proto.RegisterMapType((map[int64]uint32)(nil), "hyperpb.test.Maps.Ma2Entry")
+	proto.RegisterMapType((map[int64]uint64)(nil), "hyperpb.test.Maps.Ma3Entry")
+	proto.RegisterMapType((map[int64]int32)(nil), "hyperpb.test.Maps.Ma4Entry")
+	proto.RegisterMapType((map[int64]int64)(nil), "hyperpb.test.Maps.Ma5Entry")
+	proto.RegisterMapType((map[int64]uint32)(nil), "hyperpb.test.Maps.Ma6Entry")
+	proto.RegisterMapType((map[int64]uint64)(nil), "hyperpb.test.Maps.Ma7Entry")
+	proto.RegisterMapType((map[int64]int32)(nil), "hyperpb.test.Maps.Ma8Entry")
+	proto.RegisterMapType((map[int64]int64)(nil), "hyperpb.test.Maps.Ma9Entry")
+	proto.RegisterMapType((map[int64]float32)(nil), "hyperpb.test.Maps.MaaEntry")
+	proto.RegisterMapType((map[int64]float64)(nil), "hyperpb.test.Maps.MabEntry")
+	proto.RegisterMapType((map[int64]bool)(nil), "hyperpb.test.Maps.MacEntry")
+	proto.RegisterMapType((map[int64]Enum)(nil), "hyperpb.test.Maps.MadEntry")
+	proto.RegisterMapType((map[int64]string)(nil), "hyperpb.test.Maps.MaeEntry")
+	proto.RegisterMapType((map[int64][]byte)(nil), "hyperpb.test.Maps.MafEntry")
+	proto.RegisterMapType((map[bool]int32)(nil), "hyperpb.test.Maps.Mb0Entry")
+	proto.RegisterMapType((map[bool]int64)(nil), "hyperpb.test.Maps.Mb1Entry")
+	proto.RegisterMapType((map[bool]uint32)(nil), "hyperpb.test.Maps.Mb2Entry")
+	proto.RegisterMapType((map[bool]uint64)(nil), "hyperpb.test.Maps.Mb3Entry")
+	proto.RegisterMapType((map[bool]int32)(nil), "hyperpb.test.Maps.Mb4Entry")
+	proto.RegisterMapType((map[bool]int64)(nil), "hyperpb.test.Maps.Mb5Entry")
+	proto.RegisterMapType((map[bool]uint32)(nil), "hyperpb.test.Maps.Mb6Entry")
+	proto.RegisterMapType((map[bool]uint64)(nil), "hyperpb.test.Maps.Mb7Entry")
+	proto.RegisterMapType((map[bool]int32)(nil), "hyperpb.test.Maps.Mb8Entry")
+	proto.RegisterMapType((map[bool]int64)(nil), "hyperpb.test.Maps.Mb9Entry")
+	proto.RegisterMapType((map[bool]float32)(nil), "hyperpb.test.Maps.MbaEntry")
+	proto.RegisterMapType((map[bool]float64)(nil), "hyperpb.test.Maps.MbbEntry")
+	proto.RegisterMapType((map[bool]bool)(nil), "hyperpb.test.Maps.MbcEntry")
+	proto.RegisterMapType((map[bool]Enum)(nil), "hyperpb.test.Maps.MbdEntry")
+	proto.RegisterMapType((map[bool]string)(nil), "hyperpb.test.Maps.MbeEntry")
+	proto.RegisterMapType((map[bool][]byte)(nil), "hyperpb.test.Maps.MbfEntry")
+	proto.RegisterMapType((map[string]int32)(nil), "hyperpb.test.Maps.Mc0Entry")
+	proto.RegisterMapType((map[string]int64)(nil), "hyperpb.test.Maps.Mc1Entry")
+	proto.RegisterMapType((map[string]uint32)(nil), "hyperpb.test.Maps.Mc2Entry")
+	proto.RegisterMapType((map[string]uint64)(nil), "hyperpb.test.Maps.Mc3Entry")
+	proto.RegisterMapType((map[string]int32)(nil), "hyperpb.test.Maps.Mc4Entry")
+	proto.RegisterMapType((map[string]int64)(nil), "hyperpb.test.Maps.Mc5Entry")
+	proto.RegisterMapType((map[string]uint32)(nil), "hyperpb.test.Maps.Mc6Entry")
+	proto.RegisterMapType((map[string]uint64)(nil), "hyperpb.test.Maps.Mc7Entry")
+	proto.RegisterMapType((map[string]int32)(nil), "hyperpb.test.Maps.Mc8Entry")
+	proto.RegisterMapType((map[string]int64)(nil), "hyperpb.test.Maps.Mc9Entry")
+	proto.RegisterMapType((map[string]float32)(nil), "hyperpb.test.Maps.McaEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "hyperpb.test.Maps.McbEntry")
+	proto.RegisterMapType((map[string]bool)(nil), "hyperpb.test.Maps.MccEntry")
+	proto.RegisterMapType((map[string]Enum)(nil), "hyperpb.test.Maps.McdEntry")
+	proto.RegisterMapType((map[string]string)(nil), "hyperpb.test.Maps.MceEntry")
+	proto.RegisterMapType((map[string][]byte)(nil), "hyperpb.test.Maps.McfEntry")
+	proto.RegisterType((*MessageMaps)(nil), "hyperpb.test.MessageMaps")
+	proto.RegisterMapType((map[int32]*MessageMaps)(nil), "hyperpb.test.MessageMaps.M1Entry")
+	proto.RegisterMapType((map[int64]*MessageMaps)(nil), "hyperpb.test.MessageMaps.M2Entry")
+	proto.RegisterMapType((map[uint32]*MessageMaps)(nil), "hyperpb.test.MessageMaps.M3Entry")
+	proto.RegisterMapType((map[uint64]*MessageMaps)(nil), "hyperpb.test.MessageMaps.M4Entry")
+	proto.RegisterMapType((map[int32]*MessageMaps)(nil), "hyperpb.test.MessageMaps.M5Entry")
+	proto.RegisterMapType((map[int64]*MessageMaps)(nil), "hyperpb.test.MessageMaps.M6Entry")
+	proto.RegisterMapType((map[uint32]*MessageMaps)(nil), "hyperpb.test.MessageMaps.M7Entry")
+	proto.RegisterMapType((map[uint64]*MessageMaps)(nil), "hyperpb.test.MessageMaps.M8Entry")
+	proto.RegisterMapType((map[int32]*MessageMaps)(nil), "hyperpb.test.MessageMaps.M9Entry")
+	proto.RegisterMapType((map[int64]*MessageMaps)(nil), "hyperpb.test.MessageMaps.MaEntry")
+	proto.RegisterMapType((map[string]*MessageMaps)(nil), "hyperpb.test.MessageMaps.McEntry")
+	proto.RegisterType((*Pathological)(nil), "hyperpb.test.Pathological")
+	proto.RegisterType((*Pathological_Inner)(nil), "hyperpb.test.Pathological.Inner")
+}
+
+func init() { proto.RegisterFile("test/test.proto", fileDescriptor_84eb23d74a64bdab) }
+
+var fileDescriptor_84eb23d74a64bdab = []byte{
+	// 4294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x9c, 0x59, 0x98, 0x1c, 0xd7,
+	0x55, 0xc7, 0xdd, 0x3d, 0x1a, 0x2d, 0x2d, 0xc9, 0x1a, 0xb5, 0xed, 0xe4, 0xc4, 0x49, 0x94, 0xb1,
+	0x02, 0x64, 0x08, 0x44, 0x9a, 0xba, 0x6b, 0x5d, 0x87, 0x25, 0x28, 0x51, 0x3c, 0x0e, 0xc8, 0x11,
+	0x6d, 0x1b, 0x42, 0x62, 0x30, 0xf7, 0x56, 0x57, 0xcb, 0x22, 0x1a, 0x49, 0xf4, 0x8c, 0xcc, 0x98,
+	0xd5, 0x6c, 0x21, 0x24, 0x40, 0xc2, 0xbe, 0x86, 0x25, 0xec, 0x61, 0xdf, 0x13, 0xc2, 0xbe, 0x86,
+	0xb0, 0xf9, 0x91, 0x47, 0x3e, 0xfb, 0x8d, 0x77, 0x9e, 0xcd, 0x57, 0x75, 0xa6, 0x4f, 0x24, 0x0e,
+	0x7d, 0x38, 0xa3, 0x17, 0x55, 0xdf, 0x33, 0xbf, 0x7f, 0x7d, 0x77, 0xaa, 0x7f, 0x7f, 0xc9, 0xd6,
+	0xb4, 0xee, 0xe8, 0xd4, 0x6e, 0xbb, 0xb3, 0x7b, 0xbe, 0xfb, 0xe5, 0xdc, 0xcd, 0xf9, 0x8d, 0xdd,
+	0x1b, 0xe3, 0x13, 0xcf, 0x3c, 0x77, 0xb3, 0x9d, 0xdf, 0x2c, 0xe7, 0xba, 0xd9, 0xd9, 0x4f, 0xae,
+	0x8e, 0x8e, 0x3c, 0xde, 0xe4, 0x6b, 0x79, 0xbe, 0x33, 0xbe, 0x77, 0x34, 0xcc, 0x15, 0x0c, 0xd6,
+	0x07, 0x1b, 0xab, 0x93, 0x61, 0xae, 0xfa, 0xb5, 0x81, 0xe1, 0xfa, 0x60, 0x63, 0x65, 0x32, 0xcc,
+	0xa6, 0x5f, 0x5b, 0x58, 0x59, 0x1f, 0x6c, 0x9c, 0x9c, 0x0c, 0xb3, 0xed, 0xd7, 0x0e, 0x0e, 0xad,
+	0x0f, 0x36, 0x0e, 0x4d, 0x86, 0xd9, 0xf5, 0x6b, 0x0f, 0xab, 0xeb, 0x83, 0x8d, 0xd3, 0x93, 0x61,
+	0xf6, 0xfd, 0x3a, 0xc0, 0xe1, 0xf5, 0xc1, 0xc6, 0x78, 0x32, 0xcc, 0xa1, 0x5f, 0x47, 0x38, 0xb2,
+	0x3e, 0xd8, 0x38, 0x32, 0x19, 0xe6, 0xd8, 0xaf, 0x6b, 0x38, 0xba, 0x3e, 0xd8, 0x38, 0x3c, 0x19,
+	0xe6, 0xba, 0x5f, 0x27, 0x38, 0xb6, 0x3e, 0xd8, 0x38, 0x35, 0x19, 0xe6, 0x34, 0x5e, 0x1b, 0xad,
+	0xe4, 0x6a, 0x13, 0x46, 0xeb, 0x83, 0x8d, 0xb5, 0x49, 0xf7, 0x12, 0x27, 0x15, 0x1c, 0x5f, 0x1f,
+	0x6c, 0x0c, 0xbb, 0x49, 0x85, 0x13, 0x03, 0x27, 0xd6, 0x07, 0x1b, 0x83, 0x6e, 0x62, 0x70, 0x62,
+	0xe1, 0xe4, 0xfa, 0x60, 0xe3, 0x68, 0x37, 0xb1, 0x38, 0x71, 0x70, 0xef, 0xfa, 0x60, 0xe3, 0x58,
+	0x37, 0x71, 0x38, 0xf1, 0x70, 0x6a, 0x7d, 0xb0, 0x71, 0xa2, 0x9b, 0xf8, 0xf1, 0x7d, 0xa3, 0x61,
+	0xa9, 0xe0, 0x81, 0xee, 0x7b, 0xdf, 0xba, 0x67, 0x32, 0x2c, 0xd5, 0xfb, 0x07, 0x83, 0x7e, 0x68,
+	0xe0, 0x15, 0xdd, 0x03, 0xd8, 0x1a, 0x4c, 0x86, 0xc5, 0x2c, 0x86, 0x16, 0x5e, 0xd9, 0x3d, 0x85,
+	0xad, 0xe1, 0x64, 0x58, 0xec, 0x62, 0xe8, 0x00, 0xba, 0x47, 0xb1, 0xb5, 0x32, 0x19, 0x16, 0xb7,
+	0x18, 0x7a, 0x78, 0x55, 0xf7, 0x3c, 0xb6, 0x0e, 0x4d, 0x86, 0xc5, 0x2f, 0x86, 0x01, 0x1e, 0xec,
+	0x1e, 0xca, 0xd6, 0xea, 0x64, 0x58, 0xc2, 0x62, 0x18, 0xe1, 0xd5, 0xdd, 0x93, 0xd9, 0x3a, 0x3c,
+	0x19, 0x96, 0xb8, 0x18, 0xd6, 0xf0, 0x9a, 0xee, 0xf1, 0x6c, 0x1d, 0x99, 0x0c, 0x4b, 0xbd, 0x18,
+	0x26, 0x78, 0x6d, 0xf7, 0x8c, 0xb6, 0x8e, 0x4e, 0x86, 0x25, 0x75, 0xc3, 0x07, 0x46, 0x2b, 0xa5,
+	0xda, 0x84, 0x33, 0xdd, 0x83, 0xda, 0x3a, 0x36, 0xe9, 0x16, 0x34, 0xae, 0xe0, 0x75, 0xdd, 0xd3,
+	0xda, 0x1a, 0x75, 0xe3, 0x8a, 0xc6, 0x06, 0xd6, 0xbb, 0x47, 0xb6, 0x75, 0xbc, 0x1b, 0x1b, 0x1a,
+	0x5b, 0x78, 0xa8, 0x7b, 0x6e, 0x5b, 0x27, 0xba, 0xb1, 0xa5, 0xb1, 0x83, 0xb3, 0xdd, 0xc3, 0xdb,
+	0x3a, 0xd9, 0x8d, 0x1d, 0x8d, 0x3d, 0xbc, 0xbe, 0x7b, 0x82, 0x5b, 0xf7, 0x76, 0xe3, 0xee, 0xbb,
+	0xbb, 0xb0, 0x3a, 0x5a, 0x79, 0xba, 0x54, 0x78, 0x31, 0x78, 0xb1, 0x78, 0x71, 0x78, 0xf1, 0x78,
+	0x09, 0x78, 0x89, 0x78, 0xa9, 0xf1, 0x92, 0x2e, 0x1c, 0x1e, 0x1d, 0x7a, 0xba, 0x54, 0x9b, 0xfb,
+	0xd7, 0x6a, 0xff, 0x6a, 0xf6, 0xaf, 0x76, 0xff, 0xea, 0xf6, 0xaf, 0xfe, 0xec, 0x27, 0x06, 0xa3,
+	0x23, 0x8f, 0xdd, 0xda, 0x2e, 0xed, 0x52, 0x7b, 0x4f, 0xed, 0xaf, 0x17, 0xf6, 0xae, 0xed, 0xaf,
+	0xed, 0xf8, 0x54, 0x6f, 0xef, 0xcb, 0x0b, 0xc0, 0xf5, 0x03, 0x0f, 0xcf, 0x2f, 0x08, 0x3f, 0x5e,
+	0xeb, 0xfd, 0x7d, 0x99, 0x90, 0xd0, 0x4f, 0x22, 0x3c, 0x4f, 0x4c, 0x1c, 0x9f, 0xee, 0x1d, 0x7e,
+	0xf9, 0x33, 0x50, 0xdd, 0x8f, 0x12, 0x3c, 0xff, 0x19, 0x2a, 0x8d, 0xc7, 0xa3, 0x61, 0xce, 0x1d,
+	0xf5, 0xf2, 0x62, 0x7f, 0xf9, 0xec, 0x07, 0x06, 0xa3, 0xa3, 0x93, 0xf6, 0x66, 0x9b, 0x77, 0xdb,
+	0x69, 0xb7, 0xb9, 0x79, 0xb7, 0xf9, 0x95, 0xee, 0x8b, 0xf3, 0x7e, 0xf3, 0xf3, 0xae, 0x7a, 0x2b,
+	0x5d, 0xf5, 0xe6, 0xfd, 0xe6, 0xe7, 0x5d, 0xf5, 0x56, 0xba, 0x6a, 0xcd, 0xfb, 0xea, 0xcd, 0xbb,
+	0xea, 0xad, 0x74, 0xd5, 0x9a, 0xf7, 0xd5, 0x9b, 0x77, 0xd5, 0x5b, 0xe9, 0xaa, 0x35, 0xef, 0xab,
+	0x37, 0xef, 0xaa, 0xb7, 0xd2, 0x55, 0x6b, 0xde, 0x57, 0x6f, 0xde, 0x55, 0x6f, 0x65, 0xe3, 0xd8,
+	0x64, 0x38, 0xef, 0xab, 0x37, 0xef, 0xaa, 0xb7, 0xb2, 0x71, 0x62, 0x32, 0x9c, 0xd7, 0x67, 0xdf,
+	0x33, 0x5a, 0x7d, 0x64, 0x9e, 0x6f, 0x3e, 0x33, 0x3e, 0x31, 0x1a, 0x3c, 0xbb, 0xff, 0x10, 0x07,
+	0xcf, 0x8e, 0x1f, 0x1a, 0x0d, 0x76, 0xfa, 0xdf, 0x00, 0x8e, 0x9b, 0xfb, 0xce, 0xdd, 0xfe, 0xfb,
+	0xc6, 0xb9, 0x9e, 0x9e, 0x0c, 0x76, 0x3a, 0x64, 0xde, 0x6f, 0x6c, 0x19, 0x32, 0x3f, 0xfb, 0xc1,
+	0xe1, 0x68, 0xf5, 0x9d, 0xd7, 0xdb, 0x1b, 0xb3, 0xee, 0xf9, 0xed, 0xec, 0xbf, 0x47, 0x5d, 0xcb,
+	0x76, 0xba, 0xfe, 0x0e, 0xb7, 0xb1, 0xd0, 0xab, 0x5d, 0xc5, 0xb6, 0x71, 0x82, 0x85, 0xee, 0x4b,
+	0xb7, 0x6d, 0xfa, 0x09, 0x16, 0xfa, 0x74, 0x3f, 0xb1, 0xfd, 0x04, 0x0b, 0x3d, 0xee, 0x27, 0xae,
+	0x9f, 0x60, 0xa1, 0x8f, 0xf4, 0x93, 0xfe, 0xdd, 0xdb, 0x0e, 0xfd, 0xfb, 0x7d, 0xb8, 0x9f, 0xf4,
+	0xef, 0xde, 0x76, 0x84, 0xd3, 0xbd, 0xe0, 0xdd, 0x24, 0xf6, 0x93, 0x1a, 0xc6, 0xbd, 0xdb, 0xdd,
+	0xa4, 0xee, 0x27, 0x09, 0xee, 0xeb, 0xb5, 0xee, 0x26, 0x69, 0xfc, 0x86, 0xd1, 0xca, 0x76, 0xb5,
+	0x09, 0xf7, 0xff, 0x5f, 0x4f, 0xa1, 0xff, 0xae, 0xb6, 0x06, 0x93, 0x8e, 0x18, 0x8f, 0x47, 0x87,
+	0x76, 0xf3, 0xd5, 0x6b, 0x7d, 0x0d, 0x57, 0x27, 0xfd, 0xeb, 0x0b, 0x47, 0x47, 0x87, 0x77, 0xae,
+	0x5e, 0xbf, 0x72, 0xad, 0xbd, 0x70, 0x64, 0xb4, 0xba, 0x7d, 0xeb, 0xda, 0xee, 0xd5, 0xb3, 0xef,
+	0xfb, 0xc8, 0x60, 0x74, 0xe8, 0x52, 0xbe, 0xb9, 0x33, 0x7e, 0x13, 0xde, 0x79, 0xad, 0x7f, 0x78,
+	0xaf, 0xbe, 0xf3, 0xce, 0x1d, 0x70, 0xee, 0x52, 0xb5, 0x79, 0xf1, 0xfa, 0xee, 0xfc, 0x39, 0xbc,
+	0x7f, 0x8f, 0x57, 0x70, 0x5a, 0xc0, 0x2b, 0xc2, 0x2b, 0xc4, 0x0d, 0x8c, 0x05, 0xdc, 0x10, 0x6e,
+	0x10, 0xb7, 0x70, 0x9f, 0x80, 0x5b, 0xc2, 0x2d, 0xe2, 0x0e, 0xee, 0x17, 0x70, 0x47, 0xb8, 0x43,
+	0xdc, 0xc3, 0x03, 0x02, 0xee, 0x09, 0xf7, 0x88, 0x07, 0x78, 0x85, 0x80, 0x07, 0xc2, 0x03, 0xe2,
+	0x11, 0x5e, 0x29, 0xe0, 0x91, 0xf0, 0x88, 0x78, 0x0d, 0x20, 0xe0, 0x35, 0xe1, 0x35, 0xe2, 0x09,
+	0x5e, 0x25, 0xe0, 0x89, 0xf0, 0x84, 0x78, 0x86, 0x07, 0x05, 0x3c, 0x13, 0x9e, 0x11, 0x2f, 0xf0,
+	0x6a, 0x01, 0x2f, 0x84, 0x17, 0xc4, 0x1b, 0x78, 0x8d, 0x80, 0x37, 0x84, 0x37, 0x88, 0x4f, 0xe1,
+	0xb5, 0x02, 0x3e, 0x25, 0x7c, 0x8a, 0x78, 0x0b, 0x67, 0x04, 0xbc, 0x25, 0xbc, 0x45, 0x7c, 0x06,
+	0xaf, 0x13, 0xf0, 0x19, 0xe1, 0xb3, 0x1e, 0x37, 0x9b, 0xb0, 0xbe, 0x1c, 0x37, 0xe4, 0xbb, 0x41,
+	0xdf, 0x4d, 0x05, 0x0f, 0x09, 0x38, 0xf9, 0x6e, 0xd0, 0x77, 0x63, 0xe0, 0xac, 0x80, 0x93, 0xef,
+	0x06, 0x7d, 0x37, 0x16, 0x5e, 0x2f, 0xe0, 0xe4, 0xbb, 0x41, 0xdf, 0x8d, 0x83, 0xcf, 0x12, 0x70,
+	0xf2, 0xdd, 0xa0, 0xef, 0xc6, 0xc3, 0x67, 0x0b, 0x38, 0xf9, 0x6e, 0xd0, 0x77, 0x13, 0xe0, 0x73,
+	0x04, 0x9c, 0x7c, 0x37, 0xe8, 0xbb, 0x89, 0xf0, 0x06, 0x01, 0x27, 0xdf, 0x0d, 0xfa, 0x6e, 0x6a,
+	0xd8, 0x10, 0x70, 0xf2, 0xdd, 0xa0, 0xef, 0x26, 0xc1, 0xe7, 0x0a, 0x38, 0xf9, 0x6e, 0xd0, 0x77,
+	0x93, 0xe1, 0x8d, 0x02, 0x4e, 0xbe, 0x1b, 0xf4, 0xdd, 0x14, 0xf8, 0x3c, 0x01, 0x27, 0xdf, 0x0d,
+	0xfa, 0x6e, 0x1a, 0xf8, 0x7c, 0x01, 0x27, 0xdf, 0x0d, 0xfa, 0x6e, 0xa6, 0xf0, 0x26, 0x01, 0x27,
+	0xdf, 0x0d, 0xfa, 0x6e, 0x5a, 0x38, 0x27, 0xe0, 0xe4, 0xbb, 0x41, 0xdf, 0xcd, 0x0c, 0xce, 0x0b,
+	0x38, 0xf9, 0x6e, 0xd0, 0x77, 0xbb, 0x09, 0x9b, 0xcb, 0x71, 0x4b, 0xbe, 0x5b, 0xf4, 0xdd, 0x56,
+	0x50, 0x09, 0x38, 0xf9, 0x6e, 0xd1, 0x77, 0x6b, 0xc0, 0x08, 0x38, 0xf9, 0x6e, 0xd1, 0x77, 0x6b,
+	0xc1, 0x0a, 0x38, 0xf9, 0x6e, 0xd1, 0x77, 0xeb, 0xc0, 0x09, 0x38, 0xf9, 0x6e, 0xd1, 0x77, 0xeb,
+	0xc1, 0x0b, 0x38, 0xf9, 0x6e, 0xd1, 0x77, 0x1b, 0x20, 0x08, 0x38, 0xf9, 0x6e, 0xd1, 0x77, 0x1b,
+	0x21, 0x0a, 0x38, 0xf9, 0x6e, 0xd1, 0x77, 0x5b, 0x43, 0x2d, 0xe0, 0xe4, 0xbb, 0x45, 0xdf, 0x6d,
+	0x82, 0x24, 0xe0, 0xe4, 0xbb, 0x45, 0xdf, 0x6d, 0x86, 0x87, 0x05, 0x9c, 0x7c, 0xb7, 0xe8, 0xbb,
+	0x2d, 0xf0, 0x66, 0x01, 0x27, 0xdf, 0x2d, 0xfa, 0x6e, 0x1b, 0xf8, 0x02, 0x01, 0x27, 0xdf, 0x2d,
+	0xfa, 0x6e, 0xa7, 0xf0, 0x85, 0x02, 0x4e, 0xbe, 0x5b, 0xf4, 0xdd, 0xb6, 0xf0, 0x45, 0x02, 0x4e,
+	0xbe, 0x5b, 0xf4, 0xdd, 0xce, 0xe0, 0x8b, 0x05, 0x9c, 0x7c, 0xb7, 0xe8, 0xbb, 0xdb, 0x84, 0xb7,
+	0x2c, 0xc7, 0x1d, 0xf9, 0xee, 0xd0, 0x77, 0x57, 0xc1, 0x97, 0x08, 0x38, 0xf9, 0xee, 0xd0, 0x77,
+	0x67, 0xe0, 0x82, 0x80, 0x93, 0xef, 0x0e, 0x7d, 0x77, 0x16, 0xde, 0x2a, 0xe0, 0xe4, 0xbb, 0x43,
+	0xdf, 0x9d, 0x83, 0xb7, 0x09, 0x38, 0xf9, 0xee, 0xd0, 0x77, 0xe7, 0xe1, 0xa2, 0x80, 0x93, 0xef,
+	0x0e, 0x7d, 0x77, 0x01, 0xde, 0x2e, 0xe0, 0xe4, 0xbb, 0x43, 0xdf, 0x5d, 0x84, 0x47, 0x04, 0x9c,
+	0x7c, 0x77, 0xe8, 0xbb, 0xab, 0x61, 0x4b, 0xc0, 0xc9, 0x77, 0x87, 0xbe, 0xbb, 0x04, 0x8f, 0x0a,
+	0x38, 0xf9, 0xee, 0xd0, 0x77, 0x97, 0xe1, 0x1d, 0x02, 0x4e, 0xbe, 0x3b, 0xf4, 0xdd, 0x15, 0xf8,
+	0x52, 0x01, 0x27, 0xdf, 0x1d, 0xfa, 0xee, 0x1a, 0xf8, 0x32, 0x01, 0x27, 0xdf, 0x1d, 0xfa, 0xee,
+	0xa6, 0x70, 0x49, 0xc0, 0xc9, 0x77, 0x87, 0xbe, 0xbb, 0x16, 0x1e, 0x13, 0x70, 0xf2, 0xdd, 0xa1,
+	0xef, 0x6e, 0x06, 0xef, 0x14, 0x70, 0xf2, 0xdd, 0xa1, 0xef, 0x7e, 0x13, 0x2e, 0x2f, 0xc7, 0x3d,
+	0xf9, 0xee, 0xd1, 0x77, 0x5f, 0xc1, 0x97, 0x0b, 0x38, 0xf9, 0xee, 0xd1, 0x77, 0x6f, 0x60, 0x22,
+	0xe0, 0xe4, 0xbb, 0x47, 0xdf, 0xbd, 0x85, 0xc7, 0x05, 0x9c, 0x7c, 0xf7, 0xe8, 0xbb, 0x77, 0xf0,
+	0x84, 0x80, 0x93, 0xef, 0x1e, 0x7d, 0xf7, 0x1e, 0x9e, 0x14, 0x70, 0xf2, 0xdd, 0xa3, 0xef, 0x3e,
+	0xc0, 0x57, 0x08, 0x38, 0xf9, 0xee, 0xd1, 0x77, 0x1f, 0xe1, 0x2b, 0x05, 0x9c, 0x7c, 0xf7, 0xe8,
+	0xbb, 0xaf, 0xe1, 0x5d, 0x02, 0x4e, 0xbe, 0x7b, 0xf4, 0xdd, 0x27, 0xf8, 0x2a, 0x01, 0x27, 0xdf,
+	0x3d, 0xfa, 0xee, 0x33, 0xbc, 0x5b, 0xc0, 0xc9, 0x77, 0x8f, 0xbe, 0xfb, 0x02, 0xef, 0x11, 0x70,
+	0xf2, 0xdd, 0xa3, 0xef, 0xbe, 0x81, 0xa7, 0x04, 0x9c, 0x7c, 0xf7, 0xe8, 0xbb, 0x9f, 0xc2, 0x57,
+	0x0b, 0x38, 0xf9, 0xee, 0xd1, 0x77, 0xdf, 0xc2, 0xd7, 0x08, 0x38, 0xf9, 0xee, 0xd1, 0x77, 0x3f,
+	0x83, 0xa7, 0x05, 0x9c, 0x7c, 0xf7, 0xe8, 0x7b, 0xd8, 0x84, 0xaf, 0x5d, 0x8e, 0x07, 0xf2, 0x3d,
+	0xa0, 0xef, 0xa1, 0x82, 0x2c, 0xe0, 0xe4, 0x7b, 0x40, 0xdf, 0x83, 0x81, 0x22, 0xe0, 0xe4, 0x7b,
+	0x40, 0xdf, 0x83, 0x85, 0x46, 0xc0, 0xc9, 0xf7, 0x80, 0xbe, 0x07, 0x07, 0x53, 0x01, 0x27, 0xdf,
+	0x03, 0xfa, 0x1e, 0x3c, 0xb4, 0x02, 0x4e, 0xbe, 0x07, 0xf4, 0x3d, 0x04, 0x98, 0x09, 0x38, 0xf9,
+	0x1e, 0xd0, 0xf7, 0x10, 0xe1, 0x8a, 0x80, 0x93, 0xef, 0x01, 0x7d, 0x0f, 0x35, 0x3c, 0x23, 0xe0,
+	0xe4, 0x7b, 0x40, 0xdf, 0x43, 0x82, 0xab, 0x02, 0x4e, 0xbe, 0x07, 0xf4, 0x3d, 0x64, 0xf8, 0x3a,
+	0x01, 0x27, 0xdf, 0x03, 0xfa, 0x1e, 0x0a, 0xbc, 0x57, 0xc0, 0xc9, 0xf7, 0x80, 0xbe, 0x87, 0x06,
+	0xae, 0x09, 0x38, 0xf9, 0x1e, 0xd0, 0xf7, 0x30, 0x85, 0x6d, 0x01, 0x27, 0xdf, 0x03, 0xfa, 0x1e,
+	0x5a, 0xb8, 0x2e, 0xe0, 0xe4, 0x7b, 0x40, 0xdf, 0xc3, 0x0c, 0x6e, 0x08, 0x38, 0xf9, 0x1e, 0xd0,
+	0xf7, 0xb8, 0x09, 0x37, 0x97, 0xe3, 0x91, 0x7c, 0x8f, 0xe8, 0x7b, 0xac, 0xe0, 0xeb, 0x05, 0x9c,
+	0x7c, 0x8f, 0xe8, 0x7b, 0x34, 0x30, 0x17, 0x70, 0xf2, 0x3d, 0xa2, 0xef, 0xd1, 0xc2, 0x8e, 0x80,
+	0x93, 0xef, 0x11, 0x7d, 0x8f, 0x0e, 0x76, 0x05, 0x9c, 0x7c, 0x8f, 0xe8, 0x7b, 0xf4, 0x70, 0x4b,
+	0xc0, 0xc9, 0xf7, 0x88, 0xbe, 0xc7, 0x00, 0xcf, 0x0a, 0x38, 0xf9, 0x1e, 0xd1, 0xf7, 0x18, 0xe1,
+	0x1b, 0x04, 0x9c, 0x7c, 0x8f, 0xe8, 0x7b, 0xac, 0x61, 0x4f, 0xc0, 0xc9, 0xf7, 0x88, 0xbe, 0xc7,
+	0x04, 0xcf, 0x09, 0x38, 0xf9, 0x1e, 0xd1, 0xf7, 0x98, 0xe1, 0x1b, 0x05, 0x9c, 0x7c, 0x8f, 0xe8,
+	0x7b, 0x2c, 0xf0, 0x4d, 0x02, 0x4e, 0xbe, 0x47, 0xf4, 0x3d, 0x36, 0xf0, 0xcd, 0x02, 0x4e, 0xbe,
+	0x47, 0xf4, 0x3d, 0x4e, 0xe1, 0x5b, 0x04, 0x9c, 0x7c, 0x8f, 0xe8, 0x7b, 0x6c, 0xe1, 0x5b, 0x05,
+	0x9c, 0x7c, 0x8f, 0xe8, 0x7b, 0x9c, 0xc1, 0xb7, 0x09, 0x38, 0xf9, 0x1e, 0x67, 0xe3, 0x73, 0xa3,
+	0x95, 0xed, 0x7a, 0x13, 0x9e, 0x1f, 0x2c, 0xe7, 0x6b, 0x12, 0xbe, 0xde, 0x44, 0xbe, 0x82, 0x6f,
+	0x97, 0x78, 0x32, 0xbe, 0xae, 0x90, 0x37, 0xf0, 0x1d, 0x12, 0x4f, 0xca, 0xd7, 0x06, 0x79, 0x0b,
+	0xdf, 0x29, 0xf1, 0xe4, 0x7c, 0x6d, 0x91, 0x77, 0xf0, 0x5d, 0x12, 0x4f, 0xd2, 0xd7, 0x0e, 0x79,
+	0x0f, 0xdf, 0x2d, 0xf1, 0x64, 0x7d, 0xed, 0x91, 0x0f, 0xf0, 0x3e, 0x89, 0x27, 0xed, 0xeb, 0x80,
+	0x7c, 0x84, 0xef, 0x91, 0x78, 0xf2, 0xbe, 0x8e, 0xc8, 0xd7, 0xf0, 0x7e, 0x89, 0x27, 0xf1, 0xeb,
+	0x1a, 0xf9, 0x04, 0xdf, 0x2b, 0xf1, 0x64, 0x7e, 0x9d, 0x90, 0xcf, 0xf0, 0x01, 0x89, 0x27, 0xf5,
+	0xeb, 0x8c, 0x7c, 0x81, 0x0f, 0x4a, 0x3c, 0xb9, 0x5f, 0x17, 0xe4, 0x1b, 0xf8, 0x3e, 0x89, 0x27,
+	0xf9, 0xeb, 0x06, 0xf9, 0x29, 0x7c, 0xbf, 0xc4, 0x93, 0xfd, 0xf5, 0x14, 0xf9, 0x16, 0x7e, 0x40,
+	0xe2, 0x49, 0xff, 0xba, 0x45, 0x7e, 0x06, 0x1f, 0x92, 0x78, 0xf2, 0xbf, 0x46, 0xff, 0xd3, 0x26,
+	0x7c, 0x58, 0xe0, 0x13, 0xf9, 0x9f, 0xd0, 0xff, 0x54, 0xc1, 0x0f, 0x4a, 0x3c, 0xf9, 0x9f, 0xd0,
+	0xff, 0x64, 0xe0, 0x87, 0x24, 0x9e, 0xfc, 0x4f, 0xe8, 0x7f, 0xb2, 0xf0, 0xc3, 0x12, 0x4f, 0xfe,
+	0x27, 0xf4, 0x3f, 0x39, 0xf8, 0x11, 0x89, 0x27, 0xff, 0x13, 0xfa, 0x9f, 0x3c, 0xfc, 0xa8, 0xc4,
+	0x93, 0xff, 0x09, 0xfd, 0x4f, 0x01, 0x7e, 0x4c, 0xe2, 0xc9, 0xff, 0x84, 0xfe, 0xa7, 0x08, 0x3f,
+	0x2e, 0xf1, 0xe4, 0x7f, 0x42, 0xff, 0x53, 0x0d, 0x3f, 0x21, 0xf1, 0xe4, 0x7f, 0x42, 0xff, 0x53,
+	0x82, 0x9f, 0x94, 0x78, 0xf2, 0x3f, 0xa1, 0xff, 0x29, 0xc3, 0x4f, 0x49, 0x3c, 0xf9, 0x9f, 0xd0,
+	0xff, 0x54, 0xe0, 0xa7, 0x25, 0x9e, 0xfc, 0x4f, 0xe8, 0x7f, 0x6a, 0xe0, 0x67, 0x24, 0x9e, 0xfc,
+	0x4f, 0xe8, 0x7f, 0x9a, 0xc2, 0x47, 0x24, 0x9e, 0xfc, 0x4f, 0xe8, 0x7f, 0x6a, 0xe1, 0x67, 0x25,
+	0x9e, 0xfc, 0x4f, 0xe8, 0x7f, 0x9a, 0xc1, 0xcf, 0x49, 0x3c, 0xf9, 0x9f, 0xd0, 0xff, 0xbc, 0x09,
+	0x3f, 0x2f, 0xf0, 0x99, 0xfc, 0xcf, 0xe8, 0x7f, 0xae, 0xe0, 0x17, 0x24, 0x9e, 0xfc, 0xcf, 0xe8,
+	0x7f, 0x36, 0xf0, 0x51, 0x89, 0x27, 0xff, 0x33, 0xfa, 0x9f, 0x2d, 0xfc, 0xa2, 0xc4, 0x93, 0xff,
+	0x19, 0xfd, 0xcf, 0x0e, 0x7e, 0x49, 0xe2, 0xc9, 0xff, 0x8c, 0xfe, 0x67, 0x0f, 0xbf, 0x2c, 0xf1,
+	0xe4, 0x7f, 0x46, 0xff, 0x73, 0x80, 0x5f, 0x91, 0x78, 0xf2, 0x3f, 0xa3, 0xff, 0x39, 0xc2, 0xaf,
+	0x4a, 0x3c, 0xf9, 0x9f, 0xd1, 0xff, 0x5c, 0xc3, 0xaf, 0x49, 0x3c, 0xf9, 0x9f, 0xd1, 0xff, 0x9c,
+	0xe0, 0xd7, 0x25, 0x9e, 0xfc, 0xcf, 0xe8, 0x7f, 0xce, 0xf0, 0x31, 0x89, 0x27, 0xff, 0x33, 0xfa,
+	0x9f, 0x0b, 0xfc, 0x86, 0xc4, 0x93, 0xff, 0x19, 0xfd, 0xcf, 0x0d, 0xfc, 0xa6, 0xc4, 0x93, 0xff,
+	0x19, 0xfd, 0xcf, 0x53, 0xf8, 0x2d, 0x89, 0x27, 0xff, 0x33, 0xfa, 0x9f, 0x5b, 0xf8, 0x6d, 0x89,
+	0x27, 0xff, 0x33, 0xfa, 0x9f, 0x67, 0xf0, 0x3b, 0x12, 0x4f, 0xfe, 0x67, 0xf4, 0xbf, 0x6c, 0xc2,
+	0xef, 0x0a, 0x7c, 0x21, 0xff, 0x0b, 0xfa, 0x5f, 0x2a, 0xf8, 0x3d, 0x89, 0x27, 0xff, 0x0b, 0xfa,
+	0x5f, 0x0c, 0xfc, 0xbe, 0xc4, 0x93, 0xff, 0x05, 0xfd, 0x2f, 0x16, 0xfe, 0x40, 0xe2, 0xc9, 0xff,
+	0x82, 0xfe, 0x17, 0x07, 0x7f, 0x28, 0xf1, 0xe4, 0x7f, 0x41, 0xff, 0x8b, 0x87, 0x3f, 0x92, 0x78,
+	0xf2, 0xbf, 0xa0, 0xff, 0x25, 0xc0, 0x1f, 0x4b, 0x3c, 0xf9, 0x5f, 0xd0, 0xff, 0x12, 0xe1, 0x4f,
+	0x24, 0x9e, 0xfc, 0x2f, 0xe8, 0x7f, 0xa9, 0xe1, 0xe3, 0x12, 0x4f, 0xfe, 0x17, 0xf4, 0xbf, 0x24,
+	0xf8, 0x84, 0xc4, 0x93, 0xff, 0x05, 0xfd, 0x2f, 0x19, 0xfe, 0x54, 0xe2, 0xc9, 0xff, 0x82, 0xfe,
+	0x97, 0x02, 0x9f, 0x94, 0x78, 0xf2, 0xbf, 0xa0, 0xff, 0xa5, 0x81, 0x3f, 0x93, 0x78, 0xf2, 0xbf,
+	0xa0, 0xff, 0x65, 0x0a, 0x7f, 0x2e, 0xf1, 0xe4, 0x7f, 0x41, 0xff, 0x4b, 0x0b, 0x7f, 0x21, 0xf1,
+	0xe4, 0x7f, 0x41, 0xff, 0xcb, 0x0c, 0xfe, 0x52, 0xe2, 0xc9, 0xff, 0x82, 0xfe, 0x37, 0x9b, 0xf0,
+	0x57, 0x02, 0xdf, 0x90, 0xff, 0x0d, 0xfa, 0xdf, 0x54, 0xf0, 0xd7, 0x12, 0x4f, 0xfe, 0x37, 0xe8,
+	0x7f, 0x63, 0xe0, 0x6f, 0x24, 0x9e, 0xfc, 0x6f, 0xd0, 0xff, 0xc6, 0xc2, 0xdf, 0x4a, 0x3c, 0xf9,
+	0xdf, 0xa0, 0xff, 0x8d, 0x83, 0xbf, 0x93, 0x78, 0xf2, 0xbf, 0x41, 0xff, 0x1b, 0x0f, 0x7f, 0x2f,
+	0xf1, 0xe4, 0x7f, 0x83, 0xfe, 0x37, 0x01, 0xfe, 0x41, 0xe2, 0xc9, 0xff, 0x06, 0xfd, 0x6f, 0x22,
+	0xfc, 0xa3, 0xc4, 0x93, 0xff, 0x0d, 0xfa, 0xdf, 0xd4, 0xf0, 0x29, 0x89, 0x27, 0xff, 0x1b, 0xf4,
+	0xbf, 0x49, 0xf0, 0x4f, 0x12, 0x4f, 0xfe, 0x37, 0xe8, 0x7f, 0x93, 0xe1, 0xd3, 0x12, 0x4f, 0xfe,
+	0x37, 0xe8, 0x7f, 0x53, 0xe0, 0x9f, 0x25, 0x9e, 0xfc, 0x6f, 0xd0, 0xff, 0xa6, 0x81, 0x7f, 0x91,
+	0x78, 0xf2, 0xbf, 0x41, 0xff, 0x9b, 0x29, 0xfc, 0xab, 0xc4, 0x93, 0xff, 0x0d, 0xfa, 0xdf, 0xb4,
+	0xf0, 0x6f, 0x12, 0x4f, 0xfe, 0x37, 0xe8, 0x7f, 0x33, 0x83, 0x7f, 0x97, 0x78, 0xf2, 0xbf, 0x99,
+	0x3d, 0x18, 0x46, 0x47, 0x17, 0x9f, 0xb8, 0xe9, 0x3e, 0x21, 0xf8, 0xde, 0xf6, 0xb9, 0xfd, 0x4f,
+	0x42, 0x75, 0x2f, 0xc7, 0xf7, 0x8f, 0x56, 0x9f, 0xcd, 0xd7, 0x6e, 0xb5, 0xfd, 0xe7, 0xa1, 0x56,
+	0x27, 0xb8, 0x78, 0x78, 0x58, 0x0f, 0x30, 0x57, 0xa9, 0x72, 0x2b, 0x2c, 0x67, 0x54, 0xb9, 0x93,
+	0x2c, 0x67, 0x55, 0xb9, 0x43, 0x2c, 0xe7, 0x54, 0xb9, 0xd3, 0x2c, 0xe7, 0x55, 0xb9, 0x31, 0xcb,
+	0x05, 0x55, 0xee, 0x08, 0xcb, 0x45, 0x55, 0xee, 0x30, 0xcb, 0xd5, 0xaa, 0xdc, 0x29, 0x96, 0x4b,
+	0xaa, 0xdc, 0x1a, 0xcb, 0x65, 0x55, 0x6e, 0xc8, 0x72, 0x45, 0x95, 0x1b, 0xb0, 0x5c, 0xa3, 0xca,
+	0x1d, 0xbd, 0x3d, 0xf7, 0x8e, 0x2e, 0x37, 0x5d, 0x96, 0xdb, 0xb8, 0x3d, 0x77, 0xaf, 0x19, 0xdf,
+	0x59, 0x93, 0x8b, 0xd7, 0x6f, 0x6d, 0xb3, 0x3d, 0xb4, 0xaa, 0x3d, 0x1c, 0x63, 0xb9, 0x99, 0x2a,
+	0x77, 0xe2, 0x7f, 0xe7, 0x0c, 0xef, 0xe4, 0x8a, 0xa6, 0x93, 0xa6, 0x52, 0xe5, 0x58, 0x27, 0x8d,
+	0x51, 0xe5, 0x58, 0x27, 0x8d, 0x55, 0xe5, 0x58, 0x27, 0x8d, 0x53, 0xe5, 0x58, 0x27, 0x8d, 0x57,
+	0xe5, 0x58, 0x27, 0x4d, 0x50, 0xe5, 0x58, 0x27, 0x4d, 0x54, 0xe5, 0x58, 0x27, 0x4d, 0xad, 0xca,
+	0xb1, 0x4e, 0x9a, 0xa4, 0xca, 0xb1, 0x4e, 0x9a, 0xac, 0xca, 0xb1, 0x4e, 0x9a, 0xa2, 0xca, 0xb1,
+	0x4e, 0x9a, 0x46, 0x95, 0x63, 0x9d, 0x34, 0xd3, 0x65, 0xb9, 0x83, 0x77, 0xd2, 0xb4, 0xaa, 0x3d,
+	0xb0, 0x4e, 0x9a, 0x99, 0x2a, 0xc7, 0x3a, 0x69, 0x79, 0x27, 0x4f, 0x6a, 0x3a, 0x69, 0x2b, 0x55,
+	0x8e, 0x75, 0xd2, 0x1a, 0x55, 0x8e, 0x75, 0xd2, 0x5a, 0x55, 0x8e, 0x75, 0xd2, 0x3a, 0x55, 0x8e,
+	0x75, 0xd2, 0x7a, 0x55, 0x8e, 0x75, 0xd2, 0x06, 0x55, 0x8e, 0x75, 0xd2, 0x46, 0x55, 0x8e, 0x75,
+	0xd2, 0xd6, 0xaa, 0x1c, 0xeb, 0xa4, 0x4d, 0xaa, 0x1c, 0xeb, 0xa4, 0xcd, 0xaa, 0x1c, 0xeb, 0xa4,
+	0x2d, 0xaa, 0x1c, 0xeb, 0xa4, 0x6d, 0x54, 0x39, 0xd6, 0x49, 0x3b, 0x5d, 0x96, 0x3b, 0x78, 0x27,
+	0x6d, 0xab, 0xda, 0x03, 0xeb, 0xa4, 0x9d, 0xa9, 0x72, 0xac, 0x93, 0x8e, 0x77, 0xf2, 0x90, 0xa6,
+	0x93, 0xae, 0x52, 0xe5, 0x58, 0x27, 0x9d, 0x51, 0xe5, 0x58, 0x27, 0x9d, 0x55, 0xe5, 0x58, 0x27,
+	0x9d, 0x53, 0xe5, 0x58, 0x27, 0x9d, 0x57, 0xe5, 0x58, 0x27, 0x5d, 0x50, 0xe5, 0x58, 0x27, 0x5d,
+	0x54, 0xe5, 0x58, 0x27, 0x5d, 0xad, 0xca, 0xb1, 0x4e, 0xba, 0xa4, 0xca, 0xb1, 0x4e, 0xba, 0xac,
+	0xca, 0xb1, 0x4e, 0xba, 0xa2, 0xca, 0xb1, 0x4e, 0xba, 0x46, 0x95, 0x63, 0x9d, 0x74, 0xd3, 0x65,
+	0xb9, 0x83, 0x77, 0xd2, 0xb5, 0xaa, 0x3d, 0xb0, 0x4e, 0xba, 0x99, 0x2a, 0xc7, 0x3a, 0xe9, 0x79,
+	0x27, 0x4f, 0x6b, 0x3a, 0xe9, 0x2b, 0x55, 0x8e, 0x75, 0xd2, 0x1b, 0x55, 0x8e, 0x75, 0xd2, 0x5b,
+	0x55, 0x8e, 0x75, 0xd2, 0x3b, 0x55, 0x8e, 0x75, 0xd2, 0x7b, 0x55, 0x8e, 0x75, 0xd2, 0x07, 0x55,
+	0x8e, 0x75, 0xd2, 0x47, 0x55, 0x8e, 0x75, 0xd2, 0xd7, 0xaa, 0x1c, 0xeb, 0xa4, 0x4f, 0xaa, 0x1c,
+	0xeb, 0xa4, 0xcf, 0xaa, 0x1c, 0xeb, 0xa4, 0x2f, 0xaa, 0x1c, 0xeb, 0xa4, 0x6f, 0x54, 0x39, 0xd6,
+	0x49, 0x3f, 0x5d, 0x96, 0x3b, 0x78, 0x27, 0x7d, 0xab, 0xda, 0x03, 0xeb, 0xa4, 0x9f, 0xa9, 0x72,
+	0xac, 0x93, 0x81, 0x77, 0x72, 0xac, 0xe9, 0x64, 0xa8, 0x54, 0x39, 0xd6, 0xc9, 0x60, 0x54, 0x39,
+	0xd6, 0xc9, 0x60, 0x55, 0x39, 0xd6, 0xc9, 0xe0, 0x54, 0x39, 0xd6, 0xc9, 0xe0, 0x55, 0x39, 0xd6,
+	0xc9, 0x10, 0x54, 0x39, 0xd6, 0xc9, 0x10, 0x55, 0x39, 0xd6, 0xc9, 0x50, 0xab, 0x72, 0xac, 0x93,
+	0x21, 0xa9, 0x72, 0xac, 0x93, 0x21, 0xab, 0x72, 0xac, 0x93, 0xa1, 0xa8, 0x72, 0xac, 0x93, 0xa1,
+	0x51, 0xe5, 0x58, 0x27, 0xc3, 0x74, 0x59, 0xee, 0xe0, 0x9d, 0x0c, 0xad, 0x6a, 0x0f, 0xac, 0x93,
+	0x61, 0xa6, 0xca, 0xb1, 0x4e, 0x46, 0xde, 0xc9, 0x23, 0x9a, 0x4e, 0xc6, 0x4a, 0x95, 0x63, 0x9d,
+	0x8c, 0x46, 0x95, 0x63, 0x9d, 0x8c, 0x56, 0x95, 0x63, 0x9d, 0x8c, 0x4e, 0x95, 0x63, 0x9d, 0x8c,
+	0x5e, 0x95, 0x63, 0x9d, 0x8c, 0x41, 0x95, 0x63, 0x9d, 0x8c, 0x51, 0x95, 0x63, 0x9d, 0x8c, 0xb5,
+	0x2a, 0xc7, 0x3a, 0x19, 0x93, 0x2a, 0xc7, 0x3a, 0x19, 0xb3, 0x2a, 0xc7, 0x3a, 0x19, 0x8b, 0x2a,
+	0xc7, 0x3a, 0x19, 0x1b, 0x55, 0x8e, 0x75, 0x32, 0x4e, 0x97, 0xe5, 0x0e, 0xde, 0xc9, 0xd8, 0xaa,
+	0xf6, 0xc0, 0x3a, 0x19, 0x67, 0xaa, 0x1c, 0xeb, 0x64, 0xcd, 0x3b, 0x79, 0x58, 0xd3, 0xc9, 0xba,
+	0x52, 0xe5, 0x58, 0x27, 0x6b, 0xa3, 0xca, 0xb1, 0x4e, 0xd6, 0x56, 0x95, 0x63, 0x9d, 0xac, 0x9d,
+	0x2a, 0xc7, 0x3a, 0x59, 0x7b, 0x55, 0x8e, 0x75, 0xb2, 0x0e, 0xaa, 0x1c, 0xeb, 0x64, 0x1d, 0x55,
+	0x39, 0xd6, 0xc9, 0xba, 0x56, 0xe5, 0x58, 0x27, 0xeb, 0xa4, 0xca, 0xb1, 0x4e, 0xd6, 0x59, 0x95,
+	0x63, 0x9d, 0xac, 0x8b, 0x2a, 0xc7, 0x3a, 0x59, 0x37, 0xaa, 0x1c, 0xeb, 0x64, 0x3d, 0x5d, 0x96,
+	0x3b, 0x78, 0x27, 0xeb, 0x56, 0xb5, 0x07, 0xd6, 0xc9, 0x7a, 0xa6, 0xca, 0xb1, 0x4e, 0x26, 0xde,
+	0xc9, 0x53, 0x9a, 0x4e, 0xa6, 0x4a, 0x95, 0x63, 0x9d, 0x4c, 0x46, 0x95, 0x63, 0x9d, 0x4c, 0x56,
+	0x95, 0x63, 0x9d, 0x4c, 0x4e, 0x95, 0x63, 0x9d, 0x4c, 0x5e, 0x95, 0x63, 0x9d, 0x4c, 0x41, 0x95,
+	0x63, 0x9d, 0x4c, 0x51, 0x95, 0x63, 0x9d, 0x4c, 0xb5, 0x2a, 0xc7, 0x3a, 0x99, 0x92, 0x2a, 0xc7,
+	0x3a, 0x99, 0xb2, 0x2a, 0xc7, 0x3a, 0x99, 0x8a, 0x2a, 0xc7, 0x3a, 0x99, 0x1a, 0x55, 0x8e, 0x75,
+	0x32, 0x4d, 0x97, 0xe5, 0x0e, 0xde, 0xc9, 0xd4, 0xaa, 0xf6, 0xc0, 0x3a, 0x99, 0x66, 0xaa, 0x1c,
+	0xeb, 0x64, 0xe6, 0x9d, 0x5c, 0xd3, 0x74, 0x32, 0x57, 0xaa, 0x1c, 0xeb, 0x64, 0x36, 0xaa, 0x1c,
+	0xeb, 0x64, 0xb6, 0xaa, 0x1c, 0xeb, 0x64, 0x76, 0xaa, 0x1c, 0xeb, 0x64, 0xf6, 0xaa, 0x1c, 0xeb,
+	0x64, 0x0e, 0xaa, 0x1c, 0xeb, 0x64, 0x8e, 0xaa, 0x1c, 0xeb, 0x64, 0xae, 0x55, 0x39, 0xd6, 0xc9,
+	0x9c, 0x54, 0x39, 0xd6, 0xc9, 0x9c, 0x55, 0x39, 0xd6, 0xc9, 0x5c, 0x54, 0x39, 0xd6, 0xc9, 0xdc,
+	0xa8, 0x72, 0xac, 0x93, 0x79, 0xba, 0x2c, 0x77, 0xf0, 0x4e, 0xe6, 0x56, 0xb5, 0x07, 0xd6, 0xc9,
+	0x3c, 0x53, 0xe5, 0x58, 0x27, 0x0b, 0xef, 0xe4, 0x51, 0x4d, 0x27, 0x4b, 0xa5, 0xca, 0xb1, 0x4e,
+	0x16, 0xa3, 0xca, 0xb1, 0x4e, 0x16, 0xab, 0xca, 0xb1, 0x4e, 0x16, 0xa7, 0xca, 0xb1, 0x4e, 0x16,
+	0xaf, 0xca, 0xb1, 0x4e, 0x96, 0xa0, 0xca, 0xb1, 0x4e, 0x96, 0xa8, 0xca, 0xb1, 0x4e, 0x96, 0x5a,
+	0x95, 0x63, 0x9d, 0x2c, 0x49, 0x95, 0x63, 0x9d, 0x2c, 0x59, 0x95, 0x63, 0x9d, 0x2c, 0x45, 0x95,
+	0x63, 0x9d, 0x2c, 0x8d, 0x2a, 0xc7, 0x3a, 0x59, 0xa6, 0xcb, 0x72, 0x07, 0xef, 0x64, 0x69, 0x55,
+	0x7b, 0x60, 0x9d, 0x2c, 0x33, 0x55, 0x8e, 0x75, 0xb2, 0xe1, 0x9d, 0x3c, 0xa6, 0xe9, 0x64, 0x53,
+	0xa9, 0x72, 0xac, 0x93, 0x8d, 0x51, 0xe5, 0x58, 0x27, 0x1b, 0xab, 0xca, 0xb1, 0x4e, 0x36, 0x4e,
+	0x95, 0x63, 0x9d, 0x6c, 0xbc, 0x2a, 0xc7, 0x3a, 0xd9, 0x04, 0x55, 0x8e, 0x75, 0xb2, 0x89, 0xaa,
+	0x1c, 0xeb, 0x64, 0x53, 0xab, 0x72, 0xac, 0x93, 0x4d, 0x52, 0xe5, 0x58, 0x27, 0x9b, 0xac, 0xca,
+	0xb1, 0x4e, 0x36, 0x45, 0x95, 0x63, 0x9d, 0x6c, 0x1a, 0x55, 0x8e, 0x75, 0xb2, 0x99, 0x2e, 0xcb,
+	0x1d, 0xbc, 0x93, 0x4d, 0xab, 0xda, 0x03, 0xeb, 0x64, 0x33, 0x53, 0xe5, 0x6e, 0xef, 0xe4, 0xd9,
+	0xff, 0x3e, 0x3e, 0x3a, 0x7e, 0xa9, 0xdd, 0xd9, 0xc9, 0x57, 0xda, 0xfe, 0x3c, 0xba, 0xf3, 0xa3,
+	0x23, 0x3b, 0x78, 0x14, 0x68, 0x9f, 0x3f, 0x6e, 0x1e, 0xb8, 0x73, 0xbf, 0xfb, 0xe7, 0x84, 0x4e,
+	0x16, 0xd4, 0xb8, 0xea, 0x0f, 0xef, 0xc3, 0x03, 0xe9, 0x1e, 0xba, 0x93, 0xbd, 0xed, 0xbe, 0xe7,
+	0x2e, 0xed, 0x7f, 0x08, 0xbc, 0x3b, 0xdd, 0xaf, 0xea, 0x4f, 0xf7, 0x1b, 0xff, 0xbf, 0x11, 0xb3,
+	0x88, 0x98, 0x3e, 0xb2, 0x38, 0x98, 0x4e, 0x8a, 0xd8, 0x45, 0xc4, 0xf6, 0x91, 0xc5, 0xe1, 0x74,
+	0x52, 0xc4, 0x2d, 0x22, 0xae, 0x8f, 0x2c, 0x0e, 0xa8, 0x93, 0x22, 0x7e, 0x11, 0xf1, 0x7d, 0x64,
+	0x71, 0x48, 0x9d, 0x14, 0x09, 0x8b, 0x48, 0xe8, 0x23, 0x8b, 0x83, 0xea, 0xa4, 0x48, 0x5c, 0x44,
+	0x62, 0x1f, 0x59, 0x1c, 0x56, 0x27, 0x45, 0xea, 0x45, 0xa4, 0xee, 0x23, 0x8b, 0x03, 0xeb, 0xa4,
+	0x48, 0x5a, 0x44, 0x52, 0x1f, 0x59, 0x1c, 0x5a, 0x27, 0x45, 0xf2, 0x22, 0x92, 0xfb, 0xc8, 0xe2,
+	0x24, 0x3a, 0x29, 0xd2, 0x2c, 0x22, 0xcd, 0x83, 0x97, 0x47, 0x47, 0x2e, 0x2d, 0xfd, 0xa0, 0xf4,
+	0xf9, 0xdb, 0x45, 0x3d, 0x6e, 0x5e, 0xb5, 0xf4, 0x96, 0xb7, 0xbb, 0xdf, 0xdd, 0x71, 0xe9, 0xc7,
+	0x35, 0xef, 0xfa, 0x8e, 0x4b, 0x3f, 0x34, 0x76, 0xd7, 0x77, 0x5c, 0xfa, 0xd1, 0x95, 0xbb, 0xbe,
+	0xe3, 0xd2, 0x1f, 0xa0, 0xdf, 0xf5, 0x1d, 0x97, 0xfe, 0x18, 0xef, 0xae, 0xef, 0xb8, 0xf4, 0x87,
+	0x09, 0x77, 0x7d, 0xc7, 0xa5, 0x7f, 0xa5, 0x79, 0xd7, 0x77, 0x5c, 0xfa, 0x17, 0x2b, 0x77, 0x7d,
+	0xc7, 0xa5, 0xff, 0x7b, 0x77, 0xd7, 0x77, 0x5c, 0xfa, 0x07, 0xd3, 0xdd, 0xdc, 0xf1, 0xec, 0x0b,
+	0xc3, 0xd1, 0x89, 0xcb, 0x79, 0xf7, 0x99, 0x1b, 0xd7, 0x6e, 0x5c, 0xb9, 0xda, 0xe4, 0x6b, 0xe3,
+	0x73, 0xa3, 0xc1, 0x1e, 0xe0, 0xbf, 0x92, 0x58, 0xbf, 0xf3, 0x0e, 0xb7, 0x63, 0xe7, 0x1e, 0xbd,
+	0x7e, 0xbd, 0x9d, 0x4f, 0x06, 0x7b, 0x0f, 0x7e, 0x68, 0x38, 0x5a, 0xed, 0x17, 0xdd, 0x39, 0xb2,
+	0x7b, 0x74, 0xe8, 0xee, 0x5e, 0x7f, 0x6e, 0xed, 0x9e, 0xd9, 0xff, 0xaf, 0xb8, 0xe1, 0x5e, 0x7f,
+	0x6e, 0xed, 0x1e, 0x1e, 0x19, 0xdd, 0xad, 0xfb, 0x73, 0x6b, 0xf7, 0xf0, 0xc8, 0xe8, 0x6e, 0xdd,
+	0x9f, 0x5b, 0xbb, 0x87, 0x47, 0x46, 0x77, 0xeb, 0xfe, 0xdc, 0xda, 0x3d, 0x3c, 0x32, 0xba, 0x5b,
+	0xf7, 0xe7, 0xd6, 0xee, 0xe1, 0x91, 0xd1, 0xdd, 0xba, 0x3f, 0xb7, 0x76, 0x0f, 0x8f, 0x8c, 0xee,
+	0xd6, 0xfd, 0x91, 0xd1, 0x7b, 0x78, 0x64, 0x74, 0xb7, 0x4e, 0xfd, 0x3a, 0xc3, 0x68, 0x7f, 0x9d,
+	0xfb, 0x75, 0xc1, 0xe3, 0x65, 0x27, 0xc3, 0xbd, 0xd2, 0xaf, 0x1b, 0x38, 0xb1, 0xbf, 0x6e, 0xfa,
+	0xf5, 0x14, 0x4e, 0xee, 0xaf, 0xfb, 0x73, 0x78, 0xf7, 0x5a, 0xb8, 0x77, 0x7f, 0xdd, 0xf6, 0xeb,
+	0xd9, 0xe2, 0x10, 0xe1, 0xbd, 0xd9, 0xf8, 0x15, 0xa3, 0xc3, 0xb9, 0xd9, 0xbd, 0x95, 0xaf, 0xc1,
+	0xb4, 0x7f, 0x13, 0xf6, 0x57, 0x6f, 0x7c, 0xcb, 0xe8, 0x50, 0xf7, 0xa7, 0xf9, 0xf8, 0xfe, 0xd1,
+	0xda, 0xc5, 0xc7, 0x9e, 0xbc, 0xf4, 0xf4, 0x93, 0x8f, 0x3d, 0x7e, 0xf9, 0xe2, 0x5b, 0x1f, 0x7d,
+	0xfb, 0xa3, 0x17, 0xdf, 0xb6, 0x76, 0xcf, 0x78, 0x34, 0x3a, 0xdc, 0x4f, 0xab, 0xb5, 0x01, 0xbd,
+	0x36, 0x6b, 0x43, 0x7a, 0x6d, 0xd7, 0x56, 0x2e, 0x7c, 0x7c, 0xf0, 0xa9, 0x17, 0xcf, 0x0c, 0x5e,
+	0x78, 0xf1, 0xcc, 0xe0, 0x3f, 0x5f, 0x3c, 0x33, 0xf8, 0xf0, 0x4b, 0x67, 0xee, 0x79, 0xe1, 0xa5,
+	0x33, 0xf7, 0xfc, 0xc7, 0x4b, 0x67, 0xee, 0x19, 0xad, 0x35, 0x37, 0xb6, 0xef, 0x78, 0x5b, 0x2e,
+	0x1c, 0x7b, 0xa2, 0xdd, 0xd9, 0xbd, 0xdc, 0x1d, 0xe7, 0x7d, 0x79, 0xf0, 0xee, 0xcd, 0x72, 0x6b,
+	0x76, 0xae, 0xdc, 0xba, 0x7a, 0x6d, 0x7a, 0xfe, 0xca, 0x8d, 0xf3, 0xfb, 0xdc, 0xf9, 0xab, 0xd7,
+	0x77, 0xdb, 0xf9, 0xf5, 0x7c, 0xed, 0xfc, 0x95, 0xf6, 0xfa, 0xf9, 0x2b, 0x37, 0xae, 0xdc, 0xe8,
+	0xcf, 0x00, 0x7f, 0x73, 0xf7, 0xcb, 0x47, 0x87, 0x2b, 0x5b, 0x4f, 0xbc, 0xeb, 0x63, 0xc3, 0x13,
+	0x5b, 0xfb, 0xf7, 0xec, 0xee, 0xf6, 0x69, 0x5a, 0x3e, 0xd5, 0x2d, 0x5f, 0x1c, 0xc2, 0xed, 0xcb,
+	0xa7, 0x1e, 0xb9, 0x7c, 0xe1, 0x52, 0xbb, 0x9b, 0xa7, 0x79, 0x37, 0xff, 0xd7, 0xf0, 0xe4, 0xfe,
+	0x97, 0x1e, 0x7e, 0xb8, 0xfb, 0x5a, 0x39, 0xdc, 0x1f, 0x2c, 0x6e, 0xff, 0x67, 0x00, 0x8f, 0x25,
+	0xbe, 0xdc, 0x6b, 0x5c, 0x00, 0x00,
+}
+
+func (m *Scalars) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Scalars) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XB15 != nil {
+		{
+			size := m.XB15.Size()
+			i -= size
+			if _, err := m.XB15.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB14 != nil {
+		{
+			size := m.XB14.Size()
+			i -= size
+			if _, err := m.XB14.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB13 != nil {
+		{
+			size := m.XB13.Size()
+			i -= size
+			if _, err := m.XB13.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB12 != nil {
+		{
+			size := m.XB12.Size()
+			i -= size
+			if _, err := m.XB12.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB11 != nil {
+		{
+			size := m.XB11.Size()
+			i -= size
+			if _, err := m.XB11.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB10 != nil {
+		{
+			size := m.XB10.Size()
+			i -= size
+			if _, err := m.XB10.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB9 != nil {
+		{
+			size := m.XB9.Size()
+			i -= size
+			if _, err := m.XB9.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB8 != nil {
+		{
+			size := m.XB8.Size()
+			i -= size
+			if _, err := m.XB8.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB7 != nil {
+		{
+			size := m.XB7.Size()
+			i -= size
+			if _, err := m.XB7.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB6 != nil {
+		{
+			size := m.XB6.Size()
+			i -= size
+			if _, err := m.XB6.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB5 != nil {
+		{
+			size := m.XB5.Size()
+			i -= size
+			if _, err := m.XB5.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB4 != nil {
+		{
+			size := m.XB4.Size()
+			i -= size
+			if _, err := m.XB4.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB3 != nil {
+		{
+			size := m.XB3.Size()
+			i -= size
+			if _, err := m.XB3.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB2 != nil {
+		{
+			size := m.XB2.Size()
+			i -= size
+			if _, err := m.XB2.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.XB1 != nil {
+		{
+			size := m.XB1.Size()
+			i -= size
+			if _, err := m.XB1.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.A15) > 0 {
+		i -= len(m.A15)
+		copy(dAtA[i:], m.A15)
+		i = encodeVarintTest(dAtA, i, uint64(len(m.A15)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.A14) > 0 {
+		i -= len(m.A14)
+		copy(dAtA[i:], m.A14)
+		i = encodeVarintTest(dAtA, i, uint64(len(m.A14)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.A13 {
+		i--
+		if m.A13 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.A12 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.A12))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if m.A11 != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.A11))))
+		i--
+		dAtA[i] = 0x5d
+	}
+	if m.A10 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.A10))
+		i--
+		dAtA[i] = 0x51
+	}
+	if m.A9 != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.A9))
+		i--
+		dAtA[i] = 0x4d
+	}
+	if m.A8 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.A8))
+		i--
+		dAtA[i] = 0x41
+	}
+	if m.A7 != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.A7))
+		i--
+		dAtA[i] = 0x3d
+	}
+	if m.A6 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64((uint64(m.A6)<<1)^uint64((m.A6>>63))))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.A5 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64((uint32(m.A5)<<1)^uint32((m.A5>>31))))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.A4 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A4))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.A3 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A3))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.A2 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A2))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.A1 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A1))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Scalars_B1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTest(dAtA, i, uint64(m.B1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa8
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTest(dAtA, i, uint64(m.B2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb0
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B3) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTest(dAtA, i, uint64(m.B3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb8
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B4) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B4) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTest(dAtA, i, uint64(m.B4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc0
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B5) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B5) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTest(dAtA, i, uint64((uint32(m.B5)<<1)^uint32((m.B5>>31))))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xc8
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B6) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B6) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTest(dAtA, i, uint64((uint64(m.B6)<<1)^uint64((m.B6>>63))))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd0
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B7) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B7) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.B7))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xdd
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B8) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B8) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.B8))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xe1
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B9) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B9) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.B9))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xed
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B10) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B10) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.B10))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf1
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B11) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B11) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.B11))))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xfd
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B12) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B12) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.B12))))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x81
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B13) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B13) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.B13 {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x88
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B14) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B14) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.B14)
+	copy(dAtA[i:], m.B14)
+	i = encodeVarintTest(dAtA, i, uint64(len(m.B14)))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x92
+	return len(dAtA) - i, nil
+}
+func (m *Scalars_B15) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Scalars_B15) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.B15 != nil {
+		i -= len(m.B15)
+		copy(dAtA[i:], m.B15)
+		i = encodeVarintTest(dAtA, i, uint64(len(m.B15)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Numbers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Numbers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Numbers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Aa != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.Aa))
+		i--
+		dAtA[i] = 0xf
+		i--
+		dAtA[i] = 0xff
+		i--
+		dAtA[i] = 0xff
+		i--
+		dAtA[i] = 0xff
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.A9 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A9))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+		i--
+		dAtA[i] = 0x80
+		i--
+		dAtA[i] = 0x80
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.A8 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A8))
+		i--
+		dAtA[i] = 0x7f
+		i--
+		dAtA[i] = 0xff
+		i--
+		dAtA[i] = 0xff
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.A7 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A7))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+		i--
+		dAtA[i] = 0x80
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.A6 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A6))
+		i--
+		dAtA[i] = 0x7f
+		i--
+		dAtA[i] = 0xff
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.A5 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A5))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.A4 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A4))
+		i--
+		dAtA[i] = 0x7f
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.A3 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A3))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.A2 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A2))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.A1 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.A1))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Repeated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Repeated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Repeated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.R8) > 0 {
+		for iNdEx := len(m.R8) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.R8[iNdEx])
+			copy(dAtA[i:], m.R8[iNdEx])
+			i = encodeVarintTest(dAtA, i, uint64(len(m.R8[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.R7) > 0 {
+		for iNdEx := len(m.R7) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.R7[iNdEx])
+			copy(dAtA[i:], m.R7[iNdEx])
+			i = encodeVarintTest(dAtA, i, uint64(len(m.R7[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.R6) > 0 {
+		for iNdEx := len(m.R6) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.R6[iNdEx]))
+		}
+		i = encodeVarintTest(dAtA, i, uint64(len(m.R6)*8))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.R5) > 0 {
+		for iNdEx := len(m.R5) - 1; iNdEx >= 0; iNdEx-- {
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.R5[iNdEx]))
+		}
+		i = encodeVarintTest(dAtA, i, uint64(len(m.R5)*4))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.R4) > 0 {
+		var j1 int
+		dAtA3 := make([]byte, len(m.R4)*10)
+		for _, num := range m.R4 {
+			x2 := (uint64(num) << 1) ^ uint64((num >> 63))
+			for x2 >= 1<<7 {
+				dAtA3[j1] = uint8(uint64(x2)&0x7f | 0x80)
+				j1++
+				x2 >>= 7
+			}
+			dAtA3[j1] = uint8(x2)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA3[:j1])
+		i = encodeVarintTest(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.R3) > 0 {
+		dAtA4 := make([]byte, len(m.R3)*5)
+		var j5 int
+		for _, num := range m.R3 {
+			x6 := (uint32(num) << 1) ^ uint32((num >> 31))
+			for x6 >= 1<<7 {
+				dAtA4[j5] = uint8(uint64(x6)&0x7f | 0x80)
+				j5++
+				x6 >>= 7
+			}
+			dAtA4[j5] = uint8(x6)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA4[:j5])
+		i = encodeVarintTest(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.R2) > 0 {
+		dAtA8 := make([]byte, len(m.R2)*10)
+		var j7 int
+		for _, num1 := range m.R2 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTest(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.R1) > 0 {
+		dAtA10 := make([]byte, len(m.R1)*10)
+		var j9 int
+		for _, num1 := range m.R1 {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTest(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Graph) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Graph) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Graph) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.R) > 0 {
+		for iNdEx := len(m.R) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.R[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.S != nil {
+		{
+			size, err := m.S.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.V != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.V))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Oneof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Oneof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tail != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.Tail))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
+	}
+	if m.Multi != nil {
+		{
+			size := m.Multi.Size()
+			i -= size
+			if _, err := m.Multi.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Single != nil {
+		{
+			size := m.Single.Size()
+			i -= size
+			if _, err := m.Single.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Oneof_S1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof_S1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTest(dAtA, i, uint64(m.S1))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *Oneof_M1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof_M1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTest(dAtA, i, uint64(m.M1))
+	i--
+	dAtA[i] = 0x58
+	return len(dAtA) - i, nil
+}
+func (m *Oneof_M2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof_M2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTest(dAtA, i, uint64(m.M2))
+	i--
+	dAtA[i] = 0x60
+	return len(dAtA) - i, nil
+}
+func (m *Oneof_M3) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof_M3) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTest(dAtA, i, uint64((uint32(m.M3)<<1)^uint32((m.M3>>31))))
+	i--
+	dAtA[i] = 0x68
+	return len(dAtA) - i, nil
+}
+func (m *Oneof_M4) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof_M4) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTest(dAtA, i, uint64((uint64(m.M4)<<1)^uint64((m.M4>>63))))
+	i--
+	dAtA[i] = 0x70
+	return len(dAtA) - i, nil
+}
+func (m *Oneof_M5) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof_M5) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 4
+	encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(m.M5))
+	i--
+	dAtA[i] = 0x7d
+	return len(dAtA) - i, nil
+}
+func (m *Oneof_M6) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof_M6) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= 8
+	encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.M6))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x81
+	return len(dAtA) - i, nil
+}
+func (m *Oneof_M7) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof_M7) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.M7 {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x88
+	return len(dAtA) - i, nil
+}
+func (m *Oneof_M8) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof_M8) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.M8)
+	copy(dAtA[i:], m.M8)
+	i = encodeVarintTest(dAtA, i, uint64(len(m.M8)))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	return len(dAtA) - i, nil
+}
+func (m *Oneof_M9) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof_M9) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.M9 != nil {
+		i -= len(m.M9)
+		copy(dAtA[i:], m.M9)
+		i = encodeVarintTest(dAtA, i, uint64(len(m.M9)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Oneof_M10) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oneof_M10) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.M10 != nil {
+		{
+			size, err := m.M10.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Maps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Maps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Maps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mcf) > 0 {
+		for k := range m.Mcf {
+			v := m.Mcf[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.Mce) > 0 {
+		for k := range m.Mce {
+			v := m.Mce[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.Mcd) > 0 {
+		for k := range m.Mcd {
+			v := m.Mcd[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.Mcc) > 0 {
+		for k := range m.Mcc {
+			v := m.Mcc[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.Mcb) > 0 {
+		for k := range m.Mcb {
+			v := m.Mcb[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.Mca) > 0 {
+		for k := range m.Mca {
+			v := m.Mca[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.Mc9) > 0 {
+		for k := range m.Mc9 {
+			v := m.Mc9[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.Mc8) > 0 {
+		for k := range m.Mc8 {
+			v := m.Mc8[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.Mc7) > 0 {
+		for k := range m.Mc7 {
+			v := m.Mc7[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.Mc6) > 0 {
+		for k := range m.Mc6 {
+			v := m.Mc6[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.Mc5) > 0 {
+		for k := range m.Mc5 {
+			v := m.Mc5[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Mc4) > 0 {
+		for k := range m.Mc4 {
+			v := m.Mc4[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.Mc3) > 0 {
+		for k := range m.Mc3 {
+			v := m.Mc3[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.Mc2) > 0 {
+		for k := range m.Mc2 {
+			v := m.Mc2[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Mc1) > 0 {
+		for k := range m.Mc1 {
+			v := m.Mc1[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Mc0) > 0 {
+		for k := range m.Mc0 {
+			v := m.Mc0[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xc
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Mbf) > 0 {
+		for k := range m.Mbf {
+			v := m.Mbf[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.Mbe) > 0 {
+		for k := range m.Mbe {
+			v := m.Mbe[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.Mbd) > 0 {
+		for k := range m.Mbd {
+			v := m.Mbd[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.Mbc) > 0 {
+		for k := range m.Mbc {
+			v := m.Mbc[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.Mbb) > 0 {
+		for k := range m.Mbb {
+			v := m.Mbb[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.Mba) > 0 {
+		for k := range m.Mba {
+			v := m.Mba[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.Mb9) > 0 {
+		for k := range m.Mb9 {
+			v := m.Mb9[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.Mb8) > 0 {
+		for k := range m.Mb8 {
+			v := m.Mb8[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.Mb7) > 0 {
+		for k := range m.Mb7 {
+			v := m.Mb7[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.Mb6) > 0 {
+		for k := range m.Mb6 {
+			v := m.Mb6[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.Mb5) > 0 {
+		for k := range m.Mb5 {
+			v := m.Mb5[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Mb4) > 0 {
+		for k := range m.Mb4 {
+			v := m.Mb4[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.Mb3) > 0 {
+		for k := range m.Mb3 {
+			v := m.Mb3[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.Mb2) > 0 {
+		for k := range m.Mb2 {
+			v := m.Mb2[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Mb1) > 0 {
+		for k := range m.Mb1 {
+			v := m.Mb1[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Mb0) > 0 {
+		for k := range m.Mb0 {
+			v := m.Mb0[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i--
+			if k {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xb
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Maf) > 0 {
+		for k := range m.Maf {
+			v := m.Maf[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.Mae) > 0 {
+		for k := range m.Mae {
+			v := m.Mae[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.Mad) > 0 {
+		for k := range m.Mad {
+			v := m.Mad[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.Mac) > 0 {
+		for k := range m.Mac {
+			v := m.Mac[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.Mab) > 0 {
+		for k := range m.Mab {
+			v := m.Mab[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.Maa) > 0 {
+		for k := range m.Maa {
+			v := m.Maa[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.Ma9) > 0 {
+		for k := range m.Ma9 {
+			v := m.Ma9[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.Ma8) > 0 {
+		for k := range m.Ma8 {
+			v := m.Ma8[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.Ma7) > 0 {
+		for k := range m.Ma7 {
+			v := m.Ma7[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.Ma6) > 0 {
+		for k := range m.Ma6 {
+			v := m.Ma6[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.Ma5) > 0 {
+		for k := range m.Ma5 {
+			v := m.Ma5[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Ma4) > 0 {
+		for k := range m.Ma4 {
+			v := m.Ma4[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.Ma3) > 0 {
+		for k := range m.Ma3 {
+			v := m.Ma3[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.Ma2) > 0 {
+		for k := range m.Ma2 {
+			v := m.Ma2[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.Ma1) > 0 {
+		for k := range m.Ma1 {
+			v := m.Ma1[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Ma0) > 0 {
+		for k := range m.Ma0 {
+			v := m.Ma0[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.M9F) > 0 {
+		for k := range m.M9F {
+			v := m.M9F[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.M9E) > 0 {
+		for k := range m.M9E {
+			v := m.M9E[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.M9D) > 0 {
+		for k := range m.M9D {
+			v := m.M9D[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.M9C) > 0 {
+		for k := range m.M9C {
+			v := m.M9C[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.M9B) > 0 {
+		for k := range m.M9B {
+			v := m.M9B[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.M9A) > 0 {
+		for k := range m.M9A {
+			v := m.M9A[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.M99) > 0 {
+		for k := range m.M99 {
+			v := m.M99[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.M98) > 0 {
+		for k := range m.M98 {
+			v := m.M98[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.M97) > 0 {
+		for k := range m.M97 {
+			v := m.M97[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.M96) > 0 {
+		for k := range m.M96 {
+			v := m.M96[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.M95) > 0 {
+		for k := range m.M95 {
+			v := m.M95[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.M94) > 0 {
+		for k := range m.M94 {
+			v := m.M94[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.M93) > 0 {
+		for k := range m.M93 {
+			v := m.M93[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.M92) > 0 {
+		for k := range m.M92 {
+			v := m.M92[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.M91) > 0 {
+		for k := range m.M91 {
+			v := m.M91[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.M90) > 0 {
+		for k := range m.M90 {
+			v := m.M90[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x9
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.M8F) > 0 {
+		for k := range m.M8F {
+			v := m.M8F[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.M8E) > 0 {
+		for k := range m.M8E {
+			v := m.M8E[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.M8D) > 0 {
+		for k := range m.M8D {
+			v := m.M8D[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.M8C) > 0 {
+		for k := range m.M8C {
+			v := m.M8C[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.M8B) > 0 {
+		for k := range m.M8B {
+			v := m.M8B[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.M8A) > 0 {
+		for k := range m.M8A {
+			v := m.M8A[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.M89) > 0 {
+		for k := range m.M89 {
+			v := m.M89[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.M88) > 0 {
+		for k := range m.M88 {
+			v := m.M88[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.M87) > 0 {
+		for k := range m.M87 {
+			v := m.M87[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.M86) > 0 {
+		for k := range m.M86 {
+			v := m.M86[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.M85) > 0 {
+		for k := range m.M85 {
+			v := m.M85[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.M84) > 0 {
+		for k := range m.M84 {
+			v := m.M84[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.M83) > 0 {
+		for k := range m.M83 {
+			v := m.M83[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.M82) > 0 {
+		for k := range m.M82 {
+			v := m.M82[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.M81) > 0 {
+		for k := range m.M81 {
+			v := m.M81[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.M80) > 0 {
+		for k := range m.M80 {
+			v := m.M80[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x8
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.M7F) > 0 {
+		for k := range m.M7F {
+			v := m.M7F[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.M7E) > 0 {
+		for k := range m.M7E {
+			v := m.M7E[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.M7D) > 0 {
+		for k := range m.M7D {
+			v := m.M7D[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.M7C) > 0 {
+		for k := range m.M7C {
+			v := m.M7C[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.M7B) > 0 {
+		for k := range m.M7B {
+			v := m.M7B[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.M7A) > 0 {
+		for k := range m.M7A {
+			v := m.M7A[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.M79) > 0 {
+		for k := range m.M79 {
+			v := m.M79[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.M78) > 0 {
+		for k := range m.M78 {
+			v := m.M78[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.M77) > 0 {
+		for k := range m.M77 {
+			v := m.M77[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.M76) > 0 {
+		for k := range m.M76 {
+			v := m.M76[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.M75) > 0 {
+		for k := range m.M75 {
+			v := m.M75[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.M74) > 0 {
+		for k := range m.M74 {
+			v := m.M74[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.M73) > 0 {
+		for k := range m.M73 {
+			v := m.M73[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.M72) > 0 {
+		for k := range m.M72 {
+			v := m.M72[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.M71) > 0 {
+		for k := range m.M71 {
+			v := m.M71[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.M70) > 0 {
+		for k := range m.M70 {
+			v := m.M70[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.M6F) > 0 {
+		for k := range m.M6F {
+			v := m.M6F[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.M6E) > 0 {
+		for k := range m.M6E {
+			v := m.M6E[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.M6D) > 0 {
+		for k := range m.M6D {
+			v := m.M6D[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.M6C) > 0 {
+		for k := range m.M6C {
+			v := m.M6C[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.M6B) > 0 {
+		for k := range m.M6B {
+			v := m.M6B[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.M6A) > 0 {
+		for k := range m.M6A {
+			v := m.M6A[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.M69) > 0 {
+		for k := range m.M69 {
+			v := m.M69[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.M68) > 0 {
+		for k := range m.M68 {
+			v := m.M68[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.M67) > 0 {
+		for k := range m.M67 {
+			v := m.M67[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.M66) > 0 {
+		for k := range m.M66 {
+			v := m.M66[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.M65) > 0 {
+		for k := range m.M65 {
+			v := m.M65[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.M64) > 0 {
+		for k := range m.M64 {
+			v := m.M64[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.M63) > 0 {
+		for k := range m.M63 {
+			v := m.M63[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.M62) > 0 {
+		for k := range m.M62 {
+			v := m.M62[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.M61) > 0 {
+		for k := range m.M61 {
+			v := m.M61[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.M60) > 0 {
+		for k := range m.M60 {
+			v := m.M60[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.M5F) > 0 {
+		for k := range m.M5F {
+			v := m.M5F[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.M5E) > 0 {
+		for k := range m.M5E {
+			v := m.M5E[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.M5D) > 0 {
+		for k := range m.M5D {
+			v := m.M5D[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.M5C) > 0 {
+		for k := range m.M5C {
+			v := m.M5C[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.M5B) > 0 {
+		for k := range m.M5B {
+			v := m.M5B[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.M5A) > 0 {
+		for k := range m.M5A {
+			v := m.M5A[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.M59) > 0 {
+		for k := range m.M59 {
+			v := m.M59[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.M58) > 0 {
+		for k := range m.M58 {
+			v := m.M58[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.M57) > 0 {
+		for k := range m.M57 {
+			v := m.M57[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.M56) > 0 {
+		for k := range m.M56 {
+			v := m.M56[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.M55) > 0 {
+		for k := range m.M55 {
+			v := m.M55[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.M54) > 0 {
+		for k := range m.M54 {
+			v := m.M54[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.M53) > 0 {
+		for k := range m.M53 {
+			v := m.M53[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.M52) > 0 {
+		for k := range m.M52 {
+			v := m.M52[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.M51) > 0 {
+		for k := range m.M51 {
+			v := m.M51[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.M50) > 0 {
+		for k := range m.M50 {
+			v := m.M50[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x5
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.M4F) > 0 {
+		for k := range m.M4F {
+			v := m.M4F[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.M4E) > 0 {
+		for k := range m.M4E {
+			v := m.M4E[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.M4D) > 0 {
+		for k := range m.M4D {
+			v := m.M4D[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.M4C) > 0 {
+		for k := range m.M4C {
+			v := m.M4C[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.M4B) > 0 {
+		for k := range m.M4B {
+			v := m.M4B[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.M4A) > 0 {
+		for k := range m.M4A {
+			v := m.M4A[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.M49) > 0 {
+		for k := range m.M49 {
+			v := m.M49[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.M48) > 0 {
+		for k := range m.M48 {
+			v := m.M48[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.M47) > 0 {
+		for k := range m.M47 {
+			v := m.M47[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.M46) > 0 {
+		for k := range m.M46 {
+			v := m.M46[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.M45) > 0 {
+		for k := range m.M45 {
+			v := m.M45[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.M44) > 0 {
+		for k := range m.M44 {
+			v := m.M44[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.M43) > 0 {
+		for k := range m.M43 {
+			v := m.M43[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.M42) > 0 {
+		for k := range m.M42 {
+			v := m.M42[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.M41) > 0 {
+		for k := range m.M41 {
+			v := m.M41[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.M40) > 0 {
+		for k := range m.M40 {
+			v := m.M40[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.M3F) > 0 {
+		for k := range m.M3F {
+			v := m.M3F[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.M3E) > 0 {
+		for k := range m.M3E {
+			v := m.M3E[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.M3D) > 0 {
+		for k := range m.M3D {
+			v := m.M3D[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.M3C) > 0 {
+		for k := range m.M3C {
+			v := m.M3C[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.M3B) > 0 {
+		for k := range m.M3B {
+			v := m.M3B[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.M3A) > 0 {
+		for k := range m.M3A {
+			v := m.M3A[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.M39) > 0 {
+		for k := range m.M39 {
+			v := m.M39[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.M38) > 0 {
+		for k := range m.M38 {
+			v := m.M38[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.M37) > 0 {
+		for k := range m.M37 {
+			v := m.M37[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.M36) > 0 {
+		for k := range m.M36 {
+			v := m.M36[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.M35) > 0 {
+		for k := range m.M35 {
+			v := m.M35[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.M34) > 0 {
+		for k := range m.M34 {
+			v := m.M34[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.M33) > 0 {
+		for k := range m.M33 {
+			v := m.M33[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.M32) > 0 {
+		for k := range m.M32 {
+			v := m.M32[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.M31) > 0 {
+		for k := range m.M31 {
+			v := m.M31[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.M30) > 0 {
+		for k := range m.M30 {
+			v := m.M30[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.M2F) > 0 {
+		for k := range m.M2F {
+			v := m.M2F[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.M2E) > 0 {
+		for k := range m.M2E {
+			v := m.M2E[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.M2D) > 0 {
+		for k := range m.M2D {
+			v := m.M2D[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.M2C) > 0 {
+		for k := range m.M2C {
+			v := m.M2C[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.M2B) > 0 {
+		for k := range m.M2B {
+			v := m.M2B[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.M2A) > 0 {
+		for k := range m.M2A {
+			v := m.M2A[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.M29) > 0 {
+		for k := range m.M29 {
+			v := m.M29[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.M28) > 0 {
+		for k := range m.M28 {
+			v := m.M28[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.M27) > 0 {
+		for k := range m.M27 {
+			v := m.M27[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.M26) > 0 {
+		for k := range m.M26 {
+			v := m.M26[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.M25) > 0 {
+		for k := range m.M25 {
+			v := m.M25[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.M24) > 0 {
+		for k := range m.M24 {
+			v := m.M24[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.M23) > 0 {
+		for k := range m.M23 {
+			v := m.M23[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.M22) > 0 {
+		for k := range m.M22 {
+			v := m.M22[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.M21) > 0 {
+		for k := range m.M21 {
+			v := m.M21[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.M20) > 0 {
+		for k := range m.M20 {
+			v := m.M20[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.M1F) > 0 {
+		for k := range m.M1F {
+			v := m.M1F[k]
+			baseI := i
+			if len(v) > 0 {
+				i -= len(v)
+				copy(dAtA[i:], v)
+				i = encodeVarintTest(dAtA, i, uint64(len(v)))
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xfa
+		}
+	}
+	if len(m.M1E) > 0 {
+		for k := range m.M1E {
+			v := m.M1E[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTest(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.M1D) > 0 {
+		for k := range m.M1D {
+			v := m.M1D[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.M1C) > 0 {
+		for k := range m.M1C {
+			v := m.M1C[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.M1B) > 0 {
+		for k := range m.M1B {
+			v := m.M1B[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	if len(m.M1A) > 0 {
+		for k := range m.M1A {
+			v := m.M1A[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(v))))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.M19) > 0 {
+		for k := range m.M19 {
+			v := m.M19[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.M18) > 0 {
+		for k := range m.M18 {
+			v := m.M18[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.M17) > 0 {
+		for k := range m.M17 {
+			v := m.M17[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(v))
+			i--
+			dAtA[i] = 0x11
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.M16) > 0 {
+		for k := range m.M16 {
+			v := m.M16[k]
+			baseI := i
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(v))
+			i--
+			dAtA[i] = 0x15
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.M15) > 0 {
+		for k := range m.M15 {
+			v := m.M15[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint64(v)<<1)^uint64((v>>63))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.M14) > 0 {
+		for k := range m.M14 {
+			v := m.M14[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64((uint32(v)<<1)^uint32((v>>31))))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.M13) > 0 {
+		for k := range m.M13 {
+			v := m.M13[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.M12) > 0 {
+		for k := range m.M12 {
+			v := m.M12[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.M11) > 0 {
+		for k := range m.M11 {
+			v := m.M11[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.M10) > 0 {
+		for k := range m.M10 {
+			v := m.M10[k]
+			baseI := i
+			i = encodeVarintTest(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MessageMaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageMaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageMaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mc) > 0 {
+		for k := range m.Mc {
+			v := m.Mc[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTest(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTest(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if len(m.Ma) > 0 {
+		for k := range m.Ma {
+			v := m.Ma[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTest(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.M9) > 0 {
+		for k := range m.M9 {
+			v := m.M9[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTest(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.M8) > 0 {
+		for k := range m.M8 {
+			v := m.M8[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTest(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(k))
+			i--
+			dAtA[i] = 0x9
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.M7) > 0 {
+		for k := range m.M7 {
+			v := m.M7[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTest(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= 4
+			encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(k))
+			i--
+			dAtA[i] = 0xd
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.M6) > 0 {
+		for k := range m.M6 {
+			v := m.M6[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTest(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64((uint64(k)<<1)^uint64((k>>63))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.M5) > 0 {
+		for k := range m.M5 {
+			v := m.M5[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTest(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64((uint32(k)<<1)^uint32((k>>31))))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.M4) > 0 {
+		for k := range m.M4 {
+			v := m.M4[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTest(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.M3) > 0 {
+		for k := range m.M3 {
+			v := m.M3[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTest(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.M2) > 0 {
+		for k := range m.M2 {
+			v := m.M2[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTest(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.M1) > 0 {
+		for k := range m.M1 {
+			v := m.M1[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintTest(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i = encodeVarintTest(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintTest(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.Scalars != nil {
+		{
+			size, err := m.Scalars.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTest(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pathological) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pathological) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pathological) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.X) > 0 {
+		for iNdEx := len(m.X) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.X[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTest(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pathological_Inner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pathological_Inner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pathological_Inner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actual) > 0 {
+		i -= len(m.Actual)
+		copy(dAtA[i:], m.Actual)
+		i = encodeVarintTest(dAtA, i, uint64(len(m.Actual)))
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.Xf != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.Xf))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Xe != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.Xe))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Xd != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.Xd))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Xc != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.Xc))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Xb != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.Xb))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Xa != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.Xa))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.X9 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.X9))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.X8 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.X8))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.X7 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.X7))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.X6 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.X6))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.X5 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.X5))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.X4 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.X4))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.X3 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.X3))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.X2 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.X2))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.X1 != 0 {
+		i = encodeVarintTest(dAtA, i, uint64(m.X1))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTest(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTest(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Scalars) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.A1 != 0 {
+		n += 1 + sovTest(uint64(m.A1))
+	}
+	if m.A2 != 0 {
+		n += 1 + sovTest(uint64(m.A2))
+	}
+	if m.A3 != 0 {
+		n += 1 + sovTest(uint64(m.A3))
+	}
+	if m.A4 != 0 {
+		n += 1 + sovTest(uint64(m.A4))
+	}
+	if m.A5 != 0 {
+		n += 1 + sozTest(uint64(m.A5))
+	}
+	if m.A6 != 0 {
+		n += 1 + sozTest(uint64(m.A6))
+	}
+	if m.A7 != 0 {
+		n += 5
+	}
+	if m.A8 != 0 {
+		n += 9
+	}
+	if m.A9 != 0 {
+		n += 5
+	}
+	if m.A10 != 0 {
+		n += 9
+	}
+	if m.A11 != 0 {
+		n += 5
+	}
+	if m.A12 != 0 {
+		n += 9
+	}
+	if m.A13 {
+		n += 2
+	}
+	l = len(m.A14)
+	if l > 0 {
+		n += 1 + l + sovTest(uint64(l))
+	}
+	l = len(m.A15)
+	if l > 0 {
+		n += 1 + l + sovTest(uint64(l))
+	}
+	if m.XB1 != nil {
+		n += m.XB1.Size()
+	}
+	if m.XB2 != nil {
+		n += m.XB2.Size()
+	}
+	if m.XB3 != nil {
+		n += m.XB3.Size()
+	}
+	if m.XB4 != nil {
+		n += m.XB4.Size()
+	}
+	if m.XB5 != nil {
+		n += m.XB5.Size()
+	}
+	if m.XB6 != nil {
+		n += m.XB6.Size()
+	}
+	if m.XB7 != nil {
+		n += m.XB7.Size()
+	}
+	if m.XB8 != nil {
+		n += m.XB8.Size()
+	}
+	if m.XB9 != nil {
+		n += m.XB9.Size()
+	}
+	if m.XB10 != nil {
+		n += m.XB10.Size()
+	}
+	if m.XB11 != nil {
+		n += m.XB11.Size()
+	}
+	if m.XB12 != nil {
+		n += m.XB12.Size()
+	}
+	if m.XB13 != nil {
+		n += m.XB13.Size()
+	}
+	if m.XB14 != nil {
+		n += m.XB14.Size()
+	}
+	if m.XB15 != nil {
+		n += m.XB15.Size()
+	}
+	return n
+}
+
+func (m *Scalars_B1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sovTest(uint64(m.B1))
+	return n
+}
+func (m *Scalars_B2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sovTest(uint64(m.B2))
+	return n
+}
+func (m *Scalars_B3) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sovTest(uint64(m.B3))
+	return n
+}
+func (m *Scalars_B4) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sovTest(uint64(m.B4))
+	return n
+}
+func (m *Scalars_B5) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sozTest(uint64(m.B5))
+	return n
+}
+func (m *Scalars_B6) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2 + sozTest(uint64(m.B6))
+	return n
+}
+func (m *Scalars_B7) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 6
+	return n
+}
+func (m *Scalars_B8) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 10
+	return n
+}
+func (m *Scalars_B9) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 6
+	return n
+}
+func (m *Scalars_B10) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 10
+	return n
+}
+func (m *Scalars_B11) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 6
+	return n
+}
+func (m *Scalars_B12) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 10
+	return n
+}
+func (m *Scalars_B13) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 3
+	return n
+}
+func (m *Scalars_B14) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.B14)
+	n += 2 + l + sovTest(uint64(l))
+	return n
+}
+func (m *Scalars_B15) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.B15 != nil {
+		l = len(m.B15)
+		n += 2 + l + sovTest(uint64(l))
+	}
+	return n
+}
+func (m *Numbers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.A1 != 0 {
+		n += 1 + sovTest(uint64(m.A1))
+	}
+	if m.A2 != 0 {
+		n += 1 + sovTest(uint64(m.A2))
+	}
+	if m.A3 != 0 {
+		n += 2 + sovTest(uint64(m.A3))
+	}
+	if m.A4 != 0 {
+		n += 2 + sovTest(uint64(m.A4))
+	}
+	if m.A5 != 0 {
+		n += 3 + sovTest(uint64(m.A5))
+	}
+	if m.A6 != 0 {
+		n += 3 + sovTest(uint64(m.A6))
+	}
+	if m.A7 != 0 {
+		n += 4 + sovTest(uint64(m.A7))
+	}
+	if m.A8 != 0 {
+		n += 4 + sovTest(uint64(m.A8))
+	}
+	if m.A9 != 0 {
+		n += 5 + sovTest(uint64(m.A9))
+	}
+	if m.Aa != 0 {
+		n += 5 + sovTest(uint64(m.Aa))
+	}
+	return n
+}
+
+func (m *Repeated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.R1) > 0 {
+		l = 0
+		for _, e := range m.R1 {
+			l += sovTest(uint64(e))
+		}
+		n += 1 + sovTest(uint64(l)) + l
+	}
+	if len(m.R2) > 0 {
+		l = 0
+		for _, e := range m.R2 {
+			l += sovTest(uint64(e))
+		}
+		n += 1 + sovTest(uint64(l)) + l
+	}
+	if len(m.R3) > 0 {
+		l = 0
+		for _, e := range m.R3 {
+			l += sozTest(uint64(e))
+		}
+		n += 1 + sovTest(uint64(l)) + l
+	}
+	if len(m.R4) > 0 {
+		l = 0
+		for _, e := range m.R4 {
+			l += sozTest(uint64(e))
+		}
+		n += 1 + sovTest(uint64(l)) + l
+	}
+	if len(m.R5) > 0 {
+		n += 1 + sovTest(uint64(len(m.R5)*4)) + len(m.R5)*4
+	}
+	if len(m.R6) > 0 {
+		n += 1 + sovTest(uint64(len(m.R6)*8)) + len(m.R6)*8
+	}
+	if len(m.R7) > 0 {
+		for _, s := range m.R7 {
+			l = len(s)
+			n += 1 + l + sovTest(uint64(l))
+		}
+	}
+	if len(m.R8) > 0 {
+		for _, b := range m.R8 {
+			l = len(b)
+			n += 1 + l + sovTest(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Graph) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.V != 0 {
+		n += 1 + sovTest(uint64(m.V))
+	}
+	if m.S != nil {
+		l = m.S.Size()
+		n += 1 + l + sovTest(uint64(l))
+	}
+	if len(m.R) > 0 {
+		for _, e := range m.R {
+			l = e.Size()
+			n += 1 + l + sovTest(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Oneof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Single != nil {
+		n += m.Single.Size()
+	}
+	if m.Multi != nil {
+		n += m.Multi.Size()
+	}
+	if m.Tail != 0 {
+		n += 2 + sovTest(uint64(m.Tail))
+	}
+	return n
+}
+
+func (m *Oneof_S1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTest(uint64(m.S1))
+	return n
+}
+func (m *Oneof_M1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTest(uint64(m.M1))
+	return n
+}
+func (m *Oneof_M2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTest(uint64(m.M2))
+	return n
+}
+func (m *Oneof_M3) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sozTest(uint64(m.M3))
+	return n
+}
+func (m *Oneof_M4) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sozTest(uint64(m.M4))
+	return n
+}
+func (m *Oneof_M5) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 5
+	return n
+}
+func (m *Oneof_M6) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 10
+	return n
+}
+func (m *Oneof_M7) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 3
+	return n
+}
+func (m *Oneof_M8) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.M8)
+	n += 2 + l + sovTest(uint64(l))
+	return n
+}
+func (m *Oneof_M9) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.M9 != nil {
+		l = len(m.M9)
+		n += 2 + l + sovTest(uint64(l))
+	}
+	return n
+}
+func (m *Oneof_M10) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.M10 != nil {
+		l = m.M10.Size()
+		n += 2 + l + sovTest(uint64(l))
+	}
+	return n
+}
+func (m *Maps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.M10) > 0 {
+		for k, v := range m.M10 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M11) > 0 {
+		for k, v := range m.M11 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M12) > 0 {
+		for k, v := range m.M12 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M13) > 0 {
+		for k, v := range m.M13 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M14) > 0 {
+		for k, v := range m.M14 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M15) > 0 {
+		for k, v := range m.M15 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M16) > 0 {
+		for k, v := range m.M16 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M17) > 0 {
+		for k, v := range m.M17 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M18) > 0 {
+		for k, v := range m.M18 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M19) > 0 {
+		for k, v := range m.M19 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M1A) > 0 {
+		for k, v := range m.M1A {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M1B) > 0 {
+		for k, v := range m.M1B {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M1C) > 0 {
+		for k, v := range m.M1C {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M1D) > 0 {
+		for k, v := range m.M1D {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M1E) > 0 {
+		for k, v := range m.M1E {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M1F) > 0 {
+		for k, v := range m.M1F {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M20) > 0 {
+		for k, v := range m.M20 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M21) > 0 {
+		for k, v := range m.M21 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M22) > 0 {
+		for k, v := range m.M22 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M23) > 0 {
+		for k, v := range m.M23 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M24) > 0 {
+		for k, v := range m.M24 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M25) > 0 {
+		for k, v := range m.M25 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M26) > 0 {
+		for k, v := range m.M26 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M27) > 0 {
+		for k, v := range m.M27 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M28) > 0 {
+		for k, v := range m.M28 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M29) > 0 {
+		for k, v := range m.M29 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M2A) > 0 {
+		for k, v := range m.M2A {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M2B) > 0 {
+		for k, v := range m.M2B {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M2C) > 0 {
+		for k, v := range m.M2C {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M2D) > 0 {
+		for k, v := range m.M2D {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M2E) > 0 {
+		for k, v := range m.M2E {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M2F) > 0 {
+		for k, v := range m.M2F {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M30) > 0 {
+		for k, v := range m.M30 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M31) > 0 {
+		for k, v := range m.M31 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M32) > 0 {
+		for k, v := range m.M32 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M33) > 0 {
+		for k, v := range m.M33 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M34) > 0 {
+		for k, v := range m.M34 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M35) > 0 {
+		for k, v := range m.M35 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M36) > 0 {
+		for k, v := range m.M36 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M37) > 0 {
+		for k, v := range m.M37 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M38) > 0 {
+		for k, v := range m.M38 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M39) > 0 {
+		for k, v := range m.M39 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M3A) > 0 {
+		for k, v := range m.M3A {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M3B) > 0 {
+		for k, v := range m.M3B {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M3C) > 0 {
+		for k, v := range m.M3C {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M3D) > 0 {
+		for k, v := range m.M3D {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M3E) > 0 {
+		for k, v := range m.M3E {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M3F) > 0 {
+		for k, v := range m.M3F {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M40) > 0 {
+		for k, v := range m.M40 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M41) > 0 {
+		for k, v := range m.M41 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M42) > 0 {
+		for k, v := range m.M42 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M43) > 0 {
+		for k, v := range m.M43 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M44) > 0 {
+		for k, v := range m.M44 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M45) > 0 {
+		for k, v := range m.M45 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M46) > 0 {
+		for k, v := range m.M46 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M47) > 0 {
+		for k, v := range m.M47 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M48) > 0 {
+		for k, v := range m.M48 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M49) > 0 {
+		for k, v := range m.M49 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M4A) > 0 {
+		for k, v := range m.M4A {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M4B) > 0 {
+		for k, v := range m.M4B {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M4C) > 0 {
+		for k, v := range m.M4C {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M4D) > 0 {
+		for k, v := range m.M4D {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M4E) > 0 {
+		for k, v := range m.M4E {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovTest(uint64(k)) + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M4F) > 0 {
+		for k, v := range m.M4F {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sovTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M50) > 0 {
+		for k, v := range m.M50 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M51) > 0 {
+		for k, v := range m.M51 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M52) > 0 {
+		for k, v := range m.M52 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M53) > 0 {
+		for k, v := range m.M53 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M54) > 0 {
+		for k, v := range m.M54 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M55) > 0 {
+		for k, v := range m.M55 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M56) > 0 {
+		for k, v := range m.M56 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M57) > 0 {
+		for k, v := range m.M57 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M58) > 0 {
+		for k, v := range m.M58 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M59) > 0 {
+		for k, v := range m.M59 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M5A) > 0 {
+		for k, v := range m.M5A {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M5B) > 0 {
+		for k, v := range m.M5B {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M5C) > 0 {
+		for k, v := range m.M5C {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M5D) > 0 {
+		for k, v := range m.M5D {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M5E) > 0 {
+		for k, v := range m.M5E {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M5F) > 0 {
+		for k, v := range m.M5F {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sozTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M60) > 0 {
+		for k, v := range m.M60 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M61) > 0 {
+		for k, v := range m.M61 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M62) > 0 {
+		for k, v := range m.M62 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M63) > 0 {
+		for k, v := range m.M63 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M64) > 0 {
+		for k, v := range m.M64 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M65) > 0 {
+		for k, v := range m.M65 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M66) > 0 {
+		for k, v := range m.M66 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M67) > 0 {
+		for k, v := range m.M67 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M68) > 0 {
+		for k, v := range m.M68 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M69) > 0 {
+		for k, v := range m.M69 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M6A) > 0 {
+		for k, v := range m.M6A {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M6B) > 0 {
+		for k, v := range m.M6B {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M6C) > 0 {
+		for k, v := range m.M6C {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M6D) > 0 {
+		for k, v := range m.M6D {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M6E) > 0 {
+		for k, v := range m.M6E {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sozTest(uint64(k)) + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M6F) > 0 {
+		for k, v := range m.M6F {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + sozTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M70) > 0 {
+		for k, v := range m.M70 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M71) > 0 {
+		for k, v := range m.M71 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M72) > 0 {
+		for k, v := range m.M72 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M73) > 0 {
+		for k, v := range m.M73 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M74) > 0 {
+		for k, v := range m.M74 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M75) > 0 {
+		for k, v := range m.M75 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M76) > 0 {
+		for k, v := range m.M76 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M77) > 0 {
+		for k, v := range m.M77 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M78) > 0 {
+		for k, v := range m.M78 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M79) > 0 {
+		for k, v := range m.M79 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M7A) > 0 {
+		for k, v := range m.M7A {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M7B) > 0 {
+		for k, v := range m.M7B {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M7C) > 0 {
+		for k, v := range m.M7C {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M7D) > 0 {
+		for k, v := range m.M7D {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M7E) > 0 {
+		for k, v := range m.M7E {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M7F) > 0 {
+		for k, v := range m.M7F {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + 4 + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M80) > 0 {
+		for k, v := range m.M80 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M81) > 0 {
+		for k, v := range m.M81 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M82) > 0 {
+		for k, v := range m.M82 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M83) > 0 {
+		for k, v := range m.M83 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M84) > 0 {
+		for k, v := range m.M84 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M85) > 0 {
+		for k, v := range m.M85 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M86) > 0 {
+		for k, v := range m.M86 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M87) > 0 {
+		for k, v := range m.M87 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M88) > 0 {
+		for k, v := range m.M88 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M89) > 0 {
+		for k, v := range m.M89 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M8A) > 0 {
+		for k, v := range m.M8A {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M8B) > 0 {
+		for k, v := range m.M8B {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M8C) > 0 {
+		for k, v := range m.M8C {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M8D) > 0 {
+		for k, v := range m.M8D {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M8E) > 0 {
+		for k, v := range m.M8E {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M8F) > 0 {
+		for k, v := range m.M8F {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + 8 + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M90) > 0 {
+		for k, v := range m.M90 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M91) > 0 {
+		for k, v := range m.M91 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M92) > 0 {
+		for k, v := range m.M92 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M93) > 0 {
+		for k, v := range m.M93 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M94) > 0 {
+		for k, v := range m.M94 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M95) > 0 {
+		for k, v := range m.M95 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M96) > 0 {
+		for k, v := range m.M96 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M97) > 0 {
+		for k, v := range m.M97 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M98) > 0 {
+		for k, v := range m.M98 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M99) > 0 {
+		for k, v := range m.M99 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M9A) > 0 {
+		for k, v := range m.M9A {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M9B) > 0 {
+		for k, v := range m.M9B {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M9C) > 0 {
+		for k, v := range m.M9C {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M9D) > 0 {
+		for k, v := range m.M9D {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M9E) > 0 {
+		for k, v := range m.M9E {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 4 + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M9F) > 0 {
+		for k, v := range m.M9F {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + 4 + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ma0) > 0 {
+		for k, v := range m.Ma0 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ma1) > 0 {
+		for k, v := range m.Ma1 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ma2) > 0 {
+		for k, v := range m.Ma2 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ma3) > 0 {
+		for k, v := range m.Ma3 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ma4) > 0 {
+		for k, v := range m.Ma4 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ma5) > 0 {
+		for k, v := range m.Ma5 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ma6) > 0 {
+		for k, v := range m.Ma6 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ma7) > 0 {
+		for k, v := range m.Ma7 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ma8) > 0 {
+		for k, v := range m.Ma8 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ma9) > 0 {
+		for k, v := range m.Ma9 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Maa) > 0 {
+		for k, v := range m.Maa {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mab) > 0 {
+		for k, v := range m.Mab {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mac) > 0 {
+		for k, v := range m.Mac {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mad) > 0 {
+		for k, v := range m.Mad {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mae) > 0 {
+		for k, v := range m.Mae {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 8 + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Maf) > 0 {
+		for k, v := range m.Maf {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + 8 + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mb0) > 0 {
+		for k, v := range m.Mb0 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mb1) > 0 {
+		for k, v := range m.Mb1 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mb2) > 0 {
+		for k, v := range m.Mb2 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mb3) > 0 {
+		for k, v := range m.Mb3 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mb4) > 0 {
+		for k, v := range m.Mb4 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mb5) > 0 {
+		for k, v := range m.Mb5 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mb6) > 0 {
+		for k, v := range m.Mb6 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mb7) > 0 {
+		for k, v := range m.Mb7 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mb8) > 0 {
+		for k, v := range m.Mb8 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mb9) > 0 {
+		for k, v := range m.Mb9 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mba) > 0 {
+		for k, v := range m.Mba {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mbb) > 0 {
+		for k, v := range m.Mbb {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mbc) > 0 {
+		for k, v := range m.Mbc {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mbd) > 0 {
+		for k, v := range m.Mbd {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mbe) > 0 {
+		for k, v := range m.Mbe {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + 1 + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mbf) > 0 {
+		for k, v := range m.Mbf {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + 1 + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mc0) > 0 {
+		for k, v := range m.Mc0 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mc1) > 0 {
+		for k, v := range m.Mc1 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mc2) > 0 {
+		for k, v := range m.Mc2 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mc3) > 0 {
+		for k, v := range m.Mc3 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mc4) > 0 {
+		for k, v := range m.Mc4 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mc5) > 0 {
+		for k, v := range m.Mc5 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + sozTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mc6) > 0 {
+		for k, v := range m.Mc6 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mc7) > 0 {
+		for k, v := range m.Mc7 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mc8) > 0 {
+		for k, v := range m.Mc8 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mc9) > 0 {
+		for k, v := range m.Mc9 {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mca) > 0 {
+		for k, v := range m.Mca {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + 4
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mcb) > 0 {
+		for k, v := range m.Mcb {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mcc) > 0 {
+		for k, v := range m.Mcc {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mcd) > 0 {
+		for k, v := range m.Mcd {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + sovTest(uint64(v))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mce) > 0 {
+		for k, v := range m.Mce {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + 1 + len(v) + sovTest(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mcf) > 0 {
+		for k, v := range m.Mcf {
+			_ = k
+			_ = v
+			l = 0
+			if len(v) > 0 {
+				l = 1 + len(v) + sovTest(uint64(len(v)))
+			}
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *MessageMaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scalars != nil {
+		l = m.Scalars.Size()
+		n += 1 + l + sovTest(uint64(l))
+	}
+	if len(m.M1) > 0 {
+		for k, v := range m.M1 {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTest(uint64(l))
+			}
+			mapEntrySize := 1 + sovTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M2) > 0 {
+		for k, v := range m.M2 {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTest(uint64(l))
+			}
+			mapEntrySize := 1 + sovTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M3) > 0 {
+		for k, v := range m.M3 {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTest(uint64(l))
+			}
+			mapEntrySize := 1 + sovTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M4) > 0 {
+		for k, v := range m.M4 {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTest(uint64(l))
+			}
+			mapEntrySize := 1 + sovTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M5) > 0 {
+		for k, v := range m.M5 {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTest(uint64(l))
+			}
+			mapEntrySize := 1 + sozTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M6) > 0 {
+		for k, v := range m.M6 {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTest(uint64(l))
+			}
+			mapEntrySize := 1 + sozTest(uint64(k)) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M7) > 0 {
+		for k, v := range m.M7 {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTest(uint64(l))
+			}
+			mapEntrySize := 1 + 4 + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M8) > 0 {
+		for k, v := range m.M8 {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTest(uint64(l))
+			}
+			mapEntrySize := 1 + 8 + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.M9) > 0 {
+		for k, v := range m.M9 {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTest(uint64(l))
+			}
+			mapEntrySize := 1 + 4 + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Ma) > 0 {
+		for k, v := range m.Ma {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTest(uint64(l))
+			}
+			mapEntrySize := 1 + 8 + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Mc) > 0 {
+		for k, v := range m.Mc {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovTest(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovTest(uint64(len(k))) + l
+			n += mapEntrySize + 2 + sovTest(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Pathological) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.X) > 0 {
+		for _, e := range m.X {
+			l = e.Size()
+			n += 1 + l + sovTest(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Pathological_Inner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.X1 != 0 {
+		n += 1 + sovTest(uint64(m.X1))
+	}
+	if m.X2 != 0 {
+		n += 1 + sovTest(uint64(m.X2))
+	}
+	if m.X3 != 0 {
+		n += 1 + sovTest(uint64(m.X3))
+	}
+	if m.X4 != 0 {
+		n += 1 + sovTest(uint64(m.X4))
+	}
+	if m.X5 != 0 {
+		n += 1 + sovTest(uint64(m.X5))
+	}
+	if m.X6 != 0 {
+		n += 1 + sovTest(uint64(m.X6))
+	}
+	if m.X7 != 0 {
+		n += 1 + sovTest(uint64(m.X7))
+	}
+	if m.X8 != 0 {
+		n += 1 + sovTest(uint64(m.X8))
+	}
+	if m.X9 != 0 {
+		n += 1 + sovTest(uint64(m.X9))
+	}
+	if m.Xa != 0 {
+		n += 1 + sovTest(uint64(m.Xa))
+	}
+	if m.Xb != 0 {
+		n += 1 + sovTest(uint64(m.Xb))
+	}
+	if m.Xc != 0 {
+		n += 1 + sovTest(uint64(m.Xc))
+	}
+	if m.Xd != 0 {
+		n += 1 + sovTest(uint64(m.Xd))
+	}
+	if m.Xe != 0 {
+		n += 1 + sovTest(uint64(m.Xe))
+	}
+	if m.Xf != 0 {
+		n += 1 + sovTest(uint64(m.Xf))
+	}
+	l = len(m.Actual)
+	if l > 0 {
+		n += 2 + l + sovTest(uint64(l))
+	}
+	return n
+}
+
+func sovTest(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTest(x uint64) (n int) {
+	return sovTest(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Scalars) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Scalars: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Scalars: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A1", wireType)
+			}
+			m.A1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A1 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A2", wireType)
+			}
+			m.A2 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A2 |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A3", wireType)
+			}
+			m.A3 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A3 |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A4", wireType)
+			}
+			m.A4 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A4 |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A5", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.A5 = v
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A6", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.A6 = int64(v)
+		case 7:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A7", wireType)
+			}
+			m.A7 = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.A7 = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		case 8:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A8", wireType)
+			}
+			m.A8 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.A8 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 9:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A9", wireType)
+			}
+			m.A9 = 0
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.A9 = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+		case 10:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A10", wireType)
+			}
+			m.A10 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.A10 = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 11:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A11", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.A11 = float32(math.Float32frombits(v))
+		case 12:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A12", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.A12 = float64(math.Float64frombits(v))
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A13", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.A13 = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A14", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.A14 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A15", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.A15 = append(m.A15[:0], dAtA[iNdEx:postIndex]...)
+			if m.A15 == nil {
+				m.A15 = []byte{}
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B1", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XB1 = &Scalars_B1{v}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B2", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XB2 = &Scalars_B2{v}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B3", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XB3 = &Scalars_B3{v}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B4", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.XB4 = &Scalars_B4{v}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B5", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.XB5 = &Scalars_B5{v}
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B6", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.XB6 = &Scalars_B6{int64(v)}
+		case 27:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B7", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.XB7 = &Scalars_B7{v}
+		case 28:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B8", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.XB8 = &Scalars_B8{v}
+		case 29:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B9", wireType)
+			}
+			var v int32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.XB9 = &Scalars_B9{v}
+		case 30:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B10", wireType)
+			}
+			var v int64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.XB10 = &Scalars_B10{v}
+		case 31:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B11", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.XB11 = &Scalars_B11{float32(math.Float32frombits(v))}
+		case 32:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B12", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.XB12 = &Scalars_B12{float64(math.Float64frombits(v))}
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B13", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.XB13 = &Scalars_B13{b}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B14", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XB14 = &Scalars_B14{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B15", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.XB15 = &Scalars_B15{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Numbers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Numbers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Numbers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A1", wireType)
+			}
+			m.A1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A1 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A2", wireType)
+			}
+			m.A2 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A2 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A3", wireType)
+			}
+			m.A3 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A3 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2047:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A4", wireType)
+			}
+			m.A4 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A4 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2048:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A5", wireType)
+			}
+			m.A5 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A5 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 262143:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A6", wireType)
+			}
+			m.A6 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A6 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 262144:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A7", wireType)
+			}
+			m.A7 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A7 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33554431:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A8", wireType)
+			}
+			m.A8 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A8 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33554432:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A9", wireType)
+			}
+			m.A9 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.A9 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 536870911:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aa", wireType)
+			}
+			m.Aa = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Aa |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Repeated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Repeated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Repeated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.R1 = append(m.R1, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTest
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTest
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.R1) == 0 {
+					m.R1 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.R1 = append(m.R1, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field R1", wireType)
+			}
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.R2 = append(m.R2, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTest
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTest
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.R2) == 0 {
+					m.R2 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.R2 = append(m.R2, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field R2", wireType)
+			}
+		case 3:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+				m.R3 = append(m.R3, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTest
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTest
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.R3) == 0 {
+					m.R3 = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+					m.R3 = append(m.R3, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field R3", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+				m.R4 = append(m.R4, int64(v))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTest
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTest
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.R4) == 0 {
+					m.R4 = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+					m.R4 = append(m.R4, int64(v))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field R4", wireType)
+			}
+		case 5:
+			if wireType == 5 {
+				var v uint32
+				if (iNdEx + 4) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+				iNdEx += 4
+				m.R5 = append(m.R5, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTest
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTest
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 4
+				if elementCount != 0 && len(m.R5) == 0 {
+					m.R5 = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					m.R5 = append(m.R5, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field R5", wireType)
+			}
+		case 6:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				m.R6 = append(m.R6, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTest
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTest
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.R6) == 0 {
+					m.R6 = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					m.R6 = append(m.R6, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field R6", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R7", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R7 = append(m.R7, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R8", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R8 = append(m.R8, make([]byte, postIndex-iNdEx))
+			copy(m.R8[len(m.R8)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Graph) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Graph: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Graph: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			m.V = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.V |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.S == nil {
+				m.S = &Graph{}
+			}
+			if err := m.S.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R, &Graph{})
+			if err := m.R[len(m.R)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Oneof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Oneof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Oneof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S1", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Single = &Oneof_S1{v}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M1", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Multi = &Oneof_M1{v}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M2", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Multi = &Oneof_M2{v}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M3", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = int32((uint32(v) >> 1) ^ uint32(((v&1)<<31)>>31))
+			m.Multi = &Oneof_M3{v}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M4", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			v = (v >> 1) ^ uint64((int64(v&1)<<63)>>63)
+			m.Multi = &Oneof_M4{int64(v)}
+		case 15:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M5", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Multi = &Oneof_M5{v}
+		case 16:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M6", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Multi = &Oneof_M6{v}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M7", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.Multi = &Oneof_M7{b}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M8", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multi = &Oneof_M8{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M9", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Multi = &Oneof_M9{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M10", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Oneof{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Multi = &Oneof_M10{v}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			}
+			m.Tail = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tail |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Maps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Maps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Maps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M10", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M10 == nil {
+				m.M10 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M10[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M11", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M11 == nil {
+				m.M11 = make(map[int32]int64)
+			}
+			var mapkey int32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M11[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M12", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M12 == nil {
+				m.M12 = make(map[int32]uint32)
+			}
+			var mapkey int32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M12[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M13", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M13 == nil {
+				m.M13 = make(map[int32]uint64)
+			}
+			var mapkey int32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M13[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M14", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M14 == nil {
+				m.M14 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M14[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M15", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M15 == nil {
+				m.M15 = make(map[int32]int64)
+			}
+			var mapkey int32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M15[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M16", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M16 == nil {
+				m.M16 = make(map[int32]uint32)
+			}
+			var mapkey int32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M16[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M17", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M17 == nil {
+				m.M17 = make(map[int32]uint64)
+			}
+			var mapkey int32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M17[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M18", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M18 == nil {
+				m.M18 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M18[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M19", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M19 == nil {
+				m.M19 = make(map[int32]int64)
+			}
+			var mapkey int32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M19[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M1A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M1A == nil {
+				m.M1A = make(map[int32]float32)
+			}
+			var mapkey int32
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M1A[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M1B", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M1B == nil {
+				m.M1B = make(map[int32]float64)
+			}
+			var mapkey int32
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M1B[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M1C", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M1C == nil {
+				m.M1C = make(map[int32]bool)
+			}
+			var mapkey int32
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M1C[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M1D", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M1D == nil {
+				m.M1D = make(map[int32]Enum)
+			}
+			var mapkey int32
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M1D[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M1E", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M1E == nil {
+				m.M1E = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M1E[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M1F", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M1F == nil {
+				m.M1F = make(map[int32][]byte)
+			}
+			var mapkey int32
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M1F[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M20", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M20 == nil {
+				m.M20 = make(map[int64]int32)
+			}
+			var mapkey int64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M20[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M21", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M21 == nil {
+				m.M21 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M21[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M22", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M22 == nil {
+				m.M22 = make(map[int64]uint32)
+			}
+			var mapkey int64
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M22[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M23", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M23 == nil {
+				m.M23 = make(map[int64]uint64)
+			}
+			var mapkey int64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M23[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M24", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M24 == nil {
+				m.M24 = make(map[int64]int32)
+			}
+			var mapkey int64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M24[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M25", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M25 == nil {
+				m.M25 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M25[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M26", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M26 == nil {
+				m.M26 = make(map[int64]uint32)
+			}
+			var mapkey int64
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M26[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M27", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M27 == nil {
+				m.M27 = make(map[int64]uint64)
+			}
+			var mapkey int64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M27[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M28", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M28 == nil {
+				m.M28 = make(map[int64]int32)
+			}
+			var mapkey int64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M28[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M29", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M29 == nil {
+				m.M29 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M29[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 42:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M2A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M2A == nil {
+				m.M2A = make(map[int64]float32)
+			}
+			var mapkey int64
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M2A[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M2B", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M2B == nil {
+				m.M2B = make(map[int64]float64)
+			}
+			var mapkey int64
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M2B[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 44:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M2C", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M2C == nil {
+				m.M2C = make(map[int64]bool)
+			}
+			var mapkey int64
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M2C[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 45:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M2D", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M2D == nil {
+				m.M2D = make(map[int64]Enum)
+			}
+			var mapkey int64
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M2D[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 46:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M2E", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M2E == nil {
+				m.M2E = make(map[int64]string)
+			}
+			var mapkey int64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M2E[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 47:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M2F", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M2F == nil {
+				m.M2F = make(map[int64][]byte)
+			}
+			var mapkey int64
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M2F[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M30", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M30 == nil {
+				m.M30 = make(map[uint32]int32)
+			}
+			var mapkey uint32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M30[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M31", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M31 == nil {
+				m.M31 = make(map[uint32]int64)
+			}
+			var mapkey uint32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M31[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 50:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M32", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M32 == nil {
+				m.M32 = make(map[uint32]uint32)
+			}
+			var mapkey uint32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M32[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M33", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M33 == nil {
+				m.M33 = make(map[uint32]uint64)
+			}
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M33[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M34", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M34 == nil {
+				m.M34 = make(map[uint32]int32)
+			}
+			var mapkey uint32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M34[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M35", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M35 == nil {
+				m.M35 = make(map[uint32]int64)
+			}
+			var mapkey uint32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M35[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M36", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M36 == nil {
+				m.M36 = make(map[uint32]uint32)
+			}
+			var mapkey uint32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M36[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M37", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M37 == nil {
+				m.M37 = make(map[uint32]uint64)
+			}
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M37[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M38", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M38 == nil {
+				m.M38 = make(map[uint32]int32)
+			}
+			var mapkey uint32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M38[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M39", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M39 == nil {
+				m.M39 = make(map[uint32]int64)
+			}
+			var mapkey uint32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M39[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M3A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M3A == nil {
+				m.M3A = make(map[uint32]float32)
+			}
+			var mapkey uint32
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M3A[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M3B", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M3B == nil {
+				m.M3B = make(map[uint32]float64)
+			}
+			var mapkey uint32
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M3B[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M3C", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M3C == nil {
+				m.M3C = make(map[uint32]bool)
+			}
+			var mapkey uint32
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M3C[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M3D", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M3D == nil {
+				m.M3D = make(map[uint32]Enum)
+			}
+			var mapkey uint32
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M3D[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M3E", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M3E == nil {
+				m.M3E = make(map[uint32]string)
+			}
+			var mapkey uint32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M3E[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M3F", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M3F == nil {
+				m.M3F = make(map[uint32][]byte)
+			}
+			var mapkey uint32
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M3F[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 64:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M40", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M40 == nil {
+				m.M40 = make(map[uint64]int32)
+			}
+			var mapkey uint64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M40[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 65:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M41", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M41 == nil {
+				m.M41 = make(map[uint64]int64)
+			}
+			var mapkey uint64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M41[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M42", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M42 == nil {
+				m.M42 = make(map[uint64]uint32)
+			}
+			var mapkey uint64
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M42[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M43", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M43 == nil {
+				m.M43 = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M43[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M44", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M44 == nil {
+				m.M44 = make(map[uint64]int32)
+			}
+			var mapkey uint64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M44[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M45", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M45 == nil {
+				m.M45 = make(map[uint64]int64)
+			}
+			var mapkey uint64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M45[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 70:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M46", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M46 == nil {
+				m.M46 = make(map[uint64]uint32)
+			}
+			var mapkey uint64
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M46[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 71:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M47", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M47 == nil {
+				m.M47 = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M47[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 72:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M48", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M48 == nil {
+				m.M48 = make(map[uint64]int32)
+			}
+			var mapkey uint64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M48[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M49", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M49 == nil {
+				m.M49 = make(map[uint64]int64)
+			}
+			var mapkey uint64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M49[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 74:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M4A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M4A == nil {
+				m.M4A = make(map[uint64]float32)
+			}
+			var mapkey uint64
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M4A[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 75:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M4B", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M4B == nil {
+				m.M4B = make(map[uint64]float64)
+			}
+			var mapkey uint64
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M4B[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 76:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M4C", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M4C == nil {
+				m.M4C = make(map[uint64]bool)
+			}
+			var mapkey uint64
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M4C[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M4D", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M4D == nil {
+				m.M4D = make(map[uint64]Enum)
+			}
+			var mapkey uint64
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M4D[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 78:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M4E", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M4E == nil {
+				m.M4E = make(map[uint64]string)
+			}
+			var mapkey uint64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M4E[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 79:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M4F", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M4F == nil {
+				m.M4F = make(map[uint64][]byte)
+			}
+			var mapkey uint64
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M4F[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 80:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M50", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M50 == nil {
+				m.M50 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M50[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 81:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M51", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M51 == nil {
+				m.M51 = make(map[int32]int64)
+			}
+			var mapkey int32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M51[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 82:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M52", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M52 == nil {
+				m.M52 = make(map[int32]uint32)
+			}
+			var mapkey int32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M52[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 83:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M53", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M53 == nil {
+				m.M53 = make(map[int32]uint64)
+			}
+			var mapkey int32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M53[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 84:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M54", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M54 == nil {
+				m.M54 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M54[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 85:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M55", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M55 == nil {
+				m.M55 = make(map[int32]int64)
+			}
+			var mapkey int32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M55[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 86:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M56", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M56 == nil {
+				m.M56 = make(map[int32]uint32)
+			}
+			var mapkey int32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M56[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 87:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M57", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M57 == nil {
+				m.M57 = make(map[int32]uint64)
+			}
+			var mapkey int32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M57[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 88:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M58", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M58 == nil {
+				m.M58 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M58[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 89:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M59", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M59 == nil {
+				m.M59 = make(map[int32]int64)
+			}
+			var mapkey int32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M59[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 90:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M5A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M5A == nil {
+				m.M5A = make(map[int32]float32)
+			}
+			var mapkey int32
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M5A[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 91:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M5B", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M5B == nil {
+				m.M5B = make(map[int32]float64)
+			}
+			var mapkey int32
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M5B[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 92:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M5C", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M5C == nil {
+				m.M5C = make(map[int32]bool)
+			}
+			var mapkey int32
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M5C[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 93:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M5D", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M5D == nil {
+				m.M5D = make(map[int32]Enum)
+			}
+			var mapkey int32
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M5D[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 94:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M5E", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M5E == nil {
+				m.M5E = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M5E[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 95:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M5F", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M5F == nil {
+				m.M5F = make(map[int32][]byte)
+			}
+			var mapkey int32
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M5F[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 96:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M60", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M60 == nil {
+				m.M60 = make(map[int64]int32)
+			}
+			var mapkey int64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M60[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 97:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M61", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M61 == nil {
+				m.M61 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M61[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M62", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M62 == nil {
+				m.M62 = make(map[int64]uint32)
+			}
+			var mapkey int64
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M62[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M63", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M63 == nil {
+				m.M63 = make(map[int64]uint64)
+			}
+			var mapkey int64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M63[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M64", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M64 == nil {
+				m.M64 = make(map[int64]int32)
+			}
+			var mapkey int64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M64[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M65", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M65 == nil {
+				m.M65 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M65[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M66", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M66 == nil {
+				m.M66 = make(map[int64]uint32)
+			}
+			var mapkey int64
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M66[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M67", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M67 == nil {
+				m.M67 = make(map[int64]uint64)
+			}
+			var mapkey int64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M67[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M68", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M68 == nil {
+				m.M68 = make(map[int64]int32)
+			}
+			var mapkey int64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M68[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M69", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M69 == nil {
+				m.M69 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M69[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M6A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M6A == nil {
+				m.M6A = make(map[int64]float32)
+			}
+			var mapkey int64
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M6A[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M6B", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M6B == nil {
+				m.M6B = make(map[int64]float64)
+			}
+			var mapkey int64
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M6B[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M6C", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M6C == nil {
+				m.M6C = make(map[int64]bool)
+			}
+			var mapkey int64
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M6C[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M6D", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M6D == nil {
+				m.M6D = make(map[int64]Enum)
+			}
+			var mapkey int64
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M6D[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M6E", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M6E == nil {
+				m.M6E = make(map[int64]string)
+			}
+			var mapkey int64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M6E[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 111:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M6F", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M6F == nil {
+				m.M6F = make(map[int64][]byte)
+			}
+			var mapkey int64
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M6F[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 112:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M70", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M70 == nil {
+				m.M70 = make(map[uint32]int32)
+			}
+			var mapkey uint32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M70[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M71", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M71 == nil {
+				m.M71 = make(map[uint32]int64)
+			}
+			var mapkey uint32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M71[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M72", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M72 == nil {
+				m.M72 = make(map[uint32]uint32)
+			}
+			var mapkey uint32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M72[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 115:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M73", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M73 == nil {
+				m.M73 = make(map[uint32]uint64)
+			}
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M73[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 116:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M74", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M74 == nil {
+				m.M74 = make(map[uint32]int32)
+			}
+			var mapkey uint32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M74[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 117:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M75", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M75 == nil {
+				m.M75 = make(map[uint32]int64)
+			}
+			var mapkey uint32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M75[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 118:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M76", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M76 == nil {
+				m.M76 = make(map[uint32]uint32)
+			}
+			var mapkey uint32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M76[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 119:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M77", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M77 == nil {
+				m.M77 = make(map[uint32]uint64)
+			}
+			var mapkey uint32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M77[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 120:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M78", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M78 == nil {
+				m.M78 = make(map[uint32]int32)
+			}
+			var mapkey uint32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M78[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 121:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M79", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M79 == nil {
+				m.M79 = make(map[uint32]int64)
+			}
+			var mapkey uint32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M79[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 122:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M7A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M7A == nil {
+				m.M7A = make(map[uint32]float32)
+			}
+			var mapkey uint32
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M7A[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 123:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M7B", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M7B == nil {
+				m.M7B = make(map[uint32]float64)
+			}
+			var mapkey uint32
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M7B[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 124:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M7C", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M7C == nil {
+				m.M7C = make(map[uint32]bool)
+			}
+			var mapkey uint32
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M7C[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 125:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M7D", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M7D == nil {
+				m.M7D = make(map[uint32]Enum)
+			}
+			var mapkey uint32
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M7D[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 126:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M7E", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M7E == nil {
+				m.M7E = make(map[uint32]string)
+			}
+			var mapkey uint32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M7E[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 127:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M7F", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M7F == nil {
+				m.M7F = make(map[uint32][]byte)
+			}
+			var mapkey uint32
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M7F[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 128:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M80", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M80 == nil {
+				m.M80 = make(map[uint64]int32)
+			}
+			var mapkey uint64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M80[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 129:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M81", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M81 == nil {
+				m.M81 = make(map[uint64]int64)
+			}
+			var mapkey uint64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M81[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 130:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M82", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M82 == nil {
+				m.M82 = make(map[uint64]uint32)
+			}
+			var mapkey uint64
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M82[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 131:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M83", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M83 == nil {
+				m.M83 = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M83[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 132:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M84", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M84 == nil {
+				m.M84 = make(map[uint64]int32)
+			}
+			var mapkey uint64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M84[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 133:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M85", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M85 == nil {
+				m.M85 = make(map[uint64]int64)
+			}
+			var mapkey uint64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M85[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 134:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M86", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M86 == nil {
+				m.M86 = make(map[uint64]uint32)
+			}
+			var mapkey uint64
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M86[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 135:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M87", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M87 == nil {
+				m.M87 = make(map[uint64]uint64)
+			}
+			var mapkey uint64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M87[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 136:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M88", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M88 == nil {
+				m.M88 = make(map[uint64]int32)
+			}
+			var mapkey uint64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M88[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 137:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M89", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M89 == nil {
+				m.M89 = make(map[uint64]int64)
+			}
+			var mapkey uint64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M89[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 138:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M8A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M8A == nil {
+				m.M8A = make(map[uint64]float32)
+			}
+			var mapkey uint64
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M8A[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 139:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M8B", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M8B == nil {
+				m.M8B = make(map[uint64]float64)
+			}
+			var mapkey uint64
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M8B[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 140:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M8C", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M8C == nil {
+				m.M8C = make(map[uint64]bool)
+			}
+			var mapkey uint64
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M8C[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 141:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M8D", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M8D == nil {
+				m.M8D = make(map[uint64]Enum)
+			}
+			var mapkey uint64
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M8D[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 142:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M8E", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M8E == nil {
+				m.M8E = make(map[uint64]string)
+			}
+			var mapkey uint64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M8E[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 143:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M8F", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M8F == nil {
+				m.M8F = make(map[uint64][]byte)
+			}
+			var mapkey uint64
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M8F[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 144:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M90", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M90 == nil {
+				m.M90 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M90[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 145:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M91", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M91 == nil {
+				m.M91 = make(map[int32]int64)
+			}
+			var mapkey int32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M91[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 146:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M92", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M92 == nil {
+				m.M92 = make(map[int32]uint32)
+			}
+			var mapkey int32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M92[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 147:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M93", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M93 == nil {
+				m.M93 = make(map[int32]uint64)
+			}
+			var mapkey int32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M93[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 148:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M94", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M94 == nil {
+				m.M94 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M94[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 149:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M95", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M95 == nil {
+				m.M95 = make(map[int32]int64)
+			}
+			var mapkey int32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M95[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 150:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M96", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M96 == nil {
+				m.M96 = make(map[int32]uint32)
+			}
+			var mapkey int32
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M96[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 151:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M97", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M97 == nil {
+				m.M97 = make(map[int32]uint64)
+			}
+			var mapkey int32
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M97[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 152:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M98", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M98 == nil {
+				m.M98 = make(map[int32]int32)
+			}
+			var mapkey int32
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M98[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 153:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M99", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M99 == nil {
+				m.M99 = make(map[int32]int64)
+			}
+			var mapkey int32
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M99[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 154:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M9A", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M9A == nil {
+				m.M9A = make(map[int32]float32)
+			}
+			var mapkey int32
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M9A[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 155:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M9B", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M9B == nil {
+				m.M9B = make(map[int32]float64)
+			}
+			var mapkey int32
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M9B[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 156:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M9C", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M9C == nil {
+				m.M9C = make(map[int32]bool)
+			}
+			var mapkey int32
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M9C[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 157:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M9D", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M9D == nil {
+				m.M9D = make(map[int32]Enum)
+			}
+			var mapkey int32
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M9D[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 158:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M9E", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M9E == nil {
+				m.M9E = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M9E[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 159:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M9F", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M9F == nil {
+				m.M9F = make(map[int32][]byte)
+			}
+			var mapkey int32
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M9F[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 160:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ma0", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ma0 == nil {
+				m.Ma0 = make(map[int64]int32)
+			}
+			var mapkey int64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ma0[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 161:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ma1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ma1 == nil {
+				m.Ma1 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ma1[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 162:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ma2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ma2 == nil {
+				m.Ma2 = make(map[int64]uint32)
+			}
+			var mapkey int64
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ma2[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 163:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ma3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ma3 == nil {
+				m.Ma3 = make(map[int64]uint64)
+			}
+			var mapkey int64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ma3[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 164:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ma4", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ma4 == nil {
+				m.Ma4 = make(map[int64]int32)
+			}
+			var mapkey int64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ma4[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 165:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ma5", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ma5 == nil {
+				m.Ma5 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ma5[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 166:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ma6", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ma6 == nil {
+				m.Ma6 = make(map[int64]uint32)
+			}
+			var mapkey int64
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ma6[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 167:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ma7", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ma7 == nil {
+				m.Ma7 = make(map[int64]uint64)
+			}
+			var mapkey int64
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ma7[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 168:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ma8", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ma8 == nil {
+				m.Ma8 = make(map[int64]int32)
+			}
+			var mapkey int64
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ma8[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 169:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ma9", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ma9 == nil {
+				m.Ma9 = make(map[int64]int64)
+			}
+			var mapkey int64
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ma9[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 170:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maa", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Maa == nil {
+				m.Maa = make(map[int64]float32)
+			}
+			var mapkey int64
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Maa[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 171:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mab", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mab == nil {
+				m.Mab = make(map[int64]float64)
+			}
+			var mapkey int64
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mab[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 172:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mac", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mac == nil {
+				m.Mac = make(map[int64]bool)
+			}
+			var mapkey int64
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mac[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 173:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mad", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mad == nil {
+				m.Mad = make(map[int64]Enum)
+			}
+			var mapkey int64
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mad[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 174:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mae", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mae == nil {
+				m.Mae = make(map[int64]string)
+			}
+			var mapkey int64
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mae[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 175:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Maf == nil {
+				m.Maf = make(map[int64][]byte)
+			}
+			var mapkey int64
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Maf[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 176:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mb0", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mb0 == nil {
+				m.Mb0 = make(map[bool]int32)
+			}
+			var mapkey bool
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mb0[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 177:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mb1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mb1 == nil {
+				m.Mb1 = make(map[bool]int64)
+			}
+			var mapkey bool
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mb1[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 178:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mb2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mb2 == nil {
+				m.Mb2 = make(map[bool]uint32)
+			}
+			var mapkey bool
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mb2[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 179:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mb3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mb3 == nil {
+				m.Mb3 = make(map[bool]uint64)
+			}
+			var mapkey bool
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mb3[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 180:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mb4", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mb4 == nil {
+				m.Mb4 = make(map[bool]int32)
+			}
+			var mapkey bool
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mb4[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 181:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mb5", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mb5 == nil {
+				m.Mb5 = make(map[bool]int64)
+			}
+			var mapkey bool
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mb5[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 182:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mb6", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mb6 == nil {
+				m.Mb6 = make(map[bool]uint32)
+			}
+			var mapkey bool
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mb6[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 183:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mb7", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mb7 == nil {
+				m.Mb7 = make(map[bool]uint64)
+			}
+			var mapkey bool
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mb7[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 184:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mb8", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mb8 == nil {
+				m.Mb8 = make(map[bool]int32)
+			}
+			var mapkey bool
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mb8[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 185:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mb9", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mb9 == nil {
+				m.Mb9 = make(map[bool]int64)
+			}
+			var mapkey bool
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mb9[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 186:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mba", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mba == nil {
+				m.Mba = make(map[bool]float32)
+			}
+			var mapkey bool
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mba[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 187:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mbb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mbb == nil {
+				m.Mbb = make(map[bool]float64)
+			}
+			var mapkey bool
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mbb[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 188:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mbc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mbc == nil {
+				m.Mbc = make(map[bool]bool)
+			}
+			var mapkey bool
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mbc[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 189:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mbd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mbd == nil {
+				m.Mbd = make(map[bool]Enum)
+			}
+			var mapkey bool
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mbd[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 190:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mbe", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mbe == nil {
+				m.Mbe = make(map[bool]string)
+			}
+			var mapkey bool
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mbe[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 191:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mbf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mbf == nil {
+				m.Mbf = make(map[bool][]byte)
+			}
+			var mapkey bool
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkey = bool(mapkeytemp != 0)
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mbf[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 192:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mc0", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mc0 == nil {
+				m.Mc0 = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mc0[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 193:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mc1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mc1 == nil {
+				m.Mc1 = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mc1[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 194:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mc2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mc2 == nil {
+				m.Mc2 = make(map[string]uint32)
+			}
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mc2[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 195:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mc3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mc3 == nil {
+				m.Mc3 = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mc3[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 196:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mc4", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mc4 == nil {
+				m.Mc4 = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = int32((uint32(mapvaluetemp) >> 1) ^ uint32(((mapvaluetemp&1)<<31)>>31))
+					mapvalue = int32(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mc4[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 197:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mc5", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mc5 == nil {
+				m.Mc5 = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvaluetemp = (mapvaluetemp >> 1) ^ uint64((int64(mapvaluetemp&1)<<63)>>63)
+					mapvalue = int64(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mc5[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 198:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mc6", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mc6 == nil {
+				m.Mc6 = make(map[string]uint32)
+			}
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mc6[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 199:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mc7", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mc7 == nil {
+				m.Mc7 = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mc7[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 200:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mc8", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mc8 == nil {
+				m.Mc8 = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mc8[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 201:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mc9", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mc9 == nil {
+				m.Mc9 = make(map[string]int64)
+			}
+			var mapkey string
+			var mapvalue int64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mc9[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 202:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mca", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mca == nil {
+				m.Mca = make(map[string]float32)
+			}
+			var mapkey string
+			var mapvalue float32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint32
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+					mapvalue = math.Float32frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mca[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 203:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mcb", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mcb == nil {
+				m.Mcb = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mcb[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 204:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mcc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mcc == nil {
+				m.Mcc = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mcc[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 205:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mcd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mcd == nil {
+				m.Mcd = make(map[string]Enum)
+			}
+			var mapkey string
+			var mapvalue Enum
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= Enum(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mcd[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 206:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mce", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mce == nil {
+				m.Mce = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mce[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 207:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mcf", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mcf == nil {
+				m.Mcf = make(map[string][]byte)
+			}
+			var mapkey string
+			mapvalue := []byte{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapbyteLen uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapbyteLen |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intMapbyteLen := int(mapbyteLen)
+					if intMapbyteLen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postbytesIndex := iNdEx + intMapbyteLen
+					if postbytesIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postbytesIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = make([]byte, mapbyteLen)
+					copy(mapvalue, dAtA[iNdEx:postbytesIndex])
+					iNdEx = postbytesIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mcf[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageMaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageMaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageMaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scalars", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scalars == nil {
+				m.Scalars = &Scalars{}
+			}
+			if err := m.Scalars.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M1 == nil {
+				m.M1 = make(map[int32]*MessageMaps)
+			}
+			var mapkey int32
+			var mapvalue *MessageMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M1[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M2 == nil {
+				m.M2 = make(map[int64]*MessageMaps)
+			}
+			var mapkey int64
+			var mapvalue *MessageMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M2[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M3 == nil {
+				m.M3 = make(map[uint32]*MessageMaps)
+			}
+			var mapkey uint32
+			var mapvalue *MessageMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M3[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M4", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M4 == nil {
+				m.M4 = make(map[uint64]*MessageMaps)
+			}
+			var mapkey uint64
+			var mapvalue *MessageMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M4[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M5", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M5 == nil {
+				m.M5 = make(map[int32]*MessageMaps)
+			}
+			var mapkey int32
+			var mapvalue *MessageMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = int32((uint32(mapkeytemp) >> 1) ^ uint32(((mapkeytemp&1)<<31)>>31))
+					mapkey = int32(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M5[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M6", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M6 == nil {
+				m.M6 = make(map[int64]*MessageMaps)
+			}
+			var mapkey int64
+			var mapvalue *MessageMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var mapkeytemp uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkeytemp |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapkeytemp = (mapkeytemp >> 1) ^ uint64((int64(mapkeytemp&1)<<63)>>63)
+					mapkey = int64(mapkeytemp)
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M6[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M7", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M7 == nil {
+				m.M7 = make(map[uint32]*MessageMaps)
+			}
+			var mapkey uint32
+			var mapvalue *MessageMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M7[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M8", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M8 == nil {
+				m.M8 = make(map[uint64]*MessageMaps)
+			}
+			var mapkey uint64
+			var mapvalue *MessageMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M8[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M9", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.M9 == nil {
+				m.M9 = make(map[int32]*MessageMaps)
+			}
+			var mapkey int32
+			var mapvalue *MessageMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 4) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+					iNdEx += 4
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.M9[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ma", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ma == nil {
+				m.Ma = make(map[int64]*MessageMaps)
+			}
+			var mapkey int64
+			var mapvalue *MessageMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = int64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Ma[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Mc == nil {
+				m.Mc = make(map[string]*MessageMaps)
+			}
+			var mapkey string
+			var mapvalue *MessageMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTest
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTest
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthTest
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthTest
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &MessageMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTest(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTest
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Mc[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pathological) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pathological: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pathological: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.X = append(m.X, &Pathological_Inner{})
+			if err := m.X[len(m.X)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pathological_Inner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTest
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Inner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Inner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X1", wireType)
+			}
+			m.X1 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X1 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X2", wireType)
+			}
+			m.X2 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X2 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X3", wireType)
+			}
+			m.X3 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X3 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X4", wireType)
+			}
+			m.X4 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X4 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X5", wireType)
+			}
+			m.X5 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X5 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X6", wireType)
+			}
+			m.X6 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X6 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X7", wireType)
+			}
+			m.X7 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X7 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X8", wireType)
+			}
+			m.X8 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X8 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field X9", wireType)
+			}
+			m.X9 = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.X9 |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xa", wireType)
+			}
+			m.Xa = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Xa |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xb", wireType)
+			}
+			m.Xb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Xb |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xc", wireType)
+			}
+			m.Xc = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Xc |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xd", wireType)
+			}
+			m.Xd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Xd |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xe", wireType)
+			}
+			m.Xe = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Xe |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Xf", wireType)
+			}
+			m.Xf = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Xf |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actual", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTest
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTest
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actual = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTest(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTest
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTest(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTest
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTest
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTest
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTest
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTest
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTest        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTest          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTest = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,133 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package intern deduplicates strings in parsed messages.
+package intern
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"unique"
+	"unsafe"
+	"weak"
+
+	"buf.build/go/hyperpb/internal/zc"
+)
+
+// Table interns strings using [unique.Make], so that identical strings
+// returned from different messages, even from different parses, share memory.
+//
+// A Table additionally tracks how many of the strings it interned were already
+// interned, without keeping any of them alive.
+type Table struct {
+	// Set of the data pointers of strings this table has interned that are
+	// still alive. Entries are removed when the string is collected.
+	seen sync.Map // [weak.Pointer[byte]]struct{}
+
+	strings, hits, bytes, hitBytes atomic.Uint64
+}
+
+// Stats are statistics about a [Table].
+type Stats struct {
+	// The number of strings interned, and the number of those which had been
+	// interned before by the same table.
+	Strings, Hits uint64
+	// The total sizes of the above.
+	Bytes, HitBytes uint64
+}
+
+// String returns the canonical copy of s.
+func (t *Table) String(s string) string {
+	if s == "" {
+		return s
+	}
+
+	// unique.Make always clones s, so c does not alias a message's memory.
+	c := unique.Make(s).Value()
+	n := uint64(len(c))
+	t.strings.Add(1)
+	t.bytes.Add(n)
+
+	data := unsafe.StringData(c)
+	key := weak.Make(data)
+	if _, loaded := t.seen.LoadOrStore(key, struct{}{}); loaded {
+		t.hits.Add(1)
+		t.hitBytes.Add(n)
+		return c
+	}
+
+	runtime.AddCleanup(data, func(key weak.Pointer[byte]) { t.seen.Delete(key) }, key)
+	return c
+}
+
+// Stats returns statistics about this table.
+func (t *Table) Stats() Stats {
+	return Stats{
+		Strings:  t.strings.Load(),
+		Hits:     t.hits.Load(),
+		Bytes:    t.bytes.Load(),
+		HitBytes: t.hitBytes.Load(),
+	}
+}
+
+// Local deduplicates strings within the input of a single parse, such as the
+// concatenated inputs of a batch.
+//
+// Strings are identified by their ranges in that input: the first range with
+// a given value becomes the canonical one, and later ranges with the same
+// value are replaced with it as they are parsed. A Local must be reset before
+// it is used with a different input.
+//
+// A Local is not safe for concurrent use. A zero Local is ready to use.
+type Local struct {
+	ranges map[string]zc.Range
+	stats  Stats
+}
+
+// Range returns the canonical range for the string at r in src.
+func (l *Local) Range(src *byte, r zc.Range) zc.Range {
+	if r.Len() == 0 {
+		return r
+	}
+
+	// s aliases src, which outlives every key, since l is reset before src
+	// is released.
+	s := r.String(src)
+	n := uint64(len(s))
+	l.stats.Strings++
+	l.stats.Bytes += n
+	if c, ok := l.ranges[s]; ok {
+		l.stats.Hits++
+		l.stats.HitBytes += n
+		return c
+	}
+
+	if l.ranges == nil {
+		l.ranges = make(map[string]zc.Range)
+	}
+	l.ranges[s] = r
+	return r
+}
+
+// Stats returns statistics about this table.
+func (l *Local) Stats() Stats {
+	return l.stats
+}
+
+// Reset discards every string in this table, and its statistics.
+func (l *Local) Reset() {
+	clear(l.ranges)
+	l.stats = Stats{}
+}
@@ -47,6 +47,12 @@ type Component[Node comparable] struct {
 // Sort sorts the strongly connected components of a directed graph
 // represented by deps, using Tarjan's algorithm.
 func Sort[Node comparable](root Node, graph Graph[Node]) *DAG[Node] {
+	return SortAll([]Node{root}, graph)
+}
+
+// SortAll is like [Sort], but sorts the nodes reachable from any of the given
+// roots.
+func SortAll[Node comparable](roots []Node, graph Graph[Node]) *DAG[Node] {
 	out := &DAG[Node]{keys: make(map[Node]int)}
 	sorter := &tarjan[Node]{
 		graph: graph,
@@ -55,7 +61,11 @@ func Sort[Node comparable](root Node, graph Graph[Node]) *DAG[Node] {
 		metadata: make(map[Node]*metadata),
 		depset:   make(map[int]struct{}),
 	}
-	sorter.rec(root)
+	for _, root := range roots {
+		if sorter.metadata[root] == nil {
+			sorter.rec(root)
+		}
+	}
 
 	return out
 }
@@ -128,6 +128,24 @@ func TestSort(t *testing.T) {
 	}
 }
 
+func TestSortAll(t *testing.T) {
+	t.Parallel()
+
+	// Two roots which share a dependency, and a node which is unreachable.
+	g := parseGraph(`..#.
+					 ..#.
+					 ....
+					 ....`)
+	dag := scc.SortAll([]int{0, 1, 2}, g.deps)
+
+	var got [][]int
+	for c := range dag.Topological() {
+		got = append(got, c.Members())
+	}
+	assert.Equal(t, [][]int{{2}, {0}, {1}}, got)
+	assert.Nil(t, dag.ForNode(3))
+}
+
 // graph is a directed in matrix form. There is an edge from n to m if
 // the value at matrix[nodes*n+m] is true.
 type graph struct {
@@ -0,0 +1,69 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import "sync/atomic"
+
+// Bounds tracks the minimum and maximum of an integer statistic.
+//
+// The zero value is ready to use. All methods may be called concurrently.
+type Bounds struct {
+	// Both bounds are stored such that the zero value is the identity for
+	// taking the maximum: hi holds the maximum in an encoding that maps
+	// math.MinInt64 to zero while preserving order, and lo holds the
+	// complement of that encoding of the minimum.
+	lo, hi atomic.Uint64
+	n      atomic.Int64 // Total number of samples ever.
+}
+
+// Record records a sample.
+func (b *Bounds) Record(sample int64) {
+	e := uint64(sample) ^ (1 << 63)
+	storeMax(&b.hi, e)
+	storeMax(&b.lo, ^e)
+	b.n.Add(1)
+}
+
+// Get returns the smallest and largest samples. Returns false if no samples
+// have been recorded.
+func (b *Bounds) Get() (lo, hi int64, ok bool) {
+	if b.n.Load() == 0 {
+		return 0, 0, false
+	}
+	lo = int64(^b.lo.Load() ^ (1 << 63))
+	hi = int64(b.hi.Load() ^ (1 << 63))
+	return lo, hi, true
+}
+
+// Merge adds all of the samples from that to b.
+func (b *Bounds) Merge(that *Bounds) {
+	n := that.n.Load()
+	if n == 0 {
+		return
+	}
+	storeMax(&b.hi, that.hi.Load())
+	storeMax(&b.lo, that.lo.Load())
+	b.n.Add(n)
+}
+
+// storeMax sets p to the maximum of its value and v.
+func storeMax(p *atomic.Uint64, v uint64) {
+	for {
+		old := p.Load()
+		if old >= v || p.CompareAndSwap(old, v) {
+			return
+		}
+	}
+}
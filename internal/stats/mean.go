@@ -14,25 +14,39 @@
 
 package stats
 
-import "buf.build/go/hyperpb/internal/xsync"
+import (
+	"math/rand/v2"
+
+	"buf.build/go/hyperpb/internal/xsync"
+)
+
+// meanShards is the number of shards a [Mean] spreads its samples over, to
+// reduce contention when many goroutines record samples at once.
+const meanShards = 8
 
 // Mean tracks an average statistic.
 //
-// The zero value is ready to use. [Mean.Record] may be called concurrently,
-// but not with [Mean.Get].
+// The zero value is ready to use. All methods may be called concurrently.
 type Mean struct {
+	shards [meanShards]meanShard
+}
+
+type meanShard struct {
 	total, samples xsync.AtomicFloat64
+
+	_ [64 - 16]byte // Pad to a cache line, to avoid false sharing.
 }
 
 // Record records a sample.
 func (m *Mean) Record(sample float64) {
-	m.total.Add(sample)
-	m.samples.Add(1)
+	s := &m.shards[rand.Uint32()%meanShards]
+	s.total.Add(sample)
+	s.samples.Add(1)
 }
 
 // Get returns the mean value of this statistic.
 func (m *Mean) Get() float64 {
-	total, samples := m.total.Load(), m.samples.Load()
+	total, samples := m.sum()
 	if samples == 0 {
 		return 0
 	}
@@ -41,6 +55,16 @@ func (m *Mean) Get() float64 {
 
 // Merge adds all of the samples from that to m.
 func (m *Mean) Merge(that *Mean) {
-	m.total.Add(that.total.Load())
-	m.samples.Add(that.samples.Load())
+	total, samples := that.sum()
+	m.shards[0].total.Add(total)
+	m.shards[0].samples.Add(samples)
+}
+
+// sum returns the sum of all shards.
+func (m *Mean) sum() (total, samples float64) {
+	for i := range m.shards {
+		total += m.shards[i].total.Load()
+		samples += m.shards[i].samples.Load()
+	}
+	return total, samples
 }
@@ -15,17 +15,18 @@
 package stats
 
 import (
+	"math"
 	"slices"
 	"sync/atomic"
 )
 
 // Median tracks a median statistic.
 //
-// Must be constructed with [NewMedian]. [Median.Record] may be called
-// concurrently, but not with [Median.Get].
+// Must be constructed with [NewMedian]. All methods may be called
+// concurrently.
 type Median struct {
-	// Implemented as a ring buffer of samples.
-	samples []float64
+	// Implemented as a ring buffer of samples, stored as their bits.
+	samples []atomic.Uint64
 	w       atomic.Int64 // Offset at which to write the next sample.
 	n       atomic.Int64 // Total number of samples ever.
 }
@@ -34,12 +35,12 @@ type Median struct {
 //
 // n should be relatively large, at least 100.
 func NewMedian(n int) *Median {
-	return &Median{samples: make([]float64, n)}
+	return &Median{samples: make([]atomic.Uint64, n)}
 }
 
 // Record records a sample.
 func (m *Median) Record(sample float64) {
-	// Lock the buffer by setting w to -1.
+	// Claim a slot by advancing w.
 again:
 	w := m.w.Load()
 	next := w + 1
@@ -49,20 +50,19 @@ again:
 	if !m.w.CompareAndSwap(w, next) {
 		goto again
 	}
-	m.n.Add(1)
 
-	// Technically this may race if len(samples) is small enough and enough
-	// goroutines are hammering this value, but the worst that will happen is
-	// we get one torn data point that will eventually get overwritten.
-	m.samples[w] = sample
+	// If len(samples) is small enough and enough goroutines are hammering
+	// this value, a slot may be claimed twice, but the worst that will happen
+	// is that we lose one data point.
+	m.samples[w].Store(math.Float64bits(sample))
+	m.n.Add(1)
 }
 
 // Get returns the median value of this statistic.
 func (m *Median) Get() float64 {
-	samples := m.samples[:min(int(m.n.Load()), len(m.samples))]
 	// For now, we copy and sort, but in principle we could also use median
 	// of medians to avoid the copy.
-	samples = slices.Clone(samples)
+	samples := m.load()
 	slices.Sort(samples)
 
 	switch {
@@ -76,3 +76,20 @@ func (m *Median) Get() float64 {
 		return samples[len(samples)/2]
 	}
 }
+
+// Merge records all of the samples remembered by that into m. If that
+// remembers more samples than m can, only some of them are kept.
+func (m *Median) Merge(that *Median) {
+	for _, sample := range that.load() {
+		m.Record(sample)
+	}
+}
+
+// load returns a copy of the remembered samples.
+func (m *Median) load() []float64 {
+	samples := make([]float64, min(int(m.n.Load()), len(m.samples)))
+	for i := range samples {
+		samples[i] = math.Float64frombits(m.samples[i].Load())
+	}
+	return samples
+}
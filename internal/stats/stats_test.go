@@ -15,6 +15,7 @@
 package stats_test
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -37,3 +38,71 @@ func TestMean(t *testing.T) {
 	m.Record(-10)
 	assert.Equal(t, m.Get(), float64(1)/3) //nolint:testifylint
 }
+
+func TestMeanConcurrent(t *testing.T) {
+	t.Parallel()
+
+	m := new(stats.Mean)
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range 1000 {
+				m.Record(float64(i))
+			}
+		}()
+	}
+	wg.Wait()
+	assert.InDelta(t, 3.5, m.Get(), 1e-9)
+
+	m2 := new(stats.Mean)
+	m2.Record(100)
+	m2.Merge(m)
+	assert.InDelta(t, (100+3.5*8000)/8001, m2.Get(), 1e-9)
+}
+
+func TestMedian(t *testing.T) {
+	t.Parallel()
+
+	m := stats.NewMedian(100)
+	assert.Equal(t, m.Get(), float64(0)) //nolint:testifylint
+
+	for _, x := range []float64{5, 1, 3} {
+		m.Record(x)
+	}
+	assert.Equal(t, m.Get(), float64(3)) //nolint:testifylint
+
+	m2 := stats.NewMedian(100)
+	m2.Record(10)
+	m2.Merge(m)
+	assert.Equal(t, m2.Get(), float64(4)) //nolint:testifylint
+}
+
+func TestBounds(t *testing.T) {
+	t.Parallel()
+
+	b := new(stats.Bounds)
+	_, _, ok := b.Get()
+	assert.False(t, ok)
+
+	b.Record(5)
+	lo, hi, ok := b.Get()
+	assert.True(t, ok)
+	assert.Equal(t, [2]int64{5, 5}, [2]int64{lo, hi})
+
+	for _, x := range []int64{-3, 1000, 0} {
+		b.Record(x)
+	}
+	lo, hi, _ = b.Get()
+	assert.Equal(t, [2]int64{-3, 1000}, [2]int64{lo, hi})
+
+	b2 := new(stats.Bounds)
+	b2.Merge(new(stats.Bounds))
+	_, _, ok = b2.Get()
+	assert.False(t, ok)
+	b2.Record(2000)
+	b2.Merge(b)
+	lo, hi, _ = b2.Get()
+	assert.Equal(t, [2]int64{-3, 2000}, [2]int64{lo, hi})
+}
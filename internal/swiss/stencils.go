@@ -1454,6 +1454,715 @@ func InsertU64xP(t *Table[uint64, unsafe.Pointer], k uint64, extract func(uint64
 	return values.Get(idx)
 }
 
+func InsertUniqueU8xU8(t *Table[uint8, uint8], k uint8, extract func(uint8) []byte) *uint8 {
+	_ = (*Table[uint8, uint8]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU8xU8(t, h, k)
+		} else {
+			_, occupied = searchFuncU8xU8(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU8xU8(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint8](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[uint8](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+func InsertUniqueU32xU8(t *Table[uint32, uint8], k uint32, extract func(uint32) []byte) *uint8 {
+	_ = (*Table[uint32, uint8]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU32xU8(t, h, k)
+		} else {
+			_, occupied = searchFuncU32xU8(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU32xU8(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint32](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[uint8](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+func InsertUniqueU64xU8(t *Table[uint64, uint8], k uint64, extract func(uint64) []byte) *uint8 {
+	_ = (*Table[uint64, uint8]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU64xU8(t, h, k)
+		} else {
+			_, occupied = searchFuncU64xU8(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU64xU8(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint64](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[uint8](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+func InsertUniqueU8xU32(t *Table[uint8, uint32], k uint8, extract func(uint8) []byte) *uint32 {
+	_ = (*Table[uint8, uint32]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU8xU32(t, h, k)
+		} else {
+			_, occupied = searchFuncU8xU32(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU8xU32(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint8](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[uint32](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+func InsertUniqueU32xU32(t *Table[uint32, uint32], k uint32, extract func(uint32) []byte) *uint32 {
+	_ = (*Table[uint32, uint32]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU32xU32(t, h, k)
+		} else {
+			_, occupied = searchFuncU32xU32(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU32xU32(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint32](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[uint32](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+func InsertUniqueU64xU32(t *Table[uint64, uint32], k uint64, extract func(uint64) []byte) *uint32 {
+	_ = (*Table[uint64, uint32]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU64xU32(t, h, k)
+		} else {
+			_, occupied = searchFuncU64xU32(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU64xU32(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint64](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[uint32](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+func InsertUniqueU8xU64(t *Table[uint8, uint64], k uint8, extract func(uint8) []byte) *uint64 {
+	_ = (*Table[uint8, uint64]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU8xU64(t, h, k)
+		} else {
+			_, occupied = searchFuncU8xU64(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU8xU64(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint8](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[uint64](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+func InsertUniqueU32xU64(t *Table[uint32, uint64], k uint32, extract func(uint32) []byte) *uint64 {
+	_ = (*Table[uint32, uint64]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU32xU64(t, h, k)
+		} else {
+			_, occupied = searchFuncU32xU64(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU32xU64(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint32](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[uint64](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+func InsertUniqueU64xU64(t *Table[uint64, uint64], k uint64, extract func(uint64) []byte) *uint64 {
+	_ = (*Table[uint64, uint64]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU64xU64(t, h, k)
+		} else {
+			_, occupied = searchFuncU64xU64(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU64xU64(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint64](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[uint64](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+func InsertUniqueU8xP(t *Table[uint8, unsafe.Pointer], k uint8, extract func(uint8) []byte) *unsafe.Pointer {
+	_ = (*Table[uint8, unsafe.Pointer]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU8xP(t, h, k)
+		} else {
+			_, occupied = searchFuncU8xP(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU8xP(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint8](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[unsafe.Pointer](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+func InsertUniqueU32xP(t *Table[uint32, unsafe.Pointer], k uint32, extract func(uint32) []byte) *unsafe.Pointer {
+	_ = (*Table[uint32, unsafe.Pointer]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU32xP(t, h, k)
+		} else {
+			_, occupied = searchFuncU32xP(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU32xP(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint32](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[unsafe.Pointer](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+func InsertUniqueU64xP(t *Table[uint64, unsafe.Pointer], k uint64, extract func(uint64) []byte) *unsafe.Pointer {
+	_ = (*Table[uint64, unsafe.Pointer]).InsertUnique
+	if t.len == t.soft {
+		return nil
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = searchU64xP(t, h, k)
+		} else {
+			_, occupied = searchFuncU64xP(t, h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := vacantU64xP(t, h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[uint64](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[unsafe.Pointer](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+
+func vacantU8xU8(t *Table[uint8, uint8], h hash) int {
+	_ = (*Table[uint8, uint8]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+func vacantU32xU8(t *Table[uint32, uint8], h hash) int {
+	_ = (*Table[uint32, uint8]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+func vacantU64xU8(t *Table[uint64, uint8], h hash) int {
+	_ = (*Table[uint64, uint8]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+func vacantU8xU32(t *Table[uint8, uint32], h hash) int {
+	_ = (*Table[uint8, uint32]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+func vacantU32xU32(t *Table[uint32, uint32], h hash) int {
+	_ = (*Table[uint32, uint32]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+func vacantU64xU32(t *Table[uint64, uint32], h hash) int {
+	_ = (*Table[uint64, uint32]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+func vacantU8xU64(t *Table[uint8, uint64], h hash) int {
+	_ = (*Table[uint8, uint64]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+func vacantU32xU64(t *Table[uint32, uint64], h hash) int {
+	_ = (*Table[uint32, uint64]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+func vacantU64xU64(t *Table[uint64, uint64], h hash) int {
+	_ = (*Table[uint64, uint64]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+func vacantU8xP(t *Table[uint8, unsafe.Pointer], h hash) int {
+	_ = (*Table[uint8, unsafe.Pointer]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+func vacantU32xP(t *Table[uint32, unsafe.Pointer], h hash) int {
+	_ = (*Table[uint32, unsafe.Pointer]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+func vacantU64xP(t *Table[uint64, unsafe.Pointer], h hash) int {
+	_ = (*Table[uint64, unsafe.Pointer]).vacant
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
 func searchU8xU8(t *Table[uint8, uint8], h hash, k uint8) (idx int, occupied bool) {
 	_ = (*Table[uint8, uint8]).search
 	t.log("search", "h: %v, k: %v", h, k)
@@ -201,6 +201,15 @@ func (t *Table[K, V]) Init(len int, from *Table[K, V], extract func(K) []byte) *
 	}
 }
 
+// InitSeeded is like [Table.Init] without a table to copy from, but uses the
+// given hash seed rather than a random one, so that tables with the same
+// contents inserted in the same order are identical byte-for-byte.
+func (t *Table[K, V]) InitSeeded(len int, seed uint64) *Table[K, V] {
+	t.Init(len, nil, nil)
+	t.seed = hash(seed)
+	return t
+}
+
 // Len returns this table's length.
 func (t *Table[K, V]) Len() int {
 	return int(t.len)
@@ -272,6 +281,48 @@ func (t *Table[K, V]) Insert(k K, extract func(K) []byte) *V {
 	return values.Get(idx)
 }
 
+// InsertUnique is like [Table.Insert], but k must not already be present in the
+// table. This avoids comparing k with the keys already in the table, which is
+// useful when building a table out of keys that are known to be distinct, such
+// as because they are sorted.
+//
+// Returns nil if the table would grow too large.
+func (t *Table[K, V]) InsertUnique(k K, extract func(K) []byte) *V {
+	if t.len == t.soft {
+		return nil // Tell the caller to reallocate.
+	}
+
+	var h hash
+	if extract == nil {
+		h = t.seed.u64(zext(k))
+	} else {
+		h = t.seed.bytes(extract(k))
+	}
+	if debug.Enabled {
+		var occupied bool
+		if extract == nil {
+			_, occupied = t.search(h, k)
+		} else {
+			_, occupied = t.searchFunc(h, extract(k), extract)
+		}
+		debug.Assert(!occupied, "InsertUnique() called with duplicate key %v", k)
+	}
+	idx := t.vacant(h)
+
+	ctrl := xunsafe.Beyond[ctrl](t)
+	last := ctrl.Get(int(t.hard) / ctrlSize)
+	keys := xunsafe.Beyond[K](last)
+	last2 := keys.Get(int(t.hard) - 1)
+	values := xunsafe.Beyond[V](last2)
+
+	mirrored := t.mirrorIndex(idx)
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(idx) = h.h2()
+	*xunsafe.Cast[xunsafe.VLA[byte]](ctrl).Get(mirrored) = h.h2()
+	*keys.Get(idx) = k
+	t.len++
+	return values.Get(idx)
+}
+
 func (t *Table[K, V]) mirrorIndex(idx int) int {
 	mask := int(t.hard - 1)
 	cloned := ctrlSize - 1
@@ -444,6 +495,31 @@ func (t *Table[K, V]) searchFunc(h hash, k []byte, extract func(K) []byte) (idx
 	}
 }
 
+// vacant is like search, but only looks for an empty slot, for inserting a key
+// that is known to not be present.
+func (t *Table[K, V]) vacant(h hash) int {
+	empty := broadcast(empty)
+
+	p := newProber(xunsafe.Beyond[ctrl](t), int(t.hard), h)
+	len := 0
+	for {
+		debug.Assert(p.i <= p.mask, "full table: %#v", p)
+		len++
+
+		var i int
+		var ctrl ctrl
+		p, i, ctrl = p.next()
+
+		j := ctrl.first(empty)
+		if j < ctrlSize {
+			n := i + j
+			t.log("found vacant", "%v,%v = %v", i, j, n)
+			t.recordProbeSeq(len)
+			return n & (int(t.hard) - 1)
+		}
+	}
+}
+
 // XXX: Go bizarrely does not inline the below functions, so they are manually
 // inlined in some places above.
 
@@ -584,6 +660,32 @@ func (t *Table[K, V]) Dump() string {
 //hyperpb:stencil InsertU32xP Table.Insert[uint32, unsafe.Pointer] search -> searchU32xP searchFunc -> searchFuncU32xP
 //hyperpb:stencil InsertU64xP Table.Insert[uint64, unsafe.Pointer] search -> searchU64xP searchFunc -> searchFuncU64xP
 
+//hyperpb:stencil InsertUniqueU8xU8 Table.InsertUnique[uint8, uint8] search -> searchU8xU8 searchFunc -> searchFuncU8xU8 vacant -> vacantU8xU8
+//hyperpb:stencil InsertUniqueU32xU8 Table.InsertUnique[uint32, uint8] search -> searchU32xU8 searchFunc -> searchFuncU32xU8 vacant -> vacantU32xU8
+//hyperpb:stencil InsertUniqueU64xU8 Table.InsertUnique[uint64, uint8] search -> searchU64xU8 searchFunc -> searchFuncU64xU8 vacant -> vacantU64xU8
+//hyperpb:stencil InsertUniqueU8xU32 Table.InsertUnique[uint8, uint32] search -> searchU8xU32 searchFunc -> searchFuncU8xU32 vacant -> vacantU8xU32
+//hyperpb:stencil InsertUniqueU32xU32 Table.InsertUnique[uint32, uint32] search -> searchU32xU32 searchFunc -> searchFuncU32xU32 vacant -> vacantU32xU32
+//hyperpb:stencil InsertUniqueU64xU32 Table.InsertUnique[uint64, uint32] search -> searchU64xU32 searchFunc -> searchFuncU64xU32 vacant -> vacantU64xU32
+//hyperpb:stencil InsertUniqueU8xU64 Table.InsertUnique[uint8, uint64] search -> searchU8xU64 searchFunc -> searchFuncU8xU64 vacant -> vacantU8xU64
+//hyperpb:stencil InsertUniqueU32xU64 Table.InsertUnique[uint32, uint64] search -> searchU32xU64 searchFunc -> searchFuncU32xU64 vacant -> vacantU32xU64
+//hyperpb:stencil InsertUniqueU64xU64 Table.InsertUnique[uint64, uint64] search -> searchU64xU64 searchFunc -> searchFuncU64xU64 vacant -> vacantU64xU64
+//hyperpb:stencil InsertUniqueU8xP Table.InsertUnique[uint8, unsafe.Pointer] search -> searchU8xP searchFunc -> searchFuncU8xP vacant -> vacantU8xP
+//hyperpb:stencil InsertUniqueU32xP Table.InsertUnique[uint32, unsafe.Pointer] search -> searchU32xP searchFunc -> searchFuncU32xP vacant -> vacantU32xP
+//hyperpb:stencil InsertUniqueU64xP Table.InsertUnique[uint64, unsafe.Pointer] search -> searchU64xP searchFunc -> searchFuncU64xP vacant -> vacantU64xP
+
+//hyperpb:stencil vacantU8xU8 Table.vacant[uint8, uint8]
+//hyperpb:stencil vacantU32xU8 Table.vacant[uint32, uint8]
+//hyperpb:stencil vacantU64xU8 Table.vacant[uint64, uint8]
+//hyperpb:stencil vacantU8xU32 Table.vacant[uint8, uint32]
+//hyperpb:stencil vacantU32xU32 Table.vacant[uint32, uint32]
+//hyperpb:stencil vacantU64xU32 Table.vacant[uint64, uint32]
+//hyperpb:stencil vacantU8xU64 Table.vacant[uint8, uint64]
+//hyperpb:stencil vacantU32xU64 Table.vacant[uint32, uint64]
+//hyperpb:stencil vacantU64xU64 Table.vacant[uint64, uint64]
+//hyperpb:stencil vacantU8xP Table.vacant[uint8, unsafe.Pointer]
+//hyperpb:stencil vacantU32xP Table.vacant[uint32, unsafe.Pointer]
+//hyperpb:stencil vacantU64xP Table.vacant[uint64, unsafe.Pointer]
+
 //hyperpb:stencil searchU8xU8 Table.search[uint8, uint8]
 //hyperpb:stencil searchU32xU8 Table.search[uint32, uint8]
 //hyperpb:stencil searchU64xU8 Table.search[uint64, uint8]
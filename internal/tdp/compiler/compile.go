@@ -18,8 +18,10 @@ import (
 	"cmp"
 	"fmt"
 	"iter"
+	"math"
 	"runtime"
 	"slices"
+	"strings"
 	"unsafe"
 
 	"google.golang.org/protobuf/encoding/protowire"
@@ -28,6 +30,7 @@ import (
 
 	"buf.build/go/hyperpb/internal/arena"
 	"buf.build/go/hyperpb/internal/debug"
+	"buf.build/go/hyperpb/internal/intern"
 	"buf.build/go/hyperpb/internal/scc"
 	"buf.build/go/hyperpb/internal/swiss"
 	"buf.build/go/hyperpb/internal/tdp"
@@ -42,6 +45,41 @@ type Options struct {
 	Profile    profile.Profile
 	Extensions ExtensionResolver
 
+	// Transforms to apply to string and bytes fields, by full name.
+	Transforms map[protoreflect.FullName]tdp.Transform
+
+	// If set, singular string fields are interned when parsed.
+	InternStrings bool
+
+	// If set, reflection accesses to fields can be counted.
+	TrackAccesses bool
+
+	// Fields whose placement in the hot or cold region of a message is fixed,
+	// rather than determined by the profile. True means hot.
+	Pinned map[protoreflect.FullName]bool
+
+	// Repeated bool fields to store as bitsets, by full name.
+	BitsetBools map[protoreflect.FullName]bool
+
+	// Singular string and bytes fields whose contents are secret, by full
+	// name.
+	Secrets map[protoreflect.FullName]bool
+
+	// Repeated message fields of which only one in every so many elements is
+	// parsed, by full name.
+	Samples map[protoreflect.FullName]int
+
+	// Maximum encoded sizes of messages, by full name of their type.
+	MaxSizes map[protoreflect.FullName]int
+
+	// If set, deprecated fields that can be elided are compiled with no
+	// storage, and parsed as unknown fields.
+	ElideDeprecated bool
+
+	// If set, validates string fields that require UTF-8, in place of the
+	// parser's built-in validation.
+	ValidUTF8 func([]byte) bool
+
 	// Backend connects a [compiler] with backend configuration defined in another
 	// package.
 	//
@@ -56,6 +94,12 @@ type Options struct {
 		// Returns nil if the field is not supported yet.
 		SelectArchetype(protoreflect.FieldDescriptor, profile.Field) *Archetype
 
+		// SelectTransformArchetype is like SelectArchetype, but for fields
+		// that have a transform in [Options].Transforms.
+		//
+		// Returns nil if the field cannot be transformed.
+		SelectTransformArchetype(protoreflect.FieldDescriptor, profile.Field) *Archetype
+
 		// PopulateMethods gives the backend an opportunity to populate the
 		// fast-path methods of the generated type.
 		PopulateMethods(*protoiface.Methods)
@@ -64,11 +108,27 @@ type Options struct {
 
 // Compile compiles a descriptor into a [Type], for optimized parsing.
 //
-// Panics if md is too complicated (i.e. it exceeds internal limitations for the compiler).
-func Compile(md protoreflect.MessageDescriptor, options Options) *tdp.Type {
+// Returns an [*UnsupportedError] if the backend does not support some of the
+// fields reachable from md. Panics if md is too complicated (i.e. it exceeds
+// internal limitations for the compiler).
+func Compile(md protoreflect.MessageDescriptor, options Options) (*tdp.Type, error) {
+	lib, err := CompileLibrary([]protoreflect.MessageDescriptor{md}, options)
+	if err != nil {
+		return nil, err
+	}
+	return lib.Types[md], nil
+}
+
+// CompileLibrary is like [Compile], but compiles several descriptors into a
+// single [tdp.Library], so that types they have in common are only compiled
+// once.
+//
+// Returns an [*UnsupportedError] under the same conditions as [Compile], and
+// panics if any of mds is too complicated.
+func CompileLibrary(mds []protoreflect.MessageDescriptor, options Options) (*tdp.Library, error) {
 	c := &compiler{
 		Options: options,
-		root:    md,
+		roots:   mds,
 
 		types:   make(map[protoreflect.MessageDescriptor]*ir),
 		sccInfo: make(map[*scc.Component[*ir]]*sccInfo),
@@ -76,13 +136,13 @@ func Compile(md protoreflect.MessageDescriptor, options Options) *tdp.Type {
 		fdCache: make(map[protoreflect.MessageDescriptor][]protoreflect.ExtensionDescriptor),
 	}
 
-	return c.compile(md)
+	return c.compile()
 }
 
 // compiler converts descriptors into [tdp.Type]s.
 type compiler struct {
 	Options
-	root  protoreflect.MessageDescriptor
+	roots []protoreflect.MessageDescriptor
 	types map[protoreflect.MessageDescriptor]*ir
 
 	linker.Linker
@@ -91,17 +151,47 @@ type compiler struct {
 	sccInfo map[*scc.Component[*ir]]*sccInfo
 
 	fdCache map[protoreflect.MessageDescriptor][]protoreflect.FieldDescriptor
+
+	// Fields for which the backend could not select an archetype.
+	unsupported []protoreflect.FieldDescriptor
+}
+
+// UnsupportedError is returned by [Compile] when the backend does not support
+// some of the fields reachable from the message being compiled.
+type UnsupportedError struct {
+	Fields []protoreflect.FieldDescriptor
+}
+
+// Error implements [error].
+func (e *UnsupportedError) Error() string {
+	var b strings.Builder
+	b.WriteString("hyperpb: unsupported fields: ")
+	for i, fd := range e.Fields {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(string(fd.FullName()))
+	}
+	return b.String()
 }
 
-func (c *compiler) compile(md protoreflect.MessageDescriptor) *tdp.Type {
+func (c *compiler) compile() (*tdp.Library, error) {
 	if debug.Enabled {
 		if profile, ok := c.Profile.(*profile.Recorder); ok {
 			c.log("pgo", "\n%s", profile.Dump())
 		}
 	}
 
-	c.recurse(md)
-	c.dag = scc.Sort(c.types[md], func(ty *ir) iter.Seq[*ir] {
+	roots := make([]*ir, len(c.roots))
+	for i, md := range c.roots {
+		c.recurse(md)
+		roots[i] = c.types[md]
+	}
+	if c.unsupported != nil {
+		return nil, &UnsupportedError{Fields: c.unsupported}
+	}
+
+	c.dag = scc.SortAll(roots, func(ty *ir) iter.Seq[*ir] {
 		return func(yield func(*ir) bool) {
 			for _, t := range ty.t {
 				md := fieldMessage(t.d)
@@ -118,6 +208,7 @@ func (c *compiler) compile(md protoreflect.MessageDescriptor) *tdp.Type {
 		for _, ir := range cycle.Members() {
 			ir.doLayout(c)
 			ir.doSchedule(c)
+			ir.lint(c.sccInfo[cycle])
 			c.codegen(ir)
 		}
 	}
@@ -132,7 +223,7 @@ func (c *compiler) compile(md protoreflect.MessageDescriptor) *tdp.Type {
 	if err != nil {
 		// This only panics if the compiler hits a hard limit somewhere; this is
 		// not really an error that can be meaningfully handled.
-		panic(fmt.Errorf("hyperpb: failed to link parser for %s: %w", c.root.FullName(), err))
+		panic(fmt.Errorf("hyperpb: failed to link parser for %s: %w", c.roots[0].FullName(), err))
 	}
 
 	c.log("bytes", "%d", len(buf))
@@ -142,6 +233,9 @@ func (c *compiler) compile(md protoreflect.MessageDescriptor) *tdp.Type {
 	lib := &tdp.Library{
 		Base:  xunsafe.Cast[tdp.Type](unsafe.SliceData(buf)),
 		Types: make(map[protoreflect.MessageDescriptor]*tdp.Type),
+		Bytes: len(buf) + len(auxes)*int(unsafe.Sizeof(tdp.Aux{})),
+
+		ValidUTF8: c.ValidUTF8,
 	}
 	requiredSet := make(map[int32]struct{})
 	var i int
@@ -153,9 +247,42 @@ func (c *compiler) compile(md protoreflect.MessageDescriptor) *tdp.Type {
 		ty.Library = lib
 		ty.Descriptor = sym.ty
 		ty.FieldDescriptors = c.fdCache[sym.ty]
+		ty.Diagnostics = c.types[sym.ty].diagnostics
 
 		c.Backend.PopulateMethods(&ty.Methods)
 
+		if ty.SizeLimited {
+			ty.MaxSize = uint32(min(c.MaxSizes[sym.ty.FullName()], math.MaxUint32))
+		}
+		if c.InternStrings {
+			ty.Interner = new(intern.Table)
+		}
+		for i, fd := range ty.FieldDescriptors {
+			prof := c.types[sym.ty].t[i].prof
+			if prof.Elided {
+				ty.Elided = append(ty.Elided, int32(i))
+			}
+			if prof.Intern && ty.Interner == nil {
+				ty.Interner = new(intern.Table)
+			}
+			if fn := c.Transforms[fd.FullName()]; fn != nil {
+				if ty.Transforms == nil {
+					ty.Transforms = make(map[int32]tdp.Transform)
+				}
+				ty.Transforms[int32(fd.Number())] = fn
+			}
+			if m := fieldMessage(fd); isEnum(fd) ||
+				m != nil && c.sccInfo[c.dag.ForNode(c.types[m])].hasEnums {
+				ty.Enums = append(ty.Enums, int32(i))
+			}
+			if n := c.Samples[fd.FullName()]; n > 1 {
+				if ty.Samples == nil {
+					ty.Samples = make(map[int32]uint32)
+				}
+				ty.Samples[int32(fd.Number())] = uint32(n)
+			}
+		}
+
 		// Find which fields are required or contain required fields.
 		for _, fd := range ty.FieldDescriptors {
 			if fd.IsExtension() {
@@ -193,9 +320,8 @@ func (c *compiler) compile(md protoreflect.MessageDescriptor) *tdp.Type {
 		})
 	}
 
-	entry := lib.Types[md]
-	c.log("done", "%v", entry)
-	return entry
+	c.log("done", "%v", lib.Types)
+	return lib, nil
 }
 
 // profile returns profiling information for fd in the compiler's current
@@ -260,7 +386,10 @@ func (c *compiler) codegen(ir *ir) {
 	pSym := parserSymbol{ty: ir.d}
 	mSym := parserSymbol{ty: ir.d, mapEntry: true}
 
+	// Each type has an identity of its own, even if its contents are identical
+	// to some other type's, since it is later associated with its descriptor.
 	ty := c.NewSymbol(tSym)
+	ty.Distinct()
 	ty.Rel(
 		linker.Rel{
 			Symbol: pSym,
@@ -277,6 +406,9 @@ func (c *compiler) codegen(ir *ir) {
 		Size:     uint32(ir.hot),
 		ColdSize: uint32(ir.cold),
 		Count:    uint32(len(ir.t)),
+
+		TrackAccesses: c.TrackAccesses,
+		SizeLimited:   c.MaxSizes[ir.d.FullName()] > 0,
 	})
 
 	numbers := make([]swiss.Entry[int32, uint32], 0, len(ir.t))
@@ -292,8 +424,9 @@ func (c *compiler) codegen(ir *ir) {
 		// Append whatever field data we can before doing layout.
 		ty.Push(tdp.Field{
 			Accessor: tdp.Accessor{
-				Offset: tf.offset,
-				Getter: tf.arch.Getter.adapt(),
+				Offset:  tf.offset,
+				Getter:  tf.arch.Getter.adapt(),
+				Default: tdp.NewDefault(tf.d),
 			},
 		})
 
@@ -307,11 +440,6 @@ func (c *compiler) codegen(ir *ir) {
 
 	tp := c.NewSymbol(pSym)
 	tp.Rel(
-		linker.Rel{
-			Symbol: tSym,
-			Offset: unsafe.Offsetof(tdp.TypeParser{}.TypeOffset),
-			Kind:   linker.Abs32,
-		},
 		linker.Rel{
 			Symbol: tableSymbol{pSym},
 			Offset: unsafe.Offsetof(tdp.TypeParser{}.Tags),
@@ -329,10 +457,26 @@ func (c *compiler) codegen(ir *ir) {
 			Kind: linker.Address,
 		},
 	)
-	tpOffset := tp.Push(tdp.TypeParser{})
+	var jumpBase protowire.Number
+	var jump []uint32
+	if ir.layout.Dispatch == tdp.DispatchJump {
+		jumpBase, jump = ir.jumpTable()
+		tp.Rel(linker.Rel{
+			Symbol: jumpSymbol{pSym},
+			Offset: unsafe.Offsetof(tdp.TypeParser{}.Jump),
+			Kind:   linker.Address,
+		})
+	}
+	tpOffset := tp.Push(tdp.TypeParser{
+		Dispatch: ir.layout.Dispatch,
+		JumpBase: uint32(jumpBase),
+		JumpLen:  uint32(len(jump)),
+	})
 
 	numbers = numbers[:0]
-	// Lay out the parser table.
+	// Lay out the parser table. Field parsers are laid out immediately after
+	// the TypeParser; see [tdp.TypeParser.Fields].
+	prev := tp
 	for i, pf := range ir.p {
 		tf := ir.t[pf.tIdx]
 		p := tf.arch.Parsers[pf.aIdx]
@@ -351,6 +495,8 @@ func (c *compiler) codegen(ir *ir) {
 		}
 
 		fp := c.NewSymbol(fieldParserSymbol{parser: pSym, index: i})
+		fp.Follows(prev)
+		prev = fp
 		fp.Rel(
 			linker.Rel{
 				Symbol: fieldParserSymbol{parser: pSym, index: nextOk},
@@ -365,17 +511,31 @@ func (c *compiler) codegen(ir *ir) {
 		)
 
 		if md := fieldMessage(tf.d); md != nil {
-			fp.Rel(linker.Rel{
-				Symbol: parserSymbol{ty: md},
-				Offset: unsafe.Offsetof(tdp.FieldParser{}.Message),
-				Kind:   linker.Address,
-			})
+			fp.Rel(
+				linker.Rel{
+					Symbol: parserSymbol{ty: md},
+					Offset: unsafe.Offsetof(tdp.FieldParser{}.Message),
+					Kind:   linker.Address,
+				},
+				linker.Rel{
+					Symbol: typeSymbol{md},
+					Offset: unsafe.Offsetof(tdp.FieldParser{}.TypeOffset),
+					Kind:   linker.Abs32,
+				},
+			)
+		}
+
+		// Dense maps size their array of keys by the preload size.
+		prof := ir.t[pf.tIdx].prof
+		preload := prof.ExpectedCount
+		if prof.DenseKeys > 0 && profile.DenseKeyable(tf.d) {
+			preload = prof.DenseKeys
 		}
 
 		fp.Push(tdp.FieldParser{
 			Tag:     tag,
 			Offset:  tf.offset,
-			Preload: uint32(ir.t[pf.tIdx].prof.ExpectedCount),
+			Preload: uint32(preload),
 			Parse:   uintptr(xunsafe.NewPC(p.Thunk)),
 		})
 	}
@@ -383,6 +543,7 @@ func (c *compiler) codegen(ir *ir) {
 	// Ensure that there is at least one parser to be the entry-point.
 	if len(ir.p) == 0 {
 		fp := c.NewSymbol(fieldParserSymbol{parser: pSym, index: 0})
+		fp.Follows(tp)
 		fp.Rel(
 			linker.Rel{
 				Symbol: fieldParserSymbol{parser: pSym, index: 0},
@@ -406,13 +567,16 @@ func (c *compiler) codegen(ir *ir) {
 	// Append the parser's field number table.
 	linker.PushTable(c.NewSymbol(tableSymbol{pSym}), numbers...)
 
+	// Append the parser's jump table.
+	if jump != nil {
+		js := c.NewSymbol(jumpSymbol{pSym})
+		for _, idx := range jump {
+			js.Push(idx)
+		}
+	}
+
 	mp := c.NewSymbol(mSym)
 	mp.Rel(
-		linker.Rel{
-			Symbol: tSym,
-			Offset: unsafe.Offsetof(tdp.TypeParser{}.TypeOffset),
-			Kind:   linker.Abs32,
-		},
 		linker.Rel{
 			Symbol: tableSymbol{mSym},
 			Offset: unsafe.Offsetof(tdp.TypeParser{}.Tags),
@@ -427,12 +591,14 @@ func (c *compiler) codegen(ir *ir) {
 	)
 	mpOffset := mp.Push(tdp.TypeParser{
 		DiscardUnknown: true,
+		Dispatch:       tdp.DispatchLUT,
 	})
 
 	// Write the map entry parser.
 	const mapValue = 0x2<<3 | tdp.Tag(protowire.BytesType) // Field number 2 with bytes type (so, 0b10010).
 	numbers = []swiss.Entry[int32, uint32]{{Key: int32(mapValue), Value: 0}}
 	mpf := c.NewSymbol(fieldParserSymbol{parser: mSym, index: 0})
+	mpf.Follows(mp)
 	mpf.Rel(
 		linker.Rel{
 			Symbol: fieldParserSymbol{parser: mSym, index: 0},
@@ -20,7 +20,9 @@ import (
 	"math"
 	"slices"
 
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 
 	"buf.build/go/hyperpb/internal/debug"
 	"buf.build/go/hyperpb/internal/scc"
@@ -44,8 +46,9 @@ type ir struct {
 	p []pField
 	s []sField
 
-	hot, cold int
-	layout    tdp.TypeLayout
+	hot, cold   int
+	layout      tdp.TypeLayout
+	diagnostics []tdp.Diagnostic
 }
 
 type tField struct {
@@ -86,6 +89,15 @@ type sccInfo struct {
 	// information to determine which fields in a message can contain required
 	// fields.
 	hasRequired bool
+
+	// Whether any message in this component has an enum field, or a
+	// submessage that transitively contains one. Used like hasRequired.
+	hasEnums bool
+
+	// The length of the longest chain of distinct message types, each
+	// containing the next, that starts in this component. Every member of a
+	// component counts towards it, since they contain each other.
+	depth int
 }
 
 // newIR generates an intermediate representation for a given message.
@@ -94,8 +106,56 @@ func newIR(c *compiler, md protoreflect.MessageDescriptor) *ir {
 
 	// Classify all of the fields into archetypes.
 	for _, fd := range c.fields(md) {
+		if c.ElideDeprecated && elidable(fd) {
+			prof := profile.Field{Elided: true}
+			ir.t = append(ir.t, tField{
+				d:    fd,
+				prof: prof,
+				arch: c.Backend.SelectArchetype(fd, prof),
+			})
+			continue
+		}
+
 		prof := c.profile(fd)
-		arch := c.Backend.SelectArchetype(fd, prof)
+		if c.BitsetBools[fd.FullName()] {
+			if !fd.IsList() || fd.Kind() != protoreflect.BoolKind {
+				panic(fmt.Errorf("hyperpb: cannot store %s as a bitset: only repeated bool fields support bitsets", fd.FullName()))
+			}
+			prof.BitsetBools = true
+		}
+		if c.Secrets[fd.FullName()] {
+			if c.Transforms[fd.FullName()] != nil {
+				panic(fmt.Errorf("hyperpb: cannot treat %s as secret: transformed fields cannot be secret", fd.FullName()))
+			}
+			prof.Secret = true
+		}
+		if c.InternStrings && fd.Kind() == protoreflect.StringKind {
+			prof.Intern = true
+		}
+		if n := c.Samples[fd.FullName()]; n > 1 {
+			if !fd.IsList() || fd.Kind() != protoreflect.MessageKind {
+				panic(fmt.Errorf("hyperpb: cannot sample %s: only repeated message fields can be sampled", fd.FullName()))
+			}
+			prof.Sample = n
+		}
+		var arch *Archetype
+		if c.Transforms[fd.FullName()] != nil {
+			arch = c.Backend.SelectTransformArchetype(fd, prof)
+			if arch == nil {
+				panic(fmt.Errorf("hyperpb: cannot transform %s: only singular string and bytes fields support transforms", fd.FullName()))
+			}
+		} else {
+			arch = c.Backend.SelectArchetype(fd, prof)
+			if arch == nil && prof.Secret {
+				panic(fmt.Errorf("hyperpb: cannot treat %s as secret: only singular string and bytes fields can be secret", fd.FullName()))
+			}
+			if arch == nil {
+				// Keep going, so that every unsupported field is reported at
+				// once.
+				c.unsupported = append(c.unsupported, fd)
+				continue
+			}
+		}
 
 		if arch.Bits > 0 && arch.Oneof {
 			panic(fmt.Sprintf("oneof archetype for %v requested bits; this is a bug", fd.FullName()))
@@ -110,6 +170,30 @@ func newIR(c *compiler, md protoreflect.MessageDescriptor) *ir {
 	return ir
 }
 
+// isEnum returns whether fd is an enum field, or a map field with enum values.
+func isEnum(fd protoreflect.FieldDescriptor) bool {
+	if fd.IsMap() {
+		fd = fd.MapValue()
+	}
+	return fd.Kind() == protoreflect.EnumKind
+}
+
+// elidable returns whether fd is deprecated, and can be elided when
+// [Options].ElideDeprecated is set.
+//
+// Required fields are never elided, since that would make every message
+// uninitialized, and neither are members of oneofs, since eliding them would
+// change which member of the oneof is set.
+func elidable(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || !opts.GetDeprecated() || fd.IsExtension() ||
+		fd.Cardinality() == protoreflect.Required {
+		return false
+	}
+	od := fd.ContainingOneof()
+	return od == nil || od.IsSynthetic()
+}
+
 // this component, and that they are stored in c.sccInfo.
 func newSCCInfo(c *compiler, component *scc.Component[*ir]) *sccInfo {
 	info := new(sccInfo)
@@ -117,12 +201,16 @@ func newSCCInfo(c *compiler, component *scc.Component[*ir]) *sccInfo {
 	// Add contributions from dependencies.
 	for dep := range component.Deps() {
 		info.hasRequired = info.hasRequired || c.sccInfo[dep].hasRequired
+		info.hasEnums = info.hasEnums || c.sccInfo[dep].hasEnums
+		info.depth = max(info.depth, c.sccInfo[dep].depth)
 	}
+	info.depth += len(component.Members())
 
 	// Add contributions from component members.
 	for _, ir := range component.Members() {
 		for _, t := range ir.t {
 			info.hasRequired = info.hasRequired || t.d.Cardinality() == protoreflect.Required
+			info.hasEnums = info.hasEnums || isEnum(t.d)
 		}
 	}
 
@@ -151,16 +239,26 @@ func (ir *ir) doLayout(c *compiler) {
 	for i := range ir.s {
 		sf := &ir.s[i]
 		var temp stats.Mean
+		var pinned, pinnedHot bool
 		for _, j := range sf.tIdx {
 			arch := ir.t[j].arch
 			sf.layout = sf.layout.Max(arch.Layout)
 			sf.bits = max(sf.bits, arch.Bits)
 
 			temp.Record(ir.t[j].prof.DecodeProbability)
+
+			// Oneof members share a slot; pinning any of them hot wins.
+			if hot, ok := c.Pinned[ir.t[j].d.FullName()]; ok {
+				pinned = true
+				pinnedHot = pinnedHot || hot
+			}
 		}
 
 		bits += int(sf.bits)
 		sf.hot = temp.Get() >= 0
+		if pinned {
+			sf.hot = pinnedHot
+		}
 
 		if ir.t[sf.tIdx[0]].arch.Oneof {
 			whichWords++
@@ -369,9 +467,11 @@ func (ir *ir) doSchedule(c *compiler) {
 		}
 	}
 
+	ir.layout.Dispatch = ir.selectDispatch()
+
 	if debug.Enabled {
 		// Print the parser CFG.
-		c.log("cfg", "%s\n%v", ir.d.FullName(), debug.Formatter(func(buf fmt.State) {
+		c.log("cfg", "%s, dispatch: %v\n%v", ir.d.FullName(), ir.layout.Dispatch, debug.Formatter(func(buf fmt.State) {
 			for i, pf := range ir.p {
 				tf := ir.t[pf.tIdx]
 				fmt.Fprintf(buf, "  #%d: %v#%d -> #%d\n", i, tf.d.Name(), pf.aIdx, pf.next)
@@ -380,6 +480,116 @@ func (ir *ir) doSchedule(c *compiler) {
 	}
 }
 
+// selectDispatch chooses a dispatch strategy for this type's parser based on
+// the distribution of its field numbers and the sizes of its oneofs. Must be
+// called after parsers are scheduled.
+//
+// Extensions are not taken into account, except to check that the LUT can be
+// used: they are always found via the hash table unless their tag happens to
+// fit in the LUT, so a few extensions with large numbers, which extension
+// ranges usually call for, should not stop the LUT from being used for the
+// message's own fields.
+func (ir *ir) selectDispatch() tdp.Dispatch {
+	var parsers, fields, large int
+	var incomplete bool
+	least, most := protowire.MaxValidNumber, protowire.Number(0)
+	members := make(map[protoreflect.OneofDescriptor]int)
+	for i, pf := range ir.p {
+		tf := ir.t[pf.tIdx]
+		p := tf.arch.Parsers[pf.aIdx]
+		tag := protowire.EncodeTag(tf.d.Number(), p.Kind)
+		if tag < 0x80 && i >= 0xff {
+			// Some parsers can't be placed in the LUT, so we can't rule out a
+			// field just because the LUT misses.
+			incomplete = true
+		}
+		if tf.d.IsExtension() {
+			continue
+		}
+
+		parsers++
+		if pf.aIdx == 0 {
+			fields++
+			if od := tf.d.ContainingOneof(); od != nil && !od.IsSynthetic() {
+				members[od]++
+			}
+		}
+		if tag >= 0x80 {
+			large++
+		}
+		least = min(least, tf.d.Number())
+		most = max(most, tf.d.Number())
+	}
+
+	var oneof int
+	for _, n := range members {
+		oneof = max(oneof, n)
+	}
+
+	switch {
+	case oneof >= jumpMembers && oneof*2 > fields && int(most-least) < jumpDensity*fields:
+		// The message is mostly one big oneof, such as an event envelope, so
+		// the next field is hard to predict, but its field numbers are dense
+		// enough to index a table with.
+		return tdp.DispatchJump
+
+	case incomplete:
+		return tdp.DispatchList
+
+	case large == 0:
+		return tdp.DispatchLUT
+
+	case large*2 > parsers && int(most-least) > sparseFactor*fields:
+		// Most tags miss the LUT, and they are so far apart that they are
+		// unlikely to be declared (and hence appear on the wire) in the same
+		// order they are numbered.
+		return tdp.DispatchHash
+
+	default:
+		return tdp.DispatchList
+	}
+}
+
+// jumpTable builds the jump table for [tdp.DispatchJump], returning the field
+// number of its first entry. Must be called after parsers are scheduled.
+func (ir *ir) jumpTable() (protowire.Number, []uint32) {
+	least, most := protowire.MaxValidNumber, protowire.Number(0)
+	for _, tf := range ir.t {
+		if !tf.d.IsExtension() {
+			least = min(least, tf.d.Number())
+			most = max(most, tf.d.Number())
+		}
+	}
+
+	table := make([]uint32, most-least+1)
+	for i := range table {
+		table[i] = tdp.NoJump
+	}
+	for i, pf := range ir.p {
+		fd := ir.t[pf.tIdx].d
+		if fd.IsExtension() {
+			continue
+		}
+		if entry := &table[fd.Number()-least]; *entry == tdp.NoJump {
+			*entry = uint32(i)
+		}
+	}
+	return least, table
+}
+
+// sparseFactor is how many times larger than the number of fields the range of
+// field numbers in a message must be for its numbering to be considered sparse.
+const sparseFactor = 64
+
+// jumpMembers is how many members the largest oneof of a message must have for
+// it to use [tdp.DispatchJump], and jumpDensity is how many times larger than
+// the number of fields the range of field numbers may be, which bounds the
+// size of the jump table.
+const (
+	jumpMembers = 100
+	jumpDensity = 4
+)
+
 func (ir *ir) logLayout(c *compiler) {
 	c.log("layout", "%s, %d/%d\n%v", ir.d.FullName(), ir.hot, ir.cold,
 		debug.Formatter(func(buf fmt.State) {
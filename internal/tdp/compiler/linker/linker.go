@@ -17,6 +17,7 @@
 package linker
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"iter"
@@ -72,10 +73,15 @@ func Symbols[Name any](l *Linker) iter.Seq2[Name, int] {
 //
 // alloc is used to obtain a suitable buffer for the size of the linked program.
 func (l *Linker) Link(alloc func(size, align int) []byte) ([]byte, error) {
+	l.dedup()
+
 	// First, figure out the total size of the program.
 	offset := 0
 	align := 1
 	for _, sym := range l.symbols {
+		if sym.dup != nil {
+			continue
+		}
 		align = max(align, sym.align)
 		offset = layout.RoundUp(offset, sym.align)
 		sym.offset = offset // Record the start offset, *after* the padding!
@@ -87,12 +93,22 @@ func (l *Linker) Link(alloc func(size, align int) []byte) ([]byte, error) {
 		return nil, errors.New("type has too many dependencies")
 	}
 
+	// Duplicates live wherever their canonical symbol does.
+	for _, sym := range l.symbols {
+		if sym.dup != nil {
+			sym.offset = sym.dup.offset
+		}
+	}
+
 	// Get a buffer big enough for what we need.
 	buf := alloc(offset, align)
 
 	// Copy over each symbol, resolving relocations as we go.
 	offset = 0
 	for _, sym := range l.symbols {
+		if sym.dup != nil {
+			continue
+		}
 		offset = layout.RoundUp(offset, sym.align)
 		copy(buf[offset:], sym.data)
 
@@ -129,3 +145,113 @@ func (l *Linker) Link(alloc func(size, align int) []byte) ([]byte, error) {
 
 	return buf, nil
 }
+
+// dedup finds symbols with identical contents and marks all but the first as
+// duplicates, so that they are only written to the output once.
+//
+// Two symbols are identical if they have the same data, and their relocations
+// are at the same offsets and refer to identical symbols. Because symbols may
+// refer to each other cyclically, such as the field parsers of a message, this
+// is computed by partition refinement: symbols start out partitioned by their
+// contents alone, and partitions are split by the partitions of the symbols
+// they refer to until no more splits happen. In practice, this deduplicates
+// the parsers and tables of messages with the same shape.
+func (l *Linker) dedup() {
+	index := make(map[*Sym]int, len(l.symbols))
+	for i, sym := range l.symbols {
+		index[sym] = i
+	}
+
+	// targets[i] holds the indices of the symbols that l.symbols[i] refers
+	// to, or -1 for undefined symbols, which Link reports. This includes
+	// the symbols it must be laid out adjacent to, if any.
+	targets := make([][]int, len(l.symbols))
+	for i, sym := range l.symbols {
+		for _, rel := range sym.rels {
+			j := -1
+			if ref, ok := l.database[rel.Symbol]; ok {
+				j = index[ref]
+			}
+			targets[i] = append(targets[i], j)
+		}
+
+		if sym.prev != nil {
+			debug.Assert(index[sym.prev] == i-1, "symbol %v does not follow %v", sym.name, sym.prev.name)
+		}
+		for _, adj := range []*Sym{sym.prev, sym.next} {
+			j := len(l.symbols) // Not a valid index, so no symbol's class.
+			if adj != nil {
+				j = index[adj]
+			}
+			targets[i] = append(targets[i], j)
+		}
+	}
+
+	// Start with a partition by contents. Distinct symbols are placed in
+	// partitions of their own.
+	class := make([]int, len(l.symbols))
+	classes := make(map[string]int)
+	var buf []byte
+	for i, sym := range l.symbols {
+		buf = buf[:0]
+		if sym.distinct {
+			buf = append(buf, 1)
+			buf = binary.AppendUvarint(buf, uint64(i))
+		} else {
+			buf = append(buf, 0)
+		}
+		buf = binary.AppendUvarint(buf, uint64(sym.align))
+		for _, rel := range sym.rels {
+			buf = binary.AppendUvarint(buf, uint64(rel.Offset))
+			buf = append(buf, byte(rel.Kind))
+		}
+		buf = append(buf, sym.data...)
+		class[i] = classOf(classes, buf)
+	}
+
+	// Refine until the number of partitions stops changing. Refining never
+	// merges partitions, so this means no partition was split.
+	for n := 0; n != len(classes); {
+		n = len(classes)
+		clear(classes)
+
+		next := make([]int, len(class))
+		for i := range l.symbols {
+			buf = binary.AppendUvarint(buf[:0], uint64(class[i]))
+			for _, j := range targets[i] {
+				switch {
+				case j < 0:
+					// Keep symbols that refer to undefined symbols apart.
+					buf = binary.AppendVarint(buf, int64(-2-i))
+				case j == len(class):
+					buf = binary.AppendVarint(buf, -1)
+				default:
+					buf = binary.AppendVarint(buf, int64(class[j]))
+				}
+			}
+			next[i] = classOf(classes, buf)
+		}
+		class = next
+	}
+
+	canon := make(map[int]*Sym, len(classes))
+	for i, sym := range l.symbols {
+		if c, ok := canon[class[i]]; ok {
+			debug.Log(nil, "dedup", "%s -> %s", sym.name, c.name)
+			sym.dup = c
+			continue
+		}
+		canon[class[i]] = sym
+	}
+}
+
+// classOf returns the partition for the given key, allocating a new one if
+// necessary.
+func classOf(classes map[string]int, key []byte) int {
+	if c, ok := classes[string(key)]; ok {
+		return c
+	}
+	c := len(classes)
+	classes[string(key)] = c
+	return c
+}
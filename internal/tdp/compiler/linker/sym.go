@@ -15,6 +15,7 @@
 package linker
 
 import (
+	"math/rand/v2"
 	"reflect"
 	"unsafe"
 
@@ -39,7 +40,11 @@ type Sym struct {
 	data  []byte
 	rels  []Rel
 
-	offset int // Assigned during Link().
+	distinct   bool // Set if this symbol must not be merged with another.
+	prev, next *Sym // Symbols this one must be laid out adjacent to.
+
+	offset int  // Assigned during Link().
+	dup    *Sym // Set during Link() if this symbol is identical to another.
 }
 
 // Rel is a relocation within a [Symbol].
@@ -50,6 +55,23 @@ type Rel struct {
 	Kind Kind
 }
 
+// Distinct marks this symbol as having an identity of its own, so that it is
+// never merged with another symbol with identical contents.
+func (s *Sym) Distinct() {
+	s.distinct = true
+}
+
+// Follows records that this symbol must be laid out immediately after prev,
+// such as when prev is a header for an array that this symbol is an element
+// of. prev must have been the last symbol added before this one.
+//
+// Symbols which follow each other are only ever merged with another chain of
+// symbols as a whole.
+func (s *Sym) Follows(prev *Sym) {
+	s.prev = prev
+	prev.next = s
+}
+
 // At returns a mutable reference to the data in the given range.
 //
 // Pushing more data to this symbol may cause this to become invalidated.
@@ -86,12 +108,16 @@ func (s *Sym) Reserve(size, align int) []byte {
 	return s.data[offset:]
 }
 
+// tableSeed is the hash seed for tables pushed with [PushTable].
+var tableSeed = rand.Uint64()
+
 // PushTable pushes a swiss.Table onto a symbol.
 func PushTable[K swiss.Key, V comparable](s *Sym, entries ...swiss.Entry[K, V]) {
 	buf := s.Reserve(swiss.Layout[K, V](len(entries)))
 
+	// All tables share a seed, so that identical tables can be deduplicated.
 	table := xunsafe.Cast[swiss.Table[K, V]](unsafe.SliceData(buf))
-	table.Init(len(entries), nil, nil)
+	table.InitSeeded(len(entries), tableSeed)
 
 	for _, e := range entries {
 		*table.Insert(e.Key, nil) = e.Value
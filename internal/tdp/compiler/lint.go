@@ -0,0 +1,85 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compiler
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"buf.build/go/hyperpb/internal/tdp"
+)
+
+const (
+	// maxNesting is the longest chain of nested message types a type may
+	// contain before it is diagnosed.
+	maxNesting = 32
+
+	// maxOneofMembers is the most members a oneof may have before it is
+	// diagnosed.
+	maxOneofMembers = 1000
+)
+
+// lint records diagnostics for schema patterns in this type that defeat
+// parser optimizations. It must be called after scheduling, since it looks at
+// the dispatch strategy that was selected.
+func (ir *ir) lint(info *sccInfo) {
+	if ir.layout.Dispatch == tdp.DispatchHash {
+		var fields int
+		least, most := protowire.MaxValidNumber, protowire.Number(0)
+		for _, tf := range ir.t {
+			if tf.d.IsExtension() {
+				continue
+			}
+			fields++
+			least = min(least, tf.d.Number())
+			most = max(most, tf.d.Number())
+		}
+		ir.diagnose(tdp.Diagnostic{
+			Kind: tdp.DiagnosticSparseNumbers,
+			Reason: fmt.Sprintf(
+				"%d fields are numbered from %d to %d; fields that are not in numbering order on the wire need a hash table lookup",
+				fields, least, most),
+		})
+	}
+
+	if info.depth > maxNesting {
+		ir.diagnose(tdp.Diagnostic{
+			Kind: tdp.DiagnosticDeepNesting,
+			Reason: fmt.Sprintf(
+				"contains a chain of %d nested message types; each level of nesting costs a parser stack frame",
+				info.depth),
+		})
+	}
+
+	oneofs := ir.d.Oneofs()
+	for i := range oneofs.Len() {
+		od := oneofs.Get(i)
+		if n := od.Fields().Len(); n > maxOneofMembers {
+			ir.diagnose(tdp.Diagnostic{
+				Kind:  tdp.DiagnosticLargeOneof,
+				Oneof: od,
+				Reason: fmt.Sprintf(
+					"oneof has %d members; each needs its own field parser, even though at most one is set",
+					n),
+			})
+		}
+	}
+}
+
+// diagnose records a diagnostic for this type.
+func (ir *ir) diagnose(d tdp.Diagnostic) {
+	ir.diagnostics = append(ir.diagnostics, d)
+}
@@ -31,6 +31,8 @@ type parserSymbol struct {
 
 type tableSymbol struct{ sym any }
 
+type jumpSymbol struct{ sym any }
+
 type fieldParserSymbol struct {
 	parser any
 	index  int
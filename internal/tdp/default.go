@@ -0,0 +1,90 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tdp
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Default is the default value of a singular scalar field, baked into its
+// [Accessor] at compile time so that getting an unset field does not need to
+// consult its descriptor.
+type Default struct {
+	// The bits of the default value, zero-extended or sign-extended to 64
+	// bits.
+	Bits uint64
+
+	// The Kind of the field. If zero, the default cannot be represented by
+	// Bits, and must be obtained from the field's descriptor instead.
+	Kind protoreflect.Kind
+}
+
+// NewDefault returns the Default for a field.
+func NewDefault(fd protoreflect.FieldDescriptor) Default {
+	if fd.Cardinality() == protoreflect.Repeated {
+		return Default{}
+	}
+
+	v := fd.Default()
+	var bits uint64
+	switch k := fd.Kind(); k {
+	case protoreflect.BoolKind:
+		if v.Bool() {
+			bits = 1
+		}
+	case protoreflect.EnumKind:
+		bits = uint64(v.Enum())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		bits = uint64(v.Int())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		bits = v.Uint()
+	case protoreflect.FloatKind:
+		bits = uint64(math.Float32bits(float32(v.Float())))
+	case protoreflect.DoubleKind:
+		bits = math.Float64bits(v.Float())
+	default:
+		return Default{}
+	}
+	return Default{Bits: bits, Kind: fd.Kind()}
+}
+
+// Value returns the default value for fd, which must be the field this
+// Default was created for.
+func (d Default) Value(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch d.Kind {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(d.Bits != 0)
+	case protoreflect.EnumKind:
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(d.Bits))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(d.Bits))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(int64(d.Bits))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(d.Bits))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(d.Bits)
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(math.Float32frombits(uint32(d.Bits)))
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(math.Float64frombits(d.Bits))
+	default:
+		return fd.Default()
+	}
+}
@@ -35,6 +35,14 @@ import (
 // Cold is portions of a message that are located in context.Cold.
 type Cold struct {
 	Unknown slice.Slice[zc.Range] // Unknown field chunks.
+
+	// Unknown field bytes which do not alias the source, because they were
+	// set with [Message.SetUnknown]. These follow the chunks in Unknown.
+	OwnedUnknown slice.Slice[byte]
+
+	// The number of unknown fields and bytes in Unknown. Only maintained when
+	// the parser is limiting unknown fields.
+	UnknownFields, UnknownBytes uint32
 }
 
 // Message is a dynamic message value.
@@ -97,6 +105,7 @@ func (m *Message) Range(yield func(protoreflect.FieldDescriptor, protoreflect.Va
 			}
 		}
 
+		ty.CountAccess(i)
 		if !yield(ty.FieldDescriptors[i], v) {
 			return
 		}
@@ -117,8 +126,17 @@ func (m *Message) Has(fd protoreflect.FieldDescriptor) bool {
 	if !f.IsValid() {
 		return false
 	}
+	return m.HasByIndex(m.Type().IndexOf(f))
+}
+
+// HasByIndex is like [Message.Has], but it takes a field index rather than a
+// descriptor, performing no bounds checks.
+func (m *Message) HasByIndex(n int) bool {
+	ty := m.Type()
+	ty.CountAccess(n)
 
-	v := f.Get(unsafe.Pointer(m))
+	fd := ty.FieldDescriptors[n]
+	v := ty.ByIndex(n).Get(unsafe.Pointer(m))
 	switch {
 	case !v.IsValid():
 		return false
@@ -150,20 +168,66 @@ func (m *Message) Get(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	if !f.IsValid() {
 		return protoreflect.ValueOf(nil)
 	}
+	m.Type().CountAccess(m.Type().IndexOf(f))
 
 	if v := f.Get(unsafe.Pointer(m)); v.IsValid() {
 		// NOTE: non-scalar (message/repeated) fields always return a valid value.
 		return v
 	}
-	return fd.Default()
+	return f.Default.Value(fd)
+}
+
+// GetByIndex is like [Message.Get], but it takes a field index rather than a
+// descriptor, performing no bounds checks.
+func (m *Message) GetByIndex(n int) protoreflect.Value {
+	ty := m.Type()
+	ty.CountAccess(n)
+
+	f := ty.ByIndex(n)
+	if v := f.Get(unsafe.Pointer(m)); v.IsValid() {
+		return v
+	}
+	return f.Default.Value(ty.FieldDescriptors[n])
 }
 
-// GetByIndex is like [Message.Get], but it takes a raw field index, performing
-// no bounds checks.
+// GetByIndexUnchecked is like [Message.GetByIndex], but does not count
+// accesses or apply defaults.
 func (m *Message) GetByIndexUnchecked(n int) protoreflect.Value {
 	return m.Type().ByIndex(n).Get(unsafe.Pointer(m))
 }
 
+// ResetForParse clears m, and the parse state of its Shared, after a failed
+// parse, so that it can be parsed into again. Memory allocated by the failed
+// parse is not reclaimed until [Shared.Free].
+func (m *Message) ResetForParse() {
+	fields := xunsafe.Cast[byte](xunsafe.Add(m, 1))
+	clear(unsafe.Slice(fields, int(m.Type().Size)-layout.Size[Message]()))
+	m.ColdIndex = -1
+
+	s := m.Shared
+	s.WipeSrc()
+	s.Src = nil
+	s.Len = 0
+	s.Root = nil
+	s.hasChecksum = false
+	s.hasFingerprint = false
+}
+
+// MessageByIndex returns the value of the singular message field at index n,
+// or nil if it is not set. Unlike [Message.GetByIndex], this does not wrap the
+// result in a [protoreflect.Value], and does not count accesses.
+func (m *Message) MessageByIndex(n int) *Message {
+	f := m.Type().ByIndex(n)
+	if f.Offset.Number != 0 && xunsafe.ByteLoad[uint32](m, f.Offset.Bit) != f.Offset.Number {
+		return nil // A different member of the oneof is set.
+	}
+	p := GetField[*Message](m, f.Offset)
+	if p == nil {
+		return nil
+	}
+	return *p
+}
+
 // GetField returns the field pointer for a given message.
 //
 // Returns nil if the field is cold and there is no cold region allocated.
@@ -240,6 +304,44 @@ func (m *Message) MutableCold() *Cold {
 	return xunsafe.LoadSlice(m.Shared.Cold, m.ColdIndex)
 }
 
+// AppendUnknown appends the unknown fields of m to out.
+func (m *Message) AppendUnknown(out []byte) []byte {
+	cold := m.Cold()
+	if cold == nil {
+		return out
+	}
+	for _, zc := range cold.Unknown.Raw() {
+		out = append(out, zc.Bytes(m.Shared.Src)...)
+	}
+	return append(out, cold.OwnedUnknown.Raw()...)
+}
+
+// SetUnknown replaces the unknown fields of m with a copy of raw.
+func (m *Message) SetUnknown(raw []byte) {
+	m.CheckMutable()
+
+	cold := m.Cold()
+	if len(raw) == 0 {
+		if cold != nil {
+			cold.Unknown = slice.Slice[zc.Range]{}
+			cold.OwnedUnknown = slice.Slice[byte]{}
+		}
+		return
+	}
+
+	cold = m.MutableCold()
+	cold.Unknown = slice.Slice[zc.Range]{}
+	cold.OwnedUnknown = slice.Of(&m.Shared.arena, raw...)
+}
+
+// CheckMutable panics if m must not be mutated, because it may be shared by
+// several elements of a repeated field. See [Shared].Deduped.
+func (m *Message) CheckMutable() {
+	if m.Shared.Deduped {
+		panic("hyperpb: cannot mutate a message parsed with WithDedupMessages")
+	}
+}
+
 // ProtoReflect is a callback to construct the root package's message type.
 //
 // It is connected to the root package via linkname.
@@ -268,6 +370,7 @@ func (m *Message) Dump() string {
 	}
 
 	tLayout := m.Type().Layout.Get()
+	fmt.Fprintf(buf, "dispatch: %v\n", tLayout.Dispatch)
 
 	// Print out the bit words.
 	if tLayout.BitWords > 0 {
@@ -381,6 +484,9 @@ func (m *Message) Dump() string {
 		}
 		fmt.Fprintln(buf)
 	}
+	if cold != nil && cold.OwnedUnknown.Len() > 0 {
+		fmt.Fprintf(buf, "owned unknown: `%x`\n", cold.OwnedUnknown.Raw())
+	}
 
 	return buf.String()
 }
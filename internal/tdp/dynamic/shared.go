@@ -15,9 +15,13 @@
 package dynamic
 
 import (
+	"hash/maphash"
 	"sync"
+	"sync/atomic"
+	"unsafe"
 
 	"buf.build/go/hyperpb/internal/arena"
+	"buf.build/go/hyperpb/internal/intern"
 	"buf.build/go/hyperpb/internal/tdp"
 	"buf.build/go/hyperpb/internal/xunsafe"
 )
@@ -36,11 +40,77 @@ type Shared struct {
 	Src *byte
 	Len int
 
+	// The message that Src was parsed into, if any.
+	Root *Message
+
 	// Synchronizes calls to startParse() with this context.
 	Lock sync.Mutex
 
 	// Off-arena memory which holds arena pointers to "Cold" parts of a message.
 	Cold []*Cold
+
+	// Addresses of repeated fields that were spilled from zero-copy storage
+	// to the arena while parsing, if requested. Nil until the first spill is
+	// recorded.
+	Spills map[xunsafe.Addr[byte]]struct{}
+
+	// A checksum of the aliased input buffer, if one was requested.
+	checksum    uint64
+	hasChecksum bool
+
+	// A fingerprint of the input buffer, if one was requested.
+	fingerprint    uint64
+	hasFingerprint bool
+
+	// Set if Src is a copy of the input made by the parser, rather than
+	// memory owned by the caller.
+	OwnsSrc bool
+
+	// Set if a field compiled as secret was parsed, or if the caller asked
+	// for this Shared to be wiped. If so, the memory that may hold its
+	// contents is wiped when this Shared is freed.
+	Secrets bool
+
+	// Set if messages were parsed into this Shared with deduplication of
+	// repeated message elements, in which case several elements may be the
+	// same message. Such messages must not be mutated.
+	Deduped bool
+
+	// If Tracking is set, Live counts the messages returned by New which have
+	// not yet been released by the user.
+	Tracking bool
+	Live     atomic.Int64
+
+	// If non-nil, singular string values are deduplicated through Strings as
+	// they are parsed into Src. Each shard has its own.
+	Strings *intern.Local
+
+	// Canonical copies of strings interned with a type's interning table
+	// while parsing. Messages point to these directly, so they must be kept
+	// alive for as long as this Shared is.
+	Interned []string
+
+	// If this Shared is a shard, the Shared that handed it out. A shard is
+	// freed along with its parent.
+	Parent *Shared
+
+	// Shards handed out by Shard. Only the first usedShards are in use; the
+	// rest are retained from before the last call to Free, for re-use.
+	shardLock  sync.Mutex
+	shards     []*Shared
+	usedShards int
+
+	// Values memoized by the root package's Memo function, keyed by MemoKey.
+	// HasMemos is set when Memos may be non-empty, since clearing a sync.Map
+	// allocates even if it is empty.
+	Memos    sync.Map
+	HasMemos atomic.Bool
+}
+
+// MemoKey is a key in [Shared].Memos.
+type MemoKey struct {
+	Message *Message
+	Key     any
 }
 
 // Arena returns the message tree's arena.
@@ -79,10 +149,131 @@ func (s *Shared) New(ty *tdp.Type) *Message {
 //
 // Any messages previously parsed using this context must not be reused.
 func (s *Shared) Free() {
+	if s.Secrets {
+		s.WipeSrc()
+		s.arena.Wipe()
+		s.Secrets = false
+	}
 	s.arena.Free()
 	s.lib = nil
 	s.Src = nil
+	s.Root = nil
+	s.hasChecksum = false
+	s.hasFingerprint = false
+	s.Deduped = false
+
+	if s.Strings != nil {
+		s.Strings.Reset()
+	}
+
+	if s.HasMemos.Swap(false) {
+		s.Memos.Clear()
+	}
 
 	clear(s.Cold)
 	s.Cold = s.Cold[:0]
+	clear(s.Spills)
+	clear(s.Interned)
+	s.Interned = s.Interned[:0]
+
+	for _, shard := range s.Shards() {
+		shard.Free()
+	}
+	s.usedShards = 0
+}
+
+// Shard returns a new Shared that is owned by this one, or by its parent if
+// this is itself a shard.
+//
+// The shard has its own arena and input buffer, so it can be used
+// concurrently with its parent and any other shards, but it inherits the
+// parent's arena policy, message tracking and string interning, and it is
+// freed along with its parent. Since strings are interned within a single
+// input, a shard that interns strings has its own table.
+func (s *Shared) Shard() *Shared {
+	if s.Parent != nil {
+		s = s.Parent
+	}
+
+	s.shardLock.Lock()
+	defer s.shardLock.Unlock()
+
+	if s.usedShards == len(s.shards) {
+		s.shards = append(s.shards, new(Shared))
+	}
+	shard := s.shards[s.usedShards]
+	s.usedShards++
+
+	shard.Parent = s
+	shard.arena.Policy = s.arena.Policy
+	shard.Tracking = s.Tracking
+	switch {
+	case s.Strings == nil:
+		shard.Strings = nil
+	case shard.Strings == nil:
+		shard.Strings = new(intern.Local)
+	}
+	return shard
+}
+
+// Shards returns the shards of this Shared that are in use.
+func (s *Shared) Shards() []*Shared {
+	s.shardLock.Lock()
+	defer s.shardLock.Unlock()
+	return s.shards[:s.usedShards]
+}
+
+// WipeSrc zeroes Src if it contains secrets and is owned by this Shared.
+// Input buffers owned by the caller are never modified.
+func (s *Shared) WipeSrc() {
+	if s.Secrets && s.OwnsSrc && s.Src != nil {
+		clear(unsafe.Slice(s.Src, s.Len))
+	}
+}
+
+// RecordChecksum records a checksum of src, the input buffer, so that
+// [Shared.Verify] can detect whether it has been modified.
+func (s *Shared) RecordChecksum(src []byte) {
+	s.checksum = maphash.Bytes(checksumSeed, src)
+	s.hasChecksum = true
+}
+
+// Verify returns whether the input buffer still matches the checksum recorded
+// with [Shared.RecordChecksum]. Returns true if no checksum was recorded.
+func (s *Shared) Verify() bool {
+	if !s.hasChecksum || s.Src == nil {
+		return true
+	}
+	return maphash.Bytes(checksumSeed, unsafe.Slice(s.Src, s.Len)) == s.checksum
+}
+
+var checksumSeed = maphash.MakeSeed()
+
+// RecordFingerprint records a fingerprint of the input buffer, computed by the
+// parser.
+func (s *Shared) RecordFingerprint(fp uint64) {
+	s.fingerprint = fp
+	s.hasFingerprint = true
+}
+
+// Fingerprint returns the fingerprint recorded with [Shared.RecordFingerprint],
+// if any.
+func (s *Shared) Fingerprint() (uint64, bool) {
+	return s.fingerprint, s.hasFingerprint
+}
+
+// RecordSpill records that the repeated field stored at p was spilled from
+// zero-copy storage to the arena while parsing.
+func (s *Shared) RecordSpill(p *byte) {
+	if s.Spills == nil {
+		s.Spills = make(map[xunsafe.Addr[byte]]struct{})
+	}
+	s.Spills[xunsafe.AddrOf(p)] = struct{}{}
+}
+
+// Spilled returns whether the repeated field stored at p was recorded as
+// spilled by [Shared.RecordSpill].
+func (s *Shared) Spilled(p *byte) bool {
+	_, ok := s.Spills[xunsafe.AddrOf(p)]
+	return ok
 }
@@ -87,7 +87,7 @@ func (e Message) Get(fd protoreflect.FieldDescriptor) protoreflect.Value {
 		return protoreflect.ValueOfMessage(Message{f.Message})
 
 	default:
-		return fd.Default()
+		return f.Default.Value(fd)
 	}
 }
 
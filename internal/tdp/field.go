@@ -62,6 +62,9 @@ type Accessor struct {
 
 	// The Getter for extracting the field.
 	Getter Getter
+
+	// The value to return when the Getter reports the field as unset.
+	Default Default
 }
 
 // Format implements [fmt.Formatter].
@@ -81,6 +84,9 @@ type FieldParser struct {
 
 	// Byte offset to the typeParser this fieldParser uses, if any.
 	Message *TypeParser
+	// The offset of the type that Message parses, relative to the
+	// [Library]'s base.
+	TypeOffset uint32
 
 	// Field Offset information for the field this parser parses. Duplicated
 	// from [getter].
@@ -107,7 +113,7 @@ func (p *FieldParser) Format(s fmt.State, verb rune) {
 			if p.Message == nil {
 				return nil
 			}
-			return p.Message.TypeOffset
+			return p.TypeOffset
 		}(),
 		"offset", p.Offset,
 		"next", func() any {
@@ -0,0 +1,153 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lazy
+
+import (
+	"math"
+	"unsafe"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// wireType returns the wire type that values of the given kind are encoded
+// with, when not packed.
+func wireType(k protoreflect.Kind) protowire.Type {
+	switch k {
+	case protoreflect.BoolKind, protoreflect.EnumKind,
+		protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Uint32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Uint64Kind:
+		return protowire.VarintType
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return protowire.Fixed32Type
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return protowire.Fixed64Type
+	case protoreflect.GroupKind:
+		return protowire.StartGroupType
+	default:
+		return protowire.BytesType
+	}
+}
+
+// decodeScalar decodes a single non-message value of the given kind from the
+// start of b.
+//
+// Returns the number of bytes consumed, or a negative value if b is malformed.
+//
+// String and bytes values alias b.
+func decodeScalar(k protoreflect.Kind, b []byte) (protoreflect.Value, int) {
+	switch wireType(k) {
+	case protowire.VarintType:
+		v, n := protowire.ConsumeVarint(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+
+		switch k {
+		case protoreflect.BoolKind:
+			return protoreflect.ValueOfBool(v != 0), n
+		case protoreflect.EnumKind:
+			return protoreflect.ValueOfEnum(protoreflect.EnumNumber(v)), n
+		case protoreflect.Int32Kind:
+			return protoreflect.ValueOfInt32(int32(v)), n
+		case protoreflect.Sint32Kind:
+			return protoreflect.ValueOfInt32(int32(protowire.DecodeZigZag(v & math.MaxUint32))), n
+		case protoreflect.Uint32Kind:
+			return protoreflect.ValueOfUint32(uint32(v)), n
+		case protoreflect.Int64Kind:
+			return protoreflect.ValueOfInt64(int64(v)), n
+		case protoreflect.Sint64Kind:
+			return protoreflect.ValueOfInt64(protowire.DecodeZigZag(v)), n
+		default:
+			return protoreflect.ValueOfUint64(v), n
+		}
+
+	case protowire.Fixed32Type:
+		v, n := protowire.ConsumeFixed32(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+
+		switch k {
+		case protoreflect.Sfixed32Kind:
+			return protoreflect.ValueOfInt32(int32(v)), n
+		case protoreflect.FloatKind:
+			return protoreflect.ValueOfFloat32(math.Float32frombits(v)), n
+		default:
+			return protoreflect.ValueOfUint32(v), n
+		}
+
+	case protowire.Fixed64Type:
+		v, n := protowire.ConsumeFixed64(b)
+		if n < 0 {
+			return protoreflect.Value{}, n
+		}
+
+		switch k {
+		case protoreflect.Sfixed64Kind:
+			return protoreflect.ValueOfInt64(int64(v)), n
+		case protoreflect.DoubleKind:
+			return protoreflect.ValueOfFloat64(math.Float64frombits(v)), n
+		default:
+			return protoreflect.ValueOfUint64(v), n
+		}
+
+	default:
+		if k == protoreflect.StringKind {
+			return protoreflect.ValueOfString(unsafe.String(unsafe.SliceData(b), len(b))), len(b)
+		}
+		return protoreflect.ValueOfBytes(b), len(b)
+	}
+}
+
+// decodeValue is like [decodeScalar], but values with a length-prefixed wire
+// type include their length prefix.
+func decodeValue(k protoreflect.Kind, b []byte) (protoreflect.Value, int) {
+	if wireType(k) != protowire.BytesType {
+		return decodeScalar(k, b)
+	}
+
+	b, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return protoreflect.Value{}, n
+	}
+	v, _ := decodeScalar(k, b)
+	return v, n
+}
+
+// isZero returns whether v is the zero value for its kind, which determines
+// whether a field without explicit presence is populated.
+//
+// Floating-point values are compared bitwise, so -0.0 is not zero.
+func isZero(k protoreflect.Kind, v protoreflect.Value) bool {
+	switch k {
+	case protoreflect.BoolKind:
+		return !v.Bool()
+	case protoreflect.EnumKind:
+		return v.Enum() == 0
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return v.Int() == 0
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return v.Uint() == 0
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return math.Float64bits(v.Float()) == 0
+	case protoreflect.StringKind:
+		return v.String() == ""
+	default:
+		return len(v.Bytes()) == 0
+	}
+}
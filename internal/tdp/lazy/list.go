@@ -0,0 +1,67 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lazy
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/debug"
+	"buf.build/go/hyperpb/internal/tdp"
+)
+
+// list is a read-only [protoreflect.List] of decoded values.
+type list []protoreflect.Value
+
+var _ protoreflect.List = list(nil)
+
+// newList decodes the records of a repeated field.
+//
+// Malformed packed records are decoded up to the first malformed element.
+func newList(fd protoreflect.FieldDescriptor, ty *tdp.Type, records []record) list {
+	var out list
+	for _, r := range records {
+		if ty != nil {
+			out = append(out, protoreflect.ValueOfMessage(newMessage(ty, [][]byte{r.data})))
+			continue
+		}
+
+		if r.kind != protowire.BytesType || wireType(fd.Kind()) == protowire.BytesType {
+			v, _ := decodeScalar(fd.Kind(), r.data)
+			out = append(out, v)
+			continue
+		}
+
+		// This is a packed field.
+		for b := r.data; len(b) > 0; {
+			v, n := decodeScalar(fd.Kind(), b)
+			if n < 0 {
+				break
+			}
+			out = append(out, v)
+			b = b[n:]
+		}
+	}
+	return out
+}
+
+func (l list) IsValid() bool                     { return true }
+func (l list) Len() int                          { return len(l) }
+func (l list) Get(n int) protoreflect.Value      { return l[n] }
+func (l list) Append(protoreflect.Value)         { panic(debug.Unsupported()) }
+func (l list) AppendMutable() protoreflect.Value { panic(debug.Unsupported()) }
+func (l list) NewElement() protoreflect.Value    { panic(debug.Unsupported()) }
+func (l list) Set(int, protoreflect.Value)       { panic(debug.Unsupported()) }
+func (l list) Truncate(int)                      { panic(debug.Unsupported()) }
@@ -0,0 +1,112 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lazy
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/debug"
+	"buf.build/go/hyperpb/internal/tdp"
+)
+
+// lazyMap is a read-only [protoreflect.Map] of decoded entries.
+type lazyMap struct {
+	keys    []protoreflect.MapKey
+	entries map[any]protoreflect.Value
+}
+
+var _ protoreflect.Map = (*lazyMap)(nil)
+
+// newMap decodes the entries of a map field.
+//
+// As with ordinary parsing, later entries overwrite earlier entries with the
+// same key. Malformed entries are skipped.
+func newMap(fd protoreflect.FieldDescriptor, ty *tdp.Type, records []record) *lazyMap {
+	m := &lazyMap{entries: make(map[any]protoreflect.Value, len(records))}
+	kd, vd := fd.MapKey(), fd.MapValue()
+
+	for _, r := range records {
+		k := kd.Default()
+		var v protoreflect.Value
+		var chunks [][]byte
+
+	entry:
+		for b := r.data; len(b) > 0; {
+			num, typ, n := protowire.ConsumeTag(b)
+			if n < 0 {
+				break
+			}
+			b = b[n:]
+
+			switch {
+			case num == 1 && typ == wireType(kd.Kind()):
+				k, n = decodeValue(kd.Kind(), b)
+			case num == 2 && typ == protowire.BytesType && ty != nil:
+				var chunk []byte
+				chunk, n = protowire.ConsumeBytes(b)
+				chunks = append(chunks, chunk)
+			case num == 2 && typ == wireType(vd.Kind()):
+				v, n = decodeValue(vd.Kind(), b)
+			default:
+				n = protowire.ConsumeFieldValue(num, typ, b)
+			}
+			if n < 0 {
+				break entry
+			}
+			b = b[n:]
+		}
+
+		switch {
+		case ty != nil:
+			v = protoreflect.ValueOfMessage(newMessage(ty, chunks))
+		case !v.IsValid():
+			v = vd.Default()
+		}
+
+		mk := k.MapKey()
+		if _, ok := m.entries[mk.Interface()]; !ok {
+			m.keys = append(m.keys, mk)
+		}
+		m.entries[mk.Interface()] = v
+	}
+
+	return m
+}
+
+func (m *lazyMap) IsValid() bool { return true }
+func (m *lazyMap) Len() int      { return len(m.keys) }
+
+func (m *lazyMap) Has(k protoreflect.MapKey) bool {
+	_, ok := m.entries[k.Interface()]
+	return ok
+}
+
+func (m *lazyMap) Get(k protoreflect.MapKey) protoreflect.Value {
+	return m.entries[k.Interface()]
+}
+
+func (m *lazyMap) Range(yield func(protoreflect.MapKey, protoreflect.Value) bool) {
+	for _, k := range m.keys {
+		if !yield(k, m.entries[k.Interface()]) {
+			return
+		}
+	}
+}
+
+func (m *lazyMap) Clear(protoreflect.MapKey)                      { panic(debug.Unsupported()) }
+func (m *lazyMap) Set(protoreflect.MapKey, protoreflect.Value)    { panic(debug.Unsupported()) }
+func (m *lazyMap) Mutable(protoreflect.MapKey) protoreflect.Value { panic(debug.Unsupported()) }
+func (m *lazyMap) NewValue() protoreflect.Value                   { panic(debug.Unsupported()) }
@@ -0,0 +1,360 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lazy provides a read-only message view which decodes fields directly
+// out of the wire format on demand, rather than parsing the whole message
+// up-front.
+package lazy
+
+import (
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"buf.build/go/hyperpb/internal/debug"
+	"buf.build/go/hyperpb/internal/tdp"
+	"buf.build/go/hyperpb/internal/tdp/empty"
+)
+
+var (
+	_ proto.Message        = (*Message)(nil)
+	_ protoreflect.Message = (*Message)(nil)
+)
+
+// Message is a lazily-decoded message.
+//
+// The first field access performs a shallow scan of the message's records,
+// which records the location of each field in the input. Values are decoded
+// from those locations when requested; composite values are memoized.
+//
+// A Message is safe to use from multiple goroutines.
+type Message struct {
+	ty *tdp.Type
+
+	// The encoded message. Submessage fields which occur more than once are
+	// merged, so they are represented as multiple chunks.
+	chunks [][]byte
+
+	once    sync.Once
+	err     error
+	records map[protoreflect.FieldNumber][]record
+	unknown [][]byte
+
+	mu    sync.Mutex
+	cache map[protoreflect.FieldNumber]protoreflect.Value
+}
+
+// record is a single record for a known field.
+type record struct {
+	pos  int // Global order of this record within the message.
+	kind protowire.Type
+
+	// The value's encoding. For length-prefixed records and groups, this is
+	// only the contents.
+	data []byte
+}
+
+// New returns a new lazy message of the given type, backed by data.
+//
+// Returns an error if the records of the message are malformed. The contents
+// of submessages are not checked.
+func New(ty *tdp.Type, data []byte) (*Message, error) {
+	m := newMessage(ty, [][]byte{data})
+	if err := m.scan(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func newMessage(ty *tdp.Type, chunks [][]byte) *Message {
+	return &Message{ty: ty, chunks: chunks}
+}
+
+// scan indexes the records in this message. This only happens once.
+//
+// Submessages that fail to scan behave as though they are empty.
+func (m *Message) scan() error {
+	m.once.Do(func() {
+		m.records = make(map[protoreflect.FieldNumber][]record)
+
+		var pos, offset int
+		for _, chunk := range m.chunks {
+			for b := chunk; len(b) > 0; {
+				num, typ, n := protowire.ConsumeField(b)
+				if n < 0 {
+					clear(m.records)
+					m.unknown = nil
+					m.err = fmt.Errorf("hyperpb: malformed record at offset %d: %w",
+						offset, protowire.ParseError(n))
+					return
+				}
+
+				raw := b[:n]
+				b = b[n:]
+				offset += n
+
+				if !m.accepts(num, typ) {
+					m.unknown = append(m.unknown, raw)
+					continue
+				}
+
+				_, _, tn := protowire.ConsumeTag(raw)
+				value := raw[tn:]
+				switch typ {
+				case protowire.BytesType:
+					value, _ = protowire.ConsumeBytes(value)
+				case protowire.StartGroupType:
+					value, _ = protowire.ConsumeGroup(num, value)
+				}
+
+				m.records[num] = append(m.records[num], record{pos: pos, kind: typ, data: value})
+				pos++
+			}
+		}
+	})
+	return m.err
+}
+
+// accepts returns whether a record with the given number and type is a
+// known field of this message. Records with a mismatched wire type are treated
+// as unknown fields.
+func (m *Message) accepts(num protoreflect.FieldNumber, typ protowire.Type) bool {
+	fd := m.field(num)
+	if fd == nil {
+		return false
+	}
+
+	want := wireType(fd.Kind())
+	return typ == want ||
+		(fd.IsList() && typ == protowire.BytesType && want != protowire.BytesType)
+}
+
+// field returns the descriptor for the field with the given number, if this
+// message's type knows about it.
+func (m *Message) field(num protoreflect.FieldNumber) protoreflect.FieldDescriptor {
+	if fd := m.ty.Descriptor.Fields().ByNumber(num); fd != nil {
+		return fd
+	}
+	for _, fd := range m.ty.FieldDescriptors {
+		if fd.IsExtension() && fd.Number() == num {
+			return fd
+		}
+	}
+	return nil
+}
+
+// ProtoReflect implements [proto.Message].
+func (m *Message) ProtoReflect() protoreflect.Message {
+	return m
+}
+
+// Descriptor implements [protoreflect.Message].
+func (m *Message) Descriptor() protoreflect.MessageDescriptor {
+	return m.ty.Descriptor
+}
+
+// Type implements [protoreflect.Message].
+func (m *Message) Type() protoreflect.MessageType {
+	return m.ty.ProtoReflect()
+}
+
+// New implements [protoreflect.Message].
+func (m *Message) New() protoreflect.Message {
+	return m.Type().New()
+}
+
+// Interface implements [protoreflect.Message].
+func (m *Message) Interface() protoreflect.ProtoMessage {
+	return m
+}
+
+// Range implements [protoreflect.Message].
+func (m *Message) Range(yield func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	_ = m.scan()
+	for _, fd := range m.ty.FieldDescriptors {
+		if !m.Has(fd) {
+			continue
+		}
+		if !yield(fd, m.Get(fd)) {
+			return
+		}
+	}
+}
+
+// Has implements [protoreflect.Message].
+func (m *Message) Has(fd protoreflect.FieldDescriptor) bool {
+	if !m.ty.ByDescriptor(fd).IsValid() {
+		return false
+	}
+
+	_ = m.scan()
+	if len(m.records[fd.Number()]) == 0 {
+		return false
+	}
+
+	switch {
+	case fd.IsList():
+		return m.Get(fd).List().Len() > 0
+	case fd.IsMap():
+		return m.Get(fd).Map().Len() > 0
+	case fd.ContainingOneof() != nil:
+		return m.WhichOneof(fd.ContainingOneof()) == fd
+	case fd.HasPresence():
+		return true
+	default:
+		return !isZero(fd.Kind(), m.Get(fd))
+	}
+}
+
+// Get implements [protoreflect.Message].
+func (m *Message) Get(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	f := m.ty.ByDescriptor(fd)
+	if !f.IsValid() {
+		return protoreflect.ValueOf(nil)
+	}
+
+	_ = m.scan()
+	records := m.records[fd.Number()]
+	if od := fd.ContainingOneof(); od != nil && len(records) > 0 && m.WhichOneof(od) != fd {
+		records = nil
+	}
+
+	switch {
+	case len(records) == 0:
+		switch {
+		case fd.IsList():
+			return protoreflect.ValueOfList(empty.List{})
+		case fd.IsMap():
+			return protoreflect.ValueOfMap(empty.Map{})
+		case fd.Message() != nil:
+			return protoreflect.ValueOfMessage(empty.NewMessage(f.Message))
+		default:
+			return f.Default.Value(fd)
+		}
+
+	case fd.IsList(), fd.IsMap(), fd.Message() != nil:
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		if v, ok := m.cache[fd.Number()]; ok {
+			return v
+		}
+
+		var v protoreflect.Value
+		switch {
+		case fd.IsList():
+			v = protoreflect.ValueOfList(newList(fd, f.Message, records))
+		case fd.IsMap():
+			v = protoreflect.ValueOfMap(newMap(fd, f.Message, records))
+		default:
+			chunks := make([][]byte, len(records))
+			for i, r := range records {
+				chunks[i] = r.data
+			}
+			v = protoreflect.ValueOfMessage(newMessage(f.Message, chunks))
+		}
+
+		if m.cache == nil {
+			m.cache = make(map[protoreflect.FieldNumber]protoreflect.Value)
+		}
+		m.cache[fd.Number()] = v
+		return v
+
+	default:
+		v, _ := decodeScalar(fd.Kind(), records[len(records)-1].data)
+		return v
+	}
+}
+
+// Clear implements [protoreflect.Message].
+//
+// Panics when called.
+func (m *Message) Clear(protoreflect.FieldDescriptor) {
+	panic(debug.Unsupported())
+}
+
+// Set implements [protoreflect.Message].
+//
+// Panics when called.
+func (m *Message) Set(protoreflect.FieldDescriptor, protoreflect.Value) {
+	panic(debug.Unsupported())
+}
+
+// Mutable implements [protoreflect.Message].
+//
+// Panics when called.
+func (m *Message) Mutable(protoreflect.FieldDescriptor) protoreflect.Value {
+	panic(debug.Unsupported())
+}
+
+// NewField implements [protoreflect.Message].
+//
+// Panics when called.
+func (m *Message) NewField(protoreflect.FieldDescriptor) protoreflect.Value {
+	panic(debug.Unsupported())
+}
+
+// WhichOneof implements [protoreflect.Message].
+func (m *Message) WhichOneof(od protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	_ = m.scan()
+
+	var which protoreflect.FieldDescriptor
+	last := -1
+	fields := od.Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		records := m.records[fd.Number()]
+		if len(records) > 0 && records[len(records)-1].pos > last {
+			which = fd
+			last = records[len(records)-1].pos
+		}
+	}
+	return which
+}
+
+// GetUnknown implements [protoreflect.Message].
+func (m *Message) GetUnknown() protoreflect.RawFields {
+	_ = m.scan()
+
+	if len(m.unknown) == 1 {
+		return m.unknown[0]
+	}
+
+	var out []byte
+	for _, raw := range m.unknown {
+		out = append(out, raw...)
+	}
+	return out
+}
+
+// SetUnknown implements [protoreflect.Message].
+//
+// Panics when called.
+func (m *Message) SetUnknown(protoreflect.RawFields) {
+	panic(debug.Unsupported())
+}
+
+// IsValid implements [protoreflect.Message].
+func (m *Message) IsValid() bool {
+	return m != nil
+}
+
+// ProtoMethods implements [protoreflect.Message].
+func (m *Message) ProtoMethods() *protoiface.Methods {
+	return nil
+}
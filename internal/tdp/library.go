@@ -15,6 +15,8 @@
 package tdp
 
 import (
+	"sync/atomic"
+
 	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"buf.build/go/hyperpb/internal/xunsafe"
@@ -26,10 +28,20 @@ import (
 type Library struct {
 	Base  *Type
 	Types map[protoreflect.MessageDescriptor]*Type
+
+	// The approximate number of bytes retained by this library: the linked
+	// parser program, plus the off-program [Aux] data for each type.
 	Bytes int
+	// Set once Bytes has been returned to the memory budget it was charged
+	// to, so that releasing several of this library's types only returns it
+	// once.
+	Released atomic.Bool
 
 	// Used to store compilation metadata. Actually a []hyperpb.CompileOptions.
 	Metadata any
+
+	// If set, used in place of the parser's built-in UTF-8 validation.
+	ValidUTF8 func([]byte) bool
 }
 
 // Type returns the [Type] for the given descriptor in this library.
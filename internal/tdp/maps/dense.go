@@ -0,0 +1,174 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maps
+
+import (
+	"math/bits"
+	"unsafe"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/swiss"
+	"buf.build/go/hyperpb/internal/tdp/empty"
+	"buf.build/go/hyperpb/internal/xprotoreflect"
+	"buf.build/go/hyperpb/internal/xunsafe"
+)
+
+// DenseKey is any integer type that can be a key to a [DenseIntToScalar].
+type DenseKey interface{ int32 | uint32 }
+
+// DenseIntToScalar is a map<K, V> field where K is a 32-bit integer type and V
+// is a scalar type, whose keys are expected to be small, non-negative
+// integers.
+//
+// Keys in [0, Cap) are stored in an array indexed by the key, along with a
+// bitset of which of them are present. Any other keys are stored in a
+// hash table, like an [IntToScalar].
+type DenseIntToScalar[K DenseKey, V any] struct {
+	// The number of keys stored in the array, and how many of them are
+	// present.
+	Cap, Count uint32
+
+	// Entries whose keys are not in the array, or nil if there are none.
+	Sparse *swiss.Table[K, V]
+
+	// Followed by the bitset, as Cap bits rounded up to a whole number of
+	// uint64s, followed by Cap values.
+}
+
+// DenseLayout returns the size of a [DenseIntToScalar] with the given capacity.
+func DenseLayout[V any](cap int) int {
+	var v V
+	words := (cap + 63) / 64
+	size := int(unsafe.Sizeof(DenseIntToScalar[int32, V]{})) + words*8
+	return size + int(unsafe.Sizeof(v))*cap
+}
+
+// Present returns the bitset of which keys in the array are present.
+func (m *DenseIntToScalar[K, V]) Present() []uint64 {
+	return unsafe.Slice(xunsafe.Beyond[uint64](m).Get(0), (m.Cap+63)/64)
+}
+
+// Values returns the array of values.
+func (m *DenseIntToScalar[K, V]) Values() []V {
+	words := int(m.Cap+63) / 64
+	p := xunsafe.Cast[V](xunsafe.Beyond[uint64](m).Get(words))
+	return unsafe.Slice(p, m.Cap)
+}
+
+// Len implements [Map].
+func (m *DenseIntToScalar[K, V]) Len() int {
+	if m == nil {
+		return 0
+	}
+	n := int(m.Count)
+	if m.Sparse != nil {
+		n += m.Sparse.Len()
+	}
+	return n
+}
+
+// Get implements [Map].
+func (m *DenseIntToScalar[K, V]) Get(key K) (V, bool) {
+	var z V
+	if m == nil {
+		return z, false
+	}
+
+	if i := uint32(key); i < m.Cap {
+		if m.Present()[i/64]&(1<<(i%64)) == 0 {
+			return z, false
+		}
+		return m.Values()[i], true
+	}
+
+	if m.Sparse == nil {
+		return z, false
+	}
+	v := m.Sparse.Lookup(key)
+	if v == nil {
+		return z, false
+	}
+	return *v, true
+}
+
+// Range implements [Map].
+//
+// The keys in the array are yielded first, in ascending order.
+func (m *DenseIntToScalar[K, V]) Range(yield func(K, V) bool) {
+	if m == nil {
+		return
+	}
+
+	values := m.Values()
+	for i, word := range m.Present() {
+		for word != 0 {
+			j := i*64 + bits.TrailingZeros64(word)
+			word &= word - 1
+			if !yield(K(j), values[j]) {
+				return
+			}
+		}
+	}
+
+	if m.Sparse != nil {
+		m.Sparse.All()(yield)
+	}
+}
+
+// ProtoReflect implements [Map].
+func (m *DenseIntToScalar[K, V]) ProtoReflect() protoreflect.Map {
+	return xunsafe.Cast[reflectDenseIntToScalar[K, V]](m)
+}
+
+func (*DenseIntToScalar[_, _]) isMap() {} //nolint:unused
+
+// reflectDenseIntToScalar wraps a DenseIntToScalar so that it implements
+// protoreflect.Map.
+type reflectDenseIntToScalar[K DenseKey, V any] struct {
+	empty.Map
+	_ DenseIntToScalar[K, V]
+}
+
+// IsValid implements [protoreflect.Map].
+func (r *reflectDenseIntToScalar[_, _]) IsValid() bool { return r != nil }
+
+// Len implements [protoreflect.Map].
+func (r *reflectDenseIntToScalar[_, _]) Len() int {
+	return raw(r).Len()
+}
+
+// Has implements [protoreflect.Map].
+func (r *reflectDenseIntToScalar[_, _]) Has(k protoreflect.MapKey) bool {
+	return r.Get(k).IsValid()
+}
+
+// Get implements [protoreflect.Map].
+func (r *reflectDenseIntToScalar[K, _]) Get(k protoreflect.MapKey) protoreflect.Value {
+	v, ok := raw(r).Get(xprotoreflect.GetInt[K](k.Value()))
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return xprotoreflect.ValueOfScalar(v)
+}
+
+// Range implements [protoreflect.Map].
+func (r *reflectDenseIntToScalar[K, _]) Range(yield func(protoreflect.MapKey, protoreflect.Value) bool) {
+	for k, v := range raw(r).Range {
+		if !yield(protoreflect.MapKey(xprotoreflect.ValueOfScalar(k)), xprotoreflect.ValueOfScalar(v)) {
+			return
+		}
+	}
+}
@@ -45,6 +45,7 @@ var (
 	_ Map[int32, string]           = (*IntToString[int32])(nil)
 	_ Map[int32, []byte]           = (*IntToBytes[int32])(nil)
 	_ Map[int32, *dynamic.Message] = (*IntToMessage[int32, dynamic.Message])(nil)
+	_ Map[int32, int32]            = (*DenseIntToScalar[int32, int32])(nil)
 
 	_ Map[string, int32]            = (*StringToScalar[int32])(nil)
 	_ Map[string, string]           = (*StringToString)(nil)
@@ -32,6 +32,60 @@ func raw[R ~struct {
 	return xunsafe.Cast[M](r)
 }
 
+// StringToMessages returns the storage of a map returned by the getter of a
+// map<string, M> field, where M is a message type.
+func StringToMessages(m protoreflect.Map) (*StringToMessage[dynamic.Message], bool) {
+	r, ok := m.(*reflectStringToMessage)
+	return raw(r), ok
+}
+
+// EqualMessages compares two maps returned by the getter of a map<K, M> field,
+// where M is a message type, without wrapping their entries in reflection
+// values. They are equal if they have the same keys, and eq returns true for
+// the values of each key.
+//
+// Returns false for ok if either map is not such a map, including if it is an
+// unset map.
+func EqualMessages(a, b protoreflect.Map, eq func(a, b *dynamic.Message) bool) (equal, ok bool) {
+	switch a := a.(type) {
+	case *reflectIntToMessage[int32]:
+		b, ok := b.(*reflectIntToMessage[int32])
+		return ok && equalMessages(raw(a), raw(b), eq), ok
+	case *reflectIntToMessage[int64]:
+		b, ok := b.(*reflectIntToMessage[int64])
+		return ok && equalMessages(raw(a), raw(b), eq), ok
+	case *reflectIntToMessage[uint32]:
+		b, ok := b.(*reflectIntToMessage[uint32])
+		return ok && equalMessages(raw(a), raw(b), eq), ok
+	case *reflectIntToMessage[uint64]:
+		b, ok := b.(*reflectIntToMessage[uint64])
+		return ok && equalMessages(raw(a), raw(b), eq), ok
+	case *reflectStringToMessage:
+		b, ok := b.(*reflectStringToMessage)
+		return ok && equalMessages(raw(a), raw(b), eq), ok
+	case *reflectBoolToMessage:
+		b, ok := b.(*reflectBoolToMessage)
+		return ok && equalMessages(raw(a), raw(b), eq), ok
+	default:
+		return false, false
+	}
+}
+
+// equalMessages implements [EqualMessages] once the types of the maps are
+// known.
+func equalMessages[K any, M Map[K, *dynamic.Message]](a, b M, eq func(a, b *dynamic.Message) bool) bool {
+	if a.Len() != b.Len() {
+		return false
+	}
+	for k, va := range a.Range {
+		vb, ok := b.Get(k)
+		if !ok || !eq(va, vb) {
+			return false
+		}
+	}
+	return true
+}
+
 // reflectIntToScalar wraps an IntToScalar so that it implements protoreflect.Map.
 type reflectIntToScalar[K Int, V any] struct {
 	empty.Map
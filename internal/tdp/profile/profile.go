@@ -40,6 +40,55 @@ type Field struct {
 
 	// Should this field assume it never sees non-UTF-8 data?
 	AssumeUTF8 bool
+
+	// Should this repeated bool field be stored as a bitset?
+	BitsetBools bool
+
+	// Are the contents of this string or bytes field secret, such that they
+	// should be parsed in time independent of their contents, and wiped from
+	// memory once freed?
+	Secret bool
+
+	// Is this string field interned as it is parsed, by its type's interning
+	// table? Ignored for fields which are not singular strings.
+	Intern bool
+
+	// If greater than one, only one in this many elements of this repeated
+	// message field is parsed; the rest are skipped, and only counted.
+	Sample int
+
+	// Is this deprecated field elided, such that it has no storage and is
+	// parsed as an unknown field?
+	Elided bool
+
+	// If positive, the entries of this map field whose keys are in
+	// [0, DenseKeys) are stored in an array indexed by key, rather than in a
+	// hash table. Ignored unless [DenseKeyable] returns true for the field.
+	DenseKeys int
+}
+
+// MaxDenseKeys is the largest value of [Field].DenseKeys that a [Recorder]
+// will choose.
+const MaxDenseKeys = 1 << 16
+
+// DenseKeyable returns whether fd is a map field that supports
+// [Field].DenseKeys: one with 32-bit varint keys and scalar values.
+func DenseKeyable(fd protoreflect.FieldDescriptor) bool {
+	if !fd.IsMap() {
+		return false
+	}
+	switch fd.MapKey().Kind() {
+	case protoreflect.Int32Kind, protoreflect.Uint32Kind, protoreflect.Sint32Kind:
+	default:
+		return false
+	}
+	switch fd.MapValue().Kind() {
+	case protoreflect.StringKind, protoreflect.BytesKind,
+		protoreflect.MessageKind, protoreflect.GroupKind:
+		return false
+	default:
+		return true
+	}
 }
 
 // DefaultProfile returns the default profile for a field.
@@ -62,12 +62,7 @@ func (r *Recorder) Record(m *dynamic.Message) {
 		f := ty.ByDescriptor(fd)
 		debug.Assert(f != nil, "invalid field in Record()")
 
-		metrics, _ := r.profiles.LoadOrStore(f, func() *metrics {
-			return &metrics{
-				desc:  fd,
-				count: *stats.NewMedian(1 << 12),
-			}
-		})
+		metrics, _ := r.profiles.LoadOrStore(f, func() *metrics { return newMetrics(fd) })
 		metrics.parse.Record(1)
 
 		if m := xprotoreflect.UnsafeUnwrap(pv, hyperpbMessage); m != nil {
@@ -90,6 +85,17 @@ func (r *Recorder) Record(m *dynamic.Message) {
 
 		if m := xprotoreflect.Map(pv); m.IsValid() {
 			metrics.count.Record(float64(m.Len()))
+			if DenseKeyable(fd) {
+				unsigned := fd.MapKey().Kind() == protoreflect.Uint32Kind
+				for k := range m.Range {
+					if unsigned {
+						metrics.keys.Record(int64(k.Uint()))
+					} else {
+						metrics.keys.Record(k.Int())
+					}
+				}
+				continue
+			}
 			for _, pv := range m.Range {
 				m := xprotoreflect.UnsafeUnwrap(pv, hyperpbMessage)
 				if m == nil {
@@ -102,6 +108,34 @@ func (r *Recorder) Record(m *dynamic.Message) {
 	}
 }
 
+// Merge adds the information recorded by that to r.
+//
+// that may be for a different library compiled from the same descriptors,
+// such as by recompiling r's types; information about fields which r's library
+// does not contain is discarded. This function may be called concurrently
+// with [Recorder.Record].
+func (r *Recorder) Merge(that *Recorder) {
+	if r == that {
+		return
+	}
+
+	for _, m := range that.profiles.All() {
+		ty, _ := r.library.Type(m.desc.ContainingMessage())
+		if ty == nil {
+			continue
+		}
+		f := ty.ByDescriptor(m.desc)
+		if f == nil || !f.IsValid() {
+			continue
+		}
+
+		metrics, _ := r.profiles.LoadOrStore(f, func() *metrics { return newMetrics(m.desc) })
+		metrics.parse.Merge(&m.parse)
+		metrics.count.Merge(&m.count)
+		metrics.keys.Merge(&m.keys)
+	}
+}
+
 // ForField implements [Profile].
 func (r *Recorder) ForField(site Site) Field {
 	profile := site.DefaultProfile()
@@ -124,6 +158,13 @@ func (r *Recorder) ForField(site Site) Field {
 	profile.DecodeProbability = m.parse.Get()
 	profile.ExpectedCount = int(m.count.Get())
 
+	// Store the keys of a map densely if they are all small and non-negative,
+	// and a typical map uses at least a quarter of the array.
+	if lo, hi, ok := m.keys.Get(); ok && lo >= 0 && hi < MaxDenseKeys &&
+		4*profile.ExpectedCount > int(hi) {
+		profile.DenseKeys = int(hi) + 1
+	}
+
 	return profile
 }
 
@@ -152,4 +193,12 @@ type metrics struct {
 	desc  protoreflect.FieldDescriptor
 	parse stats.Mean
 	count stats.Median
+	keys  stats.Bounds // Only recorded for fields that are DenseKeyable.
+}
+
+func newMetrics(fd protoreflect.FieldDescriptor) *metrics {
+	return &metrics{
+		desc:  fd,
+		count: *stats.NewMedian(1 << 12),
+	}
 }
@@ -15,11 +15,13 @@
 package repeated
 
 import (
+	"fmt"
 	"iter"
 	"slices"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"buf.build/go/hyperpb/internal/arena"
 	"buf.build/go/hyperpb/internal/arena/slice"
 	"buf.build/go/hyperpb/internal/xunsafe"
 )
@@ -79,7 +81,105 @@ func (b Bools) Copy(out []bool) []bool {
 	return out
 }
 
+// Append appends v to this repeated field, first copying its elements onto a
+// if it is in zero-copy mode.
+func (b *Bools) Append(a *arena.Arena, v bool) {
+	raw := slice.CastUntyped[byte](b.Raw)
+	if b.Raw.OffArena() {
+		raw = slice.Of(a, raw.Raw()...)
+	}
+	var x byte
+	if v {
+		x = 1
+	}
+	b.Raw = raw.AppendOne(a, x).Addr().Untyped()
+}
+
 // ProtoReflect returns a reflection value for this list.
 func (b *Bools) ProtoReflect() protoreflect.List {
 	return xunsafe.Cast[reflectBools](b)
 }
+
+// BitBools is a repeated field containing bools, stored as a bitset of one bit
+// per element, rather than one byte per element like [Bools].
+//
+//nolint:recvcheck
+type BitBools struct {
+	_ [0]bool // Prevent sketchy casts.
+
+	Words slice.Addr[uint64]
+	N     uint32 // The number of bits in Words that are in use.
+}
+
+// Len returns the length of this repeated field.
+func (b BitBools) Len() int {
+	return int(b.N)
+}
+
+// Get extracts a value at the given index.
+//
+// Panics if the index is out-of-bounds.
+func (b BitBools) Get(n int) bool {
+	if uint(n) >= uint(b.N) {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", n, b.N))
+	}
+	word := b.Words.AssertValid().Load(n / 64)
+	return word&(1<<(n%64)) != 0
+}
+
+// Values returns an iterator over the elements of s.
+func (b BitBools) Values() iter.Seq[bool] {
+	return func(yield func(bool) bool) {
+		for _, v := range b.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the indices and elements of s.
+func (b BitBools) All() iter.Seq2[int, bool] {
+	return func(yield func(int, bool) bool) {
+		if b.N == 0 {
+			return
+		}
+		for i, word := range b.Words.AssertValid().Raw() {
+			for j := range min(64, int(b.N)-i*64) {
+				if !yield(i*64+j, word&(1<<j) != 0) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Copy copies these bools to a slice, appending to out.
+//
+// To get a fresh slice, pass nil to this function.
+func (b BitBools) Copy(out []bool) []bool {
+	out = slices.Grow(out, b.Len())
+	for v := range b.Values() {
+		out = append(out, v)
+	}
+	return out
+}
+
+// Append appends v to this repeated field.
+func (b *BitBools) Append(a *arena.Arena, v bool) {
+	words := b.Words.AssertValid()
+	if b.N%64 == 0 {
+		words = words.AppendOne(a, 0)
+		b.Words = words.Addr()
+	}
+	if v {
+		raw := words.Raw()
+		raw[b.N/64] |= 1 << (b.N % 64)
+	}
+	b.N++
+}
+
+// ProtoReflect returns a reflection value for this list.
+func (b *BitBools) ProtoReflect() protoreflect.List {
+	return xunsafe.Cast[reflectBitBools](b)
+}
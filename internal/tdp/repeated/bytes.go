@@ -32,7 +32,7 @@ type Bytes struct {
 	_ [0][]byte // Prevent sketchy casts.
 
 	Src *byte
-	Raw slice.Slice[zc.Range]
+	Raw slice.Chunked[zc.Range]
 }
 
 // Len returns the length of this repeated field.
@@ -44,14 +44,14 @@ func (b Bytes) Len() int {
 //
 // Panics if the index is out-of-bounds.
 func (b Bytes) Get(n int) []byte {
-	r := b.Raw.Raw()[n]
+	r := b.Raw.Load(n)
 	return r.Bytes(b.Src)
 }
 
 // Values returns an iterator over the elements of b.
 func (b Bytes) Values() iter.Seq[[]byte] {
 	return func(yield func([]byte) bool) {
-		for _, v := range b.Raw.Raw() {
+		for _, v := range b.Raw.All() {
 			if !yield(v.Bytes(b.Src)) {
 				return
 			}
@@ -62,7 +62,7 @@ func (b Bytes) Values() iter.Seq[[]byte] {
 // All returns an iterator over the indices and elements of b.
 func (b Bytes) All() iter.Seq2[int, []byte] {
 	return func(yield func(int, []byte) bool) {
-		for i, v := range b.Raw.Raw() {
+		for i, v := range b.Raw.All() {
 			if !yield(i, v.Bytes(b.Src)) {
 				return
 			}
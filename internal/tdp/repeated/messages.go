@@ -28,16 +28,21 @@ import (
 //
 // Repeated messages use two different layouts, and the stride is used to
 // differentiate them. The messages can either be packed into an arena slice,
-// or the arena slice can contain *message pointers. These are called inlined
-// and outlined modes; the stride is zero in the latter case. We switch to the
-// outlined mode to avoid needing to copy parsed messages on slice resize.
+// or they can be referred to by a chunked list of *message pointers. These are
+// called inlined and outlined modes; the stride is zero in the latter case.
+// We switch to the outlined mode to avoid needing to copy parsed messages on
+// slice resize, and use a chunked list so that the pointers themselves are
+// never copied either.
 //
 // M *must* be some type which wraps a dynamic.Message.
 type Messages[M any] struct {
-	// Slice[byte] if stride is nonzero, Slice[*Message] otherwise.
+	// Slice[byte] if stride is nonzero. Once outlined, this still holds the
+	// messages that were parsed before switching modes.
 	Raw slice.Untyped
 	// The array stride for when raw is an inlined message list.
 	Stride uint32
+	// Pointers to every message, if stride is zero.
+	Ptrs slice.Chunked[xunsafe.Addr[M]]
 }
 
 // Len returns the length of this repeated field.
@@ -46,7 +51,7 @@ func (m Messages[_]) Len() int {
 		return int(m.Raw.Len) / int(m.Stride)
 	}
 
-	return int(m.Raw.Len)
+	return m.Ptrs.Len()
 }
 
 // Get extracts a value at the given index.
@@ -62,22 +67,13 @@ func (m Messages[M]) Get(n int) *M {
 		)
 	}
 
-	return slice.CastUntyped[*M](m.Raw).Raw()[n]
+	return m.Ptrs.Load(n).AssertValid()
 }
 
 // Values returns an iterator over the elements of m.
 func (m Messages[M]) Values() iter.Seq[*M] {
 	return func(yield func(*M) bool) {
-		if m.Stride != 0 {
-			for k := 0; k < int(m.Raw.Len); k += int(m.Stride) {
-				p := xunsafe.ByteAdd[M](m.Raw.Ptr.AssertValid(), k)
-				if !yield(p) {
-					return
-				}
-			}
-		}
-
-		for _, p := range slice.CastUntyped[*M](m.Raw).Raw() {
+		for _, p := range m.All() {
 			if !yield(p) {
 				return
 			}
@@ -97,24 +93,21 @@ func (m Messages[M]) All() iter.Seq2[int, *M] {
 				}
 				i++
 			}
+			return
 		}
 
-		for i, p := range slice.CastUntyped[*M](m.Raw).Raw() {
-			if !yield(i, p) {
+		for i, p := range m.Ptrs.All() {
+			if !yield(i, p.AssertValid()) {
 				return
 			}
 		}
 	}
 }
 
-// Copy copies these scalars to a slice, appending to out.
+// Copy copies these messages to a slice, appending to out.
 //
 // To get a fresh slice, pass nil to this function.
 func (m Messages[M]) Copy(out []*M) []*M {
-	if m.Stride == 0 {
-		return append(out, slice.CastUntyped[*M](m.Raw).Raw()...)
-	}
-
 	out = slices.Grow(out, m.Len())
 	for v := range m.Values() {
 		out = append(out, v)
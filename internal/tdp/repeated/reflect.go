@@ -15,12 +15,18 @@
 package repeated
 
 import (
+	"fmt"
+
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"buf.build/go/hyperpb/internal/arena"
+	"buf.build/go/hyperpb/internal/arena/slice"
 	"buf.build/go/hyperpb/internal/tdp"
 	"buf.build/go/hyperpb/internal/tdp/dynamic"
 	"buf.build/go/hyperpb/internal/tdp/empty"
 	"buf.build/go/hyperpb/internal/xprotoreflect"
+	"buf.build/go/hyperpb/internal/xunsafe"
+	"buf.build/go/hyperpb/internal/xunsafe/layout"
 )
 
 // reflectScalars wraps a repeated.Scalars so that it implements protoreflect.List.
@@ -42,6 +48,64 @@ func (r *reflectScalars[Z, E]) Get(n int) protoreflect.Value {
 	return xprotoreflect.ValueOfScalar(r.raw.Get(n))
 }
 
+func (r *reflectScalars[_, E]) append(a *arena.Arena, v protoreflect.Value) {
+	r.raw.Append(a, scalarOf[E](v))
+}
+
+// ScalarSlice returns the storage of a list returned by the getter of a
+// repeated scalar field, if its elements are stored as a slice of E.
+func ScalarSlice[E tdp.Number](list protoreflect.List) ([]E, bool) {
+	switch r := list.(type) {
+	case *reflectScalars[byte, E]:
+		return r.raw.Slice()
+	case *reflectScalars[E, E]:
+		return r.raw.Slice()
+	default:
+		return nil, false
+	}
+}
+
+// CompactVarints returns the storage of a list returned by the getter of a
+// repeated varint field, if it is in zero-copy mode. In this mode, every
+// element is a one-byte varint, i.e., it is its own value.
+func CompactVarints(list protoreflect.List) ([]byte, bool) {
+	r, ok := list.(interface{ compactVarints() ([]byte, bool) })
+	if !ok {
+		return nil, false
+	}
+	return r.compactVarints()
+}
+
+func (r *reflectScalars[_, _]) isZC() bool { return r.raw.IsZC() }
+
+func (r *reflectScalars[ZC, E]) compactVarints() ([]byte, bool) {
+	if !r.raw.IsZC() || layout.Size[ZC]() != 1 || layout.Size[E]() == 1 {
+		return nil, false
+	}
+	return slice.CastUntyped[byte](r.raw.Raw).Raw(), true
+}
+
+// ScalarStorage returns whether a list returned by the getter of a repeated
+// scalar field is in zero-copy mode, and the address of the field's storage
+// within its message.
+func ScalarStorage(list protoreflect.List) (field *byte, zc, ok bool) {
+	r, ok := list.(interface{ isZC() bool })
+	if !ok {
+		return nil, false, false
+	}
+	return xunsafe.AnyData(r), r.isZC(), true
+}
+
+// MessageList returns the storage of a list returned by the getter of a
+// repeated message field.
+func MessageList(list protoreflect.List) (*Messages[dynamic.Message], bool) {
+	r, ok := list.(*reflectMessages)
+	if !ok {
+		return nil, false
+	}
+	return &r.raw, true
+}
+
 // reflectZigzags wraps a repeated.Zigzags so that it implements protoreflect.List.
 type reflectZigzags[ZC, E tdp.Number] struct {
 	empty.List
@@ -61,6 +125,12 @@ func (r *reflectZigzags[Z, E]) Get(n int) protoreflect.Value {
 	return xprotoreflect.ValueOfScalar(r.raw.Get(n))
 }
 
+func (r *reflectZigzags[_, _]) isZC() bool { return r.raw.IsZC() }
+
+func (r *reflectZigzags[_, E]) append(a *arena.Arena, v protoreflect.Value) {
+	r.raw.Append(a, scalarOf[E](v))
+}
+
 // reflectBools wraps a repeated.Bools so that it implements protoreflect.List.
 type reflectBools struct {
 	empty.List
@@ -80,6 +150,36 @@ func (r *reflectBools) Get(n int) protoreflect.Value {
 	return protoreflect.ValueOfBool(r.raw.Get(n))
 }
 
+func (r *reflectBools) isZC() bool { return r.raw.Raw.OffArena() }
+
+func (r *reflectBools) append(a *arena.Arena, v protoreflect.Value) {
+	r.raw.Append(a, v.Bool())
+}
+
+// reflectBitBools wraps a repeated.BitBools so that it implements
+// protoreflect.List.
+type reflectBitBools struct {
+	empty.List
+	raw BitBools
+}
+
+// IsValid implements [protoreflect.List].
+func (r *reflectBitBools) IsValid() bool { return r != nil }
+
+// Len implements [protoreflect.List].
+func (r *reflectBitBools) Len() int {
+	return r.raw.Len()
+}
+
+// Get implements [protoreflect.List].
+func (r *reflectBitBools) Get(n int) protoreflect.Value {
+	return protoreflect.ValueOfBool(r.raw.Get(n))
+}
+
+func (r *reflectBitBools) append(a *arena.Arena, v protoreflect.Value) {
+	r.raw.Append(a, v.Bool())
+}
+
 // reflectStrings wraps a repeated.Strings so that it implements protoreflect.List.
 type reflectStrings struct {
 	empty.List
@@ -136,3 +236,43 @@ func (r *reflectMessages) Len() int {
 func (r *reflectMessages) Get(n int) protoreflect.Value {
 	return protoreflect.ValueOfMessage(r.raw.Get(n).ProtoReflect())
 }
+
+// Appendable wraps a list returned by the getter of a repeated scalar field,
+// so that its Append method appends to the field, allocating on a.
+//
+// Returns false if list is not such a list.
+func Appendable(list protoreflect.List, a *arena.Arena) (protoreflect.List, bool) {
+	r, ok := list.(appender)
+	if !ok {
+		return nil, false
+	}
+	return &appendable{appender: r, arena: a}, true
+}
+
+// appender is a list that can be appended to, given an arena.
+type appender interface {
+	protoreflect.List
+	append(*arena.Arena, protoreflect.Value)
+}
+
+// appendable is a list returned by [Appendable].
+type appendable struct {
+	appender
+	arena *arena.Arena
+}
+
+// Append implements [protoreflect.List].
+func (l *appendable) Append(v protoreflect.Value) {
+	l.append(l.arena, v)
+}
+
+// scalarOf extracts an E from v.
+//
+// Panics if v does not contain an E.
+func scalarOf[E tdp.Number](v protoreflect.Value) E {
+	e, ok := v.Interface().(E)
+	if !ok {
+		panic(fmt.Sprintf("hyperpb: invalid type %T for list of %T", v.Interface(), e))
+	}
+	return e
+}
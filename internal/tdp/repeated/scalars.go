@@ -20,6 +20,7 @@ import (
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"buf.build/go/hyperpb/internal/arena"
 	"buf.build/go/hyperpb/internal/arena/slice"
 	"buf.build/go/hyperpb/internal/tdp"
 	"buf.build/go/hyperpb/internal/xunsafe"
@@ -119,6 +120,27 @@ func (s Scalars[ZC, E]) Copy(out []E) []E {
 	return out
 }
 
+// Slice returns the elements of s without copying, if they are stored as a
+// slice of E. This is not the case when s is in zero-copy mode and ZC is
+// narrower than E.
+func (s Scalars[ZC, E]) Slice() ([]E, bool) {
+	if s.IsZC() && layout.Size[ZC]() != layout.Size[E]() {
+		return nil, false
+	}
+	return slice.CastUntyped[E](s.Raw).Raw(), true
+}
+
+// Append appends v to this repeated field, first copying its elements onto a
+// if it is in zero-copy mode.
+func (s *Scalars[ZC, E]) Append(a *arena.Arena, v E) {
+	raw := slice.CastUntyped[E](s.Raw)
+	if s.IsZC() {
+		raw = slice.Make[E](a, s.Len())
+		s.Copy(raw.Raw()[:0])
+	}
+	s.Raw = raw.AppendOne(a, v).Addr().Untyped()
+}
+
 // ProtoReflect returns a reflection value for this list.
 func (s *Scalars[ZC, E]) ProtoReflect() protoreflect.List {
 	return xunsafe.Cast[reflectScalars[ZC, E]](s)
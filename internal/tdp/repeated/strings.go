@@ -34,7 +34,7 @@ type Strings struct {
 	_ [0]string // Prevent sketchy casts.
 
 	Src *byte
-	Raw slice.Slice[zc.Range]
+	Raw slice.Chunked[zc.Range]
 }
 
 // Len returns the length of this repeated field.
@@ -46,14 +46,14 @@ func (s Strings) Len() int {
 //
 // Panics if the index is out-of-bounds.
 func (s Strings) Get(n int) string {
-	r := s.Raw.Raw()[n]
+	r := s.Raw.Load(n)
 	return r.String(s.Src)
 }
 
 // Values returns an iterator over the elements of s.
 func (s Strings) Values() iter.Seq[string] {
 	return func(yield func(string) bool) {
-		for _, v := range s.Raw.Raw() {
+		for _, v := range s.Raw.All() {
 			if !yield(v.String(s.Src)) {
 				return
 			}
@@ -64,7 +64,7 @@ func (s Strings) Values() iter.Seq[string] {
 // All returns an iterator over the indices and elements of s.
 func (s Strings) All() iter.Seq2[int, string] {
 	return func(yield func(int, string) bool) {
-		for i, v := range s.Raw.Raw() {
+		for i, v := range s.Raw.All() {
 			if !yield(i, v.String(s.Src)) {
 				return
 			}
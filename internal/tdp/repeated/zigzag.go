@@ -18,8 +18,10 @@ import (
 	"iter"
 	"slices"
 
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"buf.build/go/hyperpb/internal/arena"
 	"buf.build/go/hyperpb/internal/arena/slice"
 	"buf.build/go/hyperpb/internal/tdp"
 	"buf.build/go/hyperpb/internal/xunsafe"
@@ -110,6 +112,20 @@ func (z Zigzags[ZC, E]) Copy(out []E) []E {
 	return out
 }
 
+// Append appends v to this repeated field, first copying its elements onto a
+// if it is in zero-copy mode.
+func (z *Zigzags[ZC, E]) Append(a *arena.Arena, v E) {
+	raw := slice.CastUntyped[E](z.Raw)
+	if z.IsZC() {
+		raw = slice.Make[E](a, z.Len())
+		for i, v := range slice.CastUntyped[ZC](z.Raw).Raw() {
+			raw.Store(i, E(v))
+		}
+	}
+	v = E(protowire.EncodeZigZag(int64(v)))
+	z.Raw = raw.AppendOne(a, v).Addr().Untyped()
+}
+
 // ProtoReflect returns a reflection value for this list.
 func (s *Zigzags[ZC, E]) ProtoReflect() protoreflect.List {
 	return xunsafe.Cast[reflectZigzags[ZC, E]](s)
@@ -78,6 +78,10 @@ func (t Tag) Format(s fmt.State, verb rune) {
 
 // Returns whether this tag is "too large", i.e., if it has more than 32 bits
 // when decoded.
+//
+// A tag of at most five bytes decodes to 35 bits, the top three of which are
+// bits 36 to 38 of t, so t overflows if it has more than 32+4 significant
+// bits.
 func (t Tag) Overflows() bool {
-	return bits.LeadingZeros64(uint64(t)) < (64 - 32 + 4)
+	return bits.LeadingZeros64(uint64(t)) < 64-(32+4)
 }
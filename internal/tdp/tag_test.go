@@ -0,0 +1,48 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tdp_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"buf.build/go/hyperpb/internal/tdp"
+)
+
+func TestOverflows(t *testing.T) {
+	t.Parallel()
+
+	raw := func(v uint64) tdp.Tag {
+		var buf [8]byte
+		protowire.AppendVarint(buf[:0], v)
+		return tdp.Tag(binary.LittleEndian.Uint64(buf[:])) &^ tdp.SignBits
+	}
+
+	// Tags of up to four bytes.
+	assert.False(t, tdp.EncodeTag(1, protowire.VarintType).Overflows())
+	assert.False(t, raw(1<<28-1).Overflows())
+
+	// Five-byte tags, which decode to up to 35 bits, only some of which fit
+	// in 32 bits. This includes all field numbers from 1<<25 up.
+	assert.False(t, tdp.EncodeTag(1<<25, protowire.VarintType).Overflows())
+	assert.False(t, tdp.EncodeTag(protowire.MaxValidNumber, protowire.BytesType).Overflows())
+	assert.False(t, raw(1<<28).Overflows())
+	assert.False(t, raw(1<<32-1).Overflows())
+	assert.True(t, raw(1<<32).Overflows())
+	assert.True(t, raw(1<<35-1).Overflows())
+}
@@ -0,0 +1,244 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thunks
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/swiss"
+	"buf.build/go/hyperpb/internal/tdp"
+	"buf.build/go/hyperpb/internal/tdp/compiler"
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/maps"
+	"buf.build/go/hyperpb/internal/tdp/vm"
+	"buf.build/go/hyperpb/internal/xunsafe"
+	"buf.build/go/hyperpb/internal/xunsafe/layout"
+)
+
+// denseMapFields consists of archetypes for map fields whose keys are stored
+// densely; see [maps.DenseIntToScalar]. The first index is the key, the second
+// is the value.
+//
+// Only 32-bit varint keys and scalar values are supported; this must match
+// [profile.DenseKeyable].
+var denseMapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
+	protoreflect.Int32Kind: {
+		// 32-bit varint types.
+		protoreflect.Int32Kind:  denseMapArch(getDenseMapIxI[int32, int32], parseDenseMapV32xV32),
+		protoreflect.Uint32Kind: denseMapArch(getDenseMapIxI[int32, uint32], parseDenseMapV32xV32),
+		protoreflect.Sint32Kind: denseMapArch(getDenseMapIxI[int32, int32], parseDenseMapV32xZ32),
+
+		// 64-bit varint types.
+		protoreflect.Int64Kind:  denseMapArch(getDenseMapIxI[int32, int64], parseDenseMapV32xV64),
+		protoreflect.Uint64Kind: denseMapArch(getDenseMapIxI[int32, uint64], parseDenseMapV32xV64),
+		protoreflect.Sint64Kind: denseMapArch(getDenseMapIxI[int32, int64], parseDenseMapV32xZ64),
+
+		// 32-bit fixed types.
+		protoreflect.Fixed32Kind:  denseMapArch(getDenseMapIxI[int32, uint32], parseDenseMapV32xF32),
+		protoreflect.Sfixed32Kind: denseMapArch(getDenseMapIxI[int32, int32], parseDenseMapV32xF32),
+		protoreflect.FloatKind:    denseMapArch(getDenseMapIxI[int32, float32], parseDenseMapV32xR32),
+
+		// 64-bit fixed types.
+		protoreflect.Fixed64Kind:  denseMapArch(getDenseMapIxI[int32, uint64], parseDenseMapV32xF64),
+		protoreflect.Sfixed64Kind: denseMapArch(getDenseMapIxI[int32, int64], parseDenseMapV32xF64),
+		protoreflect.DoubleKind:   denseMapArch(getDenseMapIxI[int32, float64], parseDenseMapV32xR64),
+
+		// Special scalar types.
+		protoreflect.BoolKind: denseMapArch(getDenseMapIxI[int32, bool], parseDenseMapV32x2),
+		protoreflect.EnumKind: denseMapArch(getDenseMapIxI[int32, protoreflect.EnumNumber], parseDenseMapV32xV32),
+	},
+	protoreflect.Uint32Kind: {
+		// 32-bit varint types.
+		protoreflect.Int32Kind:  denseMapArch(getDenseMapIxI[uint32, int32], parseDenseMapV32xV32),
+		protoreflect.Uint32Kind: denseMapArch(getDenseMapIxI[uint32, uint32], parseDenseMapV32xV32),
+		protoreflect.Sint32Kind: denseMapArch(getDenseMapIxI[uint32, int32], parseDenseMapV32xZ32),
+
+		// 64-bit varint types.
+		protoreflect.Int64Kind:  denseMapArch(getDenseMapIxI[uint32, int64], parseDenseMapV32xV64),
+		protoreflect.Uint64Kind: denseMapArch(getDenseMapIxI[uint32, uint64], parseDenseMapV32xV64),
+		protoreflect.Sint64Kind: denseMapArch(getDenseMapIxI[uint32, int64], parseDenseMapV32xZ64),
+
+		// 32-bit fixed types.
+		protoreflect.Fixed32Kind:  denseMapArch(getDenseMapIxI[uint32, uint32], parseDenseMapV32xF32),
+		protoreflect.Sfixed32Kind: denseMapArch(getDenseMapIxI[uint32, int32], parseDenseMapV32xF32),
+		protoreflect.FloatKind:    denseMapArch(getDenseMapIxI[uint32, float32], parseDenseMapV32xR32),
+
+		// 64-bit fixed types.
+		protoreflect.Fixed64Kind:  denseMapArch(getDenseMapIxI[uint32, uint64], parseDenseMapV32xF64),
+		protoreflect.Sfixed64Kind: denseMapArch(getDenseMapIxI[uint32, int64], parseDenseMapV32xF64),
+		protoreflect.DoubleKind:   denseMapArch(getDenseMapIxI[uint32, float64], parseDenseMapV32xR64),
+
+		// Special scalar types.
+		protoreflect.BoolKind: denseMapArch(getDenseMapIxI[uint32, bool], parseDenseMapV32x2),
+		protoreflect.EnumKind: denseMapArch(getDenseMapIxI[uint32, protoreflect.EnumNumber], parseDenseMapV32xV32),
+	},
+	protoreflect.Sint32Kind: {
+		// 32-bit varint types.
+		protoreflect.Int32Kind:  denseMapArch(getDenseMapIxI[int32, int32], parseDenseMapZ32xV32),
+		protoreflect.Uint32Kind: denseMapArch(getDenseMapIxI[int32, uint32], parseDenseMapZ32xV32),
+		protoreflect.Sint32Kind: denseMapArch(getDenseMapIxI[int32, int32], parseDenseMapZ32xZ32),
+
+		// 64-bit varint types.
+		protoreflect.Int64Kind:  denseMapArch(getDenseMapIxI[int32, int64], parseDenseMapZ32xV64),
+		protoreflect.Uint64Kind: denseMapArch(getDenseMapIxI[int32, uint64], parseDenseMapZ32xV64),
+		protoreflect.Sint64Kind: denseMapArch(getDenseMapIxI[int32, int64], parseDenseMapZ32xZ64),
+
+		// 32-bit fixed types.
+		protoreflect.Fixed32Kind:  denseMapArch(getDenseMapIxI[int32, uint32], parseDenseMapZ32xF32),
+		protoreflect.Sfixed32Kind: denseMapArch(getDenseMapIxI[int32, int32], parseDenseMapZ32xF32),
+		protoreflect.FloatKind:    denseMapArch(getDenseMapIxI[int32, float32], parseDenseMapZ32xR32),
+
+		// 64-bit fixed types.
+		protoreflect.Fixed64Kind:  denseMapArch(getDenseMapIxI[int32, uint64], parseDenseMapZ32xF64),
+		protoreflect.Sfixed64Kind: denseMapArch(getDenseMapIxI[int32, int64], parseDenseMapZ32xF64),
+		protoreflect.DoubleKind:   denseMapArch(getDenseMapIxI[int32, float64], parseDenseMapZ32xR64),
+
+		// Special scalar types.
+		protoreflect.BoolKind: denseMapArch(getDenseMapIxI[int32, bool], parseDenseMapZ32x2),
+		protoreflect.EnumKind: denseMapArch(getDenseMapIxI[int32, protoreflect.EnumNumber], parseDenseMapZ32xV32),
+	},
+}
+
+// getDenseMapIxI is a [getterThunk] for map<K, V> where K is a 32-bit integer
+// type and V is a scalar type, whose keys are stored densely.
+func getDenseMapIxI[K maps.DenseKey, V any](m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
+	v := dynamic.LoadField[*maps.DenseIntToScalar[K, V]](m, getter.Offset)
+	return protoreflect.ValueOfMap(v.ProtoReflect())
+}
+
+// denseMapArch is a helper for constructing dense map<K, V> archetypes.
+func denseMapArch(getter compiler.Getter, parser vm.Thunk) *compiler.Archetype {
+	return &compiler.Archetype{
+		Layout:  layout.Of[*maps.DenseIntToScalar[uint32, uint32]](),
+		Getter:  getter,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Retry: true, Thunk: parser}},
+	}
+}
+
+//hyperpb:stencil parseDenseMapV32xV32 parseDenseMapKxV[varint32Item, varint32Item, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
+//hyperpb:stencil parseDenseMapV32xV64 parseDenseMapKxV[varint32Item, varint64Item, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
+//hyperpb:stencil parseDenseMapV32xZ32 parseDenseMapKxV[varint32Item, zigzag32Item, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
+//hyperpb:stencil parseDenseMapV32xZ64 parseDenseMapKxV[varint32Item, zigzag64Item, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
+//hyperpb:stencil parseDenseMapV32xF32 parseDenseMapKxV[varint32Item, fixed32Item, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
+//hyperpb:stencil parseDenseMapV32xR32 parseDenseMapKxV[varint32Item, float32Item, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
+//hyperpb:stencil parseDenseMapV32xF64 parseDenseMapKxV[varint32Item, fixed64Item, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
+//hyperpb:stencil parseDenseMapV32xR64 parseDenseMapKxV[varint32Item, float64Item, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
+//hyperpb:stencil parseDenseMapV32x2   parseDenseMapKxV[varint32Item, boolItem, uint8] Init -> swiss.InitU32xU8 Insert -> swiss.InsertU32xU8
+//hyperpb:stencil parseDenseMapZ32xV32 parseDenseMapKxV[zigzag32Item, varint32Item, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
+//hyperpb:stencil parseDenseMapZ32xV64 parseDenseMapKxV[zigzag32Item, varint64Item, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
+//hyperpb:stencil parseDenseMapZ32xZ32 parseDenseMapKxV[zigzag32Item, zigzag32Item, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
+//hyperpb:stencil parseDenseMapZ32xZ64 parseDenseMapKxV[zigzag32Item, zigzag64Item, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
+//hyperpb:stencil parseDenseMapZ32xF32 parseDenseMapKxV[zigzag32Item, fixed32Item, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
+//hyperpb:stencil parseDenseMapZ32xR32 parseDenseMapKxV[zigzag32Item, float32Item, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
+//hyperpb:stencil parseDenseMapZ32xF64 parseDenseMapKxV[zigzag32Item, fixed64Item, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
+//hyperpb:stencil parseDenseMapZ32xR64 parseDenseMapKxV[zigzag32Item, float64Item, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
+//hyperpb:stencil parseDenseMapZ32x2   parseDenseMapKxV[zigzag32Item, boolItem, uint8] Init -> swiss.InitU32xU8 Insert -> swiss.InsertU32xU8
+
+// parseDenseMapKxV parses a map type whose keys are stored densely. The size
+// of the array of dense keys is the field's preload size.
+//
+// Dense maps are only used when a profile shows that they are worthwhile, so
+// unlike [parseMapKxV], this does not have a fast path for entries that
+// consist of exactly a key and a value.
+func parseDenseMapKxV[KI mapItem[uint32], VI mapItem[V], V any](p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki KI
+	var vi VI
+	var k uint32
+	var v V
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, V]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, V]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, V]](p1.Arena().Alloc(maps.DenseLayout[V](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	// Keys outside of the array go in a hash table, exactly as in parseMapKxV.
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, V](1)
+		t := xunsafe.Cast[swiss.Table[uint32, V]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		t.Init(1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := m.Sparse.Insert(k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, V](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, V]](p1.Arena().Alloc(size))
+			t.Init(m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = t.Insert(k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
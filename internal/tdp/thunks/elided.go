@@ -0,0 +1,76 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thunks
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp"
+	"buf.build/go/hyperpb/internal/tdp/compiler"
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/empty"
+	"buf.build/go/hyperpb/internal/xunsafe/layout"
+)
+
+// Archetypes for elided fields, which have no storage and no parsers, so
+// that they are parsed as unknown fields. See [profile.Field].Elided.
+var (
+	elidedFields = &compiler.Archetype{
+		Layout: layout.Of[[0]byte](),
+		Getter: getElided,
+	}
+	elidedListFields = &compiler.Archetype{
+		Layout: layout.Of[[0]byte](),
+		Getter: getElidedList,
+	}
+	elidedMapFields = &compiler.Archetype{
+		Layout: layout.Of[[0]byte](),
+		Getter: getElidedMap,
+	}
+	elidedMessageFields = &compiler.Archetype{
+		Layout: layout.Of[[0]byte](),
+		Getter: getElidedMessage,
+	}
+)
+
+// selectElidedArchetype selects an archetype for an elided field.
+func selectElidedArchetype(fd protoreflect.FieldDescriptor) *compiler.Archetype {
+	switch {
+	case fd.IsMap():
+		return elidedMapFields
+	case fd.IsList():
+		return elidedListFields
+	case fd.Message() != nil:
+		return elidedMessageFields
+	default:
+		return elidedFields
+	}
+}
+
+func getElided(*dynamic.Message, *tdp.Type, *tdp.Accessor) protoreflect.Value {
+	return protoreflect.Value{}
+}
+
+func getElidedList(*dynamic.Message, *tdp.Type, *tdp.Accessor) protoreflect.Value {
+	return protoreflect.ValueOfList(empty.List{})
+}
+
+func getElidedMap(*dynamic.Message, *tdp.Type, *tdp.Accessor) protoreflect.Value {
+	return protoreflect.ValueOfMap(empty.Map{})
+}
+
+func getElidedMessage(_ *dynamic.Message, ty *tdp.Type, _ *tdp.Accessor) protoreflect.Value {
+	return protoreflect.ValueOfMessage(empty.NewMessage(ty))
+}
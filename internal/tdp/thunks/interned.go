@@ -0,0 +1,171 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thunks
+
+import (
+	"unsafe"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/arena/slice"
+	"buf.build/go/hyperpb/internal/tdp"
+	"buf.build/go/hyperpb/internal/tdp/compiler"
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/profile"
+	"buf.build/go/hyperpb/internal/tdp/vm"
+	"buf.build/go/hyperpb/internal/xunsafe"
+	"buf.build/go/hyperpb/internal/xunsafe/layout"
+	"buf.build/go/hyperpb/internal/zc"
+)
+
+// Interned fields are singular string fields of types compiled with string
+// interning, whose values are interned with the type's [intern.Table] once,
+// as they are parsed. The canonical copy of a value lives on the Go heap
+// rather than in the input buffer, so they cannot be stored as a [zc.Range];
+// instead, they are stored as an off-arena [slice.Addr], and the copy is kept
+// alive by the message's [dynamic.Shared]. See [profile.Field].Intern.
+//
+// Like transformed fields, interned fields that are not in a oneof always
+// have a hasbit, which is only consulted for fields with explicit presence.
+
+// internedFields consists of archetypes for interned singular fields.
+var internedFields = map[protoreflect.Kind]*compiler.Archetype{
+	protoreflect.StringKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Bits:    1,
+		Getter:  getTransformedString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseInternedString}},
+	},
+	proto2StringKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Bits:    1,
+		Getter:  getTransformedString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseInternedProto2String}},
+	},
+}
+
+// optionalInternedFields consists of archetypes for interned optional fields.
+var optionalInternedFields = map[protoreflect.Kind]*compiler.Archetype{
+	protoreflect.StringKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Bits:    1,
+		Getter:  getOptionalTransformedString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseInternedString}},
+	},
+	proto2StringKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Bits:    1,
+		Getter:  getOptionalTransformedString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseInternedProto2String}},
+	},
+}
+
+// oneofInternedFields consists of archetypes for interned oneof members.
+var oneofInternedFields = map[protoreflect.Kind]*compiler.Archetype{
+	protoreflect.StringKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Oneof:   true,
+		Getter:  getOneofInternedString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseOneofInternedString}},
+	},
+	proto2StringKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Oneof:   true,
+		Getter:  getOneofInternedString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseOneofInternedProto2String}},
+	},
+}
+
+// selectInternedArchetype selects an archetype for an interned field.
+//
+// Returns nil if fd is not a singular string field.
+func selectInternedArchetype(fd protoreflect.FieldDescriptor, prof profile.Field) *compiler.Archetype {
+	od := fd.ContainingOneof()
+	switch {
+	case fd.IsMap(), fd.IsList():
+		return nil
+	case od != nil && od.Fields().Len() > 1:
+		return oneofInternedFields[fieldKind(fd, prof)]
+	case fd.HasPresence():
+		return optionalInternedFields[fieldKind(fd, prof)]
+	default:
+		return internedFields[fieldKind(fd, prof)]
+	}
+}
+
+func getOneofInternedString(m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
+	which := xunsafe.ByteLoad[uint32](m, getter.Offset.Bit)
+	if which != getter.Offset.Number {
+		return protoreflect.Value{}
+	}
+	s := dynamic.GetField[slice.Addr[byte]](m, getter.Offset)
+	if s.Len == 0 {
+		return protoreflect.ValueOfString("")
+	}
+	return protoreflect.ValueOfString(xunsafe.SliceToString(s.AssertValid().Raw()))
+}
+
+func parseInternedString(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var r zc.Range
+	p1, p2, r = p1.UTF8(p2)
+	p1, p2 = vm.SetBit(p1, p2)
+	return storeInterned(p1, p2, r)
+}
+
+func parseInternedProto2String(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var r zc.Range
+	p1, p2, r = p1.Bytes(p2)
+	p1, p2 = vm.SetBit(p1, p2)
+	return storeInterned(p1, p2, r)
+}
+
+func parseOneofInternedString(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var r zc.Range
+	p1, p2, r = p1.UTF8(p2)
+	xunsafe.ByteStore(p2.Message(), p2.Field().Offset.Bit, p2.Field().Offset.Number)
+	return storeInterned(p1, p2, r)
+}
+
+func parseOneofInternedProto2String(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var r zc.Range
+	p1, p2, r = p1.Bytes(p2)
+	xunsafe.ByteStore(p2.Message(), p2.Field().Offset.Bit, p2.Field().Offset.Number)
+	return storeInterned(p1, p2, r)
+}
+
+// storeInterned interns the string at r with the current type's table, and
+// stores the canonical copy in the current field.
+//
+//go:noinline
+func storeInterned(p1 vm.P1, p2 vm.P2, r zc.Range) (vm.P1, vm.P2) {
+	var s slice.Addr[byte]
+	if r.Len() > 0 {
+		c := p2.Message().Type().Interner.String(r.String(p1.Src()))
+		shared := p1.Shared()
+		shared.Interned = append(shared.Interned, c)
+		s = slice.Addr[byte]{
+			Ptr: ^xunsafe.AddrOf(unsafe.StringData(c)),
+			Len: uint32(len(c)),
+			Cap: uint32(len(c)),
+		}
+	}
+
+	var p *slice.Addr[byte]
+	p1, p2, p = vm.GetMutableField[slice.Addr[byte]](p1, p2)
+	*p = s
+
+	return p1, p2
+}
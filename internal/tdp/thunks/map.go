@@ -15,6 +15,8 @@
 package thunks
 
 import (
+	"bytes"
+	"encoding/binary"
 	"unsafe"
 
 	"google.golang.org/protobuf/encoding/protowire"
@@ -50,12 +52,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapIxI[int32, uint32], parseMapV32xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapIxI[int32, int32], parseMapV32xF32),
-		protoreflect.FloatKind:    mapArch(getMapIxI[int32, float32], parseMapV32xF32),
+		protoreflect.FloatKind:    mapArch(getMapIxI[int32, float32], parseMapV32xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapIxI[int32, uint64], parseMapV32xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapIxI[int32, int64], parseMapV32xF64),
-		protoreflect.DoubleKind:   mapArch(getMapIxI[int32, float64], parseMapV32xF64),
+		protoreflect.DoubleKind:   mapArch(getMapIxI[int32, float64], parseMapV32xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapIxI[int32, bool], parseMapV32x2),
@@ -67,9 +69,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapIxM[int32], parseMapV32xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 	protoreflect.Int64Kind: {
 		// 32-bit varint types.
@@ -85,12 +85,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapIxI[int64, uint32], parseMapV64xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapIxI[int64, int32], parseMapV64xF32),
-		protoreflect.FloatKind:    mapArch(getMapIxI[int64, float32], parseMapV64xF32),
+		protoreflect.FloatKind:    mapArch(getMapIxI[int64, float32], parseMapV64xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapIxI[int64, uint64], parseMapV64xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapIxI[int64, int64], parseMapV64xF64),
-		protoreflect.DoubleKind:   mapArch(getMapIxI[int64, float64], parseMapV64xF64),
+		protoreflect.DoubleKind:   mapArch(getMapIxI[int64, float64], parseMapV64xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapIxI[int64, bool], parseMapV64x2),
@@ -102,9 +102,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapIxM[int64], parseMapV64xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 	protoreflect.Uint32Kind: {
 		// 32-bit varint types.
@@ -120,12 +118,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapIxI[uint32, uint32], parseMapV32xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapIxI[uint32, int32], parseMapV32xF32),
-		protoreflect.FloatKind:    mapArch(getMapIxI[uint32, float32], parseMapV32xF32),
+		protoreflect.FloatKind:    mapArch(getMapIxI[uint32, float32], parseMapV32xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapIxI[uint32, uint64], parseMapV32xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapIxI[uint32, int64], parseMapV32xF64),
-		protoreflect.DoubleKind:   mapArch(getMapIxI[uint32, float64], parseMapV32xF64),
+		protoreflect.DoubleKind:   mapArch(getMapIxI[uint32, float64], parseMapV32xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapIxI[uint32, bool], parseMapV32x2),
@@ -137,9 +135,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapIxM[uint32], parseMapV32xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 	protoreflect.Uint64Kind: {
 		// 32-bit varint types.
@@ -155,12 +151,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapIxI[uint64, uint32], parseMapV64xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapIxI[uint64, int32], parseMapV64xF32),
-		protoreflect.FloatKind:    mapArch(getMapIxI[uint64, float32], parseMapV64xF32),
+		protoreflect.FloatKind:    mapArch(getMapIxI[uint64, float32], parseMapV64xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapIxI[uint64, uint64], parseMapV64xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapIxI[uint64, int64], parseMapV64xF64),
-		protoreflect.DoubleKind:   mapArch(getMapIxI[uint64, float64], parseMapV64xF64),
+		protoreflect.DoubleKind:   mapArch(getMapIxI[uint64, float64], parseMapV64xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapIxI[uint64, bool], parseMapV64x2),
@@ -172,9 +168,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapIxM[uint64], parseMapV64xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 	protoreflect.Sint32Kind: {
 		// 32-bit varint types.
@@ -190,12 +184,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapIxI[int32, uint32], parseMapZ32xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapIxI[int32, int32], parseMapZ32xF32),
-		protoreflect.FloatKind:    mapArch(getMapIxI[int32, float32], parseMapZ32xF32),
+		protoreflect.FloatKind:    mapArch(getMapIxI[int32, float32], parseMapZ32xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapIxI[int32, uint64], parseMapZ32xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapIxI[int32, int64], parseMapZ32xF64),
-		protoreflect.DoubleKind:   mapArch(getMapIxI[int32, float64], parseMapZ32xF64),
+		protoreflect.DoubleKind:   mapArch(getMapIxI[int32, float64], parseMapZ32xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapIxI[int32, bool], parseMapZ32x2),
@@ -207,9 +201,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapIxM[int32], parseMapZ32xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 	protoreflect.Sint64Kind: {
 		// 32-bit varint types.
@@ -225,12 +217,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapIxI[int64, uint32], parseMapZ64xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapIxI[int64, int32], parseMapZ64xF32),
-		protoreflect.FloatKind:    mapArch(getMapIxI[int64, float32], parseMapZ64xF32),
+		protoreflect.FloatKind:    mapArch(getMapIxI[int64, float32], parseMapZ64xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapIxI[int64, uint64], parseMapZ64xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapIxI[int64, int64], parseMapZ64xF64),
-		protoreflect.DoubleKind:   mapArch(getMapIxI[int64, float64], parseMapZ64xF64),
+		protoreflect.DoubleKind:   mapArch(getMapIxI[int64, float64], parseMapZ64xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapIxI[int64, bool], parseMapZ64x2),
@@ -242,9 +234,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapIxM[int64], parseMapZ64xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 
 	protoreflect.Fixed32Kind: {
@@ -261,12 +251,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapIxI[uint32, uint32], parseMapF32xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapIxI[uint32, int32], parseMapF32xF32),
-		protoreflect.FloatKind:    mapArch(getMapIxI[uint32, float32], parseMapF32xF32),
+		protoreflect.FloatKind:    mapArch(getMapIxI[uint32, float32], parseMapF32xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapIxI[uint32, uint64], parseMapF32xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapIxI[uint32, int64], parseMapF32xF64),
-		protoreflect.DoubleKind:   mapArch(getMapIxI[uint32, float64], parseMapF32xF64),
+		protoreflect.DoubleKind:   mapArch(getMapIxI[uint32, float64], parseMapF32xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapIxI[uint32, bool], parseMapF32x2),
@@ -278,9 +268,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapIxM[uint32], parseMapF32xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 	protoreflect.Fixed64Kind: {
 		// 32-bit varint types.
@@ -296,12 +284,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapIxI[uint64, uint32], parseMapF64xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapIxI[uint64, int32], parseMapF64xF32),
-		protoreflect.FloatKind:    mapArch(getMapIxI[uint64, float32], parseMapF64xF32),
+		protoreflect.FloatKind:    mapArch(getMapIxI[uint64, float32], parseMapF64xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapIxI[uint64, uint64], parseMapF64xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapIxI[uint64, int64], parseMapF64xF64),
-		protoreflect.DoubleKind:   mapArch(getMapIxI[uint64, float64], parseMapF64xF64),
+		protoreflect.DoubleKind:   mapArch(getMapIxI[uint64, float64], parseMapF64xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapIxI[uint64, bool], parseMapF64x2),
@@ -313,9 +301,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapIxM[uint64], parseMapF64xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 	protoreflect.Sfixed32Kind: {
 		// 32-bit varint types.
@@ -331,12 +317,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapIxI[int32, uint32], parseMapF32xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapIxI[int32, int32], parseMapF32xF32),
-		protoreflect.FloatKind:    mapArch(getMapIxI[int32, float32], parseMapF32xF32),
+		protoreflect.FloatKind:    mapArch(getMapIxI[int32, float32], parseMapF32xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapIxI[int32, uint64], parseMapF32xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapIxI[int32, int64], parseMapF32xF64),
-		protoreflect.DoubleKind:   mapArch(getMapIxI[int32, float64], parseMapF32xF64),
+		protoreflect.DoubleKind:   mapArch(getMapIxI[int32, float64], parseMapF32xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapIxI[int32, bool], parseMapF32x2),
@@ -348,9 +334,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapIxM[int32], parseMapF32xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 	protoreflect.Sfixed64Kind: {
 		// 32-bit varint types.
@@ -366,12 +350,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapIxI[int64, uint32], parseMapF64xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapIxI[int64, int32], parseMapF64xF32),
-		protoreflect.FloatKind:    mapArch(getMapIxI[int64, float32], parseMapF64xF32),
+		protoreflect.FloatKind:    mapArch(getMapIxI[int64, float32], parseMapF64xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapIxI[int64, uint64], parseMapF64xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapIxI[int64, int64], parseMapF64xF64),
-		protoreflect.DoubleKind:   mapArch(getMapIxI[int64, float64], parseMapF64xF64),
+		protoreflect.DoubleKind:   mapArch(getMapIxI[int64, float64], parseMapF64xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapIxI[int64, bool], parseMapF64x2),
@@ -383,9 +367,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapIxM[int64], parseMapF64xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 
 	protoreflect.BoolKind: {
@@ -402,12 +384,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMap2xI[uint32], parseMap2xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMap2xI[int32], parseMap2xF32),
-		protoreflect.FloatKind:    mapArch(getMap2xI[float32], parseMap2xF32),
+		protoreflect.FloatKind:    mapArch(getMap2xI[float32], parseMap2xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMap2xI[uint64], parseMap2xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMap2xI[int64], parseMap2xF64),
-		protoreflect.DoubleKind:   mapArch(getMap2xI[float64], parseMap2xF64),
+		protoreflect.DoubleKind:   mapArch(getMap2xI[float64], parseMap2xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMap2xI[bool], parseMap2x2),
@@ -419,9 +401,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMap2xM, parseMap2xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 
 	protoreflect.EnumKind: {
@@ -438,12 +418,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapIxI[protoreflect.EnumNumber, uint32], parseMapV32xF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapIxI[protoreflect.EnumNumber, int32], parseMapV32xF32),
-		protoreflect.FloatKind:    mapArch(getMapIxI[protoreflect.EnumNumber, float32], parseMapV32xF32),
+		protoreflect.FloatKind:    mapArch(getMapIxI[protoreflect.EnumNumber, float32], parseMapV32xR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapIxI[protoreflect.EnumNumber, uint64], parseMapV32xF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapIxI[protoreflect.EnumNumber, int64], parseMapV32xF64),
-		protoreflect.DoubleKind:   mapArch(getMapIxI[protoreflect.EnumNumber, float64], parseMapV32xF64),
+		protoreflect.DoubleKind:   mapArch(getMapIxI[protoreflect.EnumNumber, float64], parseMapV32xR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapIxI[protoreflect.EnumNumber, bool], parseMapV32x2),
@@ -455,9 +435,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapIxM[protoreflect.EnumNumber], parseMapV32xM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 
 	protoreflect.StringKind: {
@@ -474,12 +452,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapSxI[uint32], parseMapSxF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapSxI[int32], parseMapSxF32),
-		protoreflect.FloatKind:    mapArch(getMapSxI[float32], parseMapSxF32),
+		protoreflect.FloatKind:    mapArch(getMapSxI[float32], parseMapSxR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapSxI[uint64], parseMapSxF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapSxI[int64], parseMapSxF64),
-		protoreflect.DoubleKind:   mapArch(getMapSxI[float64], parseMapSxF64),
+		protoreflect.DoubleKind:   mapArch(getMapSxI[float64], parseMapSxR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapSxI[bool], parseMapSx2),
@@ -491,9 +469,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapSxM, parseMapSxM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 
 	proto2StringKind: {
@@ -510,12 +486,12 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 		// 32-bit fixed types.
 		protoreflect.Fixed32Kind:  mapArch(getMapSxI[uint32], parseMapBxF32),
 		protoreflect.Sfixed32Kind: mapArch(getMapSxI[int32], parseMapBxF32),
-		protoreflect.FloatKind:    mapArch(getMapSxI[float32], parseMapBxF32),
+		protoreflect.FloatKind:    mapArch(getMapSxI[float32], parseMapBxR32),
 
 		// 64-bit fixed types.
 		protoreflect.Fixed64Kind:  mapArch(getMapSxI[uint64], parseMapBxF64),
 		protoreflect.Sfixed64Kind: mapArch(getMapSxI[int64], parseMapBxF64),
-		protoreflect.DoubleKind:   mapArch(getMapSxI[float64], parseMapBxF64),
+		protoreflect.DoubleKind:   mapArch(getMapSxI[float64], parseMapBxR64),
 
 		// Special scalar types.
 		protoreflect.BoolKind: mapArch(getMapSxI[bool], parseMapBx2),
@@ -527,9 +503,7 @@ var mapFields = map[protoreflect.Kind]map[protoreflect.Kind]*compiler.Archetype{
 
 		// Message types.
 		protoreflect.MessageKind: mapArch(getMapSxM, parseMapBxM),
-		protoreflect.GroupKind:   {
-			// Not implemented.
-		},
+		protoreflect.GroupKind:   nil, // Not implemented.
 	},
 }
 
@@ -638,6 +612,31 @@ type mapItem[V any] interface {
 
 	// Returns the key extraction function used with swiss.Table.Insert.
 	extract(vm.P1, vm.P2) func(V) []byte
+
+	// Decodes a value of this item type from the start of b, without failing
+	// the parse, for looking ahead at map entries that have yet to be parsed.
+	// Returns the number of bytes consumed, which is negative if b does not
+	// start with a valid value.
+	peek(b []byte) (mapKey, int)
+}
+
+// mapKey is a map key decoded by [mapItem.peek].
+type mapKey struct {
+	n uint64 // Integer keys, sign-extended to 64 bits.
+	b []byte // String and bytes keys.
+}
+
+// less returns whether a sorts before b, with integers compared as signed or
+// unsigned depending on signed. Two keys which compare as less in either order
+// are distinct.
+func (a mapKey) less(b mapKey, signed bool) bool {
+	if a.n != b.n {
+		if signed {
+			return int64(a.n) < int64(b.n)
+		}
+		return a.n < b.n
+	}
+	return string(a.b) < string(b.b)
 }
 
 type (
@@ -648,6 +647,8 @@ type (
 	boolItem     struct{}
 	fixed32Item  struct{}
 	fixed64Item  struct{}
+	float32Item  struct{}
+	float64Item  struct{}
 	stringItem   struct{}
 	bytesItem    struct{}
 )
@@ -659,6 +660,8 @@ var (
 	_ mapItem[uint64] = zigzag64Item{}
 	_ mapItem[uint32] = fixed32Item{}
 	_ mapItem[uint64] = fixed64Item{}
+	_ mapItem[uint32] = float32Item{}
+	_ mapItem[uint64] = float64Item{}
 	_ mapItem[uint8]  = boolItem{}
 	_ mapItem[uint64] = stringItem{}
 	_ mapItem[uint64] = bytesItem{}
@@ -670,7 +673,9 @@ func (zigzag32Item) kind() protowire.Type { return protowire.VarintType }
 func (zigzag64Item) kind() protowire.Type { return protowire.VarintType }
 func (boolItem) kind() protowire.Type     { return protowire.VarintType }
 func (fixed32Item) kind() protowire.Type  { return protowire.Fixed32Type }
+func (float32Item) kind() protowire.Type  { return protowire.Fixed32Type }
 func (fixed64Item) kind() protowire.Type  { return protowire.Fixed64Type }
+func (float64Item) kind() protowire.Type  { return protowire.Fixed64Type }
 func (stringItem) kind() protowire.Type   { return protowire.BytesType }
 func (bytesItem) kind() protowire.Type    { return protowire.BytesType }
 
@@ -720,6 +725,16 @@ func (fixed64Item) parse(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2, uint64) {
 	return p1.Fixed64(p2)
 }
 
+//go:nosplit
+func (float32Item) parse(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2, uint32) {
+	return p1.Float32(p2)
+}
+
+//go:nosplit
+func (float64Item) parse(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2, uint64) {
+	return p1.Float64(p2)
+}
+
 //go:nosplit
 func (stringItem) parse(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2, uint64) {
 	var r zc.Range
@@ -740,6 +755,8 @@ func (zigzag32Item) extract(vm.P1, vm.P2) func(uint32) []byte { return nil }
 func (zigzag64Item) extract(vm.P1, vm.P2) func(uint64) []byte { return nil }
 func (fixed32Item) extract(vm.P1, vm.P2) func(uint32) []byte  { return nil }
 func (fixed64Item) extract(vm.P1, vm.P2) func(uint64) []byte  { return nil }
+func (float32Item) extract(vm.P1, vm.P2) func(uint32) []byte  { return nil }
+func (float64Item) extract(vm.P1, vm.P2) func(uint64) []byte  { return nil }
 func (boolItem) extract(vm.P1, vm.P2) func(uint8) []byte      { return nil }
 func (stringItem) extract(p1 vm.P1, _ vm.P2) func(uint64) []byte {
 	return zc.ExtractFrom{Src: p1.Src()}.Bytes
@@ -749,95 +766,225 @@ func (bytesItem) extract(p1 vm.P1, _ vm.P2) func(uint64) []byte {
 	return zc.ExtractFrom{Src: p1.Src()}.Bytes
 }
 
-//hyperpb:stencil parseMapV32xV32 parseMapKxV[varint32Item, varint32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
-//hyperpb:stencil parseMapV32xV64 parseMapKxV[varint32Item, varint64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapV32xZ32 parseMapKxV[varint32Item, zigzag32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
-//hyperpb:stencil parseMapV32xZ64 parseMapKxV[varint32Item, zigzag64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapV32xF32 parseMapKxV[varint32Item, fixed32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
-//hyperpb:stencil parseMapV32xF64 parseMapKxV[varint32Item, fixed64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapV32x2   parseMapKxV[varint32Item, boolItem, uint32, uint8] Init -> swiss.InitU32xU8 Insert -> swiss.InsertU32xU8
-//hyperpb:stencil parseMapV32xS   parseMapKxV[varint32Item, stringItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapV32xB   parseMapKxV[varint32Item, bytesItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-
-//hyperpb:stencil parseMapV64xV32 parseMapKxV[varint64Item, varint32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapV64xV64 parseMapKxV[varint64Item, varint64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapV64xZ32 parseMapKxV[varint64Item, zigzag32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapV64xZ64 parseMapKxV[varint64Item, zigzag64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapV64xF32 parseMapKxV[varint64Item, fixed32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapV64xF64 parseMapKxV[varint64Item, fixed64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapV64x2   parseMapKxV[varint64Item, boolItem, uint64, uint8] Init -> swiss.InitU64xU8 Insert -> swiss.InsertU64xU8
-//hyperpb:stencil parseMapV64xS   parseMapKxV[varint64Item, stringItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapV64xB   parseMapKxV[varint64Item, bytesItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-
-//hyperpb:stencil parseMapZ32xV32 parseMapKxV[zigzag32Item, varint32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
-//hyperpb:stencil parseMapZ32xV64 parseMapKxV[zigzag32Item, varint64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapZ32xZ32 parseMapKxV[zigzag32Item, zigzag32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
-//hyperpb:stencil parseMapZ32xZ64 parseMapKxV[zigzag32Item, zigzag64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapZ32xF32 parseMapKxV[zigzag32Item, fixed32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
-//hyperpb:stencil parseMapZ32xF64 parseMapKxV[zigzag32Item, fixed64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapZ32x2   parseMapKxV[zigzag32Item, boolItem, uint32, uint8] Init -> swiss.InitU32xU8 Insert -> swiss.InsertU32xU8
-//hyperpb:stencil parseMapZ32xS   parseMapKxV[zigzag32Item, stringItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapZ32xB   parseMapKxV[zigzag32Item, bytesItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-
-//hyperpb:stencil parseMapZ64xV32 parseMapKxV[zigzag64Item, varint32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapZ64xV64 parseMapKxV[zigzag64Item, varint64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapZ64xZ32 parseMapKxV[zigzag64Item, zigzag32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapZ64xZ64 parseMapKxV[zigzag64Item, zigzag64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapZ64xF32 parseMapKxV[zigzag64Item, fixed32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapZ64xF64 parseMapKxV[zigzag64Item, fixed64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapZ64x2   parseMapKxV[zigzag64Item, boolItem, uint64, uint8] Init -> swiss.InitU64xU8 Insert -> swiss.InsertU64xU8
-//hyperpb:stencil parseMapZ64xS   parseMapKxV[zigzag64Item, stringItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapZ64xB   parseMapKxV[zigzag64Item, bytesItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-
-//hyperpb:stencil parseMapF32xV32 parseMapKxV[fixed32Item, varint32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
-//hyperpb:stencil parseMapF32xV64 parseMapKxV[fixed32Item, varint64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapF32xZ32 parseMapKxV[fixed32Item, zigzag32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
-//hyperpb:stencil parseMapF32xZ64 parseMapKxV[fixed32Item, zigzag64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapF32xF32 parseMapKxV[fixed32Item, fixed32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32
-//hyperpb:stencil parseMapF32xF64 parseMapKxV[fixed32Item, fixed64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapF32x2   parseMapKxV[fixed32Item, boolItem, uint32, uint8] Init -> swiss.InitU32xU8 Insert -> swiss.InsertU32xU8
-//hyperpb:stencil parseMapF32xS   parseMapKxV[fixed32Item, stringItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-//hyperpb:stencil parseMapF32xB   parseMapKxV[fixed32Item, bytesItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64
-
-//hyperpb:stencil parseMapF64xV32 parseMapKxV[fixed64Item, varint32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapF64xV64 parseMapKxV[fixed64Item, varint64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapF64xZ32 parseMapKxV[fixed64Item, zigzag32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapF64xZ64 parseMapKxV[fixed64Item, zigzag64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapF64xF32 parseMapKxV[fixed64Item, fixed32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapF64xF64 parseMapKxV[fixed64Item, fixed64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapF64x2   parseMapKxV[fixed64Item, boolItem, uint64, uint8] Init -> swiss.InitU64xU8 Insert -> swiss.InsertU64xU8
-//hyperpb:stencil parseMapF64xS   parseMapKxV[fixed64Item, stringItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapF64xB   parseMapKxV[fixed64Item, bytesItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-
-//hyperpb:stencil parseMapSxV32 parseMapKxV[stringItem, varint32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapSxV64 parseMapKxV[stringItem, varint64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapSxZ32 parseMapKxV[stringItem, zigzag32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapSxZ64 parseMapKxV[stringItem, zigzag64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapSxF32 parseMapKxV[stringItem, fixed32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapSxF64 parseMapKxV[stringItem, fixed64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapSx2   parseMapKxV[stringItem, boolItem, uint64, uint8] Init -> swiss.InitU64xU8 Insert -> swiss.InsertU64xU8
-//hyperpb:stencil parseMapSxS   parseMapKxV[stringItem, stringItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapSxB   parseMapKxV[stringItem, bytesItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-
-//hyperpb:stencil parseMapBxV32 parseMapKxV[bytesItem, varint32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapBxV64 parseMapKxV[bytesItem, varint64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapBxZ32 parseMapKxV[bytesItem, zigzag32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapBxZ64 parseMapKxV[bytesItem, zigzag64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapBxF32 parseMapKxV[bytesItem, fixed32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32
-//hyperpb:stencil parseMapBxF64 parseMapKxV[bytesItem, fixed64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapBx2   parseMapKxV[bytesItem, boolItem, uint64, uint8] Init -> swiss.InitU64xU8 Insert -> swiss.InsertU64xU8
-//hyperpb:stencil parseMapBxS   parseMapKxV[bytesItem, stringItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-//hyperpb:stencil parseMapBxB   parseMapKxV[bytesItem, bytesItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64
-
-//hyperpb:stencil parseMap2xV32 parseMapKxV[boolItem, varint32Item, uint8, uint32] Init -> swiss.InitU8xU32 Insert -> swiss.InsertU8xU32
-//hyperpb:stencil parseMap2xV64 parseMapKxV[boolItem, varint64Item, uint8, uint64] Init -> swiss.InitU8xU64 Insert -> swiss.InsertU8xU64
-//hyperpb:stencil parseMap2xZ32 parseMapKxV[boolItem, zigzag32Item, uint8, uint32] Init -> swiss.InitU8xU32 Insert -> swiss.InsertU8xU32
-//hyperpb:stencil parseMap2xZ64 parseMapKxV[boolItem, zigzag64Item, uint8, uint64] Init -> swiss.InitU8xU64 Insert -> swiss.InsertU8xU64
-//hyperpb:stencil parseMap2xF32 parseMapKxV[boolItem, fixed32Item, uint8, uint32] Init -> swiss.InitU8xU32 Insert -> swiss.InsertU8xU32
-//hyperpb:stencil parseMap2xF64 parseMapKxV[boolItem, fixed64Item, uint8, uint64] Init -> swiss.InitU8xU64 Insert -> swiss.InsertU8xU64
-//hyperpb:stencil parseMap2x2   parseMapKxV[boolItem, boolItem, uint8, uint8] Init -> swiss.InitU8xU8 Insert -> swiss.InsertU8xU8
-//hyperpb:stencil parseMap2xS   parseMapKxV[boolItem, stringItem, uint8, uint64] Init -> swiss.InitU8xU64 Insert -> swiss.InsertU8xU64
-//hyperpb:stencil parseMap2xB   parseMapKxV[boolItem, bytesItem, uint8, uint64] Init -> swiss.InitU8xU64 Insert -> swiss.InsertU8xU64
+func (varint32Item) peek(b []byte) (mapKey, int) {
+	v, n := protowire.ConsumeVarint(b)
+	return mapKey{n: uint64(int32(v))}, n
+}
+
+func (varint64Item) peek(b []byte) (mapKey, int) {
+	v, n := protowire.ConsumeVarint(b)
+	return mapKey{n: v}, n
+}
+
+func (zigzag32Item) peek(b []byte) (mapKey, int) {
+	v, n := protowire.ConsumeVarint(b)
+	return mapKey{n: uint64(int32(zigzag.Decode64[uint32](v)))}, n
+}
+
+func (zigzag64Item) peek(b []byte) (mapKey, int) {
+	v, n := protowire.ConsumeVarint(b)
+	return mapKey{n: zigzag.Decode64[uint64](v)}, n
+}
+
+func (boolItem) peek(b []byte) (mapKey, int) {
+	v, n := protowire.ConsumeVarint(b)
+	if v != 0 {
+		v = 1
+	}
+	return mapKey{n: v}, n
+}
+
+func (fixed32Item) peek(b []byte) (mapKey, int) {
+	v, n := protowire.ConsumeFixed32(b)
+	return mapKey{n: uint64(int32(v))}, n
+}
+
+func (fixed64Item) peek(b []byte) (mapKey, int) {
+	v, n := protowire.ConsumeFixed64(b)
+	return mapKey{n: v}, n
+}
+
+func (float32Item) peek(b []byte) (mapKey, int) {
+	v, n := protowire.ConsumeFixed32(b)
+	return mapKey{n: uint64(v)}, n
+}
+
+func (float64Item) peek(b []byte) (mapKey, int) {
+	v, n := protowire.ConsumeFixed64(b)
+	return mapKey{n: v}, n
+}
+
+func (stringItem) peek(b []byte) (mapKey, int) {
+	v, n := protowire.ConsumeBytes(b)
+	return mapKey{b: v}, n
+}
+
+func (bytesItem) peek(b []byte) (mapKey, int) {
+	v, n := protowire.ConsumeBytes(b)
+	return mapKey{b: v}, n
+}
+
+// scanMapRun looks ahead from the first entry of a map, whose contents are
+// entry, through the records in rest, which follow it. It counts the entries
+// of the same map field, whose tag is encoded as tag, that immediately follow
+// it, and checks whether their keys are sorted.
+//
+// Only entries that consist of exactly a key followed by a value are counted,
+// which is how every serializer encodes map entries in practice. The count
+// includes the first entry.
+//
+// Returns whether the keys of the counted entries are strictly increasing,
+// either as signed or as unsigned integers, and thus distinct.
+func scanMapRun[KI mapItem[K], VI mapItem[V], K swiss.Key, V any](entry, rest, tag []byte) (count int, sorted bool) {
+	var ki KI
+	var vi VI
+	kTag := byte(protowire.EncodeTag(1, ki.kind()))
+	vTag := byte(protowire.EncodeTag(2, vi.kind()))
+
+	asSigned, asUnsigned := true, true
+	var prev mapKey
+	for {
+		if len(entry) < 2 || entry[0] != kTag {
+			break
+		}
+		k, n := ki.peek(entry[1:])
+		if n < 0 || 1+n >= len(entry) || entry[1+n] != vTag {
+			break
+		}
+		if m := protowire.ConsumeFieldValue(2, vi.kind(), entry[2+n:]); m != len(entry)-2-n {
+			break
+		}
+
+		if count > 0 {
+			asSigned = asSigned && prev.less(k, true)
+			asUnsigned = asUnsigned && prev.less(k, false)
+			if !asSigned && !asUnsigned {
+				return count, false
+			}
+		}
+		prev = k
+		count++
+
+		if !bytes.HasPrefix(rest, tag) {
+			break
+		}
+		b, n := protowire.ConsumeBytes(rest[len(tag):])
+		if n < 0 {
+			break
+		}
+		entry, rest = b, rest[len(tag)+n:]
+	}
+
+	return count, count > 0 && (asSigned || asUnsigned)
+}
+
+//hyperpb:stencil parseMapV32xV32 parseMapKxV[varint32Item, varint32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapV32xV64 parseMapKxV[varint32Item, varint64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapV32xZ32 parseMapKxV[varint32Item, zigzag32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapV32xZ64 parseMapKxV[varint32Item, zigzag64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapV32xF32 parseMapKxV[varint32Item, fixed32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapV32xR32 parseMapKxV[varint32Item, float32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapV32xF64 parseMapKxV[varint32Item, fixed64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapV32xR64 parseMapKxV[varint32Item, float64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapV32x2   parseMapKxV[varint32Item, boolItem, uint32, uint8] Init -> swiss.InitU32xU8 Insert -> swiss.InsertU32xU8 InsertUnique -> swiss.InsertUniqueU32xU8
+//hyperpb:stencil parseMapV32xS   parseMapKxV[varint32Item, stringItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapV32xB   parseMapKxV[varint32Item, bytesItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+
+//hyperpb:stencil parseMapV64xV32 parseMapKxV[varint64Item, varint32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapV64xV64 parseMapKxV[varint64Item, varint64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapV64xZ32 parseMapKxV[varint64Item, zigzag32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapV64xZ64 parseMapKxV[varint64Item, zigzag64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapV64xF32 parseMapKxV[varint64Item, fixed32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapV64xR32 parseMapKxV[varint64Item, float32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapV64xF64 parseMapKxV[varint64Item, fixed64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapV64xR64 parseMapKxV[varint64Item, float64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapV64x2   parseMapKxV[varint64Item, boolItem, uint64, uint8] Init -> swiss.InitU64xU8 Insert -> swiss.InsertU64xU8 InsertUnique -> swiss.InsertUniqueU64xU8
+//hyperpb:stencil parseMapV64xS   parseMapKxV[varint64Item, stringItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapV64xB   parseMapKxV[varint64Item, bytesItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+
+//hyperpb:stencil parseMapZ32xV32 parseMapKxV[zigzag32Item, varint32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapZ32xV64 parseMapKxV[zigzag32Item, varint64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapZ32xZ32 parseMapKxV[zigzag32Item, zigzag32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapZ32xZ64 parseMapKxV[zigzag32Item, zigzag64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapZ32xF32 parseMapKxV[zigzag32Item, fixed32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapZ32xR32 parseMapKxV[zigzag32Item, float32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapZ32xF64 parseMapKxV[zigzag32Item, fixed64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapZ32xR64 parseMapKxV[zigzag32Item, float64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapZ32x2   parseMapKxV[zigzag32Item, boolItem, uint32, uint8] Init -> swiss.InitU32xU8 Insert -> swiss.InsertU32xU8 InsertUnique -> swiss.InsertUniqueU32xU8
+//hyperpb:stencil parseMapZ32xS   parseMapKxV[zigzag32Item, stringItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapZ32xB   parseMapKxV[zigzag32Item, bytesItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+
+//hyperpb:stencil parseMapZ64xV32 parseMapKxV[zigzag64Item, varint32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapZ64xV64 parseMapKxV[zigzag64Item, varint64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapZ64xZ32 parseMapKxV[zigzag64Item, zigzag32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapZ64xZ64 parseMapKxV[zigzag64Item, zigzag64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapZ64xF32 parseMapKxV[zigzag64Item, fixed32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapZ64xR32 parseMapKxV[zigzag64Item, float32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapZ64xF64 parseMapKxV[zigzag64Item, fixed64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapZ64xR64 parseMapKxV[zigzag64Item, float64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapZ64x2   parseMapKxV[zigzag64Item, boolItem, uint64, uint8] Init -> swiss.InitU64xU8 Insert -> swiss.InsertU64xU8 InsertUnique -> swiss.InsertUniqueU64xU8
+//hyperpb:stencil parseMapZ64xS   parseMapKxV[zigzag64Item, stringItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapZ64xB   parseMapKxV[zigzag64Item, bytesItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+
+//hyperpb:stencil parseMapF32xV32 parseMapKxV[fixed32Item, varint32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapF32xV64 parseMapKxV[fixed32Item, varint64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapF32xZ32 parseMapKxV[fixed32Item, zigzag32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapF32xZ64 parseMapKxV[fixed32Item, zigzag64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapF32xF32 parseMapKxV[fixed32Item, fixed32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapF32xR32 parseMapKxV[fixed32Item, float32Item, uint32, uint32] Init -> swiss.InitU32xU32 Insert -> swiss.InsertU32xU32 InsertUnique -> swiss.InsertUniqueU32xU32
+//hyperpb:stencil parseMapF32xF64 parseMapKxV[fixed32Item, fixed64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapF32xR64 parseMapKxV[fixed32Item, float64Item, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapF32x2   parseMapKxV[fixed32Item, boolItem, uint32, uint8] Init -> swiss.InitU32xU8 Insert -> swiss.InsertU32xU8 InsertUnique -> swiss.InsertUniqueU32xU8
+//hyperpb:stencil parseMapF32xS   parseMapKxV[fixed32Item, stringItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+//hyperpb:stencil parseMapF32xB   parseMapKxV[fixed32Item, bytesItem, uint32, uint64] Init -> swiss.InitU32xU64 Insert -> swiss.InsertU32xU64 InsertUnique -> swiss.InsertUniqueU32xU64
+
+//hyperpb:stencil parseMapF64xV32 parseMapKxV[fixed64Item, varint32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapF64xV64 parseMapKxV[fixed64Item, varint64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapF64xZ32 parseMapKxV[fixed64Item, zigzag32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapF64xZ64 parseMapKxV[fixed64Item, zigzag64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapF64xF32 parseMapKxV[fixed64Item, fixed32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapF64xR32 parseMapKxV[fixed64Item, float32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapF64xF64 parseMapKxV[fixed64Item, fixed64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapF64xR64 parseMapKxV[fixed64Item, float64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapF64x2   parseMapKxV[fixed64Item, boolItem, uint64, uint8] Init -> swiss.InitU64xU8 Insert -> swiss.InsertU64xU8 InsertUnique -> swiss.InsertUniqueU64xU8
+//hyperpb:stencil parseMapF64xS   parseMapKxV[fixed64Item, stringItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapF64xB   parseMapKxV[fixed64Item, bytesItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+
+//hyperpb:stencil parseMapSxV32 parseMapKxV[stringItem, varint32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapSxV64 parseMapKxV[stringItem, varint64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapSxZ32 parseMapKxV[stringItem, zigzag32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapSxZ64 parseMapKxV[stringItem, zigzag64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapSxF32 parseMapKxV[stringItem, fixed32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapSxR32 parseMapKxV[stringItem, float32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapSxF64 parseMapKxV[stringItem, fixed64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapSxR64 parseMapKxV[stringItem, float64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapSx2   parseMapKxV[stringItem, boolItem, uint64, uint8] Init -> swiss.InitU64xU8 Insert -> swiss.InsertU64xU8 InsertUnique -> swiss.InsertUniqueU64xU8
+//hyperpb:stencil parseMapSxS   parseMapKxV[stringItem, stringItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapSxB   parseMapKxV[stringItem, bytesItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+
+//hyperpb:stencil parseMapBxV32 parseMapKxV[bytesItem, varint32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapBxV64 parseMapKxV[bytesItem, varint64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapBxZ32 parseMapKxV[bytesItem, zigzag32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapBxZ64 parseMapKxV[bytesItem, zigzag64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapBxF32 parseMapKxV[bytesItem, fixed32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapBxR32 parseMapKxV[bytesItem, float32Item, uint64, uint32] Init -> swiss.InitU64xU32 Insert -> swiss.InsertU64xU32 InsertUnique -> swiss.InsertUniqueU64xU32
+//hyperpb:stencil parseMapBxF64 parseMapKxV[bytesItem, fixed64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapBxR64 parseMapKxV[bytesItem, float64Item, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapBx2   parseMapKxV[bytesItem, boolItem, uint64, uint8] Init -> swiss.InitU64xU8 Insert -> swiss.InsertU64xU8 InsertUnique -> swiss.InsertUniqueU64xU8
+//hyperpb:stencil parseMapBxS   parseMapKxV[bytesItem, stringItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+//hyperpb:stencil parseMapBxB   parseMapKxV[bytesItem, bytesItem, uint64, uint64] Init -> swiss.InitU64xU64 Insert -> swiss.InsertU64xU64 InsertUnique -> swiss.InsertUniqueU64xU64
+
+//hyperpb:stencil parseMap2xV32 parseMapKxV[boolItem, varint32Item, uint8, uint32] Init -> swiss.InitU8xU32 Insert -> swiss.InsertU8xU32 InsertUnique -> swiss.InsertUniqueU8xU32
+//hyperpb:stencil parseMap2xV64 parseMapKxV[boolItem, varint64Item, uint8, uint64] Init -> swiss.InitU8xU64 Insert -> swiss.InsertU8xU64 InsertUnique -> swiss.InsertUniqueU8xU64
+//hyperpb:stencil parseMap2xZ32 parseMapKxV[boolItem, zigzag32Item, uint8, uint32] Init -> swiss.InitU8xU32 Insert -> swiss.InsertU8xU32 InsertUnique -> swiss.InsertUniqueU8xU32
+//hyperpb:stencil parseMap2xZ64 parseMapKxV[boolItem, zigzag64Item, uint8, uint64] Init -> swiss.InitU8xU64 Insert -> swiss.InsertU8xU64 InsertUnique -> swiss.InsertUniqueU8xU64
+//hyperpb:stencil parseMap2xF32 parseMapKxV[boolItem, fixed32Item, uint8, uint32] Init -> swiss.InitU8xU32 Insert -> swiss.InsertU8xU32 InsertUnique -> swiss.InsertUniqueU8xU32
+//hyperpb:stencil parseMap2xR32 parseMapKxV[boolItem, float32Item, uint8, uint32] Init -> swiss.InitU8xU32 Insert -> swiss.InsertU8xU32 InsertUnique -> swiss.InsertUniqueU8xU32
+//hyperpb:stencil parseMap2xF64 parseMapKxV[boolItem, fixed64Item, uint8, uint64] Init -> swiss.InitU8xU64 Insert -> swiss.InsertU8xU64 InsertUnique -> swiss.InsertUniqueU8xU64
+//hyperpb:stencil parseMap2xR64 parseMapKxV[boolItem, float64Item, uint8, uint64] Init -> swiss.InitU8xU64 Insert -> swiss.InsertU8xU64 InsertUnique -> swiss.InsertUniqueU8xU64
+//hyperpb:stencil parseMap2x2   parseMapKxV[boolItem, boolItem, uint8, uint8] Init -> swiss.InitU8xU8 Insert -> swiss.InsertU8xU8 InsertUnique -> swiss.InsertUniqueU8xU8
+//hyperpb:stencil parseMap2xS   parseMapKxV[boolItem, stringItem, uint8, uint64] Init -> swiss.InitU8xU64 Insert -> swiss.InsertU8xU64 InsertUnique -> swiss.InsertUniqueU8xU64
+//hyperpb:stencil parseMap2xB   parseMapKxV[boolItem, bytesItem, uint8, uint64] Init -> swiss.InitU8xU64 Insert -> swiss.InsertU8xU64 InsertUnique -> swiss.InsertUniqueU8xU64
 
 // parseMapKxV parses a map type whose value is a non-message type.
 func parseMapKxV[
@@ -849,11 +996,13 @@ func parseMapKxV[
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki KI
 	var vi VI
 	var k K
 	var v V
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -880,6 +1029,7 @@ func parseMapKxV[
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -902,8 +1052,12 @@ func parseMapKxV[
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -913,13 +1067,51 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[KI, VI](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[K, V](cap)
 		m = xunsafe.Cast[swiss.Table[K, V]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		m.Init(cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*m.InsertUnique(k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+				// scanMapRun has already checked that this is a sequence of
+				// key-value pairs, so we only need to parse them.
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*m.InsertUnique(k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := m.Insert(k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[K, V](m.Len() + 1)
@@ -930,12 +1122,35 @@ insert:
 		vp = m2.Insert(k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
 
+// sortedMapRun returns the number of entries, starting at the one that was
+// just parsed, which begins at entry, in the run of consecutive entries of the
+// current map field that have sorted keys, or zero if the keys are not sorted.
+//
+// See [scanMapRun].
+func sortedMapRun[KI mapItem[K], VI mapItem[V], K swiss.Key, V any](p1 vm.P1, p2 vm.P2, entry xunsafe.Addr[byte]) int {
+	var tag [binary.MaxVarintLen64]byte
+	tagLen := len(protowire.AppendVarint(tag[:0], p2.Field().Tag.Decode()))
+
+	rest := p1
+	rest.PtrAddr = p1.EndAddr
+	rest.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	p1.PtrAddr = entry
+
+	count, sorted := scanMapRun[KI, VI](p1.Buf(), rest.Buf(), tag[:tagLen])
+	if !sorted {
+		return 0
+	}
+	return count
+}
+
 //hyperpb:stencil parseMapV32xM parseMapKxM[varint32Item, uint32] Init -> swiss.InitU32xP Insert -> swiss.InsertU32xP
 //hyperpb:stencil parseMapV64xM parseMapKxM[varint64Item, uint64] Init -> swiss.InitU64xP Insert -> swiss.InsertU64xP
 //hyperpb:stencil parseMapZ32xM parseMapKxM[zigzag32Item, uint32] Init -> swiss.InitU32xP Insert -> swiss.InsertU32xP
@@ -953,10 +1168,11 @@ func parseMapKxM[KI mapItem[K], K swiss.Key](p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki KI
 	var k K
-	var fast bool
+	var fast, extra bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(1, protowire.BytesType)
@@ -1003,8 +1219,12 @@ func parseMapKxM[KI mapItem[K], K swiss.Key](p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2)
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = extra || tag != protowire.EncodeTag(2, protowire.BytesType)
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 	// Now we need to rewind back to the beginning.
 	p1.PtrAddr = p1.EndAddr.Add(-n)
@@ -1026,6 +1246,7 @@ insert:
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
 	}
 
+	n0 := m.Len()
 	vp := m.Insert(k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[K, V](m.Len() + 1)
@@ -1036,6 +1257,13 @@ insert:
 		vp = m2.Insert(k, extract)
 	}
 
+	if (*mp).Len() == n0 && p2.MapEntries()&vm.MapKeepFirst != 0 {
+		// Skip the entry, keeping the existing value.
+		p1.PtrAddr = p1.EndAddr
+		p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+		return p1, p2
+	}
+
 	var v *dynamic.Message
 	// Allocate unconditionally to match Go protobuf's behavior.
 	// TODO: This could instead clear, but that optimization will almost never
@@ -104,7 +104,7 @@ var oneofFields = map[protoreflect.Kind]*compiler.Archetype{
 		Layout:  layout.Of[float32](),
 		Oneof:   true,
 		Getter:  getOneofScalar[float32],
-		Parsers: []compiler.Parser{{Kind: protowire.Fixed32Type, Thunk: parseOneofFixed32}},
+		Parsers: []compiler.Parser{{Kind: protowire.Fixed32Type, Thunk: parseOneofFloat32}},
 	},
 
 	// 64-bit fixed types.
@@ -124,7 +124,7 @@ var oneofFields = map[protoreflect.Kind]*compiler.Archetype{
 		Layout:  layout.Of[float64](),
 		Oneof:   true,
 		Getter:  getOneofScalar[float64],
-		Parsers: []compiler.Parser{{Kind: protowire.Fixed64Type, Thunk: parseOneofFixed64}},
+		Parsers: []compiler.Parser{{Kind: protowire.Fixed64Type, Thunk: parseOneofFloat64}},
 	},
 
 	// Special scalar types.
@@ -152,7 +152,7 @@ var oneofFields = map[protoreflect.Kind]*compiler.Archetype{
 		Layout:  layout.Of[zc.Range](),
 		Oneof:   true,
 		Getter:  getOneofString,
-		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseOneofBytes}},
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseOneofProto2String}},
 	},
 	protoreflect.BytesKind: {
 		Layout:  layout.Of[zc.Range](),
@@ -257,12 +257,30 @@ func parseOneofFixed64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	return parseFixed64(p1, p2)
 }
 
+//go:nosplit
+func parseOneofFloat32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	xunsafe.ByteStore(p2.Message(), p2.Field().Offset.Bit, p2.Field().Offset.Number)
+	return parseFloat32(p1, p2)
+}
+
+//go:nosplit
+func parseOneofFloat64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	xunsafe.ByteStore(p2.Message(), p2.Field().Offset.Bit, p2.Field().Offset.Number)
+	return parseFloat64(p1, p2)
+}
+
 //go:nosplit
 func parseOneofString(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	xunsafe.ByteStore(p2.Message(), p2.Field().Offset.Bit, p2.Field().Offset.Number)
 	return parseString(p1, p2)
 }
 
+//go:nosplit
+func parseOneofProto2String(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	xunsafe.ByteStore(p2.Message(), p2.Field().Offset.Bit, p2.Field().Offset.Number)
+	return parseProto2String(p1, p2)
+}
+
 //go:nosplit
 func parseOneofBytes(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	xunsafe.ByteStore(p2.Message(), p2.Field().Offset.Bit, p2.Field().Offset.Number)
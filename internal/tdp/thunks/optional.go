@@ -91,7 +91,7 @@ var optionalFields = map[protoreflect.Kind]*compiler.Archetype{
 		Layout:  layout.Of[float32](),
 		Bits:    1,
 		Getter:  getOptionalScalar[float32],
-		Parsers: []compiler.Parser{{Kind: protowire.Fixed32Type, Thunk: parseOptionalFixed32}},
+		Parsers: []compiler.Parser{{Kind: protowire.Fixed32Type, Thunk: parseOptionalFloat32}},
 	},
 
 	// 64-bit fixed types.
@@ -111,7 +111,7 @@ var optionalFields = map[protoreflect.Kind]*compiler.Archetype{
 		Layout:  layout.Of[float64](),
 		Bits:    1,
 		Getter:  getOptionalScalar[float64],
-		Parsers: []compiler.Parser{{Kind: protowire.Fixed64Type, Thunk: parseOptionalFixed64}},
+		Parsers: []compiler.Parser{{Kind: protowire.Fixed64Type, Thunk: parseOptionalFloat64}},
 	},
 
 	// Special scalar types.
@@ -139,7 +139,7 @@ var optionalFields = map[protoreflect.Kind]*compiler.Archetype{
 		Layout:  layout.Of[zc.Range](),
 		Bits:    1,
 		Getter:  getOptionalString,
-		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseOptionalBytes}},
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseOptionalProto2String}},
 	},
 	protoreflect.BytesKind: {
 		Layout:  layout.Of[zc.Range](),
@@ -220,12 +220,30 @@ func parseOptionalFixed64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	return parseFixed64(p1, p2)
 }
 
+//go:nosplit
+func parseOptionalFloat32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	vm.SetBit(p1, p2)
+	return parseFloat32(p1, p2)
+}
+
+//go:nosplit
+func parseOptionalFloat64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	vm.SetBit(p1, p2)
+	return parseFloat64(p1, p2)
+}
+
 //go:nosplit
 func parseOptionalString(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	vm.SetBit(p1, p2)
 	return parseString(p1, p2)
 }
 
+//go:nosplit
+func parseOptionalProto2String(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	vm.SetBit(p1, p2)
+	return parseProto2String(p1, p2)
+}
+
 //go:nosplit
 func parseOptionalBytes(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	vm.SetBit(p1, p2)
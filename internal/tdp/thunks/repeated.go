@@ -26,6 +26,7 @@ import (
 	"buf.build/go/hyperpb/internal/tdp"
 	"buf.build/go/hyperpb/internal/tdp/compiler"
 	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/empty"
 	"buf.build/go/hyperpb/internal/tdp/repeated"
 	"buf.build/go/hyperpb/internal/tdp/vm"
 	"buf.build/go/hyperpb/internal/xunsafe"
@@ -120,8 +121,8 @@ var repeatedFields = map[protoreflect.Kind]*compiler.Archetype{
 		Layout: layout.Of[repeated.Scalars[float32, float32]](),
 		Getter: getRepeatedScalar[float32, float32],
 		Parsers: []compiler.Parser{
-			{Kind: protowire.BytesType, Thunk: parsePackedFixed32},
-			{Kind: protowire.Fixed32Type, Retry: true, Thunk: parseRepeatedFixed32},
+			{Kind: protowire.BytesType, Thunk: parsePackedFloat32},
+			{Kind: protowire.Fixed32Type, Retry: true, Thunk: parseRepeatedFloat32},
 		},
 	},
 
@@ -146,8 +147,8 @@ var repeatedFields = map[protoreflect.Kind]*compiler.Archetype{
 		Layout: layout.Of[repeated.Scalars[float64, float64]](),
 		Getter: getRepeatedScalar[float64, float64],
 		Parsers: []compiler.Parser{
-			{Kind: protowire.BytesType, Thunk: parsePackedFixed64},
-			{Kind: protowire.Fixed64Type, Retry: true, Thunk: parseRepeatedFixed64},
+			{Kind: protowire.BytesType, Thunk: parsePackedFloat64},
+			{Kind: protowire.Fixed64Type, Retry: true, Thunk: parseRepeatedFloat64},
 		},
 	},
 
@@ -199,28 +200,62 @@ var repeatedFields = map[protoreflect.Kind]*compiler.Archetype{
 	},
 }
 
+// bitsetBoolFields is the archetype for repeated bool fields that are stored as
+// bitsets. See [profile.Field].BitsetBools.
+var bitsetBoolFields = &compiler.Archetype{
+	Layout: layout.Of[repeated.BitBools](),
+	Getter: getRepeatedBitBool,
+	Parsers: []compiler.Parser{
+		{Kind: protowire.BytesType, Thunk: parsePackedBitBool},
+		{Kind: protowire.VarintType, Retry: true, Thunk: parseRepeatedBitBool},
+	},
+}
+
 func getRepeatedScalar[ZC, E tdp.Number](m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
 	p := dynamic.GetField[repeated.Scalars[ZC, E]](m, getter.Offset)
+	if p == nil {
+		return protoreflect.ValueOfList(empty.List{})
+	}
 	return protoreflect.ValueOfList(p.ProtoReflect())
 }
 
 func getRepeatedZigzag[Z, E tdp.Int](m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
 	p := dynamic.GetField[repeated.Zigzags[Z, E]](m, getter.Offset)
+	if p == nil {
+		return protoreflect.ValueOfList(empty.List{})
+	}
 	return protoreflect.ValueOfList(p.ProtoReflect())
 }
 
 func getRepeatedBool(m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
 	p := dynamic.GetField[repeated.Bools](m, getter.Offset)
+	if p == nil {
+		return protoreflect.ValueOfList(empty.List{})
+	}
+	return protoreflect.ValueOfList(p.ProtoReflect())
+}
+
+func getRepeatedBitBool(m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
+	p := dynamic.GetField[repeated.BitBools](m, getter.Offset)
+	if p == nil {
+		return protoreflect.ValueOfList(empty.List{})
+	}
 	return protoreflect.ValueOfList(p.ProtoReflect())
 }
 
 func getRepeatedString(m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
 	p := dynamic.GetField[repeated.Strings](m, getter.Offset)
+	if p == nil {
+		return protoreflect.ValueOfList(empty.List{})
+	}
 	return protoreflect.ValueOfList(p.ProtoReflect())
 }
 
 func getRepeatedBytes(m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
 	p := dynamic.GetField[repeated.Bytes](m, getter.Offset)
+	if p == nil {
+		return protoreflect.ValueOfList(empty.List{})
+	}
 	return protoreflect.ValueOfList(p.ProtoReflect())
 }
 
@@ -247,6 +282,7 @@ func parseRepeatedVarint[T tdp.Int](p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 		}
 		s.Store(s.Len()-1, T(n))
 		p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+		p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 
 		r.Raw = s.Addr().Untyped()
 		return p1, p2
@@ -336,6 +372,7 @@ func parsePackedVarint[T tdp.Int](p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 		s = s.SetLen(len(borrow))
 
 		p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+		p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 
 	default:
 		s = slice.CastUntyped[T](r.Raw)
@@ -410,6 +447,40 @@ func parsePackedVarint[T tdp.Int](p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	return p1, p2
 }
 
+// //go:nosplit // TODO(#30): Enable once upstream is fixed.
+func parseRepeatedBitBool(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var n uint64
+	p1, p2, n = p1.Varint(p2)
+
+	var r *repeated.BitBools
+	p1, p2, r = vm.GetMutableField[repeated.BitBools](p1, p2)
+	r.Append(p1.Arena(), n != 0)
+	return p1, p2
+}
+
+// //go:nosplit // TODO(#30): Enable once upstream is fixed.
+func parsePackedBitBool(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+	if n == 0 {
+		return p1, p2
+	}
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+
+	var r *repeated.BitBools
+	p1, p2, r = vm.GetMutableField[repeated.BitBools](p1, p2)
+	for p1.PtrAddr < p1.EndAddr {
+		var x uint64
+		p1, p2, x = p1.Varint(p2)
+		r.Append(p1.Arena(), x != 0)
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+
 //go:nosplit
 func parseRepeatedFixed32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	return appendFixed32(p1.Fixed32(p2))
@@ -420,6 +491,16 @@ func parseRepeatedFixed64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	return appendFixed64(p1.Fixed64(p2))
 }
 
+//go:nosplit
+func parseRepeatedFloat32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	return appendFixed32(p1.Float32(p2))
+}
+
+//go:nosplit
+func parseRepeatedFloat64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	return appendFixed64(p1.Float64(p2))
+}
+
 // //go:nosplit // TODO(#30): Enable once upstream is fixed.
 //
 //hyperpb:stencil appendFixed32 appendFixed[uint32] spillArena -> spillArena32
@@ -445,6 +526,7 @@ func appendFixed[T uint32 | uint64](p1 vm.P1, p2 vm.P2, v T) (vm.P1, vm.P2) {
 		s = s.SetLen(len(borrow))
 
 		p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+		p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 	}
 
 	s = s.AppendOne(p1.Arena(), v)
@@ -453,6 +535,57 @@ func appendFixed[T uint32 | uint64](p1 vm.P1, p2 vm.P2, v T) (vm.P1, vm.P2) {
 	return p1, p2
 }
 
+// parsePackedFloat32 is like parsePackedFixed32, but applies
+// [vm.Options].Floats.
+func parsePackedFloat32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	if p2.Floats() == 0 {
+		return parsePackedFixed32(p1, p2)
+	}
+	return parsePackedFloatSlow(p1, p2, 4)
+}
+
+// parsePackedFloat64 is like parsePackedFixed64, but applies
+// [vm.Options].Floats.
+func parsePackedFloat64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	if p2.Floats() == 0 {
+		return parsePackedFixed64(p1, p2)
+	}
+	return parsePackedFloatSlow(p1, p2, 8)
+}
+
+// parsePackedFloatSlow parses a packed float field one element at a time,
+// since each element may need to be rewritten. This means the elements are
+// never borrowed from the input.
+//
+//go:noinline
+func parsePackedFloatSlow(p1 vm.P1, p2 vm.P2, size int) (vm.P1, vm.P2) {
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+	if n%size != 0 {
+		p1.Fail(p2, vm.ErrorTruncated)
+	}
+
+	end := p1.EndAddr
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	for p1.Len() > 0 {
+		if size == 4 {
+			p1, p2 = appendFixed32(p1.Float32(p2))
+		} else {
+			p1, p2 = appendFixed64(p1.Float64(p2))
+		}
+	}
+	p1.EndAddr = end
+
+	return p1, p2
+}
+
+// parsePackedFixed parses a packed fixed-width field. The first record of a
+// field is borrowed from the input without copying; later records are
+// appended with a single memmove each, into an arena slice whose capacity is
+// rounded up to a power of two, so appending is amortized constant time.
+//
+// No byte-swapping is needed, since big-endian targets are not supported.
+//
 // //go:nosplit // TODO(#30): Enable once upstream is fixed.
 //
 //hyperpb:stencil parsePackedFixed32 parsePackedFixed[uint32]
@@ -495,6 +628,7 @@ func parsePackedFixed[T tdp.Int](p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 			s = s.SetLen(len(borrow))
 
 			p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+			p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 		}
 
 		size := layout.Size[T]()
@@ -523,9 +657,9 @@ func parseRepeatedBytes(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	var r *repeated.Bytes
 	p1, p2, r = vm.GetMutableField[repeated.Bytes](p1, p2)
-	if r.Raw.Ptr() == nil {
+	if r.Raw.Len() == 0 {
 		if preload := p2.Field().Preload; preload > 0 {
-			r.Raw = slice.Make[zc.Range](p1.Arena(), int(preload))
+			r.Raw = slice.MakeChunked[zc.Range](int(preload))
 		}
 	}
 
@@ -542,9 +676,9 @@ func parseRepeatedUTF8(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	var r *repeated.Strings
 	p1, p2, r = vm.GetMutableField[repeated.Strings](p1, p2)
-	if r.Raw.Ptr() == nil {
+	if r.Raw.Len() == 0 {
 		if preload := p2.Field().Preload; preload > 0 {
-			r.Raw = slice.Make[zc.Range](p1.Arena(), int(preload))
+			r.Raw = slice.MakeChunked[zc.Range](int(preload))
 		}
 	}
 
@@ -21,6 +21,7 @@ import (
 	"buf.build/go/hyperpb/internal/debug"
 	"buf.build/go/hyperpb/internal/tdp"
 	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/empty"
 	"buf.build/go/hyperpb/internal/tdp/repeated"
 	"buf.build/go/hyperpb/internal/tdp/vm"
 	"buf.build/go/hyperpb/internal/xunsafe"
@@ -28,6 +29,9 @@ import (
 
 func getRepeatedMessage(m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
 	p := dynamic.GetField[repeated.Messages[dynamic.Message]](m, getter.Offset)
+	if p == nil {
+		return protoreflect.ValueOfList(empty.List{})
+	}
 	return protoreflect.ValueOfList(p.ProtoReflect())
 }
 
@@ -35,11 +39,41 @@ func getRepeatedMessage(m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) p
 func parseRepeatedMessage(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
+	if limit := p2.DedupMessages(); limit > 0 && n <= limit {
+		return parseRepeatedMessageDedup(p1, p2, n)
+	}
 	p1, p2 = p1.SetScratch(p2, uint64(n))
 	p1, p2, m := allocRepeatedMessage(p1, p2)
 	return p1.PushMessage(p2, m)
 }
 
+// parseRepeatedMessageDedup is like [parseRepeatedMessage], for when the
+// element being parsed, n bytes long, may be a duplicate of an earlier one.
+//
+//go:noinline
+func parseRepeatedMessageDedup(p1 vm.P1, p2 vm.P2, n int) (vm.P1, vm.P2) {
+	hash, dup := vm.LookupDuplicate(p1, p2, n)
+	if dup != nil {
+		// Sharing messages requires the field to hold pointers.
+		var r *repeated.Messages[dynamic.Message]
+		p1, p2, r = vm.GetMutableField[repeated.Messages[dynamic.Message]](p1, p2)
+		if r.Raw.Ptr == 0 {
+			p1, p2, r = newInlineRepeatedField(p1, p2, r)
+		}
+		if r.Stride != 0 {
+			p1, p2 = spillInlineRepeatedField(p1, p2, r)
+		}
+
+		p1, p2, _ = appendOneMessage(p1, p2, dup)
+		return p1.Advance(n), p2
+	}
+
+	p1, p2 = p1.SetScratch(p2, uint64(n))
+	p1, p2, m := allocRepeatedMessage(p1, p2)
+	vm.RecordDuplicate(p1, p2, hash, n, m)
+	return p1.PushMessage(p2, m)
+}
+
 // //go:nosplit // TODO(#30): Enable once upstream is fixed.
 func parseRepeatedGroup(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	p1, p2, m := allocRepeatedMessage(p1, p2)
@@ -71,7 +105,7 @@ func allocRepeatedMessage2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2, *dynamic.Message)
 	}
 
 	{
-		ty := p1.Shared().Library().AtOffset(p2.Field().Message.TypeOffset)
+		ty := p1.Shared().Library().AtOffset(p2.Field().TypeOffset)
 		stride := int(ty.Size)
 		s := slice.CastUntyped[byte](r.Raw)
 
@@ -94,22 +128,9 @@ func allocRepeatedMessage2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2, *dynamic.Message)
 	}
 
 pointers:
-	{
-		p1, p2, m = vm.AllocMessage(p1, p2)
-
-		r := xunsafe.Cast[slice.Addr[xunsafe.Addr[dynamic.Message]]](r)
-		s := r.AssertValid()
-		if s.Len() == s.Cap() {
-			p1, p2, m = appendOneMessage(p1, p2, m)
-			p1.Log(p2, "outline repeated message", "%v, %p", *r, m)
-			return p1, p2, m
-		}
-
-		s = s.SetLen(s.Len() + 1)
-		s.Store(s.Len()-1, xunsafe.AddrOf(m))
-		p1.Log(p2, "outline repeated message", "%v, %p", s.Addr(), m)
-		*r = s.Addr()
-	}
+	p1, p2, m = vm.AllocMessage(p1, p2)
+	p1, p2, m = appendOneMessage(p1, p2, m)
+	p1.Log(p2, "outline repeated message", "%v, %p", r.Ptrs, m)
 
 	return p1, p2, m
 }
@@ -118,7 +139,7 @@ pointers:
 func newInlineRepeatedField(p1 vm.P1, p2 vm.P2, r *repeated.Messages[dynamic.Message]) (vm.P1, vm.P2, *repeated.Messages[dynamic.Message]) {
 	// First element of this field. Allocate a byte array large enough to
 	// hold one element.
-	ty := p1.Shared().Library().AtOffset(p2.Field().Message.TypeOffset)
+	ty := p1.Shared().Library().AtOffset(p2.Field().TypeOffset)
 	stride := ty.Size
 
 	preload := max(1, p2.Field().Preload)
@@ -133,21 +154,19 @@ func newInlineRepeatedField(p1 vm.P1, p2 vm.P2, r *repeated.Messages[dynamic.Mes
 
 //go:noinline
 func spillInlineRepeatedField(p1 vm.P1, p2 vm.P2, r *repeated.Messages[dynamic.Message]) (vm.P1, vm.P2) {
-	ty := p1.Shared().Library().AtOffset(p2.Field().Message.TypeOffset)
+	ty := p1.Shared().Library().AtOffset(p2.Field().TypeOffset)
 	stride := int(ty.Size)
 	s := slice.CastUntyped[byte](r.Raw)
 
-	// Spill all of the messages onto a pointer slice.
-	spill := slice.Make[xunsafe.Addr[dynamic.Message]](p1.Arena(), s.Cap()/stride*2)
-	var j int
+	// Spill pointers to all of the messages onto a chunked list. The messages
+	// themselves stay where they are.
+	spill := slice.MakeChunked[xunsafe.Addr[dynamic.Message]](s.Cap() / stride * 2)
 	for i := 0; i < s.Len(); i += stride {
 		m := xunsafe.Cast[dynamic.Message](xunsafe.Add(s.Ptr(), i))
-		spill.Store(j, xunsafe.AddrOf(m))
-		j++
+		spill = spill.AppendOne(p1.Arena(), xunsafe.AddrOf(m))
 	}
-	spill = spill.SetLen(j)
 
-	r.Raw = spill.Addr().Untyped()
+	r.Ptrs = spill
 	r.Stride = 0 // Mark this as an outlined message.
 
 	return p1, p2
@@ -157,7 +176,6 @@ func spillInlineRepeatedField(p1 vm.P1, p2 vm.P2, r *repeated.Messages[dynamic.M
 func appendOneMessage(p1 vm.P1, p2 vm.P2, m *dynamic.Message) (vm.P1, vm.P2, *dynamic.Message) {
 	var r *repeated.Messages[dynamic.Message]
 	p1, p2, r = vm.GetMutableField[repeated.Messages[dynamic.Message]](p1, p2)
-	s := slice.CastUntyped[xunsafe.Addr[dynamic.Message]](r.Raw)
-	r.Raw = s.AppendOne(p1.Arena(), xunsafe.AddrOf(m)).Addr().Untyped()
+	r.Ptrs = r.Ptrs.AppendOne(p1.Arena(), xunsafe.AddrOf(m))
 	return p1, p2, m
 }
@@ -0,0 +1,84 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thunks
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"buf.build/go/hyperpb/internal/tdp"
+	"buf.build/go/hyperpb/internal/tdp/compiler"
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/repeated"
+	"buf.build/go/hyperpb/internal/tdp/vm"
+	"buf.build/go/hyperpb/internal/xunsafe/layout"
+)
+
+// sampledMessages is the storage for a sampled repeated message field. See
+// [profile.Field].Sample.
+//
+// The storage of the elements that were parsed comes first, so that the
+// ordinary repeated message getter and allocation functions work with it.
+type sampledMessages struct {
+	repeated.Messages[dynamic.Message]
+
+	// The number of elements in the input, including those that were skipped.
+	Seen uint32
+	// One in how many elements is parsed, copied from [tdp.Type].Samples
+	// when the first element is seen.
+	Every uint32
+}
+
+// sampledMessageFields is the archetype for sampled repeated message fields.
+var sampledMessageFields = &compiler.Archetype{
+	Layout:  layout.Of[sampledMessages](),
+	Getter:  getRepeatedMessage,
+	Parsers: []compiler.Parser{{Kind: protowire.BytesType, Retry: true, Thunk: parseSampledMessage}},
+}
+
+// SampledCount returns the number of elements that were in the input for
+// the sampled repeated message field at offset in m.
+func SampledCount(m *dynamic.Message, offset tdp.Offset) int {
+	s := dynamic.GetField[sampledMessages](m, offset)
+	if s == nil {
+		return 0
+	}
+	return int(s.Seen)
+}
+
+func parseSampledMessage(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	var s *sampledMessages
+	p1, p2, s = vm.GetMutableField[sampledMessages](p1, p2)
+	if s.Every == 0 {
+		ty := p2.Message().Type()
+		s.Every = max(1, ty.Samples[int32(p2.Field().Tag.Decode()>>3)])
+	}
+	seen := s.Seen
+	s.Seen++
+
+	if seen%s.Every != 0 {
+		p1.Log(p2, "skip sampled message", "%d/%d", seen, s.Every)
+		return p1.Advance(n), p2
+	}
+
+	if limit := p2.DedupMessages(); limit > 0 && n <= limit {
+		return parseRepeatedMessageDedup(p1, p2, n)
+	}
+	p1, p2 = p1.SetScratch(p2, uint64(n))
+	p1, p2, m := allocRepeatedMessage(p1, p2)
+	return p1.PushMessage(p2, m)
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thunks
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp/compiler"
+	"buf.build/go/hyperpb/internal/tdp/profile"
+	"buf.build/go/hyperpb/internal/tdp/vm"
+	"buf.build/go/hyperpb/internal/xunsafe/layout"
+	"buf.build/go/hyperpb/internal/zc"
+)
+
+// Secret fields are string or bytes fields whose contents must not leak
+// through timing, and which are wiped from memory when their message is freed.
+// They are laid out exactly like ordinary string and bytes fields, but their
+// parsers avoid the data-dependent fast paths of UTF-8 validation, and mark
+// the message's Shared as holding secrets. See [profile.Field].Secret.
+
+// secretFields consists of archetypes for secret singular fields.
+var secretFields = map[protoreflect.Kind]*compiler.Archetype{
+	protoreflect.StringKind: {
+		Layout:  layout.Of[zc.Range](),
+		Getter:  getString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseSecretString}},
+	},
+	proto2StringKind: {
+		Layout:  layout.Of[zc.Range](),
+		Getter:  getString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseSecretBytes}},
+	},
+	protoreflect.BytesKind: {
+		Layout:  layout.Of[zc.Range](),
+		Getter:  getBytes,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseSecretBytes}},
+	},
+}
+
+// optionalSecretFields consists of archetypes for secret optional fields.
+var optionalSecretFields = map[protoreflect.Kind]*compiler.Archetype{
+	protoreflect.StringKind: {
+		Layout:  layout.Of[zc.Range](),
+		Bits:    1,
+		Getter:  getOptionalString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseOptionalSecretString}},
+	},
+	proto2StringKind: {
+		Layout:  layout.Of[zc.Range](),
+		Bits:    1,
+		Getter:  getOptionalString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseOptionalSecretBytes}},
+	},
+	protoreflect.BytesKind: {
+		Layout:  layout.Of[zc.Range](),
+		Bits:    1,
+		Getter:  getOptionalBytes,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseOptionalSecretBytes}},
+	},
+}
+
+// selectSecretArchetype selects an archetype for a secret field.
+//
+// Returns nil if fd is not a singular or optional string or bytes field.
+func selectSecretArchetype(fd protoreflect.FieldDescriptor, prof profile.Field) *compiler.Archetype {
+	od := fd.ContainingOneof()
+	switch {
+	case fd.IsMap(), fd.IsList():
+		return nil
+	case od != nil && od.Fields().Len() > 1:
+		return nil
+	case fd.HasPresence():
+		return optionalSecretFields[fieldKind(fd, prof)]
+	default:
+		return secretFields[fieldKind(fd, prof)]
+	}
+}
+
+func parseSecretString(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var r zc.Range
+	p1, p2, r = p1.SecretUTF8(p2)
+	p1, p2 = p1.SetScratch(p2, uint64(r))
+
+	var p *zc.Range
+	p1, p2, p = vm.GetMutableField[zc.Range](p1, p2)
+	*p = zc.Range(p2.Scratch())
+
+	return p1, p2
+}
+
+func parseSecretBytes(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var r zc.Range
+	p1, p2, r = p1.SecretBytes(p2)
+	p1, p2 = p1.SetScratch(p2, uint64(r))
+
+	var p *zc.Range
+	p1, p2, p = vm.GetMutableField[zc.Range](p1, p2)
+	*p = zc.Range(p2.Scratch())
+
+	return p1, p2
+}
+
+func parseOptionalSecretString(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	vm.SetBit(p1, p2)
+	return parseSecretString(p1, p2)
+}
+
+func parseOptionalSecretBytes(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	vm.SetBit(p1, p2)
+	return parseSecretBytes(p1, p2)
+}
@@ -92,7 +92,7 @@ var singularFields = map[protoreflect.Kind]*compiler.Archetype{
 	protoreflect.FloatKind: {
 		Layout:  layout.Of[float32](),
 		Getter:  getFloat32,
-		Parsers: []compiler.Parser{{Kind: protowire.Fixed32Type, Thunk: parseFixed32}},
+		Parsers: []compiler.Parser{{Kind: protowire.Fixed32Type, Thunk: parseFloat32}},
 	},
 
 	// 64-bit fixed types.
@@ -109,7 +109,7 @@ var singularFields = map[protoreflect.Kind]*compiler.Archetype{
 	protoreflect.DoubleKind: {
 		Layout:  layout.Of[float64](),
 		Getter:  getFloat64,
-		Parsers: []compiler.Parser{{Kind: protowire.Fixed64Type, Thunk: parseFixed64}},
+		Parsers: []compiler.Parser{{Kind: protowire.Fixed64Type, Thunk: parseFloat64}},
 	},
 
 	// Special scalar types.
@@ -134,7 +134,7 @@ var singularFields = map[protoreflect.Kind]*compiler.Archetype{
 	proto2StringKind: {
 		Layout:  layout.Of[zc.Range](),
 		Getter:  getString,
-		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseBytes}},
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseProto2String}},
 	},
 	protoreflect.BytesKind: {
 		Layout:  layout.Of[zc.Range](),
@@ -300,10 +300,59 @@ func parseFixed[T tdp.Int](p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	return p1, p2
 }
 
+// parseFloat32 is like parseFixed32, but applies [vm.Options].Floats.
+//
+//go:nosplit
+func parseFloat32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	if p2.Floats() == 0 {
+		return parseFixed32(p1, p2)
+	}
+
+	var v uint32
+	p1, p2, v = p1.Float32(p2)
+	var p *uint32
+	p1, p2, p = vm.GetMutableField[uint32](p1, p2)
+	*p = v
+
+	return p1, p2
+}
+
+// parseFloat64 is like parseFixed64, but applies [vm.Options].Floats.
+//
+//go:nosplit
+func parseFloat64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	if p2.Floats() == 0 {
+		return parseFixed64(p1, p2)
+	}
+
+	var v uint64
+	p1, p2, v = p1.Float64(p2)
+	var p *uint64
+	p1, p2, p = vm.GetMutableField[uint64](p1, p2)
+	*p = v
+
+	return p1, p2
+}
+
 // //go:nosplit // TODO(#30): Enable once upstream is fixed.
 func parseString(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	var r zc.Range
 	p1, p2, r = p1.UTF8(p2)
+	p1, p2, r = p1.Intern(p2, r)
+	p1, p2 = p1.SetScratch(p2, uint64(r))
+
+	var p *zc.Range
+	p1, p2, p = vm.GetMutableField[zc.Range](p1, p2)
+	*p = zc.Range(p2.Scratch())
+
+	return p1, p2
+}
+
+// //go:nosplit // TODO(#30): Enable once upstream is fixed.
+func parseProto2String(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var r zc.Range
+	p1, p2, r = p1.Bytes(p2)
+	p1, p2, r = p1.Intern(p2, r)
 	p1, p2 = p1.SetScratch(p2, uint64(r))
 
 	var p *zc.Range
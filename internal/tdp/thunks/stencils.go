@@ -22,6 +22,7 @@ import (
 	"buf.build/go/hyperpb/internal/swiss"
 	"buf.build/go/hyperpb/internal/tdp"
 	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/maps"
 	"buf.build/go/hyperpb/internal/tdp/repeated"
 	"buf.build/go/hyperpb/internal/tdp/vm"
 	"buf.build/go/hyperpb/internal/xunsafe"
@@ -32,6 +33,1609 @@ import (
 	"unsafe"
 )
 
+func parseDenseMapV32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[varint32Item, varint32Item, uint32]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi varint32Item
+	var k uint32
+	var v uint32
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint32]](p1.Arena().Alloc(maps.DenseLayout[uint32](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint32](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU32(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU32(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint32](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+			swiss.InitU32xU32(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU32(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapV32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[varint32Item, varint64Item, uint64]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi varint64Item
+	var k uint32
+	var v uint64
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint64]](p1.Arena().Alloc(maps.DenseLayout[uint64](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint64](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU64(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU64(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint64](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+			swiss.InitU32xU64(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU64(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapV32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[varint32Item, zigzag32Item, uint32]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi zigzag32Item
+	var k uint32
+	var v uint32
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint32]](p1.Arena().Alloc(maps.DenseLayout[uint32](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint32](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU32(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU32(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint32](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+			swiss.InitU32xU32(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU32(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapV32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[varint32Item, zigzag64Item, uint64]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi zigzag64Item
+	var k uint32
+	var v uint64
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint64]](p1.Arena().Alloc(maps.DenseLayout[uint64](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint64](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU64(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU64(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint64](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+			swiss.InitU32xU64(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU64(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapV32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[varint32Item, fixed32Item, uint32]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi fixed32Item
+	var k uint32
+	var v uint32
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint32]](p1.Arena().Alloc(maps.DenseLayout[uint32](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint32](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU32(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU32(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint32](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+			swiss.InitU32xU32(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU32(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapV32xR32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[varint32Item, float32Item, uint32]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi float32Item
+	var k uint32
+	var v uint32
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint32]](p1.Arena().Alloc(maps.DenseLayout[uint32](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint32](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU32(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU32(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint32](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+			swiss.InitU32xU32(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU32(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapV32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[varint32Item, fixed64Item, uint64]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi fixed64Item
+	var k uint32
+	var v uint64
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint64]](p1.Arena().Alloc(maps.DenseLayout[uint64](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint64](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU64(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU64(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint64](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+			swiss.InitU32xU64(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU64(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapV32xR64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[varint32Item, float64Item, uint64]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi float64Item
+	var k uint32
+	var v uint64
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint64]](p1.Arena().Alloc(maps.DenseLayout[uint64](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint64](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU64(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU64(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint64](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+			swiss.InitU32xU64(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU64(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapV32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[varint32Item, boolItem, uint8]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi boolItem
+	var k uint32
+	var v uint8
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint8]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint8]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint8]](p1.Arena().Alloc(maps.DenseLayout[uint8](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint8](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU8(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU8(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint8](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+			swiss.InitU32xU8(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU8(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapZ32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[zigzag32Item, varint32Item, uint32]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki zigzag32Item
+	var vi varint32Item
+	var k uint32
+	var v uint32
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint32]](p1.Arena().Alloc(maps.DenseLayout[uint32](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint32](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU32(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU32(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint32](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+			swiss.InitU32xU32(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU32(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapZ32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[zigzag32Item, varint64Item, uint64]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki zigzag32Item
+	var vi varint64Item
+	var k uint32
+	var v uint64
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint64]](p1.Arena().Alloc(maps.DenseLayout[uint64](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint64](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU64(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU64(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint64](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+			swiss.InitU32xU64(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU64(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapZ32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[zigzag32Item, zigzag32Item, uint32]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki zigzag32Item
+	var vi zigzag32Item
+	var k uint32
+	var v uint32
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint32]](p1.Arena().Alloc(maps.DenseLayout[uint32](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint32](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU32(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU32(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint32](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+			swiss.InitU32xU32(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU32(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapZ32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[zigzag32Item, zigzag64Item, uint64]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki zigzag32Item
+	var vi zigzag64Item
+	var k uint32
+	var v uint64
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint64]](p1.Arena().Alloc(maps.DenseLayout[uint64](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint64](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU64(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU64(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint64](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+			swiss.InitU32xU64(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU64(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapZ32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[zigzag32Item, fixed32Item, uint32]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki zigzag32Item
+	var vi fixed32Item
+	var k uint32
+	var v uint32
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint32]](p1.Arena().Alloc(maps.DenseLayout[uint32](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint32](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU32(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU32(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint32](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+			swiss.InitU32xU32(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU32(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapZ32xR32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[zigzag32Item, float32Item, uint32]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki zigzag32Item
+	var vi float32Item
+	var k uint32
+	var v uint32
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint32]](p1.Arena().Alloc(maps.DenseLayout[uint32](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint32](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU32(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU32(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint32](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+			swiss.InitU32xU32(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU32(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapZ32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[zigzag32Item, fixed64Item, uint64]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki zigzag32Item
+	var vi fixed64Item
+	var k uint32
+	var v uint64
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint64]](p1.Arena().Alloc(maps.DenseLayout[uint64](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint64](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU64(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU64(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint64](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+			swiss.InitU32xU64(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU64(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapZ32xR64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[zigzag32Item, float64Item, uint64]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki zigzag32Item
+	var vi float64Item
+	var k uint32
+	var v uint64
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint64]](p1.Arena().Alloc(maps.DenseLayout[uint64](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint64](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU64(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU64(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint64](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+			swiss.InitU32xU64(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU64(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseDenseMapZ32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseDenseMapKxV[zigzag32Item, boolItem, uint8]
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki zigzag32Item
+	var vi boolItem
+	var k uint32
+	var v uint8
+	var extra bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+	var mp **maps.DenseIntToScalar[uint32, uint8]
+	p1, p2, mp = vm.GetMutableField[*maps.DenseIntToScalar[uint32, uint8]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(p2.Field().Preload)
+		m = xunsafe.Cast[maps.DenseIntToScalar[uint32, uint8]](p1.Arena().Alloc(maps.DenseLayout[uint8](cap)))
+		xunsafe.StoreNoWB(mp, m)
+		m.Cap = uint32(cap)
+	}
+	keepFirst := p2.MapEntries()&vm.MapKeepFirst != 0
+
+	if k < m.Cap {
+		word := &m.Present()[k/64]
+		bit := uint64(1) << (k % 64)
+		if *word&bit == 0 {
+			*word |= bit
+			m.Count++
+		} else if keepFirst {
+			goto done
+		}
+		m.Values()[k] = v
+		goto done
+	}
+
+	if m.Sparse == nil {
+		size, _ := swiss.Layout[uint32, uint8](1)
+		t := xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(&m.Sparse, t)
+		swiss.InitU32xU8(t, 1, nil, nil)
+	}
+	{
+		n0 := m.Sparse.Len()
+		vp := swiss.InsertU32xU8(m.Sparse, k, nil)
+		if vp == nil {
+			size, _ := swiss.Layout[uint32, uint8](m.Sparse.Len() + 1)
+			t := xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+			swiss.InitU32xU8(t, m.Sparse.Len()+1, m.Sparse, nil)
+			xunsafe.StoreNoWB(&m.Sparse, t)
+			vp = swiss.InsertU32xU8(t, k, nil)
+		}
+		if m.Sparse.Len() > n0 || !keepFirst {
+			*vp = v
+		}
+	}
+
+done:
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+
 func parseMapV32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 	_ = parseMapKxV[varint32Item, varint32Item, uint32, uint32]
 
@@ -40,11 +1644,2597 @@ func parseMapV32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi varint32Item
+	var k uint32
+	var v uint32
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint32Item, varint32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU32xU32(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU32xU32(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU32xU32(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint32Item, varint64Item, uint32, uint64]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi varint64Item
+	var k uint32
+	var v uint64
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint32Item, varint64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU32xU64(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU32xU64(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint32Item, zigzag32Item, uint32, uint32]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi zigzag32Item
+	var k uint32
+	var v uint32
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint32Item, zigzag32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU32xU32(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU32xU32(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU32xU32(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint32Item, zigzag64Item, uint32, uint64]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi zigzag64Item
+	var k uint32
+	var v uint64
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint32Item, zigzag64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU32xU64(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU32xU64(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint32Item, fixed32Item, uint32, uint32]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi fixed32Item
+	var k uint32
+	var v uint32
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint32Item, fixed32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU32xU32(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU32xU32(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU32xU32(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV32xR32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint32Item, float32Item, uint32, uint32]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi float32Item
+	var k uint32
+	var v uint32
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint32Item, float32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU32xU32(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU32xU32(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU32xU32(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint32Item, fixed64Item, uint32, uint64]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi fixed64Item
+	var k uint32
+	var v uint64
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint32Item, fixed64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU32xU64(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU32xU64(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV32xR64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint32Item, float64Item, uint32, uint64]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi float64Item
+	var k uint32
+	var v uint64
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint32Item, float64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU32xU64(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU32xU64(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint32Item, boolItem, uint32, uint8]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi boolItem
+	var k uint32
+	var v uint8
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint32, uint8]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint8]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint32Item, boolItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint8](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU32xU8(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU8(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU8(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU32xU8(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint32, uint8](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU32xU8(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU32xU8(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint32Item, stringItem, uint32, uint64]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi stringItem
+	var k uint32
+	var v uint64
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint32Item, stringItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU32xU64(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU32xU64(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint32Item, bytesItem, uint32, uint64]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint32Item
+	var vi bytesItem
+	var k uint32
+	var v uint64
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint32Item, bytesItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU32xU64(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU32xU64(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint64Item, varint32Item, uint64, uint32]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint64Item
+	var vi varint32Item
+	var k uint64
+	var v uint32
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint64Item, varint32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU64xU32(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU64xU32(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint64Item, varint64Item, uint64, uint64]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint64Item
+	var vi varint64Item
+	var k uint64
+	var v uint64
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint64Item, varint64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU64xU64(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU64xU64(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint64Item, zigzag32Item, uint64, uint32]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint64Item
+	var vi zigzag32Item
+	var k uint64
+	var v uint32
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint64Item, zigzag32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU64xU32(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU64xU32(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint64Item, zigzag64Item, uint64, uint64]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint64Item
+	var vi zigzag64Item
+	var k uint64
+	var v uint64
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint64Item, zigzag64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU64xU64(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU64xU64(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint64Item, fixed32Item, uint64, uint32]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint64Item
+	var vi fixed32Item
+	var k uint64
+	var v uint32
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint64Item, fixed32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU64xU32(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU64xU32(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV64xR32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint64Item, float32Item, uint64, uint32]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint64Item
+	var vi float32Item
+	var k uint64
+	var v uint32
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint64Item, float32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU64xU32(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU64xU32(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint64Item, fixed64Item, uint64, uint64]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint64Item
+	var vi fixed64Item
+	var k uint64
+	var v uint64
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint64Item, fixed64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU64xU64(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU64xU64(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV64xR64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint64Item, float64Item, uint64, uint64]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki varint64Item
+	var vi float64Item
+	var k uint64
+	var v uint64
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint64Item, float64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU64xU64(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU64xU64(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapV64x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint64Item, boolItem, uint64, uint8]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint32Item
-	var vi varint32Item
-	var k uint32
-	var v uint32
+	var ki varint64Item
+	var vi boolItem
+	var k uint64
+	var v uint8
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -69,6 +4259,7 @@ func parseMapV32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -89,52 +4280,97 @@ func parseMapV32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint8]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint8]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint64Item, boolItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint8](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU32(m, cap, nil, extract)
+		swiss.InitU64xU8(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU8(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU8(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU8(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint8](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU8(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU32(m2, k, extract)
+		vp = swiss.InsertU64xU8(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint32Item, varint64Item, uint32, uint64]
+func parseMapV64xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint64Item, stringItem, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint32Item
-	var vi varint64Item
-	var k uint32
+	var ki varint64Item
+	var vi stringItem
+	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -159,6 +4395,7 @@ func parseMapV32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -179,52 +4416,233 @@ func parseMapV32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint64Item, stringItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU64(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU64(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint32Item, zigzag32Item, uint32, uint32]
+func parseMapV64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[varint64Item, bytesItem, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint32Item
-	var vi zigzag32Item
+	var ki varint64Item
+	var vi bytesItem
+	var k uint64
+	var v uint64
+	var extra, canonical bool
+
+	kTag := protowire.EncodeTag(1, ki.kind())
+	vTag := protowire.EncodeTag(2, vi.kind())
+
+	if p1.Len() == 0 {
+		goto insert
+	}
+	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
+	if *p1.Ptr() == byte(kTag) {
+		p1.PtrAddr++
+		p1, p2, k = ki.parse(p1, p2)
+		if p1.Len() == 0 {
+			goto insert
+		}
+		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
+		if *p1.Ptr() == byte(vTag) {
+			p1.PtrAddr++
+			p1, p2, v = vi.parse(p1, p2)
+			p1.Log(p2, "map done?",
+				"%v:%v, %v/%x: %v/%x",
+				p1.PtrAddr, p1.EndAddr,
+				k, xunsafe.Bytes(&k),
+				v, xunsafe.Bytes(&v))
+			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
+				goto insert
+			}
+		}
+	}
+
+	for p1.PtrAddr < p1.EndAddr {
+		var tag uint64
+		p1, p2, tag = p1.Varint(p2)
+		switch tag {
+		case kTag:
+			p1, p2, k = ki.parse(p1, p2)
+		case vTag:
+			p1, p2, v = vi.parse(p1, p2)
+		default:
+			n, t := protowire.DecodeTag(tag)
+			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
+			if m < 0 {
+				p1.Fail(p2, -vm.ErrorCode(m))
+			}
+			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
+		}
+	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
+
+insert:
+	extract := ki.extract(p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+
+	m := *mp
+	if m == nil {
+		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[varint64Item, bytesItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU64xU64(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
+	}
+
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
+	if vp == nil {
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m2)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
+		vp = swiss.InsertU64xU64(m2, k, extract)
+	}
+
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
+
+	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+	return p1, p2
+}
+func parseMapZ32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag32Item, varint32Item, uint32, uint32]
+
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+
+	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
+	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
+
+	var ki zigzag32Item
+	var vi varint32Item
 	var k uint32
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -249,6 +4667,7 @@ func parseMapV32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -269,8 +4688,12 @@ func parseMapV32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -280,13 +4703,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag32Item, varint32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint32, uint32](cap)
 		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU32xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU32xU32(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
@@ -297,24 +4757,28 @@ insert:
 		vp = swiss.InsertU32xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint32Item, zigzag64Item, uint32, uint64]
+func parseMapZ32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag32Item, varint64Item, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint32Item
-	var vi zigzag64Item
+	var ki zigzag32Item
+	var vi varint64Item
 	var k uint32
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -339,6 +4803,7 @@ func parseMapV32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -359,8 +4824,12 @@ func parseMapV32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -370,13 +4839,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag32Item, varint64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint32, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
@@ -387,24 +4893,28 @@ insert:
 		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint32Item, fixed32Item, uint32, uint32]
+func parseMapZ32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag32Item, zigzag32Item, uint32, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint32Item
-	var vi fixed32Item
+	var ki zigzag32Item
+	var vi zigzag32Item
 	var k uint32
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -429,6 +4939,7 @@ func parseMapV32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -449,8 +4960,12 @@ func parseMapV32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -460,13 +4975,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag32Item, zigzag32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint32, uint32](cap)
 		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU32xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU32xU32(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
@@ -477,24 +5029,28 @@ insert:
 		vp = swiss.InsertU32xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint32Item, fixed64Item, uint32, uint64]
+func parseMapZ32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag32Item, zigzag64Item, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint32Item
-	var vi fixed64Item
+	var ki zigzag32Item
+	var vi zigzag64Item
 	var k uint32
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -519,6 +5075,7 @@ func parseMapV32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -539,8 +5096,12 @@ func parseMapV32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -550,13 +5111,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag32Item, zigzag64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint32, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
@@ -567,24 +5165,28 @@ insert:
 		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint32Item, boolItem, uint32, uint8]
+func parseMapZ32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag32Item, fixed32Item, uint32, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint32Item
-	var vi boolItem
+	var ki zigzag32Item
+	var vi fixed32Item
 	var k uint32
-	var v uint8
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -609,6 +5211,7 @@ func parseMapV32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -629,52 +5232,97 @@ func parseMapV32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint8]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint8]](p1, p2)
+	var mp **swiss.Table[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint8](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag32Item, fixed32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU8(m, cap, nil, extract)
+		swiss.InitU32xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU8(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint8](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU8(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU8(m2, k, extract)
+		vp = swiss.InsertU32xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint32Item, stringItem, uint32, uint64]
+func parseMapZ32xR32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag32Item, float32Item, uint32, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint32Item
-	var vi stringItem
+	var ki zigzag32Item
+	var vi float32Item
 	var k uint32
-	var v uint64
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -699,6 +5347,7 @@ func parseMapV32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -719,52 +5368,97 @@ func parseMapV32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+	var mp **swiss.Table[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag32Item, float32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU64(m, cap, nil, extract)
+		swiss.InitU32xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU64(m2, k, extract)
+		vp = swiss.InsertU32xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint32Item, bytesItem, uint32, uint64]
+func parseMapZ32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag32Item, fixed64Item, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint32Item
-	var vi bytesItem
+	var ki zigzag32Item
+	var vi fixed64Item
 	var k uint32
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -789,6 +5483,7 @@ func parseMapV32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -809,8 +5504,12 @@ func parseMapV32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -820,13 +5519,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag32Item, fixed64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint32, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
@@ -837,24 +5573,28 @@ insert:
 		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint64Item, varint32Item, uint64, uint32]
+func parseMapZ32xR64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag32Item, float64Item, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint64Item
-	var vi varint32Item
-	var k uint64
-	var v uint32
+	var ki zigzag32Item
+	var vi float64Item
+	var k uint32
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -879,6 +5619,7 @@ func parseMapV64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -899,52 +5640,97 @@ func parseMapV64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag32Item, float64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU32(m, cap, nil, extract)
+		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU32(m2, k, extract)
+		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint64Item, varint64Item, uint64, uint64]
+func parseMapZ32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag32Item, boolItem, uint32, uint8]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint64Item
-	var vi varint64Item
-	var k uint64
-	var v uint64
+	var ki zigzag32Item
+	var vi boolItem
+	var k uint32
+	var v uint8
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -969,6 +5755,7 @@ func parseMapV64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -989,52 +5776,97 @@ func parseMapV64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint32, uint8]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint8]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag32Item, boolItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint8](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU32xU8(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU8(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU8(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU8(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint8](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU8(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU32xU8(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint64Item, zigzag32Item, uint64, uint32]
+func parseMapZ32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag32Item, stringItem, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint64Item
-	var vi zigzag32Item
-	var k uint64
-	var v uint32
+	var ki zigzag32Item
+	var vi stringItem
+	var k uint32
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -1059,6 +5891,7 @@ func parseMapV64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -1079,52 +5912,97 @@ func parseMapV64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag32Item, stringItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU32(m, cap, nil, extract)
+		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU32(m2, k, extract)
+		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint64Item, zigzag64Item, uint64, uint64]
+func parseMapZ32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag32Item, bytesItem, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint64Item
-	var vi zigzag64Item
-	var k uint64
+	var ki zigzag32Item
+	var vi bytesItem
+	var k uint32
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -1149,6 +6027,7 @@ func parseMapV64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -1169,52 +6048,97 @@ func parseMapV64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag32Item, bytesItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint64Item, fixed32Item, uint64, uint32]
+func parseMapZ64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag64Item, varint32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint64Item
-	var vi fixed32Item
+	var ki zigzag64Item
+	var vi varint32Item
 	var k uint64
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -1239,6 +6163,7 @@ func parseMapV64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -1259,8 +6184,12 @@ func parseMapV64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -1270,13 +6199,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag64Item, varint32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint32](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
@@ -1287,24 +6253,28 @@ insert:
 		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint64Item, fixed64Item, uint64, uint64]
+func parseMapZ64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag64Item, varint64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint64Item
-	var vi fixed64Item
+	var ki zigzag64Item
+	var vi varint64Item
 	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -1329,6 +6299,7 @@ func parseMapV64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -1349,8 +6320,12 @@ func parseMapV64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -1360,13 +6335,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag64Item, varint64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
@@ -1377,24 +6389,28 @@ insert:
 		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV64x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint64Item, boolItem, uint64, uint8]
+func parseMapZ64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag64Item, zigzag32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint64Item
-	var vi boolItem
+	var ki zigzag64Item
+	var vi zigzag32Item
 	var k uint64
-	var v uint8
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -1419,6 +6435,7 @@ func parseMapV64x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -1439,142 +6456,97 @@ func parseMapV64x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint8]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint8]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint8](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
-		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU8(m, cap, nil, extract)
-		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
-	}
-
-	vp := swiss.InsertU64xU8(m, k, extract)
-	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint8](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
-		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU8(m2, m.Len()+1, m, extract)
-		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU8(m2, k, extract)
-	}
 
-	*vp = v
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag64Item, zigzag32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
 
-	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
-	return p1, p2
-}
-func parseMapV64xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint64Item, stringItem, uint64, uint64]
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		xunsafe.StoreNoWB(mp, m)
+		swiss.InitU64xU32(m, cap, nil, extract)
+		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
 
-	var n int
-	p1, p2, n = p1.LengthPrefix(p2)
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
 
-	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
-	p1.EndAddr = p1.PtrAddr.Add(n)
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
 
-	var ki varint64Item
-	var vi stringItem
-	var k uint64
-	var v uint64
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
 
-	kTag := protowire.EncodeTag(1, ki.kind())
-	vTag := protowire.EncodeTag(2, vi.kind())
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
 
-	if p1.Len() == 0 {
-		goto insert
-	}
-	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
-	if *p1.Ptr() == byte(kTag) {
-		p1.PtrAddr++
-		p1, p2, k = ki.parse(p1, p2)
-		if p1.Len() == 0 {
-			goto insert
-		}
-		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
-		if *p1.Ptr() == byte(vTag) {
-			p1.PtrAddr++
-			p1, p2, v = vi.parse(p1, p2)
-			p1.Log(p2, "map done?",
-				"%v:%v, %v/%x: %v/%x",
-				p1.PtrAddr, p1.EndAddr,
-				k, xunsafe.Bytes(&k),
-				v, xunsafe.Bytes(&v))
-			if p1.PtrAddr == p1.EndAddr {
-				goto insert
+				p1.EndAddr = end
 			}
-		}
-	}
 
-	for p1.PtrAddr < p1.EndAddr {
-		var tag uint64
-		p1, p2, tag = p1.Varint(p2)
-		switch tag {
-		case kTag:
-			p1, p2, k = ki.parse(p1, p2)
-		case vTag:
-			p1, p2, v = vi.parse(p1, p2)
-		default:
-			n, t := protowire.DecodeTag(tag)
-			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
-			if m < 0 {
-				p1.Fail(p2, -vm.ErrorCode(m))
-			}
-			p1.PtrAddr = p1.PtrAddr.Add(m)
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
 		}
 	}
 
-insert:
-	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
-
-	m := *mp
-	if m == nil {
-		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
-		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
-		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
-	}
-
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapV64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[varint64Item, bytesItem, uint64, uint64]
+func parseMapZ64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag64Item, zigzag64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki varint64Item
-	var vi bytesItem
+	var ki zigzag64Item
+	var vi zigzag64Item
 	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -1599,6 +6571,7 @@ func parseMapV64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -1619,8 +6592,12 @@ func parseMapV64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -1630,13 +6607,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag64Item, zigzag64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
@@ -1647,24 +6661,28 @@ insert:
 		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag32Item, varint32Item, uint32, uint32]
+func parseMapZ64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag64Item, fixed32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag32Item
-	var vi varint32Item
-	var k uint32
+	var ki zigzag64Item
+	var vi fixed32Item
+	var k uint64
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -1689,6 +6707,7 @@ func parseMapZ32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -1709,52 +6728,97 @@ func parseMapZ32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag64Item, fixed32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU32(m, cap, nil, extract)
+		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU32(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag32Item, varint64Item, uint32, uint64]
+func parseMapZ64xR32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag64Item, float32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag32Item
-	var vi varint64Item
-	var k uint32
-	var v uint64
+	var ki zigzag64Item
+	var vi float32Item
+	var k uint64
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -1779,6 +6843,7 @@ func parseMapZ32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -1799,52 +6864,97 @@ func parseMapZ32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag64Item, float32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU64(m, cap, nil, extract)
+		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU64(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag32Item, zigzag32Item, uint32, uint32]
+func parseMapZ64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag64Item, fixed64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag32Item
-	var vi zigzag32Item
-	var k uint32
-	var v uint32
+	var ki zigzag64Item
+	var vi fixed64Item
+	var k uint64
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -1869,6 +6979,7 @@ func parseMapZ32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -1889,52 +7000,97 @@ func parseMapZ32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag64Item, fixed64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU32(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU32(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag32Item, zigzag64Item, uint32, uint64]
+func parseMapZ64xR64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag64Item, float64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag32Item
-	var vi zigzag64Item
-	var k uint32
+	var ki zigzag64Item
+	var vi float64Item
+	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -1959,6 +7115,7 @@ func parseMapZ32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -1979,52 +7136,97 @@ func parseMapZ32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag64Item, float64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU64(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU64(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag32Item, fixed32Item, uint32, uint32]
+func parseMapZ64x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag64Item, boolItem, uint64, uint8]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag32Item
-	var vi fixed32Item
-	var k uint32
-	var v uint32
+	var ki zigzag64Item
+	var vi boolItem
+	var k uint64
+	var v uint8
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -2049,6 +7251,7 @@ func parseMapZ32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -2069,52 +7272,97 @@ func parseMapZ32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint8]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint8]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag64Item, boolItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint8](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU32(m, cap, nil, extract)
+		swiss.InitU64xU8(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU8(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU8(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU8(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint8](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU8(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU32(m2, k, extract)
+		vp = swiss.InsertU64xU8(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag32Item, fixed64Item, uint32, uint64]
+func parseMapZ64xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag64Item, stringItem, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag32Item
-	var vi fixed64Item
-	var k uint32
+	var ki zigzag64Item
+	var vi stringItem
+	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -2139,6 +7387,7 @@ func parseMapZ32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -2159,52 +7408,97 @@ func parseMapZ32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag64Item, stringItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU64(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU64(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag32Item, boolItem, uint32, uint8]
+func parseMapZ64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[zigzag64Item, bytesItem, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag32Item
-	var vi boolItem
-	var k uint32
-	var v uint8
+	var ki zigzag64Item
+	var vi bytesItem
+	var k uint64
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -2229,6 +7523,7 @@ func parseMapZ32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -2249,52 +7544,97 @@ func parseMapZ32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint8]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint8]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint8](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[zigzag64Item, bytesItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU8(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU8(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint8](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU8(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU8(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag32Item, stringItem, uint32, uint64]
+func parseMapF32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed32Item, varint32Item, uint32, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag32Item
-	var vi stringItem
+	var ki fixed32Item
+	var vi varint32Item
 	var k uint32
-	var v uint64
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -2319,6 +7659,7 @@ func parseMapZ32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -2339,52 +7680,97 @@ func parseMapZ32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+	var mp **swiss.Table[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed32Item, varint32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU64(m, cap, nil, extract)
+		swiss.InitU32xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU64(m2, k, extract)
+		vp = swiss.InsertU32xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag32Item, bytesItem, uint32, uint64]
+func parseMapF32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed32Item, varint64Item, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag32Item
-	var vi bytesItem
+	var ki fixed32Item
+	var vi varint64Item
 	var k uint32
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -2409,6 +7795,7 @@ func parseMapZ32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -2429,8 +7816,12 @@ func parseMapZ32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -2440,13 +7831,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed32Item, varint64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint32, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
@@ -2457,24 +7885,28 @@ insert:
 		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag64Item, varint32Item, uint64, uint32]
+func parseMapF32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed32Item, zigzag32Item, uint32, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag64Item
-	var vi varint32Item
-	var k uint64
+	var ki fixed32Item
+	var vi zigzag32Item
+	var k uint32
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -2499,6 +7931,7 @@ func parseMapZ64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -2519,52 +7952,97 @@ func parseMapZ64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+	var mp **swiss.Table[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed32Item, zigzag32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU32(m, cap, nil, extract)
+		swiss.InitU32xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU32(m2, k, extract)
+		vp = swiss.InsertU32xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag64Item, varint64Item, uint64, uint64]
+func parseMapF32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed32Item, zigzag64Item, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag64Item
-	var vi varint64Item
-	var k uint64
+	var ki fixed32Item
+	var vi zigzag64Item
+	var k uint32
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -2589,6 +8067,7 @@ func parseMapZ64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -2609,52 +8088,97 @@ func parseMapZ64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed32Item, zigzag64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag64Item, zigzag32Item, uint64, uint32]
+func parseMapF32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed32Item, fixed32Item, uint32, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag64Item
-	var vi zigzag32Item
-	var k uint64
+	var ki fixed32Item
+	var vi fixed32Item
+	var k uint32
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -2679,6 +8203,7 @@ func parseMapZ64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -2699,52 +8224,97 @@ func parseMapZ64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+	var mp **swiss.Table[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed32Item, fixed32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU32(m, cap, nil, extract)
+		swiss.InitU32xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU32(m2, k, extract)
+		vp = swiss.InsertU32xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag64Item, zigzag64Item, uint64, uint64]
+func parseMapF32xR32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed32Item, float32Item, uint32, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag64Item
-	var vi zigzag64Item
-	var k uint64
-	var v uint64
+	var ki fixed32Item
+	var vi float32Item
+	var k uint32
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -2769,6 +8339,7 @@ func parseMapZ64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -2789,52 +8360,97 @@ func parseMapZ64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint32, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed32Item, float32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU32xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU32xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag64Item, fixed32Item, uint64, uint32]
+func parseMapF32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed32Item, fixed64Item, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag64Item
-	var vi fixed32Item
-	var k uint64
-	var v uint32
+	var ki fixed32Item
+	var vi fixed64Item
+	var k uint32
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -2859,6 +8475,7 @@ func parseMapZ64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -2879,52 +8496,97 @@ func parseMapZ64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed32Item, fixed64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU32(m, cap, nil, extract)
+		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU32(m2, k, extract)
+		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag64Item, fixed64Item, uint64, uint64]
+func parseMapF32xR64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed32Item, float64Item, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag64Item
-	var vi fixed64Item
-	var k uint64
+	var ki fixed32Item
+	var vi float64Item
+	var k uint32
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -2949,6 +8611,7 @@ func parseMapZ64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -2969,52 +8632,97 @@ func parseMapZ64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed32Item, float64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ64x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag64Item, boolItem, uint64, uint8]
+func parseMapF32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed32Item, boolItem, uint32, uint8]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag64Item
+	var ki fixed32Item
 	var vi boolItem
-	var k uint64
+	var k uint32
 	var v uint8
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -3039,6 +8747,7 @@ func parseMapZ64x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -3059,52 +8768,97 @@ func parseMapZ64x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint8]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint8]](p1, p2)
+	var mp **swiss.Table[uint32, uint8]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint8]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint8](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed32Item, boolItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint8](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU8(m, cap, nil, extract)
+		swiss.InitU32xU8(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU8(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU8(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU8(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU8(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint8](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint8](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU8(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU8(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU8(m2, k, extract)
+		vp = swiss.InsertU32xU8(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ64xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag64Item, stringItem, uint64, uint64]
+func parseMapF32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed32Item, stringItem, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag64Item
+	var ki fixed32Item
 	var vi stringItem
-	var k uint64
+	var k uint32
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -3129,6 +8883,7 @@ func parseMapZ64xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -3149,52 +8904,97 @@ func parseMapZ64xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed32Item, stringItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapZ64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[zigzag64Item, bytesItem, uint64, uint64]
+func parseMapF32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed32Item, bytesItem, uint32, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki zigzag64Item
+	var ki fixed32Item
 	var vi bytesItem
-	var k uint64
+	var k uint32
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -3219,6 +9019,7 @@ func parseMapZ64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -3239,52 +9040,97 @@ func parseMapZ64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint32, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed32Item, bytesItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint32, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU32xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU32xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU32xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU32xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed32Item, varint32Item, uint32, uint32]
+func parseMapF64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed64Item, varint32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed32Item
+	var ki fixed64Item
 	var vi varint32Item
-	var k uint32
+	var k uint64
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -3309,6 +9155,7 @@ func parseMapF32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -3329,52 +9176,97 @@ func parseMapF32xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed64Item, varint32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU32(m, cap, nil, extract)
+		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU32(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed32Item, varint64Item, uint32, uint64]
+func parseMapF64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed64Item, varint64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed32Item
+	var ki fixed64Item
 	var vi varint64Item
-	var k uint32
+	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -3399,6 +9291,7 @@ func parseMapF32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -3419,52 +9312,97 @@ func parseMapF32xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed64Item, varint64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU64(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU64(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed32Item, zigzag32Item, uint32, uint32]
+func parseMapF64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed64Item, zigzag32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed32Item
+	var ki fixed64Item
 	var vi zigzag32Item
-	var k uint32
+	var k uint64
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -3489,6 +9427,7 @@ func parseMapF32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -3509,52 +9448,97 @@ func parseMapF32xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed64Item, zigzag32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU32(m, cap, nil, extract)
+		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU32(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed32Item, zigzag64Item, uint32, uint64]
+func parseMapF64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed64Item, zigzag64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed32Item
+	var ki fixed64Item
 	var vi zigzag64Item
-	var k uint32
+	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -3579,6 +9563,7 @@ func parseMapF32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -3599,52 +9584,97 @@ func parseMapF32xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed64Item, zigzag64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU64(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU64(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed32Item, fixed32Item, uint32, uint32]
+func parseMapF64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed64Item, fixed32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed32Item
+	var ki fixed64Item
 	var vi fixed32Item
-	var k uint32
+	var k uint64
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -3669,6 +9699,7 @@ func parseMapF32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -3689,52 +9720,97 @@ func parseMapF32xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed64Item, fixed32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU32(m, cap, nil, extract)
+		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU32(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed32Item, fixed64Item, uint32, uint64]
+func parseMapF64xR32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed64Item, float32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed32Item
-	var vi fixed64Item
-	var k uint32
-	var v uint64
+	var ki fixed64Item
+	var vi float32Item
+	var k uint64
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -3759,6 +9835,7 @@ func parseMapF32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -3779,52 +9856,97 @@ func parseMapF32xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed64Item, float32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU64(m, cap, nil, extract)
+		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU64(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed32Item, boolItem, uint32, uint8]
+func parseMapF64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed64Item, fixed64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed32Item
-	var vi boolItem
-	var k uint32
-	var v uint8
+	var ki fixed64Item
+	var vi fixed64Item
+	var k uint64
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -3849,6 +9971,7 @@ func parseMapF32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -3869,52 +9992,97 @@ func parseMapF32x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint8]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint8]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint8](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed64Item, fixed64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU8(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU8(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint8](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint8]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU8(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU8(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed32Item, stringItem, uint32, uint64]
+func parseMapF64xR64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed64Item, float64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed32Item
-	var vi stringItem
-	var k uint32
+	var ki fixed64Item
+	var vi float64Item
+	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -3939,6 +10107,7 @@ func parseMapF32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -3959,52 +10128,97 @@ func parseMapF32xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed64Item, float64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU64(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU64(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed32Item, bytesItem, uint32, uint64]
+func parseMapF64x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed64Item, boolItem, uint64, uint8]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed32Item
-	var vi bytesItem
-	var k uint32
-	var v uint64
+	var ki fixed64Item
+	var vi boolItem
+	var k uint64
+	var v uint8
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -4029,6 +10243,7 @@ func parseMapF32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -4049,52 +10264,97 @@ func parseMapF32xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint32, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint32, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint8]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint8]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint32, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed64Item, boolItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint8](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU32xU64(m, cap, nil, extract)
+		swiss.InitU64xU8(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU8(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU8(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU32xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU8(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint32, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint32, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint8](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU32xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU8(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU32xU64(m2, k, extract)
+		vp = swiss.InsertU64xU8(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed64Item, varint32Item, uint64, uint32]
+func parseMapF64xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed64Item, stringItem, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki fixed64Item
-	var vi varint32Item
+	var vi stringItem
 	var k uint64
-	var v uint32
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -4119,6 +10379,7 @@ func parseMapF64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -4139,52 +10400,97 @@ func parseMapF64xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed64Item, stringItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU32(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU32(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed64Item, varint64Item, uint64, uint64]
+func parseMapF64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[fixed64Item, bytesItem, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki fixed64Item
-	var vi varint64Item
+	var vi bytesItem
 	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -4209,6 +10515,7 @@ func parseMapF64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -4229,8 +10536,12 @@ func parseMapF64xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -4240,13 +10551,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[fixed64Item, bytesItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
@@ -4257,24 +10605,28 @@ insert:
 		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed64Item, zigzag32Item, uint64, uint32]
+func parseMapSxV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[stringItem, varint32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed64Item
-	var vi zigzag32Item
+	var ki stringItem
+	var vi varint32Item
 	var k uint64
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -4299,6 +10651,7 @@ func parseMapF64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -4319,8 +10672,12 @@ func parseMapF64xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -4330,13 +10687,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[stringItem, varint32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint32](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
@@ -4347,24 +10741,28 @@ insert:
 		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed64Item, zigzag64Item, uint64, uint64]
+func parseMapSxV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[stringItem, varint64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed64Item
-	var vi zigzag64Item
+	var ki stringItem
+	var vi varint64Item
 	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -4389,6 +10787,7 @@ func parseMapF64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -4409,8 +10808,12 @@ func parseMapF64xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -4420,13 +10823,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[stringItem, varint64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
@@ -4437,24 +10877,28 @@ insert:
 		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed64Item, fixed32Item, uint64, uint32]
+func parseMapSxZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[stringItem, zigzag32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed64Item
-	var vi fixed32Item
+	var ki stringItem
+	var vi zigzag32Item
 	var k uint64
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -4479,6 +10923,7 @@ func parseMapF64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -4499,8 +10944,12 @@ func parseMapF64xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -4510,13 +10959,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[stringItem, zigzag32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint32](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
@@ -4527,24 +11013,28 @@ insert:
 		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed64Item, fixed64Item, uint64, uint64]
+func parseMapSxZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[stringItem, zigzag64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed64Item
-	var vi fixed64Item
+	var ki stringItem
+	var vi zigzag64Item
 	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -4569,6 +11059,7 @@ func parseMapF64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -4589,8 +11080,12 @@ func parseMapF64xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -4600,131 +11095,82 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[stringItem, zigzag64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
-	}
-
-	vp := swiss.InsertU64xU64(m, k, extract)
-	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
-		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
-		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
-	}
-
-	*vp = v
-
-	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
-	return p1, p2
-}
-func parseMapF64x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed64Item, boolItem, uint64, uint8]
 
-	var n int
-	p1, p2, n = p1.LengthPrefix(p2)
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
 
-	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
-	p1.EndAddr = p1.PtrAddr.Add(n)
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
 
-	var ki fixed64Item
-	var vi boolItem
-	var k uint64
-	var v uint8
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
 
-	kTag := protowire.EncodeTag(1, ki.kind())
-	vTag := protowire.EncodeTag(2, vi.kind())
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
 
-	if p1.Len() == 0 {
-		goto insert
-	}
-	p1.Log(p2, "first byte", "%#02x", *p1.Ptr())
-	if *p1.Ptr() == byte(kTag) {
-		p1.PtrAddr++
-		p1, p2, k = ki.parse(p1, p2)
-		if p1.Len() == 0 {
-			goto insert
-		}
-		p1.Log(p2, "second byte", "%#02x", *p1.Ptr())
-		if *p1.Ptr() == byte(vTag) {
-			p1.PtrAddr++
-			p1, p2, v = vi.parse(p1, p2)
-			p1.Log(p2, "map done?",
-				"%v:%v, %v/%x: %v/%x",
-				p1.PtrAddr, p1.EndAddr,
-				k, xunsafe.Bytes(&k),
-				v, xunsafe.Bytes(&v))
-			if p1.PtrAddr == p1.EndAddr {
-				goto insert
+				p1.EndAddr = end
 			}
-		}
-	}
 
-	for p1.PtrAddr < p1.EndAddr {
-		var tag uint64
-		p1, p2, tag = p1.Varint(p2)
-		switch tag {
-		case kTag:
-			p1, p2, k = ki.parse(p1, p2)
-		case vTag:
-			p1, p2, v = vi.parse(p1, p2)
-		default:
-			n, t := protowire.DecodeTag(tag)
-			m := protowire.ConsumeFieldValue(n, t, p1.Buf())
-			if m < 0 {
-				p1.Fail(p2, -vm.ErrorCode(m))
-			}
-			p1.PtrAddr = p1.PtrAddr.Add(m)
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
 		}
 	}
 
-insert:
-	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint8]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint8]](p1, p2)
-
-	m := *mp
-	if m == nil {
-		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint8](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
-		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU8(m, cap, nil, extract)
-		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
-	}
-
-	vp := swiss.InsertU64xU8(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint8](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU8(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU8(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF64xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed64Item, stringItem, uint64, uint64]
+func parseMapSxF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[stringItem, fixed32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed64Item
-	var vi stringItem
+	var ki stringItem
+	var vi fixed32Item
 	var k uint64
-	var v uint64
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -4749,6 +11195,7 @@ func parseMapF64xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -4769,52 +11216,97 @@ func parseMapF64xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[stringItem, fixed32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapF64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[fixed64Item, bytesItem, uint64, uint64]
+func parseMapSxR32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[stringItem, float32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki fixed64Item
-	var vi bytesItem
+	var ki stringItem
+	var vi float32Item
 	var k uint64
-	var v uint64
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -4839,6 +11331,7 @@ func parseMapF64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -4859,52 +11352,97 @@ func parseMapF64xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[stringItem, float32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapSxV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[stringItem, varint32Item, uint64, uint32]
+func parseMapSxF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[stringItem, fixed64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki stringItem
-	var vi varint32Item
+	var vi fixed64Item
 	var k uint64
-	var v uint32
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -4929,6 +11467,7 @@ func parseMapSxV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -4949,52 +11488,97 @@ func parseMapSxV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[stringItem, fixed64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU32(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU32(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapSxV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[stringItem, varint64Item, uint64, uint64]
+func parseMapSxR64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[stringItem, float64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki stringItem
-	var vi varint64Item
+	var vi float64Item
 	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -5019,6 +11603,7 @@ func parseMapSxV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -5039,8 +11624,12 @@ func parseMapSxV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -5050,13 +11639,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[stringItem, float64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
@@ -5067,24 +11693,28 @@ insert:
 		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapSxZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[stringItem, zigzag32Item, uint64, uint32]
+func parseMapSx2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[stringItem, boolItem, uint64, uint8]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki stringItem
-	var vi zigzag32Item
+	var vi boolItem
 	var k uint64
-	var v uint32
+	var v uint8
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -5109,6 +11739,7 @@ func parseMapSxZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -5129,52 +11760,97 @@ func parseMapSxZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint8]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint8]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[stringItem, boolItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint8](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU32(m, cap, nil, extract)
+		swiss.InitU64xU8(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU8(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU8(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU8(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint8](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU8(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU32(m2, k, extract)
+		vp = swiss.InsertU64xU8(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapSxZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[stringItem, zigzag64Item, uint64, uint64]
+func parseMapSxS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[stringItem, stringItem, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki stringItem
-	var vi zigzag64Item
+	var vi stringItem
 	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -5199,6 +11875,7 @@ func parseMapSxZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -5219,8 +11896,12 @@ func parseMapSxZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -5230,13 +11911,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[stringItem, stringItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
@@ -5247,24 +11965,28 @@ insert:
 		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapSxF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[stringItem, fixed32Item, uint64, uint32]
+func parseMapSxB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[stringItem, bytesItem, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki stringItem
-	var vi fixed32Item
+	var vi bytesItem
 	var k uint64
-	var v uint32
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -5289,6 +12011,7 @@ func parseMapSxF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -5309,52 +12032,97 @@ func parseMapSxF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[stringItem, bytesItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU32(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU32(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapSxF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[stringItem, fixed64Item, uint64, uint64]
+func parseMapBxV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[bytesItem, varint32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki stringItem
-	var vi fixed64Item
+	var ki bytesItem
+	var vi varint32Item
 	var k uint64
-	var v uint64
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -5379,6 +12147,7 @@ func parseMapSxF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -5399,52 +12168,97 @@ func parseMapSxF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[bytesItem, varint32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapSx2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[stringItem, boolItem, uint64, uint8]
+func parseMapBxV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[bytesItem, varint64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki stringItem
-	var vi boolItem
+	var ki bytesItem
+	var vi varint64Item
 	var k uint64
-	var v uint8
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -5469,6 +12283,7 @@ func parseMapSx2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -5489,52 +12304,97 @@ func parseMapSx2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint8]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint8]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint8](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[bytesItem, varint64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU8(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU8(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint8](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU8(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU8(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapSxS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[stringItem, stringItem, uint64, uint64]
+func parseMapBxZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[bytesItem, zigzag32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki stringItem
-	var vi stringItem
+	var ki bytesItem
+	var vi zigzag32Item
 	var k uint64
-	var v uint64
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -5559,6 +12419,7 @@ func parseMapSxS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -5579,52 +12440,97 @@ func parseMapSxS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[bytesItem, zigzag32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapSxB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[stringItem, bytesItem, uint64, uint64]
+func parseMapBxZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[bytesItem, zigzag64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki stringItem
-	var vi bytesItem
+	var ki bytesItem
+	var vi zigzag64Item
 	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -5649,6 +12555,7 @@ func parseMapSxB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -5669,8 +12576,12 @@ func parseMapSxB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -5680,13 +12591,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[bytesItem, zigzag64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
@@ -5697,24 +12645,28 @@ insert:
 		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapBxV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[bytesItem, varint32Item, uint64, uint32]
+func parseMapBxF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[bytesItem, fixed32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki bytesItem
-	var vi varint32Item
+	var vi fixed32Item
 	var k uint64
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -5739,6 +12691,7 @@ func parseMapBxV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -5759,8 +12712,12 @@ func parseMapBxV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -5770,13 +12727,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[bytesItem, fixed32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint32](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
@@ -5787,24 +12781,28 @@ insert:
 		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapBxV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[bytesItem, varint64Item, uint64, uint64]
+func parseMapBxR32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[bytesItem, float32Item, uint64, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki bytesItem
-	var vi varint64Item
+	var vi float32Item
 	var k uint64
-	var v uint64
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -5829,6 +12827,7 @@ func parseMapBxV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -5849,52 +12848,97 @@ func parseMapBxV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint64, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[bytesItem, float32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU64xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU64xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapBxZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[bytesItem, zigzag32Item, uint64, uint32]
+func parseMapBxF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[bytesItem, fixed64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki bytesItem
-	var vi zigzag32Item
+	var vi fixed64Item
 	var k uint64
-	var v uint32
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -5919,6 +12963,7 @@ func parseMapBxZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -5939,52 +12984,97 @@ func parseMapBxZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[bytesItem, fixed64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU32(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU32(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapBxZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[bytesItem, zigzag64Item, uint64, uint64]
+func parseMapBxR64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[bytesItem, float64Item, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki bytesItem
-	var vi zigzag64Item
+	var vi float64Item
 	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6009,6 +13099,7 @@ func parseMapBxZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -6029,8 +13120,12 @@ func parseMapBxZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -6040,13 +13135,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[bytesItem, float64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
@@ -6057,24 +13189,28 @@ insert:
 		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapBxF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[bytesItem, fixed32Item, uint64, uint32]
+func parseMapBx2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[bytesItem, boolItem, uint64, uint8]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki bytesItem
-	var vi fixed32Item
+	var vi boolItem
 	var k uint64
-	var v uint32
+	var v uint8
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6099,6 +13235,7 @@ func parseMapBxF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -6119,52 +13256,97 @@ func parseMapBxF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint32]](p1, p2)
+	var mp **swiss.Table[uint64, uint8]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint8]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[bytesItem, boolItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint8](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU32(m, cap, nil, extract)
+		swiss.InitU64xU8(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU8(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU8(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU8(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint8](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU8(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU32(m2, k, extract)
+		vp = swiss.InsertU64xU8(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapBxF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[bytesItem, fixed64Item, uint64, uint64]
+func parseMapBxS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[bytesItem, stringItem, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki bytesItem
-	var vi fixed64Item
+	var vi stringItem
 	var k uint64
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6189,6 +13371,7 @@ func parseMapBxF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -6209,8 +13392,12 @@ func parseMapBxF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -6220,13 +13407,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[bytesItem, stringItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint64, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
@@ -6237,24 +13461,28 @@ insert:
 		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapBx2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[bytesItem, boolItem, uint64, uint8]
+func parseMapBxB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[bytesItem, bytesItem, uint64, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki bytesItem
-	var vi boolItem
+	var vi bytesItem
 	var k uint64
-	var v uint8
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6279,6 +13507,7 @@ func parseMapBx2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -6299,52 +13528,97 @@ func parseMapBx2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint8]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint8]](p1, p2)
+	var mp **swiss.Table[uint64, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint8](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[bytesItem, bytesItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint64, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU8(m, cap, nil, extract)
+		swiss.InitU64xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU64xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU8(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU64xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint8](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint8]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU8(m2, m.Len()+1, m, extract)
+		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU8(m2, k, extract)
+		vp = swiss.InsertU64xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapBxS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[bytesItem, stringItem, uint64, uint64]
+func parseMap2xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[boolItem, varint32Item, uint8, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki bytesItem
-	var vi stringItem
-	var k uint64
-	var v uint64
+	var ki boolItem
+	var vi varint32Item
+	var k uint8
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6369,6 +13643,7 @@ func parseMapBxS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -6389,52 +13664,97 @@ func parseMapBxS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint8, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint8, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[boolItem, varint32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint8, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint8, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU8xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU8xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU8xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU8xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint8, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint8, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU8xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU8xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMapBxB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[bytesItem, bytesItem, uint64, uint64]
+func parseMap2xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[boolItem, varint64Item, uint8, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
-	var ki bytesItem
-	var vi bytesItem
-	var k uint64
+	var ki boolItem
+	var vi varint64Item
+	var k uint8
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6459,6 +13779,7 @@ func parseMapBxB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -6479,52 +13800,97 @@ func parseMapBxB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint64, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint64, uint64]](p1, p2)
+	var mp **swiss.Table[uint8, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint8, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint64, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[boolItem, varint64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint8, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint8, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU64xU64(m, cap, nil, extract)
+		swiss.InitU8xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU64xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU8xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint64, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint64, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint8, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint8, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU64xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU8xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU64xU64(m2, k, extract)
+		vp = swiss.InsertU8xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMap2xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[boolItem, varint32Item, uint8, uint32]
+func parseMap2xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[boolItem, zigzag32Item, uint8, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki boolItem
-	var vi varint32Item
+	var vi zigzag32Item
 	var k uint8
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6549,6 +13915,7 @@ func parseMap2xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -6569,8 +13936,12 @@ func parseMap2xV32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -6580,13 +13951,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[boolItem, zigzag32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint8, uint32](cap)
 		m = xunsafe.Cast[swiss.Table[uint8, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU8xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU8xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU8xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU8xU32(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint8, uint32](m.Len() + 1)
@@ -6597,24 +14005,28 @@ insert:
 		vp = swiss.InsertU8xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMap2xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[boolItem, varint64Item, uint8, uint64]
+func parseMap2xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[boolItem, zigzag64Item, uint8, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki boolItem
-	var vi varint64Item
+	var vi zigzag64Item
 	var k uint8
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6639,6 +14051,7 @@ func parseMap2xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -6659,8 +14072,12 @@ func parseMap2xV64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -6670,13 +14087,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[boolItem, zigzag64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint8, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint8, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU8xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU8xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint8, uint64](m.Len() + 1)
@@ -6687,24 +14141,28 @@ insert:
 		vp = swiss.InsertU8xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMap2xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[boolItem, zigzag32Item, uint8, uint32]
+func parseMap2xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[boolItem, fixed32Item, uint8, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki boolItem
-	var vi zigzag32Item
+	var vi fixed32Item
 	var k uint8
 	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6729,6 +14187,7 @@ func parseMap2xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -6749,8 +14208,12 @@ func parseMap2xZ32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -6760,13 +14223,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[boolItem, fixed32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint8, uint32](cap)
 		m = xunsafe.Cast[swiss.Table[uint8, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU8xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU8xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU8xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU8xU32(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint8, uint32](m.Len() + 1)
@@ -6777,24 +14277,28 @@ insert:
 		vp = swiss.InsertU8xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMap2xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[boolItem, zigzag64Item, uint8, uint64]
+func parseMap2xR32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[boolItem, float32Item, uint8, uint32]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki boolItem
-	var vi zigzag64Item
+	var vi float32Item
 	var k uint8
-	var v uint64
+	var v uint32
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6819,6 +14323,7 @@ func parseMap2xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -6839,52 +14344,97 @@ func parseMap2xZ64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint8, uint64]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint8, uint64]](p1, p2)
+	var mp **swiss.Table[uint8, uint32]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint8, uint32]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint8, uint64](cap)
-		m = xunsafe.Cast[swiss.Table[uint8, uint64]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[boolItem, float32Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint8, uint32](cap)
+		m = xunsafe.Cast[swiss.Table[uint8, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU8xU64(m, cap, nil, extract)
+		swiss.InitU8xU32(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU8xU32(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU8xU32(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU8xU64(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU8xU32(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint8, uint64](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint8, uint64]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint8, uint32](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint8, uint32]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU8xU64(m2, m.Len()+1, m, extract)
+		swiss.InitU8xU32(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU8xU64(m2, k, extract)
+		vp = swiss.InsertU8xU32(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMap2xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[boolItem, fixed32Item, uint8, uint32]
+func parseMap2xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[boolItem, fixed64Item, uint8, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki boolItem
-	var vi fixed32Item
+	var vi fixed64Item
 	var k uint8
-	var v uint32
+	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6909,6 +14459,7 @@ func parseMap2xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -6929,52 +14480,97 @@ func parseMap2xF32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
-	var mp **swiss.Table[uint8, uint32]
-	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint8, uint32]](p1, p2)
+	var mp **swiss.Table[uint8, uint64]
+	p1, p2, mp = vm.GetMutableField[*swiss.Table[uint8, uint64]](p1, p2)
 
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
-		size, _ := swiss.Layout[uint8, uint32](cap)
-		m = xunsafe.Cast[swiss.Table[uint8, uint32]](p1.Arena().Alloc(size))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[boolItem, fixed64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
+		size, _ := swiss.Layout[uint8, uint64](cap)
+		m = xunsafe.Cast[swiss.Table[uint8, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
-		swiss.InitU8xU32(m, cap, nil, extract)
+		swiss.InitU8xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
-	vp := swiss.InsertU8xU32(m, k, extract)
+	n0 := m.Len()
+	vp := swiss.InsertU8xU64(m, k, extract)
 	if vp == nil {
-		size, _ := swiss.Layout[uint8, uint32](m.Len() + 1)
-		m2 := xunsafe.Cast[swiss.Table[uint8, uint32]](p1.Arena().Alloc(size))
+		size, _ := swiss.Layout[uint8, uint64](m.Len() + 1)
+		m2 := xunsafe.Cast[swiss.Table[uint8, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m2)
-		swiss.InitU8xU32(m2, m.Len()+1, m, extract)
+		swiss.InitU8xU64(m2, m.Len()+1, m, extract)
 		xunsafe.StoreNoWB(&m2.Scratch, p1.Shared().Src)
-		vp = swiss.InsertU8xU32(m2, k, extract)
+		vp = swiss.InsertU8xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
 }
-func parseMap2xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
-	_ = parseMapKxV[boolItem, fixed64Item, uint8, uint64]
+func parseMap2xR64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	_ = parseMapKxV[boolItem, float64Item, uint8, uint64]
 
 	var n int
 	p1, p2, n = p1.LengthPrefix(p2)
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki boolItem
-	var vi fixed64Item
+	var vi float64Item
 	var k uint8
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -6999,6 +14595,7 @@ func parseMap2xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -7019,8 +14616,12 @@ func parseMap2xF64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -7030,13 +14631,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[boolItem, float64Item](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint8, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint8, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU8xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU8xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint8, uint64](m.Len() + 1)
@@ -7047,7 +14685,9 @@ insert:
 		vp = swiss.InsertU8xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
@@ -7060,11 +14700,13 @@ func parseMap2x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki boolItem
 	var vi boolItem
 	var k uint8
 	var v uint8
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -7089,6 +14731,7 @@ func parseMap2x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -7109,8 +14752,12 @@ func parseMap2x2(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -7120,13 +14767,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[boolItem, boolItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint8, uint8](cap)
 		m = xunsafe.Cast[swiss.Table[uint8, uint8]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU8xU8(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU8xU8(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU8xU8(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU8xU8(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint8, uint8](m.Len() + 1)
@@ -7137,7 +14821,9 @@ insert:
 		vp = swiss.InsertU8xU8(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
@@ -7150,11 +14836,13 @@ func parseMap2xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki boolItem
 	var vi stringItem
 	var k uint8
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -7179,6 +14867,7 @@ func parseMap2xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -7199,8 +14888,12 @@ func parseMap2xS(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -7210,13 +14903,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[boolItem, stringItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint8, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint8, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU8xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU8xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint8, uint64](m.Len() + 1)
@@ -7227,7 +14957,9 @@ insert:
 		vp = swiss.InsertU8xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
@@ -7240,11 +14972,13 @@ func parseMap2xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki boolItem
 	var vi bytesItem
 	var k uint8
 	var v uint64
+	var extra, canonical bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(2, vi.kind())
@@ -7269,6 +15003,7 @@ func parseMap2xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				k, xunsafe.Bytes(&k),
 				v, xunsafe.Bytes(&v))
 			if p1.PtrAddr == p1.EndAddr {
+				canonical = true
 				goto insert
 			}
 		}
@@ -7289,8 +15024,12 @@ func parseMap2xB(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = true
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 insert:
 	extract := ki.extract(p1, p2)
@@ -7300,13 +15039,50 @@ insert:
 	m := *mp
 	if m == nil {
 		cap := int(max(1, p2.Field().Preload))
+
+		// Serializers that emit maps in key order, which is what deterministic
+		// serialization does, allow us to build the whole table in one go: the
+		// keys are known to be distinct, so the table can be sized exactly and
+		// filled without searching for existing keys.
+		var run int
+		if canonical {
+			run = sortedMapRun[boolItem, bytesItem](p1, p2, entry)
+			cap = max(cap, run)
+		}
+
 		size, _ := swiss.Layout[uint8, uint64](cap)
 		m = xunsafe.Cast[swiss.Table[uint8, uint64]](p1.Arena().Alloc(size))
 		xunsafe.StoreNoWB(mp, m)
 		swiss.InitU8xU64(m, cap, nil, extract)
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
+
+		if run > 1 {
+			*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+			p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+			tagLen := protowire.SizeVarint(p2.Field().Tag.Decode())
+			for range run - 1 {
+
+				p1.PtrAddr = p1.PtrAddr.Add(tagLen)
+				p1, p2, n = p1.LengthPrefix(p2)
+				end := p1.EndAddr
+				p1.EndAddr = p1.PtrAddr.Add(n)
+
+				p1.PtrAddr++
+				p1, p2, k = ki.parse(p1, p2)
+				p1.PtrAddr++
+				p1, p2, v = vi.parse(p1, p2)
+				*swiss.InsertUniqueU8xU64(m, k, extract) = v
+
+				p1.EndAddr = end
+			}
+
+			p1.Log(p2, "sorted map run", "%d", run)
+			return p1, p2
+		}
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU8xU64(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint8, uint64](m.Len() + 1)
@@ -7317,7 +15093,9 @@ insert:
 		vp = swiss.InsertU8xU64(m2, k, extract)
 	}
 
-	*vp = v
+	if (*mp).Len() > n0 || p2.MapEntries()&vm.MapKeepFirst == 0 {
+		*vp = v
+	}
 
 	p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
 	return p1, p2
@@ -7330,10 +15108,11 @@ func parseMapV32xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki varint32Item
 	var k uint32
-	var fast bool
+	var fast, extra bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(1, protowire.BytesType)
@@ -7375,8 +15154,12 @@ func parseMapV32xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = extra || tag != protowire.EncodeTag(2, protowire.BytesType)
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 	p1.PtrAddr = p1.EndAddr.Add(-n)
 
@@ -7397,6 +15180,7 @@ insert:
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU32xP(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint32, V](m.Len() + 1)
@@ -7407,6 +15191,13 @@ insert:
 		vp = swiss.InsertU32xP(m2, k, extract)
 	}
 
+	if (*mp).Len() == n0 && p2.MapEntries()&vm.MapKeepFirst != 0 {
+
+		p1.PtrAddr = p1.EndAddr
+		p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+		return p1, p2
+	}
+
 	var v *dynamic.Message
 
 	p1, p2, v = vm.AllocMessage(p1, p2)
@@ -7430,10 +15221,11 @@ func parseMapV64xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki varint64Item
 	var k uint64
-	var fast bool
+	var fast, extra bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(1, protowire.BytesType)
@@ -7475,8 +15267,12 @@ func parseMapV64xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = extra || tag != protowire.EncodeTag(2, protowire.BytesType)
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 	p1.PtrAddr = p1.EndAddr.Add(-n)
 
@@ -7497,6 +15293,7 @@ insert:
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xP(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, V](m.Len() + 1)
@@ -7507,6 +15304,13 @@ insert:
 		vp = swiss.InsertU64xP(m2, k, extract)
 	}
 
+	if (*mp).Len() == n0 && p2.MapEntries()&vm.MapKeepFirst != 0 {
+
+		p1.PtrAddr = p1.EndAddr
+		p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+		return p1, p2
+	}
+
 	var v *dynamic.Message
 
 	p1, p2, v = vm.AllocMessage(p1, p2)
@@ -7530,10 +15334,11 @@ func parseMapZ32xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki zigzag32Item
 	var k uint32
-	var fast bool
+	var fast, extra bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(1, protowire.BytesType)
@@ -7575,8 +15380,12 @@ func parseMapZ32xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = extra || tag != protowire.EncodeTag(2, protowire.BytesType)
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 	p1.PtrAddr = p1.EndAddr.Add(-n)
 
@@ -7597,6 +15406,7 @@ insert:
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU32xP(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint32, V](m.Len() + 1)
@@ -7607,6 +15417,13 @@ insert:
 		vp = swiss.InsertU32xP(m2, k, extract)
 	}
 
+	if (*mp).Len() == n0 && p2.MapEntries()&vm.MapKeepFirst != 0 {
+
+		p1.PtrAddr = p1.EndAddr
+		p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+		return p1, p2
+	}
+
 	var v *dynamic.Message
 
 	p1, p2, v = vm.AllocMessage(p1, p2)
@@ -7630,10 +15447,11 @@ func parseMapZ64xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki zigzag64Item
 	var k uint64
-	var fast bool
+	var fast, extra bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(1, protowire.BytesType)
@@ -7675,8 +15493,12 @@ func parseMapZ64xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = extra || tag != protowire.EncodeTag(2, protowire.BytesType)
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 	p1.PtrAddr = p1.EndAddr.Add(-n)
 
@@ -7697,6 +15519,7 @@ insert:
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xP(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, V](m.Len() + 1)
@@ -7707,6 +15530,13 @@ insert:
 		vp = swiss.InsertU64xP(m2, k, extract)
 	}
 
+	if (*mp).Len() == n0 && p2.MapEntries()&vm.MapKeepFirst != 0 {
+
+		p1.PtrAddr = p1.EndAddr
+		p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+		return p1, p2
+	}
+
 	var v *dynamic.Message
 
 	p1, p2, v = vm.AllocMessage(p1, p2)
@@ -7730,10 +15560,11 @@ func parseMapF32xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki fixed32Item
 	var k uint32
-	var fast bool
+	var fast, extra bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(1, protowire.BytesType)
@@ -7775,8 +15606,12 @@ func parseMapF32xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = extra || tag != protowire.EncodeTag(2, protowire.BytesType)
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 	p1.PtrAddr = p1.EndAddr.Add(-n)
 
@@ -7797,6 +15632,7 @@ insert:
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU32xP(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint32, V](m.Len() + 1)
@@ -7807,6 +15643,13 @@ insert:
 		vp = swiss.InsertU32xP(m2, k, extract)
 	}
 
+	if (*mp).Len() == n0 && p2.MapEntries()&vm.MapKeepFirst != 0 {
+
+		p1.PtrAddr = p1.EndAddr
+		p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+		return p1, p2
+	}
+
 	var v *dynamic.Message
 
 	p1, p2, v = vm.AllocMessage(p1, p2)
@@ -7830,10 +15673,11 @@ func parseMapF64xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki fixed64Item
 	var k uint64
-	var fast bool
+	var fast, extra bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(1, protowire.BytesType)
@@ -7875,8 +15719,12 @@ func parseMapF64xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = extra || tag != protowire.EncodeTag(2, protowire.BytesType)
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 	p1.PtrAddr = p1.EndAddr.Add(-n)
 
@@ -7897,6 +15745,7 @@ insert:
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xP(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, V](m.Len() + 1)
@@ -7907,6 +15756,13 @@ insert:
 		vp = swiss.InsertU64xP(m2, k, extract)
 	}
 
+	if (*mp).Len() == n0 && p2.MapEntries()&vm.MapKeepFirst != 0 {
+
+		p1.PtrAddr = p1.EndAddr
+		p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+		return p1, p2
+	}
+
 	var v *dynamic.Message
 
 	p1, p2, v = vm.AllocMessage(p1, p2)
@@ -7930,10 +15786,11 @@ func parseMapSxM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki stringItem
 	var k uint64
-	var fast bool
+	var fast, extra bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(1, protowire.BytesType)
@@ -7975,8 +15832,12 @@ func parseMapSxM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = extra || tag != protowire.EncodeTag(2, protowire.BytesType)
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 	p1.PtrAddr = p1.EndAddr.Add(-n)
 
@@ -7997,6 +15858,7 @@ insert:
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xP(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, V](m.Len() + 1)
@@ -8007,6 +15869,13 @@ insert:
 		vp = swiss.InsertU64xP(m2, k, extract)
 	}
 
+	if (*mp).Len() == n0 && p2.MapEntries()&vm.MapKeepFirst != 0 {
+
+		p1.PtrAddr = p1.EndAddr
+		p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+		return p1, p2
+	}
+
 	var v *dynamic.Message
 
 	p1, p2, v = vm.AllocMessage(p1, p2)
@@ -8030,10 +15899,11 @@ func parseMapBxM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki bytesItem
 	var k uint64
-	var fast bool
+	var fast, extra bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(1, protowire.BytesType)
@@ -8075,8 +15945,12 @@ func parseMapBxM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = extra || tag != protowire.EncodeTag(2, protowire.BytesType)
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 	p1.PtrAddr = p1.EndAddr.Add(-n)
 
@@ -8097,6 +15971,7 @@ insert:
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU64xP(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint64, V](m.Len() + 1)
@@ -8107,6 +15982,13 @@ insert:
 		vp = swiss.InsertU64xP(m2, k, extract)
 	}
 
+	if (*mp).Len() == n0 && p2.MapEntries()&vm.MapKeepFirst != 0 {
+
+		p1.PtrAddr = p1.EndAddr
+		p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+		return p1, p2
+	}
+
 	var v *dynamic.Message
 
 	p1, p2, v = vm.AllocMessage(p1, p2)
@@ -8130,10 +16012,11 @@ func parseMap2xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 
 	p1, p2 = p1.SetScratch(p2, uint64(p1.EndAddr))
 	p1.EndAddr = p1.PtrAddr.Add(n)
+	entry := p1.PtrAddr
 
 	var ki boolItem
 	var k uint8
-	var fast bool
+	var fast, extra bool
 
 	kTag := protowire.EncodeTag(1, ki.kind())
 	vTag := protowire.EncodeTag(1, protowire.BytesType)
@@ -8175,8 +16058,12 @@ func parseMap2xM(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 				p1.Fail(p2, -vm.ErrorCode(m))
 			}
 			p1.PtrAddr = p1.PtrAddr.Add(m)
+			extra = extra || tag != protowire.EncodeTag(2, protowire.BytesType)
 		}
 	}
+	if extra {
+		p1, p2 = vm.PreserveMapEntry(p1, p2, entry)
+	}
 
 	p1.PtrAddr = p1.EndAddr.Add(-n)
 
@@ -8197,6 +16084,7 @@ insert:
 		xunsafe.StoreNoWB(&m.Scratch, p1.Shared().Src)
 	}
 
+	n0 := m.Len()
 	vp := swiss.InsertU8xP(m, k, extract)
 	if vp == nil {
 		size, _ := swiss.Layout[uint8, V](m.Len() + 1)
@@ -8207,6 +16095,13 @@ insert:
 		vp = swiss.InsertU8xP(m2, k, extract)
 	}
 
+	if (*mp).Len() == n0 && p2.MapEntries()&vm.MapKeepFirst != 0 {
+
+		p1.PtrAddr = p1.EndAddr
+		p1.EndAddr = xunsafe.Addr[byte](p2.Scratch())
+		return p1, p2
+	}
+
 	var v *dynamic.Message
 
 	p1, p2, v = vm.AllocMessage(p1, p2)
@@ -8240,6 +16135,7 @@ func parseRepeatedVarint8(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 		}
 		s.Store(s.Len()-1, uint8(n))
 		p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+		p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 
 		r.Raw = s.Addr().Untyped()
 		return p1, p2
@@ -8277,6 +16173,7 @@ func parseRepeatedVarint32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 		}
 		s.Store(s.Len()-1, uint32(n))
 		p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+		p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 
 		r.Raw = s.Addr().Untyped()
 		return p1, p2
@@ -8314,6 +16211,7 @@ func parseRepeatedVarint64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 		}
 		s.Store(s.Len()-1, uint64(n))
 		p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+		p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 
 		r.Raw = s.Addr().Untyped()
 		return p1, p2
@@ -8395,6 +16293,7 @@ func parsePackedVarint8(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 		s = s.SetLen(len(borrow))
 
 		p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+		p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 
 	default:
 		s = slice.CastUntyped[uint8](r.Raw)
@@ -8524,6 +16423,7 @@ func parsePackedVarint32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 		s = s.SetLen(len(borrow))
 
 		p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+		p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 
 	default:
 		s = slice.CastUntyped[uint32](r.Raw)
@@ -8653,6 +16553,7 @@ func parsePackedVarint64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 		s = s.SetLen(len(borrow))
 
 		p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+		p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 
 	default:
 		s = slice.CastUntyped[uint64](r.Raw)
@@ -8743,6 +16644,7 @@ func appendFixed32(p1 vm.P1, p2 vm.P2, v uint32) (vm.P1, vm.P2) {
 		s = s.SetLen(len(borrow))
 
 		p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+		p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 	}
 
 	s = s.AppendOne(p1.Arena(), v)
@@ -8771,6 +16673,7 @@ func appendFixed64(p1 vm.P1, p2 vm.P2, v uint64) (vm.P1, vm.P2) {
 		s = s.SetLen(len(borrow))
 
 		p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+		p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 	}
 
 	s = s.AppendOne(p1.Arena(), v)
@@ -8816,6 +16719,7 @@ func parsePackedFixed32(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 			s = s.SetLen(len(borrow))
 
 			p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+			p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 		}
 
 		size := layout.Size[uint32]()
@@ -8873,6 +16777,7 @@ func parsePackedFixed64(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
 			s = s.SetLen(len(borrow))
 
 			p1.Log(p2, "spill", "%v->%v", r.Raw, s.Addr())
+			p1, p2 = vm.RecordSpill(p1, p2, unsafe.Pointer(r))
 		}
 
 		size := layout.Size[uint64]()
@@ -34,12 +34,31 @@ const (
 // SelectArchetype selects an archetype from among those in this package.
 func SelectArchetype(fd protoreflect.FieldDescriptor, prof profile.Field) *compiler.Archetype {
 	var a *compiler.Archetype
+	if prof.Elided {
+		return selectElidedArchetype(fd)
+	}
+	if prof.Secret {
+		return selectSecretArchetype(fd, prof)
+	}
+	if prof.Intern {
+		if a := selectInternedArchetype(fd, prof); a != nil {
+			return a
+		}
+	}
+
 	od := fd.ContainingOneof()
 	switch {
 	case fd.IsMap():
 		k := fieldKind(fd.MapKey(), prof)
 		v := fieldKind(fd.MapValue(), prof)
 		a = mapFields[k][v]
+		if dense := denseMapFields[k][v]; dense != nil && prof.DenseKeys > 0 {
+			a = dense
+		}
+	case fd.IsList() && fd.Kind() == protoreflect.BoolKind && prof.BitsetBools:
+		a = bitsetBoolFields
+	case fd.IsList() && fd.Kind() == protoreflect.MessageKind && prof.Sample > 1:
+		a = sampledMessageFields
 	case fd.IsList():
 		a = repeatedFields[fieldKind(fd, prof)]
 	case od != nil && od.Fields().Len() > 1:
@@ -0,0 +1,191 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thunks
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/arena/slice"
+	"buf.build/go/hyperpb/internal/tdp"
+	"buf.build/go/hyperpb/internal/tdp/compiler"
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/profile"
+	"buf.build/go/hyperpb/internal/tdp/vm"
+	"buf.build/go/hyperpb/internal/xunsafe"
+	"buf.build/go/hyperpb/internal/xunsafe/layout"
+	"buf.build/go/hyperpb/internal/zc"
+)
+
+// Transformed fields are string or bytes fields whose wire contents are passed
+// through a [tdp.Transform] at parse time. Because the output does not live in
+// the input buffer, they cannot be stored as a [zc.Range]; instead, the output
+// is copied onto the arena and stored as a [slice.Addr].
+//
+// Transformed fields always have a hasbit, which is only consulted for fields
+// with explicit presence.
+
+// transformFields consists of archetypes for transformed singular fields.
+var transformFields = map[protoreflect.Kind]*compiler.Archetype{
+	protoreflect.StringKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Bits:    1,
+		Getter:  getTransformedString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseTransformedString}},
+	},
+	proto2StringKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Bits:    1,
+		Getter:  getTransformedString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseTransformedBytes}},
+	},
+	protoreflect.BytesKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Bits:    1,
+		Getter:  getTransformedBytes,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseTransformedBytes}},
+	},
+}
+
+// optionalTransformFields consists of archetypes for transformed optional
+// fields.
+var optionalTransformFields = map[protoreflect.Kind]*compiler.Archetype{
+	protoreflect.StringKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Bits:    1,
+		Getter:  getOptionalTransformedString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseTransformedString}},
+	},
+	proto2StringKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Bits:    1,
+		Getter:  getOptionalTransformedString,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseTransformedBytes}},
+	},
+	protoreflect.BytesKind: {
+		Layout:  layout.Of[slice.Addr[byte]](),
+		Bits:    1,
+		Getter:  getOptionalTransformedBytes,
+		Parsers: []compiler.Parser{{Kind: protowire.BytesType, Thunk: parseTransformedBytes}},
+	},
+}
+
+// SelectTransformArchetype selects an archetype for a field with a transform.
+//
+// Returns nil if fd is not a singular or optional string or bytes field.
+func SelectTransformArchetype(fd protoreflect.FieldDescriptor, prof profile.Field) *compiler.Archetype {
+	od := fd.ContainingOneof()
+	switch {
+	case fd.IsMap(), fd.IsList():
+		return nil
+	case od != nil && od.Fields().Len() > 1:
+		return nil
+	case fd.HasPresence():
+		return optionalTransformFields[fieldKind(fd, prof)]
+	default:
+		return transformFields[fieldKind(fd, prof)]
+	}
+}
+
+// transformed returns the transformed contents of a field, or false if it
+// has not been set.
+func transformed(m *dynamic.Message, getter *tdp.Accessor) ([]byte, bool) {
+	if !m.GetBit(getter.Offset.Bit) {
+		return nil, false
+	}
+	s := dynamic.GetField[slice.Addr[byte]](m, getter.Offset)
+	if s.Len == 0 {
+		return []byte{}, true
+	}
+	return s.AssertValid().Raw(), true
+}
+
+func getTransformedString(m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
+	b, _ := transformed(m, getter)
+	if len(b) == 0 {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(xunsafe.SliceToString(b))
+}
+
+func getTransformedBytes(m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
+	b, _ := transformed(m, getter)
+	if len(b) == 0 {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfBytes(b)
+}
+
+func getOptionalTransformedString(m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
+	b, ok := transformed(m, getter)
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfString(xunsafe.SliceToString(b))
+}
+
+func getOptionalTransformedBytes(m *dynamic.Message, _ *tdp.Type, getter *tdp.Accessor) protoreflect.Value {
+	b, ok := transformed(m, getter)
+	if !ok {
+		return protoreflect.Value{}
+	}
+	return protoreflect.ValueOfBytes(b)
+}
+
+func parseTransformedString(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var r zc.Range
+	p1, p2, r = p1.Bytes(p2)
+	return storeTransformed(p1, p2, r, true)
+}
+
+func parseTransformedBytes(p1 vm.P1, p2 vm.P2) (vm.P1, vm.P2) {
+	var r zc.Range
+	p1, p2, r = p1.Bytes(p2)
+	return storeTransformed(p1, p2, r, false)
+}
+
+// storeTransformed runs the current field's transform on r, unless transforms
+// are disabled, and copies the result onto the arena. If checkUTF8 is set, the
+// result (rather than the input) must be valid UTF-8.
+//
+//go:noinline
+func storeTransformed(p1 vm.P1, p2 vm.P2, r zc.Range, checkUTF8 bool) (vm.P1, vm.P2) {
+	out := r.Bytes(p1.Src())
+	if !p2.SkipTransforms() {
+		n := int32(p2.Field().Tag.Decode() >> 3)
+		fn := p2.Message().Type().Transforms[n]
+
+		var err error
+		out, err = fn(out)
+		if err != nil {
+			p1.FailWith(p2, vm.ErrorTransform, err)
+		}
+	}
+	if checkUTF8 && !p2.ValidUTF8(out) {
+		p1.Fail(p2, vm.ErrorUTF8)
+	}
+
+	var s slice.Addr[byte]
+	if len(out) > 0 {
+		s = slice.Of(p1.Arena(), out...).Addr()
+	}
+
+	p1, p2 = vm.SetBit(p1, p2)
+	var p *slice.Addr[byte]
+	p1, p2, p = vm.GetMutableField[slice.Addr[byte]](p1, p2)
+	*p = s
+
+	return p1, p2
+}
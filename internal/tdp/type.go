@@ -17,12 +17,14 @@ package tdp
 import (
 	"fmt"
 	"iter"
+	"sync/atomic"
 	_ "unsafe"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/runtime/protoiface"
 
 	"buf.build/go/hyperpb/internal/debug"
+	"buf.build/go/hyperpb/internal/intern"
 	"buf.build/go/hyperpb/internal/swiss"
 	"buf.build/go/hyperpb/internal/xunsafe"
 )
@@ -48,6 +50,16 @@ type Type struct {
 	// padding field with number equal to zero.
 	Count uint32
 
+	// Whether reflection accesses to this type's fields may be counted. This
+	// is fixed at compile time, so that types which never count accesses do
+	// not pay for an atomic load of Accesses on every access.
+	TrackAccesses bool
+
+	// Whether encoded messages of this type are limited to Aux.MaxSize bytes.
+	// This is fixed at compile time, so that pushing a message of a type with
+	// no limit does not need to load one.
+	SizeLimited bool
+
 	// Followed by:
 	// 1. An array of fields of length equal to count+1.
 	// 2. A table.Table that maps field numbers to entires in the
@@ -81,6 +93,26 @@ func (t *Type) ByDescriptor(fd protoreflect.FieldDescriptor) *Field {
 	}
 }
 
+// CountAccess records a reflection access to the field at index n, if access
+// counting is enabled.
+func (t *Type) CountAccess(n int) {
+	if t.TrackAccesses {
+		t.countAccess(n)
+	}
+}
+
+//go:noinline
+func (t *Type) countAccess(n int) {
+	if c := t.Accesses.Load(); c != nil {
+		(*c)[n].Add(1)
+	}
+}
+
+// IndexOf returns the index of f, which must be a field of this type.
+func (t *Type) IndexOf(f *Field) int {
+	return xunsafe.Sub(f, t.ByIndex(0))
+}
+
 // Submessages returns an iterator over the types of submessage fields in this
 // type.
 func (t *Type) Submessages() iter.Seq[*Type] {
@@ -133,15 +165,69 @@ type Aux struct {
 	// Negative numbers are the complement of a message field which
 	// might contain required fields.
 	Required []int32
+
+	// Indices of the fields, including extensions, which are enums or may
+	// contain enums, such as message fields whose types have enum fields. Nil
+	// if there are none. Used for rejecting unknown enum values.
+	Enums []int32
+
+	// Reflection access counters, indexed by field index. Nil unless access
+	// counting is enabled for this type. Only used if [Type].TrackAccesses is
+	// set.
+	Accesses atomic.Pointer[[]atomic.Uint64]
+
+	// The root package's message pool for this type, created on first use.
+	Pool atomic.Value
+
+	// The root package's field metadata table for this type, created on first
+	// use.
+	FieldInfo atomic.Value
+
+	// Transforms for fields of this type, keyed by field number. Nil if no
+	// fields of this type are transformed.
+	Transforms map[int32]Transform
+
+	// If not nil, singular string fields of this type are interned with this
+	// table as they are parsed. See [profile.Field].Intern.
+	Interner *intern.Table
+
+	// The maximum size in bytes of an encoded message of this type. Only used
+	// if [Type].SizeLimited is set.
+	MaxSize uint32
+
+	// For sampled repeated message fields of this type, keyed by field
+	// number, one in how many elements is parsed. Nil if there are none.
+	Samples map[int32]uint32
+
+	// Indices of the deprecated fields of this type which were elided, in
+	// order. See [profile.Field].Elided.
+	Elided []int32
+
+	// Patterns in the schema of this type that defeat parser optimizations,
+	// found by the compiler. Nil if there are none.
+	Diagnostics []Diagnostic
+
+	// The root package's cache of custom option values for this type and its
+	// fields, or nil if none were requested. Actually a *hyperpb.optionCache.
+	Options any
 }
 
+// Transform is a function that rewrites the contents of a string or bytes
+// field during parsing, before it is stored in the arena.
+type Transform func(src []byte) ([]byte, error)
+
 // TypeLayout is layout information for a [Type]. Only for debugging.
 type TypeLayout struct {
 	BitWords int           // Number of 32-bit words in the type.
 	Fields   []FieldLayout // Sorted in offset order.
+	Dispatch Dispatch      // The dispatch strategy of the type's parser.
 }
 
 // TypeParser is a parser for some [Type]. A [Type] may have multiple parsers.
+//
+// A TypeParser does not refer to the type it parses, so that types with the
+// same shape can share a parser; the type of a submessage is instead recorded
+// in the [FieldParser] that parses it.
 type TypeParser struct {
 	_ xunsafe.NoCopy
 
@@ -150,12 +236,18 @@ type TypeParser struct {
 	// than the first 256 fields.
 	TagLUT [128]uint8
 
-	TypeOffset     uint32 // The type that this parser parses.
-	DiscardUnknown bool   // Should unknown fields be kept?
+	DiscardUnknown bool     // Should unknown fields be kept?
+	Dispatch       Dispatch // How to find a field's parser on a miss.
 
 	// Maps field tags to offsets in fields.
 	Tags *swiss.Table[int32, uint32]
 
+	// For [DispatchJump], the indices of the parsers for field numbers
+	// JumpBase to JumpBase+JumpLen-1, or [NoJump] for numbers with no parser.
+	// Fields with several parsers map to one of them.
+	Jump              *xunsafe.VLA[uint32]
+	JumpBase, JumpLen uint32
+
 	// If this is an ordinary parser, this is the parser for parsing this
 	// message as a "map entry"; that is, it will have a single field with
 	// number 2 that forwards to this parser.
@@ -166,6 +258,9 @@ type TypeParser struct {
 	// Followed by an unspecified number of fieldParser values.
 }
 
+// NoJump is the entry in [TypeParser].Jump for field numbers with no parser.
+const NoJump = ^uint32(0)
+
 // Fields returns a raw pointer to this parser's field array.
 func (p *TypeParser) Fields() *xunsafe.VLA[FieldParser] {
 	// Don't use Beyond, since Go does not inline it in a critical place.
@@ -178,7 +273,78 @@ func (p *TypeParser) Fields() *xunsafe.VLA[FieldParser] {
 func (p *TypeParser) Format(s fmt.State, verb rune) {
 	debug.Dict(
 		debug.Fprintf("%p", p),
-		"ty", p.TypeOffset,
+		"dispatch", p.Dispatch,
 		"tags", p.Tags,
 	).Format(s, verb)
 }
+
+// Dispatch is a strategy for finding the parser for a field tag when it does
+// not match the parser that was predicted to come next.
+type Dispatch uint8
+
+const (
+	// DispatchList walks the list of parsers for a few tries, and then falls
+	// back to a hash table lookup.
+	DispatchList Dispatch = iota
+
+	// DispatchLUT is used when every parser's tag fits in [TypeParser.TagLUT].
+	// A one-byte tag that misses in the LUT is known to be unknown, so no
+	// further searching is required.
+	DispatchLUT
+
+	// DispatchHash skips walking the list of parsers, going straight to the
+	// hash table. This is used when field numbers are large and sparse, where
+	// walking the list is unlikely to find a match.
+	DispatchHash
+
+	// DispatchJump is like DispatchHash, but looks up field numbers in a
+	// dense jump table, [TypeParser].Jump, before falling back to the hash
+	// table. This is used for messages which are mostly a oneof with many
+	// densely-numbered members, such as event envelopes.
+	DispatchJump
+)
+
+// String implements [fmt.Stringer].
+func (d Dispatch) String() string {
+	switch d {
+	case DispatchList:
+		return "list"
+	case DispatchLUT:
+		return "lut"
+	case DispatchHash:
+		return "hash"
+	case DispatchJump:
+		return "jump"
+	default:
+		return fmt.Sprintf("Dispatch(%d)", uint8(d))
+	}
+}
+
+// Diagnostic is a pattern in the schema of a [Type] that defeats one of the
+// parser's optimizations.
+type Diagnostic struct {
+	Kind DiagnosticKind
+
+	// The oneof this diagnostic is about, if any.
+	Oneof protoreflect.OneofDescriptor
+
+	// A human-readable description of the problem.
+	Reason string
+}
+
+// DiagnosticKind is the kind of a [Diagnostic].
+type DiagnosticKind uint8
+
+const (
+	// DiagnosticSparseNumbers is reported for types whose field numbers are
+	// so large and sparse that the parser uses [DispatchHash].
+	DiagnosticSparseNumbers DiagnosticKind = iota + 1
+
+	// DiagnosticDeepNesting is reported for types that contain long chains
+	// of nested message types, which each cost a parser stack frame.
+	DiagnosticDeepNesting
+
+	// DiagnosticLargeOneof is reported for oneofs with so many members that
+	// they bloat the type's parser tables.
+	DiagnosticLargeOneof
+)
@@ -0,0 +1,67 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vm
+
+import (
+	"math"
+	"time"
+
+	"buf.build/go/hyperpb/internal/xunsafe"
+)
+
+// deadlineInterval is the number of fields parsed between checks of
+// [Options].Deadline. Reading the clock costs tens of nanoseconds, so this
+// amortizes it to well under a nanosecond per field.
+const deadlineInterval = 1024
+
+// progressInterval is the number of fields parsed between checks of whether
+// to call [Options].Progress. This is smaller than deadlineInterval, since
+// it does not read the clock.
+const progressInterval = 64
+
+// resetSteps sets the number of fields to parse before the next call to
+// checkpoint. If checkpoints are not needed, this is [math.MaxInt], and the
+// instantiation of [loop] that does not count steps is used.
+func (p3 *p3) resetSteps() {
+	switch {
+	case p3.Progress != nil:
+		p3.steps = progressInterval
+	case p3.Deadline != 0:
+		p3.steps = deadlineInterval
+	default:
+		p3.steps = math.MaxInt
+	}
+}
+
+// checkpoint fails the parse if its deadline has passed, reports progress
+// if requested, and resets the step counter. Outlined to keep it off of the
+// hot path in [loop].
+//
+//go:noinline
+func checkpoint(p1 P1, p2 P2) (P1, P2) {
+	p3 := p2.p3()
+	if p3.Deadline != 0 && time.Now().UnixNano() > p3.Deadline {
+		p1.Fail(p2, ErrorDeadline)
+	}
+	if p3.Progress != nil {
+		offset := int(p1.PtrAddr - xunsafe.AddrOf(p1.Shared().Src))
+		if offset >= p3.nextProgress {
+			p3.Progress(offset, p1.Shared().Len)
+			p3.nextProgress = offset + max(p3.ProgressInterval, 1)
+		}
+	}
+	p3.resetSteps()
+	return p1, p2
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vm
+
+import (
+	"bytes"
+	"hash/maphash"
+	"unsafe"
+
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/xunsafe"
+)
+
+// dedupSeed is the seed for hashing messages for deduplication.
+var dedupSeed = maphash.MakeSeed()
+
+// dedupEntry is a message recorded by [RecordDuplicate].
+type dedupEntry struct {
+	// The type of the message, as an offset into the library.
+	ty uint32
+	// The location of the message's encoding in the input.
+	offset, len uint32
+
+	message xunsafe.Addr[dynamic.Message]
+}
+
+// DedupMessages returns the maximum size of a submessage to deduplicate, or
+// zero if deduplication is disabled.
+func (p2 P2) DedupMessages() int {
+	return p2.p3().DedupMessages
+}
+
+// LookupDuplicate looks up a message previously recorded with
+// [RecordDuplicate] whose encoding is identical to the next n bytes of the
+// input, and whose type is that of the field being parsed.
+//
+// Returns the hash of those bytes, for passing to RecordDuplicate, and the
+// message, if one was found.
+func LookupDuplicate(p1 P1, p2 P2, n int) (uint64, *dynamic.Message) {
+	data := unsafe.Slice(p1.Ptr(), n)
+	hash := maphash.Bytes(dedupSeed, data)
+
+	e, ok := p2.p3().dedup[hash]
+	if !ok || e.ty != p2.Field().TypeOffset {
+		return hash, nil
+	}
+	if !bytes.Equal(data, unsafe.Slice(xunsafe.Add(p1.Src(), e.offset), e.len)) {
+		return hash, nil
+	}
+
+	p1.Log(p2, "dedup", "%p", e.message.AssertValid())
+	return hash, e.message.AssertValid()
+}
+
+// RecordDuplicate records that m is to be parsed from the next n bytes of the
+// input, which have the given hash, so that later identical messages can
+// share it.
+func RecordDuplicate(p1 P1, p2 P2, hash uint64, n int, m *dynamic.Message) {
+	p3 := p2.p3()
+	if p3.dedup == nil {
+		p3.dedup = make(map[uint64]dedupEntry)
+	}
+	p3.dedup[hash] = dedupEntry{
+		ty:      p2.Field().TypeOffset,
+		offset:  uint32(p1.PtrAddr.Sub(xunsafe.AddrOf(p1.Src()))),
+		len:     uint32(n),
+		message: xunsafe.AddrOf(m),
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vm
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/swiss"
+	"buf.build/go/hyperpb/internal/tdp"
+)
+
+// DispatchStats counts how well a [tdp.TypeParser]'s field order predicts the
+// order in which fields appear on the wire.
+type DispatchStats struct {
+	// Number of messages, including submessages, groups, and map values.
+	Messages int
+	// Number of field tags dispatched.
+	Fields int
+	// Number of field parsers whose tag was compared against a field tag
+	// without matching it.
+	Probes int
+	// Number of field tags which were not found by walking the parser list,
+	// and required a hash table lookup. This includes unknown fields.
+	Misses int
+}
+
+// Add adds the counts in that to s.
+func (s *DispatchStats) Add(that DispatchStats) {
+	s.Messages += that.Messages
+	s.Fields += that.Fields
+	s.Probes += that.Probes
+	s.Misses += that.Misses
+}
+
+// SimulateDispatch replays how the parser loop would find the field parser for
+// each field of data, an encoded message of type ty, and counts how often its
+// predictions fail.
+//
+// This does not run any thunks, so it does not validate data beyond its tags
+// and lengths; parsing stops at the first malformed field. maxMisses is as in
+// [Options].
+func SimulateDispatch(ty *tdp.Type, data []byte, maxMisses int) DispatchStats {
+	sim := dispatchSim{maxMisses: maxMisses}
+	sim.message(ty, data, 0, 0)
+	return sim.stats
+}
+
+type dispatchSim struct {
+	stats     DispatchStats
+	maxMisses int
+}
+
+// message simulates parsing a message with parser p. If endGroup is nonzero,
+// data ends with the group's end tag, which is dispatched like any other.
+//
+// Returns false if data is malformed.
+func (s *dispatchSim) message(ty *tdp.Type, data []byte, endGroup protowire.Number, depth int) bool {
+	if depth > 1000 {
+		return false
+	}
+	s.stats.Messages++
+
+	p := ty.Parser
+	fields := fieldsOf(ty)
+	next := p.Entrypoint.NextOk.AssertValid()
+	for len(data) > 0 {
+		num, wt, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return false
+		}
+		if wt == protowire.EndGroupType && num == endGroup {
+			// The end tag is never one of the parser's tags, so it is only
+			// recognized once dispatching misses.
+			s.dispatch(p, next, data[:n], num, wt)
+			return true
+		}
+
+		m := protowire.ConsumeFieldValue(num, wt, data[n:])
+		if m < 0 {
+			return false
+		}
+		value := data[n : n+m]
+		tag := data[:n]
+		data = data[n+m:]
+
+		f := s.dispatch(p, next, tag, num, wt)
+		if f == nil {
+			// Unknown fields are skipped until the next known field, which
+			// is then dispatched to directly.
+			continue
+		}
+
+		next = f.NextOk.AssertValid()
+		fd, sub := fields(num)
+		if fd == nil || sub == nil {
+			continue
+		}
+		switch {
+		case wt == protowire.StartGroupType && fd.Kind() == protoreflect.GroupKind:
+			if !s.message(sub, value, num, depth+1) {
+				return false
+			}
+		case wt == protowire.BytesType && fd.IsMap():
+			// Map keys are parsed by the map's thunk, so only the values
+			// are dispatched. This assumes entries are encoded key first.
+			v, ok := mapValue(value)
+			if !ok {
+				return false
+			}
+			if len(v) > 0 && !s.message(sub, v, 0, depth+1) {
+				return false
+			}
+		case wt == protowire.BytesType && fd.Kind() == protoreflect.MessageKind:
+			v, _ := protowire.ConsumeBytes(value)
+			if len(v) == 0 {
+				continue
+			}
+			if !s.message(sub, v, 0, depth+1) {
+				return false
+			}
+		default:
+			continue
+		}
+		// Once the submessage is popped, the parser resumes by predicting the
+		// same field, which is what repeated fields want.
+		next = f
+	}
+	return true
+}
+
+// mapValue extracts the value of an encoded map entry field.
+func mapValue(data []byte) ([]byte, bool) {
+	entry, n := protowire.ConsumeBytes(data)
+	if n < 0 {
+		return nil, false
+	}
+	var value []byte
+	for len(entry) > 0 {
+		num, wt, n := protowire.ConsumeTag(entry)
+		if n < 0 {
+			return nil, false
+		}
+		m := protowire.ConsumeFieldValue(num, wt, entry[n:])
+		if m < 0 {
+			return nil, false
+		}
+		if num == 2 && wt == protowire.BytesType {
+			value, _ = protowire.ConsumeBytes(entry[n : n+m])
+		}
+		entry = entry[n+m:]
+	}
+	return value, true
+}
+
+// dispatch finds the parser for the given tag, starting the search at next.
+func (s *dispatchSim) dispatch(
+	p *tdp.TypeParser, next *tdp.FieldParser,
+	raw []byte, num protowire.Number, wt protowire.Type,
+) *tdp.FieldParser {
+	s.stats.Fields++
+
+	if len(raw) == 1 {
+		if offset := p.TagLUT[raw[0]]; offset != 0xff {
+			return p.Fields().Get(int(offset))
+		}
+		if p.Dispatch == tdp.DispatchLUT {
+			s.stats.Misses++
+			return nil
+		}
+	}
+
+	tries := s.maxMisses
+	if p.Dispatch == tdp.DispatchHash || p.Dispatch == tdp.DispatchJump {
+		tries = 1
+	}
+	tag := tdp.EncodeTag(num, wt)
+	for f := next; tries > 0; tries-- {
+		if f.Tag == tag {
+			return f
+		}
+		s.stats.Probes++
+		f = f.NextErr.AssertValid()
+	}
+
+	s.stats.Misses++
+	idx := swiss.LookupI32xU32(p.Tags, int32(protowire.EncodeTag(num, wt)))
+	if idx == nil {
+		return nil
+	}
+	return p.Fields().Get(int(*idx))
+}
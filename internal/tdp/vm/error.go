@@ -15,9 +15,14 @@
 package vm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"buf.build/go/hyperpb/internal/tdp"
 )
 
 const (
@@ -32,6 +37,12 @@ const (
 
 	ErrorUTF8
 	ErrorTooBig
+	ErrorDeadline
+	ErrorSignalingNaN
+	ErrorTransform
+	ErrorUnknownLimit
+	ErrorUnknownField
+	ErrorSizeLimit
 )
 
 var errs = [...]error{
@@ -43,7 +54,13 @@ var errs = [...]error{
 	ErrorEndGroup:       errors.New("mismatching end group marker"),
 	ErrorRecursionDepth: errors.New("recursion depth exceeded"),
 	ErrorUTF8:           errors.New("invalid UTF-8 in string"),
-	ErrorTooBig:         errors.New("input was larger than 4GB"),
+	ErrorTooBig:         errors.New("input exceeded maximum size"),
+	ErrorDeadline:       context.DeadlineExceeded,
+	ErrorSignalingNaN:   errors.New("signaling NaN in floating-point field"),
+	ErrorTransform:      errors.New("field transform failed"),
+	ErrorUnknownLimit:   errors.New("too many unknown fields"),
+	ErrorUnknownField:   errors.New("unknown field rejected by filter"),
+	ErrorSizeLimit:      errors.New("message exceeded the size limit for its type"),
 }
 
 // ErrorCode is one of the possible types of errors in [ParseError].
@@ -53,6 +70,27 @@ type ErrorCode int
 type ParseError struct {
 	code   ErrorCode
 	offset int
+	cause  error // Set for ErrorTransform.
+	group  groupInfo
+	limit  sizeLimitInfo // Set for ErrorSizeLimit.
+}
+
+// groupInfo describes a group that was not correctly terminated.
+type groupInfo struct {
+	start    int              // Offset of the group's start tag, or -1.
+	expected protowire.Number // Zero if not inside of a group.
+	actual   protowire.Number // Zero if the input ended.
+}
+
+// sizeLimitInfo describes a message that exceeded the size limit for its type.
+type sizeLimitInfo struct {
+	ty   *tdp.Type
+	size int
+}
+
+// Code returns this error's code.
+func (e *ParseError) Code() ErrorCode {
+	return e.code
 }
 
 // Offset returns the offset at which the error occurred.
@@ -60,12 +98,67 @@ func (e *ParseError) Offset() int {
 	return e.offset
 }
 
+// Group returns information about the group that was not correctly
+// terminated, if this error was caused by a mismatched or missing end group
+// marker.
+//
+// start is the offset of the start tag of the group that was expected to end,
+// whose field number is expected, or -1 and zero if no group was being
+// parsed. actual is the field number of the end tag that was found instead, or
+// zero if the input ended.
+func (e *ParseError) Group() (start int, expected, actual protowire.Number, ok bool) {
+	ok = e.code == ErrorEndGroup || (e.code == ErrorTruncated && e.group.expected != 0)
+	if !ok {
+		return -1, 0, 0, false
+	}
+	return e.group.start, e.group.expected, e.group.actual, true
+}
+
+// SizeLimit returns the type of the message that exceeded the size limit for
+// its type, and the size of that message, if this error was caused by one.
+func (e *ParseError) SizeLimit() (ty *tdp.Type, size int, ok bool) {
+	if e.code != ErrorSizeLimit {
+		return nil, 0, false
+	}
+	return e.limit.ty, e.limit.size, true
+}
+
+// rebase makes this error's offsets relative to the given offset.
+func (e *ParseError) rebase(start int) {
+	e.offset -= start
+	if e.group.start >= 0 && e.group.expected != 0 {
+		e.group.start -= start
+	}
+}
+
 // Unwrap implements error unwrapping viz [errors.Unwrap].
 func (e *ParseError) Unwrap() error {
+	if e.cause != nil {
+		return e.cause
+	}
 	return errs[e.code]
 }
 
 // Error implements [error].
 func (e *ParseError) Error() string {
+	if e.cause != nil {
+		return fmt.Sprintf("hyperpb: parser error at offset %d/%#x: %v: %v", e.offset, e.offset, errs[e.code], e.cause)
+	}
+	if start, expected, actual, ok := e.Group(); ok {
+		var what string
+		switch {
+		case expected == 0:
+			what = fmt.Sprintf("unexpected end of group %d", actual)
+		case actual == 0:
+			what = fmt.Sprintf("input ended inside of group %d starting at offset %d", expected, start)
+		default:
+			what = fmt.Sprintf("expected end of group %d starting at offset %d, got end of group %d", expected, start, actual)
+		}
+		return fmt.Sprintf("hyperpb: parser error at offset %d/%#x: %v: %s", e.offset, e.offset, e.Unwrap(), what)
+	}
+	if ty, size, ok := e.SizeLimit(); ok {
+		return fmt.Sprintf("hyperpb: parser error at offset %d/%#x: %v: %s is %d bytes, limit is %d",
+			e.offset, e.offset, e.Unwrap(), ty.Descriptor.FullName(), size, ty.MaxSize)
+	}
 	return fmt.Sprintf("hyperpb: parser error at offset %d/%#x: %v", e.offset, e.offset, e.Unwrap())
 }
@@ -0,0 +1,109 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vm
+
+// FloatMode is a set of transformations to apply to float and double fields
+// while parsing.
+type FloatMode uint8
+
+const (
+	// Replace all NaNs with the canonical quiet NaN.
+	FloatCanonicalNaN FloatMode = 1 << iota
+	// Fail the parse if a signaling NaN is encountered.
+	FloatRejectSignalingNaN
+	// Replace all subnormal values with a zero of the same sign.
+	FloatFlushSubnormal
+)
+
+// Bit patterns for canonical NaNs: positive, quiet, with a zero payload.
+const (
+	canonicalNaN32 = 0x7fc00000
+	canonicalNaN64 = 0x7ff8000000000000
+)
+
+// Float32 parses a float, applying the transformations in [Options].Floats.
+func (p1 P1) Float32(p2 P2) (P1, P2, uint32) {
+	var x uint32
+	p1, p2, x = p1.Fixed32(p2)
+	if p2.p3().Floats != 0 {
+		p1, p2, x = float32Slow(p1, p2, x)
+	}
+	return p1, p2, x
+}
+
+// Float64 parses a double, applying the transformations in [Options].Floats.
+func (p1 P1) Float64(p2 P2) (P1, P2, uint64) {
+	var x uint64
+	p1, p2, x = p1.Fixed64(p2)
+	if p2.p3().Floats != 0 {
+		p1, p2, x = float64Slow(p1, p2, x)
+	}
+	return p1, p2, x
+}
+
+// Floats returns the float transformations for this parse.
+func (p2 P2) Floats() FloatMode {
+	return p2.p3().Floats
+}
+
+//go:noinline
+func float32Slow(p1 P1, p2 P2, x uint32) (P1, P2, uint32) {
+	const (
+		exp   = 0x7f800000
+		man   = 0x007fffff
+		quiet = 0x00400000
+	)
+
+	mode := p2.p3().Floats
+	switch {
+	case x&exp == exp && x&man != 0: // NaN
+		if mode&FloatRejectSignalingNaN != 0 && x&quiet == 0 {
+			p1.Fail(p2, ErrorSignalingNaN)
+		}
+		if mode&FloatCanonicalNaN != 0 {
+			x = canonicalNaN32
+		}
+	case x&exp == 0 && x&man != 0: // Subnormal
+		if mode&FloatFlushSubnormal != 0 {
+			x &^= man
+		}
+	}
+	return p1, p2, x
+}
+
+//go:noinline
+func float64Slow(p1 P1, p2 P2, x uint64) (P1, P2, uint64) {
+	const (
+		exp   = 0x7ff0000000000000
+		man   = 0x000fffffffffffff
+		quiet = 0x0008000000000000
+	)
+
+	mode := p2.p3().Floats
+	switch {
+	case x&exp == exp && x&man != 0: // NaN
+		if mode&FloatRejectSignalingNaN != 0 && x&quiet == 0 {
+			p1.Fail(p2, ErrorSignalingNaN)
+		}
+		if mode&FloatCanonicalNaN != 0 {
+			x = canonicalNaN64
+		}
+	case x&exp == 0 && x&man != 0: // Subnormal
+		if mode&FloatFlushSubnormal != 0 {
+			x &^= man
+		}
+	}
+	return p1, p2, x
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vm
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"buf.build/go/hyperpb/internal/xunsafe"
+)
+
+// MapEntryMode is a set of behaviors for parsing map entries that are not
+// minimally encoded.
+type MapEntryMode uint8
+
+const (
+	// When a key appears more than once, keep the first value rather than the
+	// last one.
+	MapKeepFirst MapEntryMode = 1 << iota
+	// Record map entries which contain fields other than the key and value as
+	// unknown fields of the message containing the map.
+	MapPreserveUnknown
+)
+
+// MapEntries returns the map entry behaviors for this parse.
+func (p2 P2) MapEntries() MapEntryMode {
+	return p2.p3().MapEntries
+}
+
+// PreserveMapEntry records the map entry whose contents span from entry to
+// p1.EndAddr as an unknown field of the current message, including its tag
+// and length prefix.
+//
+// Does nothing unless [MapPreserveUnknown] is set.
+//
+//go:noinline
+func PreserveMapEntry(p1 P1, p2 P2, entry xunsafe.Addr[byte]) (P1, P2) {
+	if p2.p3().MapEntries&MapPreserveUnknown == 0 {
+		return p1, p2
+	}
+
+	start := rewindVarint(entry, uint64(p1.EndAddr-entry))
+	start = rewindVarint(start, p2.Field().Tag.Decode())
+
+	n := int(p1.EndAddr - start)
+	p1.Log(p2, "preserve map entry", "%d bytes", n)
+	return appendUnknown(p1, p2, start, n)
+}
+
+// rewindVarint returns the start of the varint ending at end, whose value is
+// v. v must be nonzero.
+func rewindVarint(end xunsafe.Addr[byte], v uint64) xunsafe.Addr[byte] {
+	// First we can trim off leading zero bytes for an over-long varint, and
+	// then skip back the minimum number of bytes needed to store v.
+	start := end - 1
+	for *start.AssertValid()&0x7f == 0 {
+		start--
+	}
+	return start.Add(1 - protowire.SizeVarint(v))
+}
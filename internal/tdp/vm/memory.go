@@ -21,7 +21,8 @@ import (
 // pageBoundary is the alignment of the smallest physical memory page on any
 // system we support (4K). If we are allowed to load memory from any address in
 // a page, we assume that loading (and discarding) memory from anywhere else is
-// also ok.
+// also ok. This also holds for WebAssembly, whose linear memory is a whole
+// number of 64K pages.
 const pageBoundary = 0x1000
 
 // RelocatePageBoundary ensures that it is always possible to read nine bytes
@@ -36,13 +37,15 @@ const pageBoundary = 0x1000
 // not met, we copy the slice in such a way as to force this condition to be
 // met.
 //
-// If forceCopy is set, this copy is performed unconditionally.
+// If forceCopy is set, this copy is performed unconditionally. In [Safe] mode,
+// the copy is always performed, so that the parser never reads memory that it
+// did not allocate.
 //
 // Exported for use by benchmarks.
 //
 //go:nosplit
 func RelocatePageBoundary(data []byte, force bool) []byte {
-	if !force {
+	if !force && !Safe {
 		// Check if there is capacity to spare.
 		if cap(data)-len(data) >= 9 {
 			return data
@@ -60,3 +63,20 @@ func RelocatePageBoundary(data []byte, force bool) []byte {
 	// Copy to a new slice with just enough capacity.
 	return append(data[:cap(data)], make([]byte, 9)...)[:len(data):cap(data)]
 }
+
+// loadWord loads up to eight bytes from p1's current position. In [Safe] mode,
+// bytes past the end of the input are read as zero; otherwise, they are
+// whatever happens to be there.
+//
+//go:nosplit
+func loadWord(p1 P1) uint64 {
+	if !Safe || p1.Len() >= 8 {
+		return xunsafe.ByteLoad[uint64](p1.Ptr(), 0)
+	}
+
+	var x uint64
+	for i := range p1.Len() {
+		x |= uint64(xunsafe.Load(p1.Ptr(), i)) << (i * 8)
+	}
+	return x
+}
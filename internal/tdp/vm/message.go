@@ -29,6 +29,18 @@ import (
 // package [dynamic], since Go cannot seem to inline them, resulting in
 // spills of p1/p2 across hot calls.
 
+// RecordSpill records that the repeated field at p was spilled from zero-copy
+// storage to the arena, if [Options].RecordSpills is set. See
+// [dynamic.Shared.Spilled].
+//
+//go:noinline
+func RecordSpill(p1 P1, p2 P2, p unsafe.Pointer) (P1, P2) {
+	if p2.p3().RecordSpills {
+		p1.Shared().RecordSpill((*byte)(p))
+	}
+	return p1, p2
+}
+
 // MutableCold is like [message.MutableCold], but with a parser-friendly ABI.
 func MutableCold(p1 P1, p2 P2) (P1, P2, *dynamic.Cold) {
 	if p2.Message().ColdIndex < 0 {
@@ -91,7 +103,7 @@ func SetBit(p1 P1, p2 P2) (P1, P2) {
 //
 //go:noinline
 func AllocMessage(p1 P1, p2 P2) (P1, P2, *dynamic.Message) {
-	ty := p1.Shared().Library().AtOffset(p2.Field().Message.TypeOffset)
+	ty := p1.Shared().Library().AtOffset(p2.Field().TypeOffset)
 	size := int(ty.Size)
 
 	// Open-coded copy of arena.Alloc, which otherwise would not inline.
@@ -109,7 +121,7 @@ func AllocMessage(p1 P1, p2 P2) (P1, P2, *dynamic.Message) {
 		// Go seems unwilling to inline AllocInPlace() here.
 		m := xunsafe.Cast[dynamic.Message](p)
 		xunsafe.StoreNoWB(&m.Shared, p1.Shared())
-		m.TypeOffset = p2.Field().Message.TypeOffset
+		m.TypeOffset = p2.Field().TypeOffset
 		m.ColdIndex = -1
 		return p1, p2, m
 	}
@@ -130,7 +142,7 @@ func AllocMessage(p1 P1, p2 P2) (P1, P2, *dynamic.Message) {
 func AllocInPlace(p1 P1, p2 P2, data *byte) (P1, P2, *dynamic.Message) {
 	m := xunsafe.Cast[dynamic.Message](data)
 	xunsafe.StoreNoWB(&m.Shared, p1.Shared())
-	m.TypeOffset = p2.Field().Message.TypeOffset
+	m.TypeOffset = p2.Field().TypeOffset
 	m.ColdIndex = -1
 	return p1, p2, m
 }
@@ -0,0 +1,154 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vm
+
+import (
+	"strings"
+	"unicode/utf8"
+	"unsafe"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/swiss"
+	"buf.build/go/hyperpb/internal/tdp"
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+)
+
+// rerunRepaired parses a copy of data in which every string that failed UTF-8
+// validation is repaired, after a parse of data into m failed with err.
+//
+// Returns err if data cannot be repaired.
+func rerunRepaired(m *dynamic.Message, data []byte, options Options, err ParseError) ParseError {
+	repaired, ok := appendRepaired(nil, fieldsOf(m.Type()), data, options.MaxDepth)
+	if !ok {
+		return err
+	}
+
+	m.ResetForParse()
+	options.RepairUTF8 = false
+	options.AllowAlias = true // repaired belongs to m.
+	options.Checksum = false
+	err = run(m, repaired, options)
+	m.Shared.OwnsSrc = true
+	return err
+}
+
+// fieldLookup returns the descriptor of the field with the given number, and
+// its message type, if it has one. Returns a nil descriptor for unknown
+// fields.
+type fieldLookup func(protowire.Number) (protoreflect.FieldDescriptor, *tdp.Type)
+
+// fieldsOf returns a [fieldLookup] for the fields of ty, including extensions.
+func fieldsOf(ty *tdp.Type) fieldLookup {
+	return func(n protowire.Number) (protoreflect.FieldDescriptor, *tdp.Type) {
+		idx := swiss.LookupI32xU32(ty.Numbers, int32(n))
+		if idx == nil {
+			return nil, nil
+		}
+		return ty.FieldDescriptors[*idx], ty.ByIndex(int(*idx)).Message
+	}
+}
+
+// entryFieldsOf returns a [fieldLookup] for the entries of the map field fd,
+// whose values are of type ty, if they are messages.
+func entryFieldsOf(fd protoreflect.FieldDescriptor, ty *tdp.Type) fieldLookup {
+	return func(n protowire.Number) (protoreflect.FieldDescriptor, *tdp.Type) {
+		switch n {
+		case 1:
+			return fd.MapKey(), nil
+		case 2:
+			return fd.MapValue(), ty
+		default:
+			return nil, nil
+		}
+	}
+}
+
+// appendRepaired appends data, an encoded message with the given fields, to
+// out, replacing invalid UTF-8 in string fields which require valid UTF-8
+// with U+FFFD, like [strings.ToValidUTF8] does.
+//
+// Returns false if data is malformed, or nests more than depth messages deep.
+func appendRepaired(out []byte, fields fieldLookup, data []byte, depth int) ([]byte, bool) {
+	if depth < 0 {
+		return nil, false
+	}
+
+	for len(data) > 0 {
+		num, wt, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return nil, false
+		}
+		out = append(out, data[:n]...)
+		data = data[n:]
+
+		n = protowire.ConsumeFieldValue(num, wt, data)
+		if n < 0 {
+			return nil, false
+		}
+		value := data[:n]
+		data = data[n:]
+
+		fd, ty := fields(num)
+		var ok bool
+		switch {
+		case fd == nil:
+			out = append(out, value...)
+			continue
+
+		case wt == protowire.StartGroupType && fd.Kind() == protoreflect.GroupKind && ty != nil:
+			end := len(value) - protowire.SizeTag(num)
+			out, ok = appendRepaired(out, fieldsOf(ty), value[:end], depth-1)
+			if !ok {
+				return nil, false
+			}
+			out = append(out, value[end:]...)
+			continue
+
+		case wt != protowire.BytesType:
+			out = append(out, value...)
+			continue
+		}
+
+		payload, _ := protowire.ConsumeBytes(value)
+		var inner []byte
+		switch {
+		case fd.IsMap():
+			inner, ok = appendRepaired(nil, entryFieldsOf(fd, ty), payload, depth-1)
+		case fd.Kind() == protoreflect.MessageKind && ty != nil:
+			inner, ok = appendRepaired(nil, fieldsOf(ty), payload, depth-1)
+		case RequiresUTF8(fd) && !utf8.Valid(payload):
+			inner, ok = []byte(strings.ToValidUTF8(unsafe.String(unsafe.SliceData(payload), len(payload)), "\uFFFD")), true
+		default:
+			inner, ok = payload, true
+		}
+		if !ok {
+			return nil, false
+		}
+		out = protowire.AppendBytes(out, inner)
+	}
+	return out, true
+}
+
+// RequiresUTF8 returns whether fd is a string field whose values are validated
+// as UTF-8 while parsing.
+func RequiresUTF8(fd protoreflect.FieldDescriptor) bool {
+	if fd.Kind() != protoreflect.StringKind {
+		return false
+	}
+	fd2, ok := fd.(interface{ EnforceUTF8() bool })
+	return fd.Syntax() == protoreflect.Proto3 || (ok && fd2.EnforceUTF8())
+}
@@ -15,6 +15,7 @@
 package vm
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"math/bits"
@@ -41,25 +42,146 @@ type Options struct {
 	// Maximum recursion depth.
 	MaxDepth int
 
+	// Number of frames the parser stack starts out with; it is grown on
+	// demand up to MaxDepth. Zero means MaxDepth.
+	StackDepth int
+
+	// Maximum input size, in bytes. This is additionally capped at
+	// [zc.MaxLen].
+	MaxSize int
+
 	// If set, unknown fields are discarded.
 	DiscardUnknown bool
 
+	// If positive, the maximum number of unknown fields, and bytes thereof,
+	// retained for each message. Unknown fields beyond these limits fail the
+	// parse with [ErrorUnknownLimit], or are dropped if TruncateUnknown is set.
+	MaxUnknownFields, MaxUnknownBytes int
+	TruncateUnknown                   bool
+
+	// If set, called for each unknown field with its number, wire type and
+	// encoding, including its tag, to decide whether it is retained.
+	UnknownFilter func(protowire.Number, protowire.Type, []byte) UnknownAction
+
+	// How to handle records with the reserved wire types 6 and 7, and the
+	// number of bytes to skip after their tags with [ReservedSkip].
+	ReservedWireTypes ReservedMode
+	ReservedSkip      int
+
+	// If set, called with the number, wire type and offset of the tag of each
+	// record with a reserved wire type, regardless of ReservedWireTypes.
+	OnReserved func(protowire.Number, protowire.Type, int)
+
+	// If set, called with the offset, operation and description of each
+	// event that [P1.Log] would log in a debug build.
+	Logger func(offset int, op, msg string)
+
+	// If set, reaching the end of the input inside of a group fails with
+	// [ErrorTruncated] rather than [ErrorEndGroup].
+	TruncatedGroups bool
+
 	// If set, all string fields behave as if they are defined in proto2.
 	AllowInvalidUTF8 bool
 
+	// If set, a parse that fails due to invalid UTF-8 is retried on a copy
+	// of the input in which the invalid strings have been repaired.
+	RepairUTF8 bool
+
 	// If set, the input data will not be copied before the parse begins.
 	AllowAlias bool
 
+	// If set and the input data is not copied, a checksum of it is recorded
+	// so that later modifications can be detected.
+	Checksum bool
+
+	// If set, a fingerprint of the input, excluding unknown fields, is
+	// computed while parsing and recorded in the message's [dynamic.Shared].
+	Fingerprint bool
+
+	// If set, repeated fields that are spilled from zero-copy storage to the
+	// arena are recorded in the message's [dynamic.Shared].
+	RecordSpills bool
+
+	// Transformations to apply to float and double fields.
+	Floats FloatMode
+
+	// Behaviors for map entries that are not minimally encoded.
+	MapEntries MapEntryMode
+
+	// If set, fields with a [tdp.Transform] are stored without applying it.
+	SkipTransforms bool
+
+	// If nonzero, the time, in nanoseconds since the Unix epoch, after which
+	// the parse is aborted with [ErrorDeadline].
+	Deadline int64
+
+	// If set, called periodically with the number of bytes parsed so far,
+	// roughly every ProgressInterval bytes, and once more when parsing
+	// succeeds.
+	Progress         func(offset, total int)
+	ProgressInterval int
+
 	// Profiler fields.
 	Recorder    *profile.Recorder
 	ProfileRate float64
+
+	// If nonzero, elements of repeated message fields whose encoding is at
+	// most this many bytes, and identical to that of an earlier element of
+	// the same type, share a single message.
+	DedupMessages int
+
+	// If set, the fields of each successfully parsed input are tallied here,
+	// as they are parsed.
+	WireStats *WireStats
+
+	// If set, called after parsing with the result of the parse. This is not
+	// called by [Run]; it is the responsibility of its caller.
+	Verify func(m *dynamic.Message, data []byte, err error, options *Options)
+
+	// If set, parses are run with the profiler labels of this context, plus
+	// one naming the type being parsed. Like Verify, this is not handled by
+	// [Run]; it is the responsibility of its caller.
+	Labels context.Context
+
+	// If set, parses of messages which contain enum values that are not
+	// declared by the enum fail. This is not handled by [Run]; it is the
+	// responsibility of its caller.
+	RejectUnknownEnums bool
 }
 
+// UnknownAction is the result of [Options].UnknownFilter.
+type UnknownAction int8
+
+const (
+	// Retain the field, subject to the other options for unknown fields.
+	UnknownKeep UnknownAction = iota
+	// Discard the field.
+	UnknownDrop
+	// Fail the parse with [ErrorUnknownField].
+	UnknownFail
+)
+
+// ReservedMode is a way of handling records with reserved wire types.
+type ReservedMode int8
+
+const (
+	// Fail the parse with [ErrorReserved].
+	ReservedFail ReservedMode = iota
+	// Skip [Options].ReservedSkip bytes after the tag, treating the record as
+	// an unknown field.
+	ReservedSkipBytes
+	// Discard the rest of the message containing the record. Inside of a
+	// group, this fails the parse instead, since groups are not
+	// length-prefixed.
+	ReservedAbortMessage
+)
+
 // NewOptions returns the default settings for [Options].
 func NewOptions() Options {
 	return Options{
 		MaxMisses: 4,
 		MaxDepth:  1000,
+		MaxSize:   zc.MaxLen,
 	}
 }
 
@@ -68,56 +190,81 @@ func NewOptions() Options {
 type Thunk func(P1, P2) (P1, P2)
 
 // Run is the top-level entry point for message parsing.
-func Run(m *dynamic.Message, data []byte, options Options) (err error) {
+func Run(m *dynamic.Message, data []byte, options Options) error {
+	perr := RunValue(m, data, options)
+	if perr.code == ErrorOk {
+		return nil
+	}
+	// Only allocate once we know that parsing failed.
+	err := new(ParseError)
+	*err = perr
+	return err
+}
+
+// RunValue is like [Run], but returns the error by value, with a code of
+// [ErrorOk] on success. Unlike Run, it does not allocate if parsing fails.
+func RunValue(m *dynamic.Message, data []byte, options Options) ParseError {
+	perr := run(m, data, options)
+	if perr.code == ErrorUTF8 && options.RepairUTF8 && !options.AllowInvalidUTF8 {
+		return rerunRepaired(m, data, options, perr)
+	}
+	return perr
+}
+
+// run parses data into m.
+func run(m *dynamic.Message, data []byte, options Options) (perr ParseError) {
 	if m.Shared.Src != nil {
 		panic("hyperpb: attempted to parse message using in-use Context")
 	}
 
-	if len(data) > math.MaxUint32 {
-		return &ParseError{code: ErrorTooBig}
+	if uint(len(data)) > min(uint(options.MaxSize), zc.MaxLen) {
+		return ParseError{code: ErrorTooBig}
 	}
 
 	if len(data) == 0 {
-		return nil
+		return ParseError{}
 	}
 
 	m.Shared.Lock.Lock()
 
 	p3 := p3Pool.Get()
 	p3.Options = options
-
-	data = RelocatePageBoundary(data, !p3.AllowAlias)
+	p3.validUTF8 = m.Type().Library.ValidUTF8
+	p3.nextProgress = p3.ProgressInterval
+	p3.resetSteps()
+	clear(p3.dedup)
+	p3.wire.reset()
+
+	aliased := RelocatePageBoundary(data, !p3.AllowAlias)
+	m.Shared.OwnsSrc = unsafe.SliceData(aliased) != unsafe.SliceData(data)
+	if p3.Checksum && !m.Shared.OwnsSrc {
+		m.Shared.RecordChecksum(data)
+	}
+	data = aliased
 	m.Shared.Src = unsafe.SliceData(data)
 	m.Shared.Len = len(data)
+	m.Shared.Root = m
+	m.Shared.Deduped = options.DedupMessages > 0
 	// The arena keeps m.context alive, so we don't need to KeepAlive src.
 
-	stack := stackPool.Get()
-	if cap(*stack) < p3.MaxDepth {
-		*stack = make([]frame, p3.MaxDepth)
+	if p3.Fingerprint {
+		p3.fingerprint.Reset()
+		p3.fingerprinted = xunsafe.AddrOf(m.Shared.Src)
 	}
 
-	p3.stack.top = xunsafe.AddrOf(unsafe.SliceData(*stack))
-	p3.stack.bottom = p3.stack.top.Add(p3.MaxDepth)
-
-	p3.stack.ptr = p3.stack.bottom
+	stack := stackPool.Get()
+	p3.initStack(stack)
 
 	defer func() {
 		if p3.err.code != 0 && recover() != nil {
 			// Make a copy of the error, since pp will get re-used by a future
 			// run of this function.
-			parseErr := p3.err
-			err = &parseErr
+			perr = p3.err
+			// Don't let the pool keep a transform error alive.
+			p3.err = ParseError{}
 
 			if debug.Enabled {
-				buf := new(strings.Builder)
-				for _, frame := range p3.stackSlice() {
-					fmt.Fprintf(buf, "- %#v\n", frame)
-				}
-
-				debug.Log(nil, "fail",
-					"%v\n"+
-						"trace to fail() call:\n%s"+
-						"stack:\n%s", err, debug.Stack(6), buf)
+				p3.logFailure(perr)
 			}
 		}
 
@@ -137,33 +284,214 @@ func Run(m *dynamic.Message, data []byte, options Options) (err error) {
 		scratch: uint64(m.Shared.Len),
 	}
 
-	if debug.Enabled {
+	if debug.Enabled || p3.Logger != nil {
 		p1.Log(p2, "start", "%p:%d `%x`, %p:%v",
 			m.Shared.Src, m.Shared.Len, data, m.Type(), m.Type().Descriptor.FullName())
 	}
 
 	p1, p2 = p1.PushMessage(p2, m)
 	p1, p2 = p1.SetScratch(p2, 0)
-	loop(p1, p2)
+	runLoop(p1, p2)
+
+	if p3.Fingerprint {
+		p3.fingerprintUpTo(xunsafe.AddrOf(m.Shared.Src).Add(m.Shared.Len))
+		m.Shared.RecordFingerprint(p3.fingerprint.Sum64())
+	}
+
+	if options.Progress != nil {
+		options.Progress(m.Shared.Len, m.Shared.Len)
+	}
 
 	if rand.Float64() < options.ProfileRate && options.Recorder != nil {
 		p1.Log(p2, "profiling...", "%p", m)
 		options.Recorder.Record(m)
 	}
 
-	return nil
+	p3.mergeWireStats()
+
+	return ParseError{}
+}
+
+// RunBatch parses each element of data into the corresponding element of ms,
+// setting up the parser once for all of them. The messages must all belong to
+// the same [dynamic.Shared].
+//
+// The inputs are copied into a single buffer, which becomes the source of the
+// messages' Shared; unlike with [Run], no message becomes its root. Parsing
+// stops at the first input that fails to parse, whose index is returned along
+// with the error. The error's offsets are relative to that input. On success,
+// the returned index is len(ms).
+func RunBatch(ms []*dynamic.Message, data [][]byte, options Options) (failed int, perr ParseError) {
+	if len(ms) == 0 {
+		return 0, ParseError{}
+	}
+	shared := ms[0].Shared
+	if shared.Src != nil {
+		panic("hyperpb: attempted to parse message using in-use Context")
+	}
+
+	// Inputs up to the first one that is too big are parsed before failing.
+	var tooBig ParseError
+	total, n := 0, len(data)
+	for i, b := range data {
+		if uint(len(b)) > uint(options.MaxSize) || uint(total+len(b)) > zc.MaxLen {
+			tooBig.code = ErrorTooBig
+			n = i
+			break
+		}
+		total += len(b)
+	}
+	ms, data = ms[:n], data[:n]
+	if total == 0 {
+		return n, tooBig
+	}
+
+	// Leave room past the end for the parser's over-long loads; see
+	// [RelocatePageBoundary].
+	src := make([]byte, 0, total+9)
+	for _, b := range data {
+		src = append(src, b...)
+	}
+
+	shared.Lock.Lock()
+
+	p3 := p3Pool.Get()
+	p3.Options = options
+	p3.validUTF8 = ms[0].Type().Library.ValidUTF8
+	p3.Fingerprint = false // Recorded per Shared, so meaningless for a batch.
+	p3.nextProgress = p3.ProgressInterval
+	p3.resetSteps()
+	p3.wire.reset()
+
+	shared.Src = unsafe.SliceData(src)
+	shared.Len = len(src)
+	shared.OwnsSrc = true
+	shared.Deduped = options.DedupMessages > 0
+
+	stack := stackPool.Get()
+	start := 0
+
+	defer func() {
+		if p3.err.code != 0 && recover() != nil {
+			perr = p3.err
+			p3.err = ParseError{}
+			perr.rebase(start)
+
+			if debug.Enabled {
+				p3.logFailure(perr)
+			}
+		}
+
+		stackPool.Put(stack)
+		p3Pool.Put(p3)
+		shared.Lock.Unlock()
+	}()
+
+	for i, m := range ms {
+		failed = i
+		n := len(data[i])
+		if n == 0 {
+			continue
+		}
+
+		clear(p3.dedup)
+		p3.initStack(stack)
+
+		p1 := P1{
+			shared:  xunsafe.AddrOf(shared),
+			PtrAddr: xunsafe.AddrOf(shared.Src).Add(start),
+		}
+		p2 := P2{
+			p3Addr:  xunsafe.AddrOf(p3),
+			scratch: uint64(n),
+		}
+
+		if debug.Enabled || p3.Logger != nil {
+			p1.Log(p2, "start batch", "%d/%d, %p:%v", i, len(ms), m.Type(), m.Type().Descriptor.FullName())
+		}
+
+		p1, p2 = p1.PushMessage(p2, m)
+		p1, p2 = p1.SetScratch(p2, 0)
+		runLoop(p1, p2)
+
+		if rand.Float64() < options.ProfileRate && options.Recorder != nil {
+			options.Recorder.Record(m)
+		}
+
+		p3.mergeWireStats()
+
+		start += n
+	}
+
+	if options.Progress != nil {
+		options.Progress(shared.Len, shared.Len)
+	}
+
+	return n, tooBig
+}
+
+// logFailure logs a parse failure, along with the state of the parser stack.
+func (p3 *p3) logFailure(perr ParseError) {
+	buf := new(strings.Builder)
+	for _, frame := range p3.stackSlice() {
+		fmt.Fprintf(buf, "- %#v\n", frame)
+	}
+
+	debug.Log(nil, "fail",
+		"%v\n"+
+			"trace to fail() call:\n%s"+
+			"stack:\n%s", perr.Error(), debug.Stack(7), buf)
+}
+
+// runLoop runs the instantiation of [loop] that the options being parsed with
+// call for.
+func runLoop(p1 P1, p2 P2) {
+	if p2.p3().steps != math.MaxInt || p2.p3().WireStats != nil {
+		loop[withCheckpoints](p1, p2)
+		return
+	}
+	loop[noCheckpoints](p1, p2)
+}
+
+// loopMode selects an instantiation of [loop].
+type loopMode interface {
+	noCheckpoints | withCheckpoints
+}
+
+// noCheckpoints and withCheckpoints select whether [loop] counts the fields it
+// parses and calls [checkpoint] periodically, which is only necessary for
+// [Options].Deadline and [Options].Progress, and whether it tallies them for
+// [Options].WireStats.
+//
+// These types have different sizes, so the compiler generates separate code
+// for each instantiation of loop, in which checkpoints is a constant. This way,
+// parses without a deadline, progress callback or wire stats pay nothing for
+// them.
+type (
+	noCheckpoints   struct{}
+	withCheckpoints struct{ _ byte }
+)
+
+// checkpoints returns whether loop[M] calls checkpoint.
+func checkpoints[M loopMode]() bool {
+	var m M
+	return unsafe.Sizeof(m) != 0
 }
 
 // loop is the core parser loop. This function is not recursive.
-func loop(p1 P1, p2 P2) {
+func loop[M loopMode](p1 P1, p2 P2) {
 	// Need this to match the ABI of returning from a thunk.
 	p2.fieldAddr = p2.Field().NextOk
 
 checkDone:
 	if p1.Len() == 0 {
 		if p1.endGroup != notAGroup {
-			// If we run out of buffer while we're still
-			p1.Fail(p2, ErrorEndGroup)
+			// We ran out of buffer while we're still inside of a group.
+			code := ErrorEndGroup
+			if p2.p3().TruncatedGroups {
+				code = ErrorTruncated
+			}
+			p1.FailGroup(p2, code, 0, 0, 0)
 		}
 		goto pop
 	}
@@ -216,11 +544,15 @@ number:
 				p2.fieldAddr = xunsafe.AddrOf(t.Fields().Get(int(offset)))
 				goto parseField
 			}
+			if t.Dispatch == tdp.DispatchLUT {
+				// No need to search: this tag is definitely not one of ours.
+				goto missedField
+			}
 			goto field
 		}
 
 		// Load up to eight bytes for the varint (at most 5 will be used).
-		p1, p2 = p1.SetScratch(p2, xunsafe.ByteLoad[uint64](p1.Ptr(), 0))
+		p1, p2 = p1.SetScratch(p2, loadWord(p1))
 		p1.Log(p2, "raw number", "%#x", p2.Scratch())
 
 		// Flip all of the sign bits. This essentially clears the sign bits
@@ -259,6 +591,9 @@ number:
 field:
 	{
 		tries := p2.p3().MaxMisses
+		if d := p2.Type().Dispatch; d == tdp.DispatchHash || d == tdp.DispatchJump {
+			tries = 1
+		}
 		tag := tdp.Tag(p2.Scratch())
 
 		for {
@@ -295,12 +630,23 @@ parseField:
 		thunk := (*xunsafe.PC[Thunk])(&p2.Field().Parse).Get()
 		p1.Log(p2, "call", "%v, %#x", debug.Func(thunk), p2.fieldAddr)
 
+		if checkpoints[M]() && p2.p3().WireStats != nil {
+			p1, p2 = tallyField(p1, p2)
+		}
+
 		// NOTE: Thunks are allowed to rely on p2.Scratch() still containing
 		// the full field tag!
 		p1, p2 = thunk(p1, p2)
 
 		p1.Log(p2, "ret", "%v, %#x", debug.Func(thunk), p2.fieldAddr)
 
+		if checkpoints[M]() {
+			p2.p3().steps--
+			if p2.p3().steps <= 0 {
+				p1, p2 = checkpoint(p1, p2)
+			}
+		}
+
 		p2.fieldAddr = p2.Field().NextOk
 
 		p1, p2 = p1.SetScratch(p2, 0) // Make sure no one relies on this being preserved.
@@ -359,6 +705,12 @@ missedField:
 		// number we recognize.
 		for {
 			p1, p2 = handleUnknown(p1, p2, tag2)
+			if checkpoints[M]() {
+				p2.p3().steps--
+				if p2.p3().steps <= 0 {
+					p1, p2 = checkpoint(p1, p2)
+				}
+			}
 			if p1.Len() == 0 {
 				goto pop
 			}
@@ -391,6 +743,10 @@ missedField:
 
 pop:
 	{
+		if checkpoints[M]() && p1.endGroup != notAGroup && p2.p3().WireStats != nil {
+			p1, p2 = tallyGroupEnd(p1, p2)
+		}
+
 		var done bool
 		p1, p2, done = p1.pop(p2)
 		if done {
@@ -415,24 +771,57 @@ func handleUnknown(p1 P1, p2 P2, tag uint64) (P1, P2) {
 	}
 
 	// Rewind the stream to find the start offset of this field. We can do this
-	// because we know that tag2 is nonzero, so first we can trim off leading
-	// zero bytes for an over-long varint, and then skip back the minimum
-	// number of bytes needed to store tag2.
-	start := p1.PtrAddr
-	start--
-	for *start.AssertValid()&0x7f == 0 {
-		start--
-	}
-	start = start.Add(1 - protowire.SizeVarint(tag))
+	// because we know that tag is nonzero.
+	start := rewindVarint(p1.PtrAddr, tag)
 
 	p1, p2 = p1.SetScratch(p2, tag)
-	p1, p2 = skipRecord(p1, p2, p2.p3().MaxDepth)
+	p1, p2 = skipRecord(p1, p2, p2.p3().MaxDepth, 0, 0)
 	n := int(p1.PtrAddr - start)
 	p1.Log(p2, "unknown", "%d bytes", n)
 
+	p3 := p2.p3()
+	action := UnknownKeep
+	if p3.aborted {
+		// The rest of the message was discarded by skipReserved.
+		p3.aborted = false
+		action = UnknownDrop
+	} else if p3.UnknownFilter != nil {
+		action = p3.UnknownFilter(
+			protowire.Number(tag>>3), protowire.Type(tag&7),
+			unsafe.Slice(start.AssertValid(), n),
+		)
+		if action == UnknownFail {
+			// Report the error at the start of the field, not its end.
+			p1.PtrAddr = start
+			p1.Fail(p2, ErrorUnknownField)
+		}
+	}
+
+	if p3.Fingerprint {
+		// Leave this field out of the fingerprint.
+		p3.fingerprintUpTo(start)
+		p3.fingerprinted = p1.PtrAddr
+	}
+
+	if p3.WireStats != nil {
+		p3.tally(p2.Message(), p2.Type(), protowire.Number(tag>>3), n, true)
+	}
+
+	if action == UnknownDrop {
+		return p1, p2
+	}
+	return appendUnknown(p1, p2, start, n)
+}
+
+// appendUnknown records the n bytes at start as an unknown field of the
+// current message, unless unknown fields are being discarded.
+func appendUnknown(p1 P1, p2 P2, start xunsafe.Addr[byte], n int) (P1, P2) {
 	if !p2.p3().DiscardUnknown && !p2.Type().DiscardUnknown {
 		r := zc.New(p1.Src(), start.AssertValid(), n)
 		cold := p2.Message().MutableCold()
+		if !withinUnknownLimits(p1, p2, cold, n) {
+			return p1, p2
+		}
 		if cold.Unknown.Len() > 0 {
 			last := xunsafe.Add(cold.Unknown.Ptr(), cold.Unknown.Len()-1)
 			if r.Start() == last.End() {
@@ -446,7 +835,35 @@ func handleUnknown(p1 P1, p2 P2, tag uint64) (P1, P2) {
 	return p1, p2
 }
 
-func skipRecord(p1 P1, p2 P2, depth int) (P1, P2) {
+// withinUnknownLimits counts an unknown field of n bytes against the limits set
+// by MaxUnknownFields and MaxUnknownBytes, and returns whether it should be
+// retained. Fails the parse if the limits are exceeded, unless TruncateUnknown
+// is set.
+func withinUnknownLimits(p1 P1, p2 P2, cold *dynamic.Cold, n int) bool {
+	p3 := p2.p3()
+	if p3.MaxUnknownFields <= 0 && p3.MaxUnknownBytes <= 0 {
+		return true
+	}
+
+	fields := int(cold.UnknownFields) + 1
+	bytes := int(cold.UnknownBytes) + n
+	if (p3.MaxUnknownFields > 0 && fields > p3.MaxUnknownFields) ||
+		(p3.MaxUnknownBytes > 0 && bytes > p3.MaxUnknownBytes) {
+		if !p3.TruncateUnknown {
+			p1.Fail(p2, ErrorUnknownLimit)
+		}
+		return false
+	}
+
+	cold.UnknownFields = uint32(fields)
+	cold.UnknownBytes = uint32(bytes)
+	return true
+}
+
+// skipRecord skips the record whose tag is in p2.Scratch(), which has already
+// been consumed. If this record is part of an unknown group, group is the
+// number of that group, and start is the start of its start tag.
+func skipRecord(p1 P1, p2 P2, depth int, group protowire.Number, start xunsafe.Addr[byte]) (P1, P2) {
 	tag := p2.Scratch()
 	num := protowire.Number(tag >> 3)
 	ty := protowire.Type(tag & 0b111)
@@ -471,8 +888,13 @@ func skipRecord(p1 P1, p2 P2, depth int) (P1, P2) {
 			p1.Fail(p2, ErrorRecursionDepth)
 		}
 
+		groupStart := rewindVarint(p1.PtrAddr, tag)
 		end := protowire.EncodeTag(num, protowire.EndGroupType)
 		for {
+			if p1.Len() == 0 {
+				p1.FailGroup(p2, ErrorTruncated, num, 0, groupStart)
+			}
+
 			var raw uint64
 			p1, p2, raw = p1.Varint(p2)
 
@@ -481,18 +903,52 @@ func skipRecord(p1 P1, p2 P2, depth int) (P1, P2) {
 			}
 
 			p1, p2 = p1.SetScratch(p2, raw)
-			p1, p2 = skipRecord(p1, p2, depth-1)
+			p1, p2 = skipRecord(p1, p2, depth-1, num, groupStart)
 		}
 
 	case protowire.EndGroupType:
-		p1.Fail(p2, ErrorEndGroup)
+		p1.FailGroup(p2, ErrorEndGroup, group, num, start)
 	default:
-		p1.Fail(p2, ErrorReserved)
+		p1, p2 = skipReserved(p1, p2, tag, group)
 	}
 
 	return p1, p2
 }
 
+// skipReserved skips a record with a reserved wire type, whose tag is in
+// p2.Scratch() and has already been consumed, according to
+// [Options].ReservedWireTypes. group is as in [skipRecord].
+//
+//go:noinline
+func skipReserved(p1 P1, p2 P2, tag uint64, group protowire.Number) (P1, P2) {
+	p3 := p2.p3()
+	if p3.OnReserved != nil {
+		offset := rewindVarint(p1.PtrAddr, tag).Sub(xunsafe.AddrOf(p1.Src()))
+		p3.OnReserved(protowire.Number(tag>>3), protowire.Type(tag&0b111), offset)
+	}
+
+	switch p3.ReservedWireTypes {
+	case ReservedSkipBytes:
+		if p1.Len() < p3.ReservedSkip {
+			p1.Fail(p2, ErrorTruncated)
+		}
+		p1.Log(p2, "skip reserved", "%d bytes", p3.ReservedSkip)
+		return p1.Advance(p3.ReservedSkip), p2
+
+	case ReservedAbortMessage:
+		if group != 0 || p1.endGroup != notAGroup {
+			break
+		}
+		p1.Log(p2, "abort reserved", "%d bytes", p1.Len())
+		p1.PtrAddr = p1.EndAddr
+		p3.aborted = true
+		return p1, p2
+	}
+
+	p1.Fail(p2, ErrorReserved)
+	return p1, p2
+}
+
 // checkLargeVarint is part of the varint decoder in [loop]. Outlined because
 // this function is almost never called, improving code locality.
 //
@@ -502,6 +958,9 @@ func checkLargeVarint(p1 P1, p2 P2) (P1, P2) {
 
 	// This is a very large varint. We need to check the next two words.
 	// This is a slow path, so we can afford to not be efficient.
+	if Safe && p1.Len() == 0 {
+		p1.Fail(p2, ErrorTruncated)
+	}
 	switch xunsafe.Load(p1.Ptr(), -1) {
 	case 0x00:
 	case 0x80:
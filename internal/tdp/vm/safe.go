@@ -0,0 +1,26 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build hyperpb.safe
+
+package vm
+
+// Safe is set when hyperpb is built with the hyperpb.safe build tag.
+//
+// In safe mode, the parser never reads memory it did not allocate: inputs are
+// always copied into a padded buffer rather than probing for a page boundary,
+// and loads that would otherwise read past the end of the input are bounds
+// checked. This is slower, but is suitable for sandboxed environments that
+// forbid or penalize such reads.
+const Safe = true
@@ -0,0 +1,86 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vm
+
+import (
+	"sync/atomic"
+	"unsafe"
+
+	"buf.build/go/hyperpb/internal/xunsafe"
+	"buf.build/go/hyperpb/internal/xunsafe/layout"
+)
+
+// stackStats counts stack allocations, which are rare enough that recording
+// them does not contend with parsing.
+var stackStats struct {
+	allocs, grows, bytes atomic.Int64
+}
+
+// StackStats returns the number of parser stacks allocated by [Run], the
+// number of times a stack was grown because a message nested deeper than
+// its initial depth, and the total number of bytes allocated for stacks.
+func StackStats() (allocs, grows, bytes int64) {
+	return stackStats.allocs.Load(), stackStats.grows.Load(), stackStats.bytes.Load()
+}
+
+// initStack prepares a stack from [stackPool] for use by a parse.
+//
+// The stack starts out with room for StackDepth frames, if set, and is grown
+// on demand up to MaxDepth frames by [growStack].
+func (p3 *p3) initStack(stack *[]frame) {
+	depth := p3.MaxDepth
+	if p3.StackDepth > 0 {
+		depth = min(depth, p3.StackDepth)
+	}
+	if cap(*stack) < depth {
+		*stack = make([]frame, depth)
+		stackStats.allocs.Add(1)
+		stackStats.bytes.Add(int64(depth * layout.Size[frame]()))
+	}
+	// A pooled stack may have been grown by a previous parse; use all of it.
+	depth = min(cap(*stack), p3.MaxDepth)
+
+	p3.stack.buf = stack
+	p3.stack.top = xunsafe.AddrOf(unsafe.SliceData(*stack))
+	p3.stack.bottom = p3.stack.top.Add(depth)
+	p3.stack.ptr = p3.stack.bottom
+}
+
+// growStack is called by push() when the stack is out of frames. It doubles
+// the size of the stack, up to MaxDepth, and fails if the stack is already
+// that deep.
+//
+//go:noinline
+func growStack(p1 P1, p2 P2) (P1, P2) {
+	p3 := p2.p3()
+	n := p3.stack.bottom.Sub(p3.stack.top)
+	if n >= p3.MaxDepth {
+		p1.Fail(p2, ErrorRecursionDepth)
+	}
+
+	// The stack grows downwards, so the frames in use, which is all of them,
+	// go at the end of the new stack.
+	m := min(max(2*n, 1), p3.MaxDepth)
+	stack := make([]frame, m)
+	copy(stack[m-n:], (*p3.stack.buf)[:n])
+	*p3.stack.buf = stack
+	stackStats.grows.Add(1)
+	stackStats.bytes.Add(int64(m * layout.Size[frame]()))
+
+	p3.stack.top = xunsafe.AddrOf(unsafe.SliceData(stack))
+	p3.stack.bottom = p3.stack.top.Add(m)
+	p3.stack.ptr = p3.stack.bottom.Add(-n)
+	return p1, p2
+}
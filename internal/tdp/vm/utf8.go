@@ -190,3 +190,101 @@ fail:
 	p1.Fail(p2, ErrorUTF8)
 	return p1, p2, 0
 }
+
+// validUTF8Secret returns whether b is valid UTF-8, like [utf8.Valid], but
+// without any branches that depend on the contents of b.
+//
+// Rather than skipping over ASCII, every byte is fed through a DFA, so the
+// time taken depends only on len(b). The DFA's tables are small enough to fit
+// in a handful of cache lines.
+func validUTF8Secret(b []byte) bool {
+	var state uint8
+	for _, c := range b {
+		state = utf8Next[state|utf8Class[c]]
+	}
+	return state == 0
+}
+
+// utf8Class and utf8Next are the tables for the DFA used by
+// [validUTF8Secret]. utf8Class maps each byte to its class, and utf8Next maps
+// a state plus a class to the next state. States are multiples of 16, so that
+// they can be combined with a class with a bitwise or.
+//
+// The states are:
+//
+//	0x00: accept.
+//	0x10, 0x20, 0x30: expecting one, two, or three continuation bytes.
+//	0x40: after E0, expecting A0..BF, which rules out overlong encodings.
+//	0x50: after ED, expecting 80..9F, which rules out surrogates.
+//	0x60: after F0, expecting 90..BF, which rules out overlong encodings.
+//	0x70: after F4, expecting 80..8F, which rules out runes past U+10FFFF.
+//	0x80: reject. This state is never left.
+var utf8Class, utf8Next = func() (class [256]uint8, next [0x90]uint8) {
+	const (
+		ascii  = iota
+		cont8  // 80..8F
+		cont9  // 90..9F
+		contA  // A0..BF
+		bad    // C0, C1, F5..FF
+		lead2  // C2..DF
+		leadE0 // E0
+		lead3  // E1..EC, EE, EF
+		leadED // ED
+		leadF0 // F0
+		lead4  // F1..F3
+		leadF4 // F4
+	)
+	for c := range class {
+		switch {
+		case c < 0x80:
+			class[c] = ascii
+		case c < 0x90:
+			class[c] = cont8
+		case c < 0xa0:
+			class[c] = cont9
+		case c < 0xc0:
+			class[c] = contA
+		case c < 0xc2 || c > 0xf4:
+			class[c] = bad
+		case c < 0xe0:
+			class[c] = lead2
+		case c == 0xe0:
+			class[c] = leadE0
+		case c == 0xed:
+			class[c] = leadED
+		case c < 0xf0:
+			class[c] = lead3
+		case c == 0xf0:
+			class[c] = leadF0
+		case c < 0xf4:
+			class[c] = lead4
+		default:
+			class[c] = leadF4
+		}
+	}
+
+	const reject = 0x80
+	for i := range next {
+		next[i] = reject
+	}
+	next[0x00|ascii] = 0x00
+	next[0x00|lead2] = 0x10
+	next[0x00|leadE0] = 0x40
+	next[0x00|lead3] = 0x20
+	next[0x00|leadED] = 0x50
+	next[0x00|leadF0] = 0x60
+	next[0x00|lead4] = 0x30
+	next[0x00|leadF4] = 0x70
+	for _, c := range []uint8{cont8, cont9, contA} {
+		next[0x10|c] = 0x00
+		next[0x20|c] = 0x10
+		next[0x30|c] = 0x20
+	}
+	next[0x40|contA] = 0x10
+	next[0x50|cont8] = 0x10
+	next[0x50|cont9] = 0x10
+	next[0x60|cont9] = 0x20
+	next[0x60|contA] = 0x20
+	next[0x70|cont8] = 0x20
+	return class, next
+}()
@@ -152,6 +152,11 @@ func parseVarint(p1 P1, p2 P2) (P1, P2, uint64) {
 	x -= 0x80 << (i * 7)
 	i++
 
+	// Outside of safe mode, the tenth byte does not need a bounds check,
+	// because RelocatePageBoundary guarantees that it can be loaded.
+	if Safe && p1.PtrAddr == p1.EndAddr {
+		goto fail
+	}
 	b = *p1.PtrAddr.AssertValid()
 	p1.PtrAddr++
 	x |= uint64(b) << (i * 7)
@@ -26,8 +26,12 @@
 package vm
 
 import (
+	"fmt"
+	"unicode/utf8"
 	"unsafe"
 
+	"google.golang.org/protobuf/encoding/protowire"
+
 	"buf.build/go/hyperpb/internal/arena"
 	"buf.build/go/hyperpb/internal/debug"
 	"buf.build/go/hyperpb/internal/swiss"
@@ -35,6 +39,7 @@ import (
 	"buf.build/go/hyperpb/internal/tdp/dynamic"
 	"buf.build/go/hyperpb/internal/xsync"
 	"buf.build/go/hyperpb/internal/xunsafe"
+	"buf.build/go/hyperpb/internal/xxhash"
 	"buf.build/go/hyperpb/internal/zc"
 )
 
@@ -108,10 +113,47 @@ type p3 struct {
 	stack struct {
 		ptr         xunsafe.Addr[frame]
 		top, bottom xunsafe.Addr[frame]
+
+		// The pooled stack that top points into, which is replaced when the
+		// stack is grown. See initStack.
+		buf *[]frame
 	}
 
 	t_ xunsafe.Addr[tdp.TypeParser]
 	Options
+
+	// Replaces the built-in UTF-8 validation, if set. Copied from the
+	// [tdp.Library] of the message being parsed.
+	validUTF8 func([]byte) bool
+
+	// Number of fields left to parse before the next call to checkpoint.
+	steps int
+	// The offset at which to next call Progress.
+	nextProgress int
+
+	// Messages recorded for deduplication. See [RecordDuplicate].
+	dedup map[uint64]dedupEntry
+
+	// Fields tallied for [Options].WireStats since the last merge.
+	wire wireTallies
+
+	// The fingerprint of the input, up to fingerprinted. Only maintained if
+	// the Fingerprint option is set.
+	fingerprint   xxhash.Digest
+	fingerprinted xunsafe.Addr[byte]
+
+	// Set by skipReserved when it discards the rest of the current message.
+	// See [ReservedAbortMessage].
+	aborted bool
+}
+
+// fingerprintUpTo adds the input from where the fingerprint left off to end to
+// the fingerprint.
+func (p3 *p3) fingerprintUpTo(end xunsafe.Addr[byte]) {
+	if end > p3.fingerprinted {
+		p3.fingerprint.Write(unsafe.Slice(p3.fingerprinted.AssertValid(), end-p3.fingerprinted))
+	}
+	p3.fingerprinted = end
 }
 
 // frame is a recursion frame for the parser.
@@ -121,6 +163,10 @@ type frame struct {
 	message xunsafe.Addr[dynamic.Message]
 	ty      xunsafe.Addr[tdp.TypeParser]
 	field   xunsafe.Addr[tdp.FieldParser]
+
+	// If this frame was pushed by [P1.PushGroup], the end of the group's
+	// start tag.
+	group xunsafe.Addr[byte]
 }
 
 func (p1 P1) Shared() *dynamic.Shared {
@@ -173,8 +219,16 @@ func (p2 P2) Scratch() uint64 {
 	return p2.scratch
 }
 
+// SkipTransforms returns whether field transforms are disabled for this parse.
+func (p2 P2) SkipTransforms() bool {
+	return p2.p3().SkipTransforms
+}
+
 func (p1 P1) SetScratch(p2 P2, v uint64) (P1, P2) {
-	p1.Log(p2, "scratch", "%d:%#x", v, v)
+	if debug.Enabled {
+		// Too noisy for [Options.Logger], and keeps this function inlinable.
+		p1.Log(p2, "scratch", "%d:%#x", v, v)
+	}
 	p2.scratch = v
 	return p1, p2
 }
@@ -185,23 +239,110 @@ func (p1 P1) Len() int {
 
 // Fail causes a parse failure by panicking with the given error code.
 func (p1 P1) Fail(p2 P2, err ErrorCode) {
+	// Assign each field separately: copying a whole ParseError is done with
+	// a bulk write barrier, which needs too much stack for nosplit thunks.
+	e := &p2.p3().err
+	e.code = err
+	e.offset = p1.PtrAddr.Sub(xunsafe.AddrOf(p1.Src()))
+	e.cause = nil
+	e.group = groupInfo{}
+	e.limit = sizeLimitInfo{}
+
+	_ = *(*byte)(nil) // Trigger a panic without calling runtime.gopanic. Linters hate this!
+	for {             //nolint:staticcheck // This code is unreachable.
+	}
+}
+
+// FailGroup is like [P1.Fail], but records that the group with the given
+// number, whose start tag begins at start, was not correctly terminated: either
+// an end tag for actual was found instead, or the input ended, in which case
+// actual is zero. If group is zero, the innermost group being parsed, if any,
+// is used.
+//
+//go:noinline
+func (p1 P1) FailGroup(p2 P2, err ErrorCode, group, actual protowire.Number, start xunsafe.Addr[byte]) {
+	if group == 0 && p1.endGroup != notAGroup {
+		tag := p1.endGroup.Decode()
+		group = protowire.Number(tag >> 3)
+		// Rewind to the start of the start tag, which is one less than the
+		// end tag.
+		start = rewindVarint(p2.p3().stack.ptr.AssertValid().group, tag-1)
+	}
+
+	src := xunsafe.AddrOf(p1.Src())
+	p2.p3().err = ParseError{
+		code:   err,
+		offset: p1.PtrAddr.Sub(src),
+		group: groupInfo{
+			start:    -1,
+			expected: group,
+			actual:   actual,
+		},
+	}
+	if group != 0 {
+		p2.p3().err.group.start = start.Sub(src)
+	}
+
+	_ = *(*byte)(nil)
+	for { //nolint:staticcheck // This code is unreachable.
+	}
+}
+
+// FailWith is like [P1.Fail], but records cause as the underlying error.
+func (p1 P1) FailWith(p2 P2, err ErrorCode, cause error) {
 	p2.p3().err = ParseError{
 		code:   err,
 		offset: p1.PtrAddr.Sub(xunsafe.AddrOf(p1.Src())),
+		cause:  cause,
 	}
 
-	_ = *(*byte)(nil) // Trigger a panic without calling runtime.gopanic. Linters hate this!
-	for {             //nolint:staticcheck // This code is unreachable.
+	_ = *(*byte)(nil)
+	for { //nolint:staticcheck // This code is unreachable.
+	}
+}
+
+// FailSizeLimit is like [P1.Fail], but records that a message of type ty
+// with the given size exceeded its type's [tdp.Aux].MaxSize.
+//
+//go:noinline
+func (p1 P1) FailSizeLimit(p2 P2, ty *tdp.Type, size int) {
+	p2.p3().err = ParseError{
+		code:   ErrorSizeLimit,
+		offset: p1.PtrAddr.Sub(xunsafe.AddrOf(p1.Src())),
+		limit:  sizeLimitInfo{ty: ty, size: size},
+	}
+
+	_ = *(*byte)(nil)
+	for { //nolint:staticcheck // This code is unreachable.
 	}
 }
 
 // Log logs debugging information during a parse.
+//
+// Logs are printed when built with the debug tag, and are sent to
+// [Options.Logger] when it is set.
 func (p1 P1) Log(p2 P2, op, format string, args ...any) {
-	if !debug.Enabled {
+	if !debug.Enabled && p2.p3().Logger == nil {
 		return
 	}
 
+	p1.log(p2, op, format, args)
+}
+
+//go:noinline
+func (p1 P1) log(p2 P2, op, format string, argv []any) {
+	// Hide the arguments from escape analysis; they are not retained, so
+	// callers need not heap-allocate them when logging is disabled.
+	args := *xunsafe.NoEscape(&argv)
+
 	start := p1.PtrAddr.Sub(xunsafe.AddrOf(p1.Src()))
+	if logger := p2.p3().Logger; logger != nil {
+		logger(start, op, fmt.Sprintf(format, args...))
+	}
+	if !debug.Enabled {
+		return
+	}
+
 	end := p1.EndAddr.Sub(xunsafe.AddrOf(p1.Src()))
 	height := p2.p3().stack.bottom.Sub(p2.p3().stack.ptr)
 	var b byte
@@ -314,13 +455,78 @@ func (p1 P1) Bytes(p2 P2) (P1, P2, zc.Range) {
 
 // UTF8 parses a length-delimited byte buffer, and validates it for UTF8.
 func (p1 P1) UTF8(p2 P2) (P1, P2, zc.Range) {
-	if p2.p3().AllowInvalidUTF8 {
+	p3 := p2.p3()
+	if p3.AllowInvalidUTF8 {
 		return p1.Bytes(p2)
 	}
+	if p3.validUTF8 != nil {
+		var r zc.Range
+		p1, p2, r = p1.Bytes(p2)
+		if !p3.validUTF8(r.Bytes(p1.Src())) {
+			p1 = p1.Advance(-r.Len())
+			p1.Fail(p2, ErrorUTF8)
+		}
+		return p1, p2, r
+	}
 
 	return verifyUTF8(p1.LengthPrefix(p2))
 }
 
+// Intern replaces r, the range of the value of a singular string field, with
+// the range of the first equal value in the input, if the message's
+// [dynamic.Shared] deduplicates strings.
+func (p1 P1) Intern(p2 P2, r zc.Range) (P1, P2, zc.Range) {
+	if s := p1.Shared().Strings; s != nil {
+		r = s.Range(p1.Src(), r)
+	}
+	return p1, p2, r
+}
+
+// ValidUTF8 returns whether b, the contents of a string field that requires
+// UTF-8, is acceptable.
+func (p2 P2) ValidUTF8(b []byte) bool {
+	if p3 := p2.p3(); p3.validUTF8 != nil {
+		return p3.validUTF8(b)
+	}
+	return utf8.Valid(b)
+}
+
+// SecretBytes is like [P1.Bytes], but for a field whose contents are secret:
+// the contents are not logged, and the message's [dynamic.Shared] is marked
+// as containing secrets, so that they are wiped when it is freed.
+func (p1 P1) SecretBytes(p2 P2) (P1, P2, zc.Range) {
+	var n int
+	p1, p2, n = p1.LengthPrefix(p2)
+	p1.Shared().Secrets = true
+
+	r := zc.NewRaw(p1.PtrAddr.Sub(xunsafe.AddrOf(p1.Src())), n)
+	p1 = p1.Advance(n)
+	return p1, p2, r
+}
+
+// SecretUTF8 is like [P1.UTF8], but for a field whose contents are secret; see
+// [P1.SecretBytes]. Unless the built-in validation has been replaced, the time
+// taken to validate the contents depends only on their length.
+func (p1 P1) SecretUTF8(p2 P2) (P1, P2, zc.Range) {
+	var r zc.Range
+	p1, p2, r = p1.SecretBytes(p2)
+
+	var valid bool
+	switch p3 := p2.p3(); {
+	case p3.AllowInvalidUTF8:
+		valid = true
+	case p3.validUTF8 != nil:
+		valid = p3.validUTF8(r.Bytes(p1.Src()))
+	default:
+		valid = validUTF8Secret(r.Bytes(p1.Src()))
+	}
+	if !valid {
+		p1 = p1.Advance(-r.Len())
+		p1.Fail(p2, ErrorUTF8)
+	}
+	return p1, p2, r
+}
+
 // ParseMapEntry is a shim over [PushMessage] used for map entries.
 //
 // //go:nosplit // TODO(#30): Enable once upstream is fixed.
@@ -347,6 +553,11 @@ func (p1 P1) PushMessage(p2 P2, m *dynamic.Message) (P1, P2) {
 
 	p1.Log(p2, "n", "%d", len)
 
+	ty := m.Type()
+	if ty.SizeLimited && uint(len) > uint(ty.MaxSize) {
+		p1.FailSizeLimit(p2, ty, len)
+	}
+
 	if p1.endGroup != notAGroup || p1.PtrAddr.Add(len) != p1.EndAddr {
 		// We don't need to push a new frame if the new message would cause
 		// the current frame to be empty once it gets popped.
@@ -356,7 +567,7 @@ func (p1 P1) PushMessage(p2 P2, m *dynamic.Message) (P1, P2) {
 	p1.endGroup = notAGroup
 	p2.messageAddr = xunsafe.AddrOf(m)
 
-	t := p2.Message().Type().Parser
+	t := ty.Parser
 	p2.p3().t_ = xunsafe.AddrOf(t)
 	if debug.Enabled {
 		p1, p2 = logMessage(p1, p2)
@@ -408,6 +619,7 @@ func (p1 P1) PushGroup(p2 P2, m *dynamic.Message) (P1, P2) {
 	end := start + 1
 
 	p1, p2 = p1.push(p2, p1.EndAddr)
+	p2.p3().stack.ptr.AssertValid().group = p1.PtrAddr
 
 	p1.endGroup = end
 	p2.messageAddr = xunsafe.AddrOf(m)
@@ -449,7 +661,7 @@ func (p1 P1) push(p2 P2, end xunsafe.Addr[byte]) (P1, P2) {
 	}
 
 	if p2.p3().stack.ptr == p2.p3().stack.top {
-		p1.Fail(p2, ErrorRecursionDepth)
+		p1, p2 = growStack(p1, p2)
 	}
 
 	p2.p3().stack.ptr = p2.p3().stack.ptr.Add(-1)
@@ -509,6 +721,20 @@ func (p1 P1) pop(p2 P2) (P1, P2, bool) {
 
 func (p1 P1) byTag(p2 P2, tag2 uint64) (P1, P2, uint64) {
 	t := p2.Type()
+	if t.Dispatch == tdp.DispatchJump {
+		if n := uint32(tag2>>3) - t.JumpBase; n < t.JumpLen {
+			if idx := *t.Jump.Get(int(n)); idx != tdp.NoJump {
+				// Fields with several parsers, like packable ones, may need
+				// the hash table to find the parser for this wire type.
+				f := t.Fields().Get(int(idx))
+				if f.Tag.Decode() == tag2 {
+					p2.fieldAddr = xunsafe.AddrOf(f)
+					return p1, p2, tag2
+				}
+			}
+		}
+	}
+
 	p := swiss.LookupI32xU32(t.Tags, int32(tag2))
 	if p == nil {
 		p2.fieldAddr = 0
@@ -0,0 +1,199 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vm
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp"
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+)
+
+// WireStats tallies the fields seen in the wire format of parsed messages.
+//
+// Fields are tallied by the parser as it dispatches on them, into a table in
+// the parser state that is private to one parse. Once the parse succeeds, the
+// table is merged into the shared counters with atomic adds, so concurrent
+// parses never wait on each other.
+type WireStats struct {
+	fields sync.Map // [WireField] -> *wireCounter
+}
+
+// WireField identifies a field number within a particular message type.
+type WireField struct {
+	Message protoreflect.FullName
+	Number  protowire.Number
+}
+
+// WireCount is the tally for a [WireField].
+type WireCount struct {
+	Count, Bytes int
+}
+
+// wireCounter is the shared tally for a [WireField].
+type wireCounter struct {
+	count, bytes atomic.Int64
+}
+
+// load returns the current value of c.
+func (c *wireCounter) load() WireCount {
+	return WireCount{Count: int(c.count.Load()), Bytes: int(c.bytes.Load())}
+}
+
+// wireTallies are the fields tallied by a single parse.
+//
+// Fields are keyed by the offset of their message type within its library,
+// which is the same for every message in a parse, and their number. Packing
+// these into an integer makes the lookup for each field much cheaper than it
+// would be with a struct key.
+type wireTallies struct {
+	index  map[uint64]int32
+	fields []wireTally
+}
+
+type wireTally struct {
+	ty     *tdp.Type
+	number protowire.Number
+	WireCount
+}
+
+// reset discards all tallies.
+func (t *wireTallies) reset() {
+	clear(t.index)
+	t.fields = t.fields[:0]
+}
+
+// Get returns the tally for f.
+func (s *WireStats) Get(f WireField) WireCount {
+	v, ok := s.fields.Load(f)
+	if !ok {
+		return WireCount{}
+	}
+	return v.(*wireCounter).load() //nolint:errcheck // Always a *wireCounter.
+}
+
+// All calls yield with each tallied field, in an unspecified order.
+func (s *WireStats) All(yield func(WireField, WireCount) bool) {
+	s.fields.Range(func(k, v any) bool {
+		return yield(k.(WireField), v.(*wireCounter).load()) //nolint:errcheck // Always a *wireCounter.
+	})
+}
+
+// Reset discards all tallies.
+func (s *WireStats) Reset() {
+	s.fields.Clear()
+}
+
+// merge adds the tallies of a single parse to s.
+func (s *WireStats) merge(tallies *wireTallies) {
+	for _, t := range tallies.fields {
+		f := WireField{Message: t.ty.Descriptor.FullName(), Number: t.number}
+		v, ok := s.fields.Load(f)
+		if !ok {
+			v, _ = s.fields.LoadOrStore(f, new(wireCounter))
+		}
+		c := v.(*wireCounter) //nolint:errcheck // Always a *wireCounter.
+		c.count.Add(int64(t.Count))
+		c.bytes.Add(int64(t.Bytes))
+	}
+}
+
+// mergeWireStats merges the fields tallied so far into [Options].WireStats,
+// and starts a new tally.
+func (p3 *p3) mergeWireStats() {
+	if p3.WireStats != nil {
+		p3.WireStats.merge(&p3.wire)
+		p3.wire.reset()
+	}
+}
+
+// tally adds n bytes to the tally for the given field number of m, which is
+// being parsed with parser. If count is set, this also counts a record.
+//
+// Fields of map entries are not tallied, since they are not fields of m,
+// which is the message containing the map; only the fields of message-typed
+// values are, as fields of their own type.
+func (p3 *p3) tally(m *dynamic.Message, parser *tdp.TypeParser, number protowire.Number, n int, count bool) {
+	ty := m.Type()
+	if parser != ty.Parser {
+		return
+	}
+
+	t := &p3.wire
+	k := uint64(m.TypeOffset)<<32 | uint64(number)
+	i, ok := t.index[k]
+	if !ok {
+		if t.index == nil {
+			t.index = make(map[uint64]int32)
+		}
+		i = int32(len(t.fields))
+		t.index[k] = i
+		t.fields = append(t.fields, wireTally{ty: ty, number: number})
+	}
+
+	c := &t.fields[i]
+	if count {
+		c.Count++
+	}
+	c.Bytes += n
+}
+
+// tallyField tallies the record for the current field, whose tag has just
+// been consumed.
+//
+// The contents of a group are not known yet, so only its start tag is
+// tallied here; the rest is added by [tallyGroupEnd].
+//
+//go:noinline
+func tallyField(p1 P1, p2 P2) (P1, P2) {
+	tag := p2.Field().Tag.Decode()
+	n := protowire.SizeVarint(tag)
+
+	buf := p1.Buf()
+	switch protowire.Type(tag & 7) {
+	case protowire.VarintType:
+		_, m := protowire.ConsumeVarint(buf)
+		n += max(m, 0)
+	case protowire.Fixed32Type:
+		n += 4
+	case protowire.Fixed64Type:
+		n += 8
+	case protowire.BytesType:
+		_, m := protowire.ConsumeBytes(buf)
+		n += max(m, 0)
+	}
+
+	p2.p3().tally(p2.Message(), p2.Type(), protowire.Number(tag>>3), n, true)
+	return p1, p2
+}
+
+// tallyGroupEnd adds the contents and end tag of the group that is about to
+// be popped to the tally for the field that started it.
+//
+//go:noinline
+func tallyGroupEnd(p1 P1, p2 P2) (P1, P2) {
+	// The frame holds the state of the parent, which is being returned to.
+	frame := p2.p3().stack.ptr.AssertValid()
+	number := protowire.Number(frame.field.AssertValid().Tag.Decode() >> 3)
+	p2.p3().tally(
+		frame.message.AssertValid(), frame.ty.AssertValid(),
+		number, p1.PtrAddr.Sub(frame.group), false,
+	)
+	return p1, p2
+}
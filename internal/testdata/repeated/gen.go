@@ -16,10 +16,12 @@
 package main
 
 import (
+	"cmp"
 	"flag"
 	"fmt"
 	"math/bits"
 	"math/rand/v2"
+	"slices"
 	"strings"
 	"unicode"
 
@@ -38,6 +40,8 @@ var (
 	uni   = flag.Bool("unicode", false, "generate Unicode strings instead")
 	bytes = flag.Bool("bytes", false, "generate byte strings instead")
 	zipf  = flag.Bool("zipf", false, "use a Zipf distribution rather than a uniform one")
+
+	sorted = flag.Bool("sorted", false, "generate distinct values in ascending order")
 )
 
 func makeString[T byte | rune](char func() T) string {
@@ -60,14 +64,8 @@ func makeString[T byte | rune](char func() T) string {
 func main() {
 	flag.Parse()
 
-	var cells [][]string
-	var widths [][]int
-	for i := range *n {
-		if i%*row == 0 {
-			cells = append(cells, nil)
-			widths = append(widths, nil)
-		}
-
+	var values []any
+	for range *n {
 		var value any
 		switch {
 		case *ascii:
@@ -110,6 +108,26 @@ func main() {
 			}
 			value = v
 		}
+		values = append(values, value)
+	}
+
+	if *sorted {
+		slices.SortFunc(values, func(a, b any) int {
+			if a, ok := a.(uint64); ok {
+				return cmp.Compare(a, b.(uint64))
+			}
+			return cmp.Compare(a.(string), b.(string))
+		})
+		values = slices.Compact(values)
+	}
+
+	var cells [][]string
+	var widths [][]int
+	for i, value := range values {
+		if i%*row == 0 {
+			cells = append(cells, nil)
+			widths = append(widths, nil)
+		}
 
 		cell := fmt.Sprintf(*format, value)
 		cells[len(cells)-1] = append(cells[len(cells)-1], cell)
@@ -83,6 +83,12 @@ type Profile []struct {
 		DecodeProbability float64 `yaml:"parse"`
 		ExpectedCount     int     `yaml:"expected_count"`
 		AssumeUTF8        bool    `yaml:"assume_utf8"`
+		BitsetBools       bool    `yaml:"bitset_bools"`
+		Secret            bool    `yaml:"secret"`
+		Intern            bool    `yaml:"intern"`
+		Sample            int     `yaml:"sample"`
+		Elided            bool    `yaml:"elided"`
+		DenseKeys         int     `yaml:"dense_keys"`
 	} `yaml:"-,inline"`
 }
 
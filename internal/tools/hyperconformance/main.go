@@ -0,0 +1,267 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// hyperconformance is a testee for the Protobuf conformance test suite, which
+// parses the suite's binary wire format inputs using hyperpb.
+//
+// To use it, build conformance_test_runner from the protobuf repository, and
+// run make conformance, which runs
+//
+//	conformance_test_runner --enforce_recommended --maximum_edition 2023 \
+//		path/to/hyperconformance
+//
+// Additional flags for the runner, such as --failure_list, can be passed with
+// make conformance CONFORMANCE_FLAGS=...
+//
+// The descriptors for the suite's messages are embedded in conformance.binpb,
+// which was generated from the conformance protos at the version of
+// google.golang.org/protobuf in go.mod.
+//
+// Inputs in formats other than the binary wire format are skipped, since hyperpb
+// only implements a binary parser. Outputs in JSON and text format are
+// produced from the parsed hyperpb message using protojson and prototext.
+package main
+
+import (
+	_ "embed"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"buf.build/go/hyperpb"
+)
+
+// schema is a FileDescriptorSet containing conformance.proto and the test
+// message definitions from the conformance suite, along with their
+// dependencies.
+//
+//go:embed conformance.binpb
+var schema []byte
+
+// Values of conformance.WireFormat.
+const (
+	formatProtobuf = 1
+	formatJSON     = 2
+	formatText     = 4
+)
+
+func main() {
+	t, err := newTestee()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "hyperconformance:", err)
+		os.Exit(1)
+	}
+
+	if err := t.serve(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "hyperconformance:", err)
+		os.Exit(1)
+	}
+}
+
+// testee answers ConformanceRequests.
+//
+// It also serves as the type resolver for formatting google.protobuf.Any, with
+// message types resolved to hyperpb types and extensions to dynamicpb types.
+type testee struct {
+	*dynamicpb.Types
+
+	files             *protoregistry.Files
+	request, response protoreflect.MessageDescriptor
+	types             map[protoreflect.FullName]*hyperpb.MessageType
+}
+
+func newTestee() (*testee, error) {
+	fds := new(descriptorpb.FileDescriptorSet)
+	if err := proto.Unmarshal(schema, fds); err != nil {
+		return nil, err
+	}
+	// protodesc rejects MessageSets unless built with the protolegacy tag, and
+	// hyperpb does not support them either, so strip the option. Tests that
+	// use MessageSets are expected to fail.
+	for _, f := range fds.File {
+		stripMessageSets(f.MessageType)
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &testee{
+		Types: dynamicpb.NewTypes(files),
+		files: files,
+		types: make(map[protoreflect.FullName]*hyperpb.MessageType),
+	}
+	for name, md := range map[protoreflect.FullName]*protoreflect.MessageDescriptor{
+		"conformance.ConformanceRequest":  &t.request,
+		"conformance.ConformanceResponse": &t.response,
+	} {
+		d, err := files.FindDescriptorByName(name)
+		if err != nil {
+			return nil, err
+		}
+		*md = d.(protoreflect.MessageDescriptor) //nolint:errcheck
+	}
+	return t, nil
+}
+
+// stripMessageSets turns MessageSets into ordinary messages, recursively.
+func stripMessageSets(mds []*descriptorpb.DescriptorProto) {
+	for _, md := range mds {
+		if md.GetOptions().GetMessageSetWireFormat() {
+			md.Options.MessageSetWireFormat = nil
+			// MessageSets permit extension numbers that are otherwise invalid.
+			for _, r := range md.ExtensionRange {
+				r.End = proto.Int32(min(r.GetEnd(), int32(protowire.MaxValidNumber)+1))
+			}
+		}
+		stripMessageSets(md.NestedType)
+	}
+}
+
+// serve answers length-prefixed requests from r until it reaches EOF.
+func (t *testee) serve(r io.Reader, w io.Writer) error {
+	var size [4]byte
+	for {
+		if _, err := io.ReadFull(r, size[:]); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		req := make([]byte, binary.LittleEndian.Uint32(size[:]))
+		if _, err := io.ReadFull(r, req); err != nil {
+			return err
+		}
+
+		resp, err := t.handle(req)
+		if err != nil {
+			return err
+		}
+
+		binary.LittleEndian.PutUint32(size[:], uint32(len(resp)))
+		if _, err := w.Write(append(size[:], resp...)); err != nil {
+			return err
+		}
+	}
+}
+
+// handle answers a single encoded ConformanceRequest.
+func (t *testee) handle(data []byte) ([]byte, error) {
+	req := dynamicpb.NewMessage(t.request)
+	if err := proto.Unmarshal(data, req); err != nil {
+		return nil, err
+	}
+	get := func(name protoreflect.Name) protoreflect.Value {
+		return req.Get(t.request.Fields().ByName(name))
+	}
+
+	resp := dynamicpb.NewMessage(t.response)
+	set := func(name protoreflect.Name, v any) {
+		resp.Set(t.response.Fields().ByName(name), protoreflect.ValueOf(v))
+	}
+
+	switch {
+	case !req.Has(t.request.Fields().ByName("protobuf_payload")):
+		set("skipped", "hyperpb only supports the binary wire format as input")
+
+	default:
+		ty, err := t.compile(protoreflect.FullName(get("message_type").String()))
+		if err != nil {
+			set("runtime_error", err.Error())
+			break
+		}
+
+		m := hyperpb.NewMessage(ty)
+		if err := proto.Unmarshal(get("protobuf_payload").Bytes(), m); err != nil {
+			set("parse_error", err.Error())
+			break
+		}
+
+		var out []byte
+		field := "protobuf_payload"
+		switch format := get("requested_output_format").Enum(); format {
+		case formatProtobuf:
+			out, err = proto.Marshal(m)
+		case formatJSON:
+			field = "json_payload"
+			out, err = protojson.MarshalOptions{Resolver: t}.Marshal(m)
+		case formatText:
+			field = "text_payload"
+			out, err = prototext.MarshalOptions{
+				Resolver:    t,
+				EmitUnknown: get("print_unknown_fields").Bool(),
+			}.Marshal(m)
+		default:
+			set("skipped", fmt.Sprintf("unsupported output format %d", format))
+			return proto.Marshal(resp)
+		}
+
+		switch {
+		case err != nil:
+			set("serialize_error", err.Error())
+		case field == "protobuf_payload":
+			set(protoreflect.Name(field), out)
+		default:
+			set(protoreflect.Name(field), string(out))
+		}
+	}
+
+	return proto.Marshal(resp)
+}
+
+// compile returns the compiled type for the given message name.
+func (t *testee) compile(name protoreflect.FullName) (*hyperpb.MessageType, error) {
+	if ty, ok := t.types[name]; ok {
+		return ty, nil
+	}
+
+	d, err := t.files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	ty := hyperpb.CompileMessageDescriptor(md, hyperpb.WithExtensionsFromFiles(t.files))
+	t.types[name] = ty
+	return ty, nil
+}
+
+// FindMessageByName implements protoregistry.MessageTypeResolver.
+func (t *testee) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	return t.compile(name)
+}
+
+// FindMessageByURL implements protoregistry.MessageTypeResolver.
+func (t *testee) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		url = url[i+1:]
+	}
+	return t.compile(protoreflect.FullName(url))
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestTestee(t *testing.T) {
+	t.Parallel()
+
+	testee, err := newTestee()
+	require.NoError(t, err)
+
+	request := func(fields map[protoreflect.Name]any) []byte {
+		m := dynamicpb.NewMessage(testee.request)
+		for name, v := range fields {
+			fd := testee.request.Fields().ByName(name)
+			if fd.Enum() != nil {
+				v = protoreflect.EnumNumber(v.(int)) //nolint:errcheck
+			}
+			m.Set(fd, protoreflect.ValueOf(v))
+		}
+		b, err := proto.Marshal(m)
+		require.NoError(t, err)
+		return append(binary.LittleEndian.AppendUint32(nil, uint32(len(b))), b...)
+	}
+
+	const proto3 = "protobuf_test_messages.proto3.TestAllTypesProto3"
+	tests := []struct {
+		request map[protoreflect.Name]any
+		field   protoreflect.Name
+		want    any
+	}{
+		{
+			request: map[protoreflect.Name]any{
+				"protobuf_payload":        []byte{0x08, 0x05}, // optional_int32: 5
+				"message_type":            proto3,
+				"requested_output_format": formatProtobuf,
+			},
+			field: "protobuf_payload",
+			want:  []byte{0x08, 0x05},
+		},
+		{
+			request: map[protoreflect.Name]any{
+				"protobuf_payload":        []byte{0x08, 0x05},
+				"message_type":            proto3,
+				"requested_output_format": formatJSON,
+			},
+			field: "json_payload",
+			want:  `{"optionalInt32":5}`,
+		},
+		{
+			request: map[protoreflect.Name]any{
+				"protobuf_payload":        []byte{0x08},
+				"message_type":            proto3,
+				"requested_output_format": formatProtobuf,
+			},
+			field: "parse_error",
+		},
+		{
+			request: map[protoreflect.Name]any{
+				"json_payload":            "{}",
+				"message_type":            proto3,
+				"requested_output_format": formatProtobuf,
+			},
+			field: "skipped",
+		},
+	}
+
+	var in bytes.Buffer
+	for _, tt := range tests {
+		in.Write(request(tt.request))
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, testee.serve(&in, &out))
+
+	for _, tt := range tests {
+		n := binary.LittleEndian.Uint32(out.Next(4))
+		resp := dynamicpb.NewMessage(testee.response)
+		require.NoError(t, proto.Unmarshal(out.Next(int(n)), resp))
+
+		fd := testee.response.Fields().ByName(tt.field)
+		require.True(t, resp.Has(fd), "%v: got %v", tt.field, resp)
+		if tt.want != nil {
+			assert.Equal(t, tt.want, resp.Get(fd).Interface())
+		}
+	}
+	assert.Zero(t, out.Len())
+}
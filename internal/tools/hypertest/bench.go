@@ -82,10 +82,14 @@ func parseBenchmarkOutput(stdout string) *benchReport {
 		// Split each benchmark into fields. Each field is separated by tabs.
 		fields := strings.Split(line, "\t")
 
-		name := fields[0]
+		name := strings.TrimSpace(fields[0])
 		// Trim off a trailing -n, since it's not especially
-		// interesting.
-		name = name[:strings.LastIndex(name, "-")]
+		// interesting. It is absent when GOMAXPROCS is 1.
+		if dash := strings.LastIndex(name, "-"); dash != -1 {
+			if _, err := strconv.Atoi(name[dash+1:]); err == nil {
+				name = name[:dash]
+			}
+		}
 		name = strings.TrimPrefix(name, "Benchmark")
 
 		// Delete all occurrences of .yaml.
@@ -234,7 +238,46 @@ func parseBenchmarkOutput(stdout string) *benchReport {
 	return r
 }
 
-func (r *benchReport) toCSV(w io.Writer) error {
+// timeRatio returns the ratio of the time taken by b to the time taken by the
+// benchmark for the baseline subtest in the same group, bs.
+func (r *benchReport) timeRatio(bs []bench, b bench, baseline string) (float64, bool) {
+	col := slices.IndexFunc(r.columns, func(c column) bool { return c.name == "time" })
+	if col < 0 {
+		return 0, false
+	}
+	idx := slices.IndexFunc(bs, func(b bench) bool { return b.subtest == baseline })
+	if idx < 0 {
+		return 0, false
+	}
+	base := bs[idx].metrics[col].value
+	if base == 0 || b.metrics[col].value == 0 {
+		return 0, false
+	}
+	return b.metrics[col].value / base, true
+}
+
+// checkRatios returns an error if any benchmark for the target subtest takes
+// more than maxRatio times as long as the baseline subtest.
+func (r *benchReport) checkRatios(target, baseline string, maxRatio float64) error {
+	var regressed []string
+	for _, bs := range r.benches {
+		for _, b := range bs {
+			if b.subtest != target {
+				continue
+			}
+			if ratio, ok := r.timeRatio(bs, b, baseline); ok && ratio > maxRatio {
+				regressed = append(regressed, fmt.Sprintf("%s: %.2fx", b.name, ratio))
+			}
+		}
+	}
+	if len(regressed) > 0 {
+		return fmt.Errorf("%s takes more than %.2fx the time of %s:\n%s",
+			target, maxRatio, baseline, strings.Join(regressed, "\n"))
+	}
+	return nil
+}
+
+func (r *benchReport) toCSV(w io.Writer, baseline string) error {
 	subtests := map[string]int{}
 	for i, s := range r.subtests {
 		subtests[s] = i
@@ -248,6 +291,12 @@ func (r *benchReport) toCSV(w io.Writer) error {
 			header = append(header, c.name+"/"+subtest)
 		}
 	}
+	ratios := len(header)
+	if baseline != "" {
+		for _, subtest := range r.subtests {
+			header = append(header, "time-vs-"+baseline+"/"+subtest)
+		}
+	}
 
 	cells := [][]string{header}
 	for _, bs := range r.benches {
@@ -259,26 +308,37 @@ func (r *benchReport) toCSV(w io.Writer) error {
 			for i, m := range b.metrics {
 				row[indices[[2]int{i, j}]] = strconv.FormatFloat(m.value, 'f', -1, 64)
 			}
+			if ratio, ok := r.timeRatio(bs, b, baseline); ok && baseline != "" {
+				row[ratios+j] = strconv.FormatFloat(ratio, 'f', -1, 64)
+			}
 		}
 	}
 
 	return csv.NewWriter(w).WriteAll(cells)
 }
 
-func (r *benchReport) toMarkdown(w io.Writer) error {
+func (r *benchReport) toMarkdown(w io.Writer, baseline string) error {
+	cols := len(r.columns)
+	if baseline != "" {
+		cols++
+	}
+
 	// Lay out the header.
-	table := [][]string{make([]string, len(r.columns)+2)}
+	table := [][]string{make([]string, cols+2)}
 
 	header := table[0]
 	header[0], header[1], header = "benchmark", "sub", header[2:]
 	for i, col := range r.columns {
 		header[i] = col.name
 	}
+	if baseline != "" {
+		header[len(r.columns)] = "vs " + baseline
+	}
 
 	// Lay out the pretty table.
 	for _, bs := range r.benches {
 		for i, b := range bs {
-			row := make([]string, len(r.columns)+2)
+			row := make([]string, cols+2)
 			if i == 0 {
 				row[0] = b.name
 			}
@@ -292,6 +352,12 @@ func (r *benchReport) toMarkdown(w io.Writer) error {
 					row[i] = "n/a  " + r.columns[i].units
 				}
 			}
+			if baseline != "" {
+				row[len(r.columns)] = "n/a  "
+				if ratio, ok := r.timeRatio(bs, b, baseline); ok {
+					row[len(r.columns)] = fmt.Sprintf("%.2fx", ratio)
+				}
+			}
 		}
 	}
 
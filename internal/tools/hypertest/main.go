@@ -16,6 +16,7 @@
 //
 // 1. Benchmark output as CSV and as a table.
 // 2. Running tests on remote hosts over SSH.
+// 3. Comparing benchmark times against a baseline, such as gogo/protobuf.
 package main
 
 import (
@@ -40,6 +41,10 @@ var (
 
 	benchCsv   = flag.String("csv", "", "file for benchmark csv output")
 	benchTable = flag.String("table", "", "file for benchmark table output")
+
+	baseline = flag.String("baseline", "", "benchmark subtest to compare times against, such as vtproto or gogo")
+	target   = flag.String("target", "arena", "benchmark subtest checked against -max-ratio")
+	maxRatio = flag.Float64("max-ratio", 0, "if positive, fail if -target is this many times slower than -baseline")
 )
 
 func open(path string) (*os.File, func(), error) {
@@ -85,7 +90,7 @@ func run() error {
 		return err
 	}
 
-	if *benchCsv == "" && *benchTable == "" {
+	if *benchCsv == "" && *benchTable == "" && *maxRatio <= 0 {
 		return nil
 	}
 
@@ -97,7 +102,7 @@ func run() error {
 		}
 		defer close()
 
-		if err := benchmarks.toCSV(f); err != nil {
+		if err := benchmarks.toCSV(f, *baseline); err != nil {
 			return err
 		}
 	}
@@ -108,10 +113,13 @@ func run() error {
 		}
 		defer close()
 
-		if err := benchmarks.toMarkdown(f); err != nil {
+		if err := benchmarks.toMarkdown(f, *baseline); err != nil {
 			return err
 		}
 	}
+	if *maxRatio > 0 && *baseline != "" {
+		return benchmarks.checkRatios(*target, *baseline, *maxRatio)
+	}
 
 	return nil
 }
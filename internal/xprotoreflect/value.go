@@ -18,6 +18,7 @@ package xprotoreflect
 
 import (
 	"fmt"
+	"reflect"
 	"unsafe"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -56,7 +57,9 @@ func ValueOfScalar(v any) protoreflect.Value {
 	case protoreflect.EnumNumber:
 		return protoreflect.ValueOfEnum(v)
 	default:
-		panic(fmt.Sprintf("invalid type: %T", v))
+		// Format the type rather than v, so that v does not escape, which
+		// would force callers to heap-allocate it.
+		panic(fmt.Sprintf("invalid type: %v", reflect.TypeOf(v)))
 	}
 }
 
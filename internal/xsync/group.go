@@ -0,0 +1,74 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xsync
+
+import "sync"
+
+// Group deduplicates concurrent calls that compute the same value, like
+// golang.org/x/sync/singleflight does.
+//
+// A zero Group is ready to use.
+type Group[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*call[V]
+}
+
+// call is an in-flight or completed call in a [Group].
+type call[V any] struct {
+	done  chan struct{}
+	v     V
+	err   error
+	panic any
+}
+
+// Do calls f and returns its result, unless a call for k is already in
+// flight, in which case it waits for that call to finish and returns its
+// result instead.
+//
+// If f panics, every caller waiting on it panics with the same value.
+func (g *Group[K, V]) Do(k K, f func() (V, error)) (V, error) {
+	g.mu.Lock()
+	if c, ok := g.calls[k]; ok {
+		g.mu.Unlock()
+		<-c.done
+		return c.result()
+	}
+	if g.calls == nil {
+		g.calls = make(map[K]*call[V])
+	}
+	c := &call[V]{done: make(chan struct{})}
+	g.calls[k] = c
+	g.mu.Unlock()
+
+	func() {
+		defer func() { c.panic = recover() }()
+		c.v, c.err = f()
+	}()
+
+	g.mu.Lock()
+	delete(g.calls, k)
+	g.mu.Unlock()
+	close(c.done)
+
+	return c.result()
+}
+
+// result returns the result of a completed call.
+func (c *call[V]) result() (V, error) {
+	if c.panic != nil {
+		panic(c.panic)
+	}
+	return c.v, c.err
+}
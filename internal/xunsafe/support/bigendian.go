@@ -0,0 +1,25 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build (armbe || arm64be || m68k || mips || mips64 || mips64p32 || ppc || ppc64 || s390 || s390x || sparc || sparc64) && cgo
+
+package support
+
+/*
+// The parser assumes little-endian in many places, so running it on a
+// big-endian target would silently corrupt data. Unlike other unsupported
+// architectures, this is not overridden by the hyperpb.unsupported tag.
+#error "big-endian architectures are not supported; see https://github.com/bufbuild/hyperpb-go/blob/main/README.md#supported-targets"
+*/
+import "C"
@@ -0,0 +1,21 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !(amd64 || arm64 || wasm || hyperpb.unsupported || (hyperpb.safe && (loong64 || mips64le || ppc64le || riscv64))) && !cgo
+
+package support
+
+// unsupported.go relies on cgo to produce a helpful error, so when cgo is
+// disabled, we produce a less helpful one by referring to an undefined name.
+var _ = unsupportedArchitecture_SeeSupportedTargetsInREADME
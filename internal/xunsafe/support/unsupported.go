@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build !(amd64 || arm64 || hyperpb.unsupported)
+//go:build !(amd64 || arm64 || wasm || hyperpb.unsupported || (hyperpb.safe && (loong64 || mips64le || ppc64le || riscv64)))
 
 package support
 
@@ -21,6 +21,10 @@ import (
 	"unsafe"
 
 	"buf.build/go/hyperpb/internal/xunsafe/layout"
+	// Every package that uses unsafe depends on this one, so importing
+	// support here reports unsupported targets before anything else fails
+	// to build.
+	_ "buf.build/go/hyperpb/internal/xunsafe/support"
 )
 
 // NoCopy is a type that go vet will complain about having been moved.
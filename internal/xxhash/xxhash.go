@@ -0,0 +1,142 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package xxhash implements the 64-bit variant of the xxHash algorithm,
+// XXH64, with a seed of zero.
+//
+// See <https://github.com/Cyan4973/xxHash/blob/dev/doc/xxhash_spec.md>.
+package xxhash
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	prime1 uint64 = 11400714785074694791
+	prime2 uint64 = 14029467366897019727
+	prime3 uint64 = 1609587929392839161
+	prime4 uint64 = 9650029242287828579
+	prime5 uint64 = 2870177450012600261
+
+	blockSize = 32
+)
+
+// Sum64 returns the hash of b.
+func Sum64(b []byte) uint64 {
+	var d Digest
+	d.Reset()
+	d.Write(b)
+	return d.Sum64()
+}
+
+// Digest computes a hash incrementally. Writing several chunks produces the
+// same hash as writing all of them at once.
+//
+// The zero value is not ready for use; call [Digest.Reset] first.
+type Digest struct {
+	v     [4]uint64
+	total uint64
+	mem   [blockSize]byte
+	n     int // Number of bytes in mem.
+}
+
+// Reset resets d to the hash of the empty string.
+func (d *Digest) Reset() {
+	*d = Digest{}
+	d.v[0] = prime1
+	d.v[0] += prime2
+	d.v[1] = prime2
+	d.v[3] -= prime1
+}
+
+// Write adds b to the hashed data.
+func (d *Digest) Write(b []byte) {
+	d.total += uint64(len(b))
+
+	if d.n+len(b) < blockSize {
+		d.n += copy(d.mem[d.n:], b)
+		return
+	}
+
+	if d.n > 0 {
+		k := copy(d.mem[d.n:], b)
+		d.block(d.mem[:])
+		b = b[k:]
+		d.n = 0
+	}
+
+	for len(b) >= blockSize {
+		d.block(b)
+		b = b[blockSize:]
+	}
+	d.n = copy(d.mem[:], b)
+}
+
+// Sum64 returns the hash of the data written so far.
+func (d *Digest) Sum64() uint64 {
+	var h uint64
+	if d.total >= blockSize {
+		h = bits.RotateLeft64(d.v[0], 1) + bits.RotateLeft64(d.v[1], 7) +
+			bits.RotateLeft64(d.v[2], 12) + bits.RotateLeft64(d.v[3], 18)
+		for _, v := range d.v {
+			h = merge(h, v)
+		}
+	} else {
+		h = prime5
+	}
+	h += d.total
+
+	b := d.mem[:d.n]
+	for ; len(b) >= 8; b = b[8:] {
+		h ^= round(0, binary.LittleEndian.Uint64(b))
+		h = bits.RotateLeft64(h, 27)*prime1 + prime4
+	}
+	if len(b) >= 4 {
+		h ^= uint64(binary.LittleEndian.Uint32(b)) * prime1
+		h = bits.RotateLeft64(h, 23)*prime2 + prime3
+		b = b[4:]
+	}
+	for _, c := range b {
+		h ^= uint64(c) * prime5
+		h = bits.RotateLeft64(h, 11) * prime1
+	}
+
+	h ^= h >> 33
+	h *= prime2
+	h ^= h >> 29
+	h *= prime3
+	h ^= h >> 32
+	return h
+}
+
+// block processes one 32-byte block from the start of b.
+func (d *Digest) block(b []byte) {
+	_ = b[blockSize-1]
+	d.v[0] = round(d.v[0], binary.LittleEndian.Uint64(b[0:]))
+	d.v[1] = round(d.v[1], binary.LittleEndian.Uint64(b[8:]))
+	d.v[2] = round(d.v[2], binary.LittleEndian.Uint64(b[16:]))
+	d.v[3] = round(d.v[3], binary.LittleEndian.Uint64(b[24:]))
+}
+
+func round(acc, input uint64) uint64 {
+	acc += input * prime2
+	acc = bits.RotateLeft64(acc, 31)
+	return acc * prime1
+}
+
+func merge(acc, v uint64) uint64 {
+	acc ^= round(0, v)
+	return acc*prime1 + prime4
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xxhash_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"buf.build/go/hyperpb/internal/xxhash"
+)
+
+func TestSum64(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		in   string
+		want uint64
+	}{
+		{"", 0xef46db3751d8e999},
+		{"a", 0xd24ec4f1a98c6e5b},
+		{"as", 0x1c330fb2d66be179},
+		{"asd", 0x631c37ce72a97393},
+		{"asdf", 0x415872f599cea71e},
+		{"Call me Ishmael. Some years ago--never mind how long precisely-", 0x02a2e85470d6fd96},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, xxhash.Sum64([]byte(tt.in)), "%q", tt.in)
+
+		// Hashing in chunks must not change the result.
+		for chunk := 1; chunk < len(tt.in); chunk++ {
+			var d xxhash.Digest
+			d.Reset()
+			for s := tt.in; s != ""; {
+				n := min(chunk, len(s))
+				d.Write([]byte(s[:n]))
+				s = s[n:]
+			}
+			assert.Equal(t, tt.want, d.Sum64(), "%q in chunks of %d", tt.in, chunk)
+		}
+	}
+
+	long := strings.Repeat("hyperpb", 100)
+	var d xxhash.Digest
+	d.Reset()
+	d.Write([]byte(long[:33]))
+	d.Write([]byte(long[33:]))
+	assert.Equal(t, xxhash.Sum64([]byte(long)), d.Sum64())
+}
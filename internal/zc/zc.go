@@ -34,8 +34,18 @@ import (
 //	}
 //
 // The zero value faithfully represents an empty slice.
+//
+// Because both halves are 32 bits, a Range cannot refer to data beyond the
+// first [MaxLen] bytes of its source, which bounds the size of any input that
+// the parser can handle.
 type Range uint64
 
+// MaxLen is the largest offset or length representable by a [Range].
+//
+// Payloads larger than this are better represented as a repeated bytes field
+// of smaller chunks, split across several messages.
+const MaxLen = math.MaxUint32
+
 // New creates a new Range over the given source buffer with the given start
 // and length.
 func New(src *byte, start *byte, len int) Range {
@@ -45,7 +55,7 @@ func New(src *byte, start *byte, len int) Range {
 
 // NewRaw is like newZC, but it only takes the offset and length.
 func NewRaw(offset, len int) Range {
-	debug.Assert(offset <= math.MaxUint32 && len <= math.MaxUint32,
+	debug.Assert(offset <= MaxLen && len <= MaxLen,
 		"offset too large for zc: [%d:%d]", offset, len)
 	return Range(offset) | Range(len)<<32
 }
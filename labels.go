@@ -0,0 +1,55 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"context"
+	"runtime/pprof"
+
+	"buf.build/go/hyperpb/internal/tdp"
+	"buf.build/go/hyperpb/internal/tdp/vm"
+)
+
+// LabelMessage is the [runtime/pprof] label set by [WithPprofLabels] to the
+// full name of the message being parsed.
+const LabelMessage = "hyperpb.message"
+
+// WithPprofLabels runs parses with [runtime/pprof] labels, so that CPU
+// profiles of workloads that parse many types can be broken down by type.
+// Parses are labeled with [LabelMessage], set to the full name of the
+// message type, plus the labels of ctx and the given key-value pairs of tags.
+//
+// As with [pprof.Do], the goroutine's labels are set to those of ctx once the
+// parse completes, so ctx should carry the goroutine's current labels, if it
+// has any. ctx may be nil, which is the same as [context.Background].
+//
+// Panics if tags has an odd length.
+func WithPprofLabels(ctx context.Context, tags ...string) UnmarshalOption {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if len(tags) > 0 {
+		ctx = pprof.WithLabels(ctx, pprof.Labels(tags...))
+	}
+	return UnmarshalOption{func(opts *vm.Options) { opts.Labels = ctx }}
+}
+
+// withLabels calls parse with the profiler labels requested with
+// [WithPprofLabels].
+func withLabels(ctx context.Context, ty *tdp.Type, parse func()) {
+	pprof.Do(ctx, pprof.Labels(LabelMessage, string(ty.Descriptor.FullName())), func(context.Context) {
+		parse()
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp/lazy"
+)
+
+// Open returns a read-only view of data as a message of type ty, which decodes
+// fields on demand instead of parsing all of data up-front.
+//
+// The first access to a message's fields scans its records to memoize the
+// location of each field; values are only decoded when requested. This can be
+// faster than [Message.Unmarshal] for access patterns which touch a small
+// fraction of a large message once. For most other access patterns, a full
+// parse is faster.
+//
+// Returns an error if the top-level records of data are malformed. Submessages
+// are only scanned once accessed; a malformed submessage behaves as if it has
+// no fields. Strings are not validated as UTF-8.
+//
+// The returned message aliases data, which must not be modified while the
+// message is in use. The message is safe to use from multiple goroutines.
+func Open(ty *MessageType, data []byte) (protoreflect.Message, error) {
+	m, err := lazy.New(&ty.impl, data)
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
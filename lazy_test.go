@@ -0,0 +1,43 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/internal/prototest"
+	"buf.build/go/hyperpb/internal/testdata"
+)
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+	testdata.RunAll(t, func(t *testing.T, test *testdata.TestCase) {
+		t.Helper()
+		for _, specimen := range test.Specimens {
+			m1 := test.Type.Gencode.New().Interface()
+			if err := proto.Unmarshal(specimen, m1); err != nil {
+				continue
+			}
+
+			m2, err := hyperpb.Open(test.Type.Fast, specimen)
+			require.NoError(t, err)
+			prototest.Equal(t, m1, m2.Interface())
+		}
+	})
+}
@@ -0,0 +1,58 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import "buf.build/go/hyperpb/internal/tdp/dynamic"
+
+// Memo returns a value derived from m, such as its JSON encoding, computing it
+// with compute the first time it is requested for a given key, and returning
+// the same value thereafter. This is intended for messages that are read many
+// times, to avoid repeatedly computing expensive derived values:
+//
+//	type jsonKey struct{}
+//	data, err := hyperpb.Memo(m, jsonKey{}, func(m *hyperpb.Message) ([]byte, error) {
+//		return protojson.Marshal(m)
+//	})
+//
+// As with [context.WithValue], key must be comparable, and should be of an
+// unexported type to avoid collisions. Using the same key with different
+// types of T panics.
+//
+// Memoized values are held by m's [Shared] until [Shared.Free] is called.
+// Because parsed messages cannot be mutated, memoized values never become
+// stale. Values are not memoized for messages which have not been parsed
+// into, or if compute returns an error.
+//
+// Memo may be called concurrently; if so, compute may be called more than
+// once for the same key, but all callers observe the same result.
+func Memo[T any](m *Message, key any, compute func(*Message) (T, error)) (T, error) {
+	s := m.impl.Shared
+	if s.Src == nil {
+		return compute(m)
+	}
+
+	k := dynamic.MemoKey{Message: &m.impl, Key: key}
+	if v, ok := s.Memos.Load(k); ok {
+		return v.(T), nil //nolint:errcheck // Panicking on misuse is intended.
+	}
+
+	v, err := compute(m)
+	if err != nil {
+		return v, err
+	}
+	s.HasMemos.Store(true)
+	actual, _ := s.Memos.LoadOrStore(k, v)
+	return actual.(T), nil //nolint:errcheck // As above.
+}
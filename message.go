@@ -15,8 +15,10 @@
 package hyperpb
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"iter"
 	"unsafe"
 	_ "unsafe"
 
@@ -27,6 +29,8 @@ import (
 	"buf.build/go/hyperpb/internal/debug"
 	"buf.build/go/hyperpb/internal/tdp/dynamic"
 	"buf.build/go/hyperpb/internal/tdp/empty"
+	"buf.build/go/hyperpb/internal/tdp/repeated"
+	"buf.build/go/hyperpb/internal/tdp/thunks"
 	"buf.build/go/hyperpb/internal/tdp/vm"
 	"buf.build/go/hyperpb/internal/xprotoreflect"
 	"buf.build/go/hyperpb/internal/xunsafe"
@@ -68,6 +72,68 @@ func NewMessage(ty *MessageType) *Message {
 // This function will return the approximate offset into data at which the
 // error occurred.
 func (m *Message) Unmarshal(data []byte, options ...UnmarshalOption) error {
+	opts := newUnmarshalOptions(options)
+	ob := observeParse(m.impl.Type(), len(data))
+	var err error
+	if opts.Labels != nil {
+		withLabels(opts.Labels, m.impl.Type(), func() { err = vm.Run(&m.impl, data, opts) })
+	} else {
+		err = vm.Run(&m.impl, data, opts)
+	}
+	if opts.Verify != nil {
+		opts.Verify(&m.impl, data, err, xunsafe.NoEscape(&opts))
+	}
+	if err == nil && opts.RejectUnknownEnums {
+		err = checkEnums(m)
+	}
+	ob.parsed(m.impl.Type(), len(data), 1, err)
+	return err
+}
+
+// UnmarshalResult is the result of [Message.TryUnmarshal].
+type UnmarshalResult struct {
+	// Why parsing failed, or nil if it succeeded.
+	//
+	// This is the error that the error returned by [Message.Unmarshal] wraps,
+	// such as [io.ErrUnexpectedEOF], which is usually a preallocated sentinel
+	// value.
+	Err error
+	// The offset in the input at which parsing failed.
+	Offset int
+}
+
+// TryUnmarshal is like [Message.Unmarshal], but reports failure with a plain
+// value rather than an error. Unlike Unmarshal, failing to parse does not
+// allocate, so this is better for workloads where parse failures are common.
+func (m *Message) TryUnmarshal(data []byte, options ...UnmarshalOption) UnmarshalResult {
+	opts := newUnmarshalOptions(options)
+	ob := observeParse(m.impl.Type(), len(data))
+	var perr vm.ParseError
+	if opts.Labels != nil {
+		withLabels(opts.Labels, m.impl.Type(), func() { perr = vm.RunValue(&m.impl, data, opts) })
+	} else {
+		perr = vm.RunValue(&m.impl, data, opts)
+	}
+	if opts.Verify != nil {
+		var err error
+		if perr.Code() != vm.ErrorOk {
+			e := perr // Only allocate when verifying.
+			err = &e
+		}
+		opts.Verify(&m.impl, data, err, xunsafe.NoEscape(&opts))
+	}
+	res := UnmarshalResult{Err: perr.Unwrap(), Offset: perr.Offset()}
+	if perr.Code() == vm.ErrorOk && opts.RejectUnknownEnums {
+		if err := checkEnums(m); err != nil {
+			res = UnmarshalResult{Err: err, Offset: len(data)}
+		}
+	}
+	ob.parsed(m.impl.Type(), len(data), 1, res.Err)
+	return res
+}
+
+// newUnmarshalOptions applies options to the default [vm.Options].
+func newUnmarshalOptions(options []UnmarshalOption) vm.Options {
 	opts := vm.NewOptions()
 	for _, opt := range options {
 		if opt.apply != nil {
@@ -77,7 +143,7 @@ func (m *Message) Unmarshal(data []byte, options ...UnmarshalOption) error {
 			opt.apply(xunsafe.NoEscape(&opts))
 		}
 	}
-	return vm.Run(&m.impl, data, opts)
+	return opts
 }
 
 // Shared returns state shared by this message and its submessages.
@@ -85,6 +151,96 @@ func (m *Message) Shared() *Shared {
 	return wrapShared(m.impl.Shared)
 }
 
+// Verify checks that the input buffer this message was parsed from has not
+// been modified since parsing. See [Shared.Verify].
+func (m *Message) Verify() error {
+	return m.Shared().Verify()
+}
+
+// WireBytes returns the encoded bytes that m was parsed from, so that they can
+// be forwarded without re-encoding m. If m was parsed with [WithAllowAlias],
+// this is usually the slice passed to [Message.Unmarshal], although the parser
+// may still copy a buffer that ends too close to a page boundary; otherwise,
+// it is the private copy made before parsing.
+//
+// Returns nil if m is not the message that [Message.Unmarshal] was called on,
+// such as a submessage, or if m has not been unmarshaled. The original
+// encoding of a submessage is not recorded, because a submessage may be
+// assembled from several records that are merged together. Strings and bytes
+// fields already alias these bytes; see [Message.FieldStorage].
+//
+// The returned slice must not be modified.
+func (m *Message) WireBytes() []byte {
+	s := m.impl.Shared
+	if s.Root != &m.impl || s.Src == nil {
+		return nil
+	}
+	return unsafe.Slice(s.Src, s.Len)
+}
+
+// Fingerprint returns the fingerprint of the input m was parsed from, if it was
+// parsed with [WithFingerprint].
+//
+// Returns false if m was not parsed with that option, if m is not the message
+// that [Message.Unmarshal] was called on, such as a submessage, or if the
+// input was empty.
+func (m *Message) Fingerprint() (uint64, bool) {
+	s := m.impl.Shared
+	if s.Root != &m.impl || s.Src == nil {
+		return 0, false
+	}
+	return s.Fingerprint()
+}
+
+// Release marks this message as no longer in use, for the purposes of
+// [Shared.TrackMessages]. It must only be called on messages returned by
+// [Shared.NewMessage] or [NewMessage], at most once.
+//
+// Does nothing if tracking is not enabled.
+func (m *Message) Release() {
+	s := m.impl.Shared
+	if !s.Tracking {
+		return
+	}
+	if s.Live.Add(-1) < 0 {
+		s.Live.Add(1)
+		panic("hyperpb: released more messages than were allocated")
+	}
+}
+
+// Detach returns a copy of m that shares no memory with m's [Shared] or with
+// the buffer m was parsed from, so that it remains valid after [Shared.Free]
+// is called. This is an escape hatch for the occasional message that must
+// outlive a parse whose other messages are freed and re-used.
+//
+// The copy is made by encoding m as [Message.WriteTo] does and parsing the
+// result into a new [Shared] that belongs to the copy alone; its memory is
+// reclaimed by the garbage collector once the copy is unreachable. Fields
+// are copied exactly as they were accepted by the parse that produced them:
+// fields with a transform registered by [WithFieldTransform] are not
+// transformed again, and strings with invalid UTF-8 that were allowed by
+// [WithAllowInvalidUTF8] are copied as-is.
+func (m *Message) Detach() (*Message, error) {
+	if !m.IsValid() {
+		return nil, errInvalid
+	}
+
+	buf := new(bytes.Buffer)
+	e := &encoder{allowInvalidUTF8: true}
+	if _, err := e.encode(m, buf, DefaultChunkSize); err != nil {
+		return nil, err
+	}
+
+	detached := NewMessage(m.HyperType())
+	// buf belongs to detached, so there is no need to copy it again.
+	err := detached.Unmarshal(buf.Bytes(), WithAllowAlias(true), WithAllowInvalidUTF8(true),
+		UnmarshalOption{func(opts *vm.Options) { opts.SkipTransforms = true }})
+	if err != nil {
+		return nil, err
+	}
+	return detached, nil
+}
+
 // ProtoReflect implements [proto.Message].
 func (m *Message) ProtoReflect() protoreflect.Message {
 	return m
@@ -242,11 +398,29 @@ func (m *Message) Initialized() error {
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value.
 //
+// String and bytes values are returned without copying or allocating: they
+// alias memory owned by the message, so they must not be used after calling
+// [Shared.Free], and bytes values must not be modified.
+//
 // Get implements [protoreflect.Message].
 func (m *Message) Get(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	return m.impl.Get(fd)
 }
 
+// SampledCount returns the number of elements of the repeated field fd that
+// were present in the input, including those that were skipped because fd is
+// sampled; see [WithSampledFields]. For fields that are not sampled, this is
+// the length of the field's list.
+func (m *Message) SampledCount(fd protoreflect.FieldDescriptor) int {
+	ty := m.impl.Type()
+	if _, ok := ty.Samples[int32(fd.Number())]; ok && !fd.IsExtension() {
+		if f := ty.ByDescriptor(fd); f != nil {
+			return thunks.SampledCount(&m.impl, f.Offset)
+		}
+	}
+	return m.Get(fd).List().Len()
+}
+
 // Set panics.
 //
 // Set implements [protoreflect.Message].
@@ -254,18 +428,68 @@ func (m *Message) Set(protoreflect.FieldDescriptor, protoreflect.Value) {
 	panic(debug.Unsupported())
 }
 
-// Mutable panics.
+// Mutable returns the value of a populated message, repeated, or map field,
+// like [Message.Get]. Mutating the returned value panics.
+//
+// This allows read-modify-write code which only writes conditionally to
+// operate on hyperpb messages. Mutable panics on scalar fields and on
+// unpopulated fields, since returning a value would require modifying m.
+//
+// The exception is repeated scalar fields, populated or not, whose lists
+// support Append: new elements are allocated on m's [Shared], and elements
+// that alias the input buffer are first copied to it. Appending is not safe
+// to do concurrently with any other access to m. This also panics if m was
+// parsed with [WithDedupMessages], since m may be shared by several elements
+// of a repeated field.
 //
 // Mutable implements [protoreflect.Message].
-func (m *Message) Mutable(protoreflect.FieldDescriptor) protoreflect.Value {
+func (m *Message) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	if fd.IsList() && fd.Message() == nil && !fd.IsExtension() {
+		if f := m.impl.Type().ByDescriptor(fd); f.IsValid() {
+			m.impl.CheckMutable()
+			if f.Offset.Data < 0 {
+				m.impl.MutableCold()
+			}
+			list, ok := repeated.Appendable(m.Get(fd).List(), m.impl.Shared.Arena())
+			if ok {
+				return protoreflect.ValueOfList(list)
+			}
+		}
+	}
+
+	if (fd.IsList() || fd.IsMap() || fd.Message() != nil) && m.Has(fd) {
+		return m.Get(fd)
+	}
 	panic(debug.Unsupported())
 }
 
-// NewField panics.
+// NewField returns a new value that is assignable to the field for the given
+// descriptor.
+//
+// For scalars, this returns the default value. For lists and maps, this
+// returns an empty, read-only value. For messages, this returns a new, empty
+// message with its own [Shared], which may be unmarshaled into.
 //
 // NewField implements [protoreflect.Message].
-func (m *Message) NewField(protoreflect.FieldDescriptor) protoreflect.Value {
-	panic(debug.Unsupported())
+func (m *Message) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	f := m.impl.Type().ByDescriptor(fd)
+	if !f.IsValid() {
+		panic("invalid field descriptor " + string(fd.FullName()) + " for message " + string(m.Descriptor().FullName()))
+	}
+
+	switch {
+	case fd.IsList():
+		return protoreflect.ValueOfList(empty.List{})
+	case fd.IsMap():
+		return protoreflect.ValueOfMap(empty.Map{})
+	case fd.Message() != nil:
+		return protoreflect.ValueOfMessage(NewMessage(wrapType(f.Message)))
+	case fd.Kind() == protoreflect.BytesKind:
+		// Default bytes values must be copies.
+		return protoreflect.ValueOfBytes(bytes.Clone(fd.Default().Bytes()))
+	default:
+		return f.Default.Value(fd)
+	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
@@ -305,25 +529,30 @@ func (m *Message) GetUnknown() protoreflect.RawFields {
 		return nil
 	}
 
-	if cold.Unknown.Len() == 1 {
+	switch {
+	case cold.Unknown.Len() == 1 && cold.OwnedUnknown.Len() == 0:
 		return cold.Unknown.Ptr().Bytes(m.Shared().impl.Src)
+	case cold.Unknown.Len() == 0:
+		return cold.OwnedUnknown.Raw()
 	}
 
-	var out []byte
-	for _, zc := range cold.Unknown.Raw() {
-		out = append(out, zc.Bytes(m.Shared().impl.Src)...)
-	}
-	return out
+	return m.impl.AppendUnknown(nil)
 }
 
-// SetUnknown panics, unless raw is zero-length, in which case it does nothing.
+// SetUnknown replaces the entire list of unknown fields with raw, which is
+// copied onto m's [Shared]. If raw is zero-length, this clears the unknown
+// fields instead, which does not allocate.
+//
+// This allows unknown fields to be stripped from a message before it is
+// serialized, without copying the message. It is not safe to call
+// concurrently with any other access to m.
+//
+// Panics if m was parsed with [WithDedupMessages], since m may be shared by
+// several elements of a repeated field.
 //
 // SetUnknown implements [protoreflect.Message].
 func (m *Message) SetUnknown(raw protoreflect.RawFields) {
-	if len(raw) == 0 {
-		return
-	}
-	panic(debug.Unsupported())
+	m.impl.SetUnknown(raw)
 }
 
 // IsValid reports whether the message is valid.
@@ -369,6 +598,32 @@ func requiredShim(in protoiface.CheckInitializedInput) (out protoiface.CheckInit
 	return out, in.Message.(*Message).Initialized()
 }
 
+// Messages returns an iterator over the messages in a list returned by
+// [Message.Get] for a repeated message field.
+//
+// Unlike calling Get on the list, this does not need to wrap each element in a
+// [protoreflect.Value]. If list did not come from a [Message], its elements are
+// obtained with Get instead, and must all be [*Message]s.
+func Messages(list protoreflect.List) iter.Seq[*Message] {
+	return func(yield func(*Message) bool) {
+		raw, ok := repeated.MessageList(list)
+		if !ok {
+			for i := range list.Len() {
+				if !yield(xprotoreflect.GetMessage[*Message](list.Get(i))) {
+					return
+				}
+			}
+			return
+		}
+
+		for m := range raw.Values() {
+			if !yield(wrapMessage(m)) {
+				return
+			}
+		}
+	}
+}
+
 // wrapMessage wraps an internal Message pointer.
 func wrapMessage(m *dynamic.Message) *Message {
 	return xunsafe.Cast[Message](m)
@@ -0,0 +1,1129 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"runtime"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/internal/debug"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+	"buf.build/go/hyperpb/internal/prototest"
+	"buf.build/go/hyperpb/internal/testdata"
+	"buf.build/go/hyperpb/internal/xxhash"
+)
+
+//nolint:paralleltest // AllocsPerRun panics in parallel tests.
+func TestGetStringNoAlloc(t *testing.T) {
+	if debug.Enabled {
+		t.Skip("debug mode allocates when logging")
+	}
+
+	b14 := "optional"
+	scalars, err := proto.Marshal(&testpb.Scalars{A14: "hello", A15: []byte("world"), B14: &b14})
+	require.NoError(t, err)
+	repeated, err := proto.Marshal(&testpb.Repeated{R7: []string{"a", "b"}, R8: [][]byte{[]byte("c")}})
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		msg    proto.Message
+		data   []byte
+		fields []protoreflect.Name
+	}{
+		{&testpb.Scalars{}, scalars, []protoreflect.Name{"a14", "a15", "b14"}},
+		{&testpb.Repeated{}, repeated, []protoreflect.Name{"r7", "r8"}},
+	} {
+		md := tt.msg.ProtoReflect().Descriptor()
+		m := hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(md))
+		require.NoError(t, m.Unmarshal(tt.data))
+
+		for _, name := range tt.fields {
+			fd := md.Fields().ByName(name)
+			allocs := testing.AllocsPerRun(100, func() {
+				v := m.Get(fd)
+				if fd.IsList() {
+					v = v.List().Get(0)
+				}
+				if fd.Kind() == protoreflect.StringKind {
+					_ = v.String()
+				} else {
+					_ = v.Bytes()
+				}
+			})
+			assert.Zero(t, allocs, "%s", fd.FullName())
+		}
+	}
+}
+
+//nolint:paralleltest // AllocsPerRun panics in parallel tests.
+func TestGetMapNoAlloc(t *testing.T) {
+	if debug.Enabled {
+		t.Skip("debug mode allocates when logging")
+	}
+
+	md := (*testpb.Maps)(nil).ProtoReflect().Descriptor()
+	data, err := proto.Marshal(&testpb.Maps{
+		M10: map[int32]int32{1: 2, 3: 4},
+		Mb0: map[bool]int32{true: 5},
+		Mc0: map[string]int32{"a": 6, "b": 7},
+	})
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(md))
+	require.NoError(t, m.Unmarshal(data))
+
+	// Map values are views of the message's storage, so getting one and
+	// looking up keys in it, repeatedly, should not allocate.
+	for _, tt := range []struct {
+		name protoreflect.Name
+		key  protoreflect.MapKey
+	}{
+		{"m10", protoreflect.ValueOfInt32(3).MapKey()},
+		{"mb0", protoreflect.ValueOfBool(true).MapKey()},
+		{"mc0", protoreflect.ValueOfString("b").MapKey()},
+		{"m11", protoreflect.ValueOfInt32(1).MapKey()}, // Empty.
+	} {
+		fd := md.Fields().ByName(tt.name)
+		allocs := testing.AllocsPerRun(100, func() {
+			if v := m.Get(fd).Map().Get(tt.key); v.IsValid() {
+				_ = v.Int()
+			}
+		})
+		assert.Zero(t, allocs, "%s", fd.FullName())
+	}
+}
+
+func TestTrackAccesses(t *testing.T) {
+	t.Parallel()
+
+	md := (&testpb.Scalars{}).ProtoReflect().Descriptor()
+	assert.Panics(t, func() { hyperpb.CompileMessageDescriptor(md).TrackAccesses(true) })
+
+	ty := hyperpb.CompileMessageDescriptor(md, hyperpb.WithTrackAccesses(true))
+	data, err := proto.Marshal(&testpb.Scalars{A1: 1, A2: 2})
+	require.NoError(t, err)
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	a1, a2, a3 := md.Fields().ByName("a1"), md.Fields().ByName("a2"), md.Fields().ByName("a3")
+
+	m.Get(a1)
+	assert.Nil(t, ty.Accesses())
+
+	ty.TrackAccesses(true)
+	m.Get(a1)
+	m.Get(a1)
+	m.Has(a3)
+	m.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool { return true })
+
+	counts := make(map[protoreflect.Name]uint64)
+	for _, fa := range ty.Accesses() {
+		counts[fa.Field.Name()] = fa.Count
+	}
+	assert.Len(t, ty.Accesses(), md.Fields().Len())
+	assert.Equal(t, uint64(3), counts["a1"])
+	assert.Equal(t, uint64(1), counts["a2"])
+	assert.Equal(t, uint64(1), counts["a3"])
+	assert.Equal(t, uint64(0), counts["a4"])
+
+	ty.TrackAccesses(false)
+	m.Get(a2)
+	assert.Nil(t, ty.Accesses())
+}
+
+//nolint:paralleltest // AllocsPerRun panics in parallel tests.
+func TestMessages(t *testing.T) {
+	md := (*testpb.Graph)(nil).ProtoReflect().Descriptor()
+	data, err := proto.Marshal(&testpb.Graph{
+		R: []*testpb.Graph{{V: 1}, {V: 2}, {V: 3}},
+	})
+	require.NoError(t, err)
+
+	m := hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(md))
+	require.NoError(t, m.Unmarshal(data))
+
+	list := m.Get(md.Fields().ByName("r")).List()
+	v := md.Fields().ByName("v")
+	var got []int32
+	for m := range hyperpb.Messages(list) {
+		got = append(got, int32(m.Get(v).Int()))
+	}
+	assert.Equal(t, []int32{1, 2, 3}, got)
+
+	if !debug.Enabled {
+		allocs := testing.AllocsPerRun(100, func() {
+			for m := range hyperpb.Messages(list) {
+				_ = m
+			}
+		})
+		assert.Zero(t, allocs)
+	}
+
+	empty := hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(md))
+	for range hyperpb.Messages(empty.Get(md.Fields().ByName("r")).List()) {
+		t.Fatal("unexpected element")
+	}
+}
+
+func TestNewFieldAndMutable(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Graph)(nil).ProtoReflect().Descriptor())
+	fields := ty.Descriptor().Fields()
+	v, s, r := fields.ByName("v"), fields.ByName("s"), fields.ByName("r")
+
+	data, err := proto.Marshal(&testpb.Graph{S: &testpb.Graph{V: 1}})
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+
+	assert.Equal(t, int64(0), m.NewField(v).Int())
+	assert.Zero(t, m.NewField(r).List().Len())
+
+	sub := m.NewField(s).Message()
+	require.True(t, sub.IsValid())
+	require.NoError(t, proto.Unmarshal([]byte{0x08, 0x02}, sub.Interface()))
+	assert.Equal(t, int64(2), sub.Get(v).Int())
+
+	assert.Equal(t, int64(1), m.Mutable(s).Message().Get(v).Int())
+	assert.Panics(t, func() { m.Mutable(r) })
+	assert.Panics(t, func() { m.Mutable(v) })
+}
+
+func TestAppendRepeatedScalars(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Repeated]()
+	fields := ty.Descriptor().Fields()
+
+	data, err := proto.Marshal(&testpb.Repeated{
+		R1: []int32{1, 2, 3},
+		R3: []int32{-1, 5},
+		R5: []uint32{7, 8},
+		R7: []string{"a"},
+	})
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+
+	m.Mutable(fields.ByName("r1")).List().Append(protoreflect.ValueOfInt32(-4))
+	m.Mutable(fields.ByName("r2")).List().Append(protoreflect.ValueOfInt64(1 << 40))
+	m.Mutable(fields.ByName("r3")).List().Append(protoreflect.ValueOfInt32(math.MinInt32))
+	r5 := m.Mutable(fields.ByName("r5")).List()
+	for i := range 100 {
+		r5.Append(protoreflect.ValueOfUint32(uint32(i)))
+	}
+
+	want := &testpb.Repeated{
+		R1: []int32{1, 2, 3, -4},
+		R2: []int64{1 << 40},
+		R3: []int32{-1, 5, math.MinInt32},
+		R5: []uint32{7, 8},
+		R7: []string{"a"},
+	}
+	for i := range 100 {
+		want.R5 = append(want.R5, uint32(i))
+	}
+	data, err = proto.Marshal(m)
+	require.NoError(t, err)
+	got := new(testpb.Repeated)
+	require.NoError(t, proto.Unmarshal(data, got))
+	prototest.Equal(t, want, got)
+
+	assert.Panics(t, func() {
+		m.Mutable(fields.ByName("r1")).List().Append(protoreflect.ValueOfInt64(1))
+	})
+	assert.Panics(t, func() { m.Mutable(fields.ByName("r8")) })
+}
+
+func TestDefaults(t *testing.T) {
+	t.Parallel()
+
+	type D = descriptorpb.FieldDescriptorProto_Type
+	defaults := []struct {
+		ty    D
+		value string
+	}{
+		{descriptorpb.FieldDescriptorProto_TYPE_INT32, "-5"},
+		{descriptorpb.FieldDescriptorProto_TYPE_INT64, "-5000000000"},
+		{descriptorpb.FieldDescriptorProto_TYPE_UINT32, "5"},
+		{descriptorpb.FieldDescriptorProto_TYPE_UINT64, "18446744073709551615"},
+		{descriptorpb.FieldDescriptorProto_TYPE_SINT32, "-7"},
+		{descriptorpb.FieldDescriptorProto_TYPE_SINT64, "-7"},
+		{descriptorpb.FieldDescriptorProto_TYPE_FIXED32, "9"},
+		{descriptorpb.FieldDescriptorProto_TYPE_FIXED64, "9"},
+		{descriptorpb.FieldDescriptorProto_TYPE_SFIXED32, "-9"},
+		{descriptorpb.FieldDescriptorProto_TYPE_SFIXED64, "-9"},
+		{descriptorpb.FieldDescriptorProto_TYPE_FLOAT, "1.5"},
+		{descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, "-inf"},
+		{descriptorpb.FieldDescriptorProto_TYPE_BOOL, "true"},
+		{descriptorpb.FieldDescriptorProto_TYPE_STRING, "hello"},
+		{descriptorpb.FieldDescriptorProto_TYPE_BYTES, "world"},
+		{descriptorpb.FieldDescriptorProto_TYPE_ENUM, "TWO"},
+		{descriptorpb.FieldDescriptorProto_TYPE_INT32, ""},
+	}
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("defaults.proto"),
+		Package: proto.String("hyperpb.test"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Defaults"),
+		}},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("E"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("ONE"), Number: proto.Int32(1)},
+				{Name: proto.String("TWO"), Number: proto.Int32(2)},
+			},
+		}},
+	}
+	for i, d := range defaults {
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(fmt.Sprintf("f%d", i+1)),
+			Number: proto.Int32(int32(i + 1)),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   d.ty.Enum(),
+		}
+		if d.value != "" {
+			f.DefaultValue = proto.String(d.value)
+		}
+		if d.ty == descriptorpb.FieldDescriptorProto_TYPE_ENUM {
+			f.TypeName = proto.String(".hyperpb.test.E")
+		}
+		fdp.MessageType[0].Field = append(fdp.MessageType[0].Field, f)
+	}
+	fd, err := protodesc.NewFile(fdp, nil)
+	require.NoError(t, err)
+	md := fd.Messages().Get(0)
+	ty := hyperpb.CompileMessageDescriptor(md)
+
+	want := dynamicpb.NewMessage(md)
+	for _, m := range []*hyperpb.Message{
+		hyperpb.NewMessage(ty),
+		func() *hyperpb.Message {
+			m := hyperpb.NewMessage(ty)
+			require.NoError(t, m.Unmarshal([]byte{0xf8, 0x01, 0x01})) // Unknown field 31.
+			return m
+		}(),
+	} {
+		for i := range md.Fields().Len() {
+			fd := md.Fields().Get(i)
+			assert.False(t, m.Has(fd), "%v", fd)
+			assert.True(t, want.Get(fd).Equal(m.Get(fd)), "%v: got %v, want %v", fd, m.Get(fd), want.Get(fd))
+		}
+	}
+}
+
+func TestProfileMerge(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Repeated)(nil).ProtoReflect().Descriptor())
+	data, err := proto.Marshal(&testpb.Repeated{R1: []int32{1, 2, 3}})
+	require.NoError(t, err)
+
+	// Record into one shared profile and several per-goroutine profiles at
+	// the same time.
+	shared := ty.NewProfile()
+	profiles := make([]*hyperpb.Profile, 4)
+	var wg sync.WaitGroup
+	for i := range profiles {
+		profiles[i] = ty.NewProfile()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s := new(hyperpb.Shared)
+			for range 100 {
+				m := s.NewMessage(ty)
+				assert.NoError(t, m.Unmarshal(data,
+					hyperpb.WithRecordProfile(shared, 1),
+				))
+				s.Free()
+				m = s.NewMessage(ty)
+				assert.NoError(t, m.Unmarshal(data,
+					hyperpb.WithRecordProfile(profiles[i], 1),
+				))
+				s.Free()
+			}
+		}()
+	}
+	wg.Wait()
+
+	merged := ty.NewProfile()
+	for _, p := range profiles {
+		merged.Merge(p)
+	}
+
+	// Profiles can also be merged across recompiled types.
+	ty2 := ty.Recompile(merged)
+	merged2 := ty2.NewProfile()
+	merged2.Merge(shared)
+
+	for _, ty := range []*hyperpb.MessageType{ty2, ty2.Recompile(merged2)} {
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data))
+		assert.Equal(t, 3, m.Get(ty.Descriptor().Fields().ByName("r1")).List().Len())
+	}
+}
+
+func TestProfileDenseMapKeys(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Maps)(nil).ProtoReflect().Descriptor())
+	histogram := &testpb.Maps{M13: make(map[int32]uint64)}
+	for i := range 1024 {
+		histogram.M13[int32(i)] = uint64(i * i)
+	}
+	data, err := proto.Marshal(histogram)
+	require.NoError(t, err)
+
+	profile := ty.NewProfile()
+	s := new(hyperpb.Shared)
+	for range 10 {
+		require.NoError(t, s.NewMessage(ty).Unmarshal(data, hyperpb.WithRecordProfile(profile, 1)))
+		s.Free()
+	}
+	dense := ty.Recompile(profile)
+
+	// Keys that the profile did not see are still stored.
+	histogram.M13[-1] = 1
+	histogram.M13[1024] = 2
+	histogram.M13[1<<20] = 3
+	data, err = proto.Marshal(histogram)
+	require.NoError(t, err)
+
+	m := s.NewMessage(dense)
+	require.NoError(t, m.Unmarshal(data))
+	assert.True(t, proto.Equal(histogram, m))
+	m13 := m.Get(dense.Descriptor().Fields().ByName("m13")).Map()
+	assert.Equal(t, len(histogram.M13), m13.Len())
+	assert.Equal(t, uint64(9), m13.Get(protoreflect.ValueOfInt32(3).MapKey()).Uint())
+	assert.Equal(t, uint64(3), m13.Get(protoreflect.ValueOfInt32(1<<20).MapKey()).Uint())
+	assert.False(t, m13.Has(protoreflect.ValueOfInt32(2000).MapKey()))
+	s.Free()
+}
+
+func TestFields(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Oneof)(nil).ProtoReflect().Descriptor())
+	fields := ty.Fields()
+	require.Len(t, fields, ty.Descriptor().Fields().Len())
+	assert.Same(t, &fields[0], &ty.Fields()[0])
+
+	byName := make(map[protoreflect.Name]hyperpb.FieldInfo)
+	for i, f := range fields {
+		assert.Equal(t, i, f.Index)
+		assert.Equal(t, ty.Descriptor().Fields().Get(i), f.Descriptor)
+		assert.Equal(t, f.Descriptor.Kind(), f.Kind)
+		assert.Equal(t, hyperpb.LocationHot, f.Location)
+		byName[f.Descriptor.Name()] = f
+	}
+	assert.Equal(t, hyperpb.PresenceOneof, byName["s1"].Presence)
+	assert.Equal(t, hyperpb.PresenceOneof, byName["m1"].Presence)
+	assert.Equal(t, hyperpb.PresenceImplicit, byName["tail"].Presence)
+
+	data, err := proto.Marshal(&testpb.Oneof{Multi: &testpb.Oneof_M1{M1: 5}, Tail: 7})
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	for _, f := range fields {
+		assert.Equal(t, m.Has(f.Descriptor), f.Has(m), "%v", f.Descriptor)
+		assert.True(t, m.Get(f.Descriptor).Equal(f.Get(m)), "%v", f.Descriptor)
+	}
+
+	graph := hyperpb.CompileMessageDescriptor((*testpb.Graph)(nil).ProtoReflect().Descriptor())
+	gf := graph.Fields()
+	assert.Equal(t, hyperpb.PresenceExplicit, gf[1].Presence)
+	assert.Equal(t, hyperpb.PresenceRepeated, gf[2].Presence)
+	assert.Equal(t, protoreflect.Repeated, gf[2].Cardinality)
+	assert.Panics(t, func() { gf[0].Get(m) })
+}
+
+//nolint:paralleltest // AllocsPerRun panics in parallel tests.
+func TestFieldByName(t *testing.T) {
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Maps)(nil).ProtoReflect().Descriptor())
+	fields := ty.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		f := ty.FieldByName(fd.Name())
+		require.NotNil(t, f, "%v", fd.Name())
+		assert.Same(t, &ty.Fields()[i], f)
+	}
+	assert.Nil(t, ty.FieldByName("nope"))
+	assert.Nil(t, ty.FieldByName(""))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = ty.FieldByName("mce")
+	})
+	assert.Zero(t, allocs)
+}
+
+//nolint:paralleltest // AllocsPerRun panics in parallel tests.
+func TestFieldByJSONName(t *testing.T) {
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Maps)(nil).ProtoReflect().Descriptor())
+	fields := ty.Descriptor().Fields()
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		f := ty.FieldByJSONName(fd.JSONName())
+		require.NotNil(t, f, "%v", fd.JSONName())
+		assert.Same(t, &ty.Fields()[i], f)
+	}
+	assert.Nil(t, ty.FieldByJSONName("nope"))
+	assert.Nil(t, ty.FieldByJSONName(""))
+
+	name := fields.Get(0).JSONName()
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = ty.FieldByJSONName(name)
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestDetach(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Graph)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md)
+	want := &testpb.Graph{V: 1, S: &testpb.Graph{V: 2}, R: []*testpb.Graph{{V: 3}, {V: 4}}}
+	data, err := proto.Marshal(want)
+	require.NoError(t, err)
+	data = append(data, 0xf8, 0x01, 0x05) // Unknown field 31.
+
+	s := new(hyperpb.Shared)
+	m := s.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithAllowAlias(true)))
+	detached, err := m.Detach()
+	require.NoError(t, err)
+	sub, err := m.Get(md.Fields().ByName("s")).Message().(*hyperpb.Message).Detach()
+	require.NoError(t, err)
+	assert.NotSame(t, s, detached.Shared())
+
+	// Neither freeing and re-using the Shared nor clobbering the input may
+	// affect the detached messages.
+	s.Free()
+	require.NoError(t, s.NewMessage(ty).Unmarshal([]byte{0x08, 0x63}))
+	clear(data)
+
+	assert.Equal(t, []byte{0xf8, 0x01, 0x05}, []byte(detached.GetUnknown()))
+	got := new(testpb.Graph)
+	proto.Merge(got, detached)
+	got.ProtoReflect().SetUnknown(nil)
+	assert.True(t, proto.Equal(want, got), "got %v", got)
+	assert.Equal(t, int64(2), sub.Get(md.Fields().ByName("v")).Int())
+
+	// Invalid UTF-8 accepted by the original parse survives detaching.
+	md = (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	a14 := md.Fields().ByName("a14")
+	data = protowire.AppendString(protowire.AppendTag(nil, a14.Number(), protowire.BytesType), "\xffoops")
+	m = hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(md))
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithAllowInvalidUTF8(true)))
+	detached, err = m.Detach()
+	require.NoError(t, err)
+	assert.Equal(t, "\xffoops", detached.Get(a14).String())
+}
+
+func TestInternStrings(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	a14 := md.Fields().ByName("a14")
+	data, err := proto.Marshal(&testpb.Scalars{A14: "interned label value"})
+	require.NoError(t, err)
+
+	plain := hyperpb.CompileMessageDescriptor(md)
+	ty := hyperpb.CompileMessageDescriptor(md, hyperpb.WithInternStrings(true))
+
+	get := func(ty *hyperpb.MessageType) string {
+		s := new(hyperpb.Shared)
+		m := s.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data))
+		v := m.Get(a14).String()
+		// Values are interned once, when parsed, so reading them again must
+		// not count towards the stats.
+		assert.Same(t, unsafe.StringData(v), unsafe.StringData(m.Get(a14).String()))
+		s.Free()
+		return v
+	}
+
+	a, b := get(ty), get(ty)
+	assert.Equal(t, "interned label value", a)
+	assert.Equal(t, a, b)
+	assert.Same(t, unsafe.StringData(a), unsafe.StringData(b))
+
+	stats := ty.InternStats()
+	assert.Equal(t, uint64(2), stats.Strings)
+	assert.Equal(t, uint64(1), stats.Hits)
+	assert.InDelta(t, 0.5, stats.Ratio(), 0.001)
+	assert.Zero(t, plain.InternStats())
+	runtime.KeepAlive(a)
+
+	// Oneof members are interned too, and are stored only while selected.
+	od := (*testpb.Oneof)(nil).ProtoReflect().Descriptor()
+	m8 := od.Fields().ByName("m8")
+	data, err = proto.Marshal(&testpb.Oneof{Multi: &testpb.Oneof_M8{M8: "member"}})
+	require.NoError(t, err)
+	oty := hyperpb.CompileMessageDescriptor(od, hyperpb.WithInternStrings(true))
+	m := hyperpb.NewMessage(oty)
+	require.NoError(t, m.Unmarshal(append(data, protowire.AppendVarint([]byte{11 << 3}, 5)...)))
+	assert.False(t, m.Has(m8))
+	m = hyperpb.NewMessage(oty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.Equal(t, "member", m.Get(m8).String())
+	assert.Equal(t, m8, m.WhichOneof(m8.ContainingOneof()))
+}
+
+func TestFieldLocation(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Graph)(nil).ProtoReflect().Descriptor()
+	fields := md.Fields()
+	v, s, r := fields.ByName("v"), fields.ByName("s"), fields.ByName("r")
+
+	ty := hyperpb.CompileMessageDescriptor(md,
+		hyperpb.WithFieldLocation(hyperpb.LocationCold, v.FullName(), s.FullName(), r.FullName()),
+		hyperpb.WithFieldLocation(hyperpb.LocationHot, s.FullName()),
+	)
+	for _, f := range ty.Fields() {
+		want := hyperpb.LocationCold
+		if f.Descriptor == s {
+			want = hyperpb.LocationHot
+		}
+		assert.Equal(t, want, f.Location, "%v", f.Descriptor)
+	}
+
+	want := &testpb.Graph{V: 1, S: &testpb.Graph{V: 2}, R: []*testpb.Graph{{V: 3}, {}}}
+	data, err := proto.Marshal(want)
+	require.NoError(t, err)
+
+	for _, data := range [][]byte{data, nil} {
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data))
+		got := new(testpb.Graph)
+		proto.Merge(got, m)
+		if data == nil {
+			assert.Empty(t, got.String())
+			assert.False(t, m.Has(v))
+			continue
+		}
+		assert.True(t, proto.Equal(want, got), "got %v", got)
+	}
+
+	oneof := (*testpb.Oneof)(nil).ProtoReflect().Descriptor()
+	ty = hyperpb.CompileMessageDescriptor(oneof,
+		hyperpb.WithFieldLocation(hyperpb.LocationCold, oneof.Fields().ByName("m2").FullName()),
+	)
+	for _, f := range ty.Fields() {
+		cold := f.Descriptor.ContainingOneof() != nil && f.Descriptor.ContainingOneof().Name() == "multi"
+		assert.Equal(t, cold, f.Location == hyperpb.LocationCold, "%v", f.Descriptor)
+	}
+}
+
+func TestRecompileStableLayout(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	a1, a2 := md.Fields().ByName("a1"), md.Fields().ByName("a2")
+	ty := hyperpb.CompileMessageDescriptor(md, hyperpb.WithFieldLocation(hyperpb.LocationCold, a2.FullName()))
+	data, err := proto.Marshal(&testpb.Scalars{A1: 1, A2: 2})
+	require.NoError(t, err)
+
+	profile := ty.NewProfile()
+	for range 10 {
+		require.NoError(t, hyperpb.NewMessage(ty).Unmarshal(data, hyperpb.WithRecordProfile(profile, 1)))
+	}
+	locations := func(ty *hyperpb.MessageType) []hyperpb.FieldLocation {
+		var out []hyperpb.FieldLocation
+		for _, f := range ty.Fields() {
+			out = append(out, f.Location)
+		}
+		return out
+	}
+	assert.Equal(t, hyperpb.LocationCold, ty.Fields()[a2.Index()].Location)
+
+	moved := ty.Recompile(profile, hyperpb.WithFieldLocation(hyperpb.LocationCold, a1.FullName()))
+	assert.NotEqual(t, locations(ty), locations(moved))
+
+	for _, stable := range []*hyperpb.MessageType{
+		hyperpb.CompileMessageDescriptor(md, hyperpb.WithLayoutOf(ty)),
+		ty.Recompile(profile, hyperpb.WithLayoutOf(ty)),
+		moved.Recompile(profile, hyperpb.WithLayoutOf(ty)),
+	} {
+		assert.Equal(t, locations(ty), locations(stable))
+		m := hyperpb.NewMessage(stable)
+		require.NoError(t, m.Unmarshal(data))
+		assert.Equal(t, int64(2), m.Get(a2).Int())
+	}
+}
+
+func TestWireBytes(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Graph)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md)
+	data, err := proto.Marshal(&testpb.Graph{V: 1, S: &testpb.Graph{V: 2}})
+	require.NoError(t, err)
+
+	for _, alias := range []bool{false, true} {
+		m := hyperpb.NewMessage(ty)
+		assert.Nil(t, m.WireBytes())
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithAllowAlias(alias)))
+		assert.Equal(t, data, m.WireBytes())
+
+		sub := m.Get(md.Fields().ByName("s")).Message().(*hyperpb.Message)
+		assert.Nil(t, sub.WireBytes())
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Graph)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md)
+	data, err := proto.Marshal(&testpb.Graph{V: 1, S: &testpb.Graph{V: 2}})
+	require.NoError(t, err)
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	_, ok := m.Fingerprint()
+	assert.False(t, ok)
+
+	m = hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithFingerprint(true)))
+	fp, ok := m.Fingerprint()
+	require.True(t, ok)
+	assert.Equal(t, xxhash.Sum64(data), fp)
+
+	sub := m.Get(md.Fields().ByName("s")).Message().(*hyperpb.Message)
+	_, ok = sub.Fingerprint()
+	assert.False(t, ok)
+
+	// Unknown fields, including those of submessages, are left out. The
+	// length prefix of a submessage still counts its unknown fields.
+	unknown := protowire.AppendVarint(protowire.AppendTag(nil, 100, protowire.VarintType), 42)
+	graph := func(unknown []byte) []byte {
+		sub := protowire.AppendVarint(protowire.AppendTag(nil, 1, protowire.VarintType), 2)
+		sub = append(sub, unknown...)
+
+		b := append([]byte{}, unknown...)
+		b = protowire.AppendVarint(protowire.AppendTag(b, 1, protowire.VarintType), 1)
+		b = protowire.AppendBytes(protowire.AppendTag(b, 2, protowire.BytesType), sub)
+		b = append(b, unknown...)
+		return b
+	}
+	want := graph(nil)
+	want[3] += byte(len(unknown)) // The length prefix of s.
+
+	for _, discard := range []bool{false, true} {
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(graph(unknown),
+			hyperpb.WithFingerprint(true),
+			hyperpb.WithDiscardUnknown(discard)))
+		fp, ok := m.Fingerprint()
+		require.True(t, ok)
+		assert.Equal(t, xxhash.Sum64(want), fp)
+	}
+}
+
+func TestMemo(t *testing.T) {
+	t.Parallel()
+
+	type jsonKey struct{}
+	var calls int
+	toJSON := func(m *hyperpb.Message) ([]byte, error) {
+		calls++
+		return protojson.Marshal(m)
+	}
+
+	md := (*testpb.Graph)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md)
+	data, err := proto.Marshal(&testpb.Graph{V: 1, S: &testpb.Graph{V: 2}})
+	require.NoError(t, err)
+
+	s := new(hyperpb.Shared)
+	m := s.NewMessage(ty)
+	_, err = hyperpb.Memo(m, jsonKey{}, toJSON)
+	require.NoError(t, err)
+	require.NoError(t, m.Unmarshal(data))
+
+	for range 3 {
+		got, err := hyperpb.Memo(m, jsonKey{}, toJSON)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"v":1,"s":{"v":2}}`, string(got))
+	}
+	assert.Equal(t, 2, calls)
+
+	sub := m.Get(md.Fields().ByName("s")).Message().(*hyperpb.Message)
+	got, err := hyperpb.Memo(sub, jsonKey{}, toJSON)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"v":2}`, string(got))
+	assert.Equal(t, 3, calls)
+
+	errFailed := errors.New("failed")
+	for range 2 {
+		_, err = hyperpb.Memo(m, "size", func(*hyperpb.Message) (int, error) {
+			calls++
+			return 0, errFailed
+		})
+		require.ErrorIs(t, err, errFailed)
+	}
+	assert.Equal(t, 5, calls)
+	assert.Panics(t, func() {
+		_, _ = hyperpb.Memo(m, jsonKey{}, func(*hyperpb.Message) (int, error) { return 0, nil })
+	})
+
+	s.Free()
+	m = s.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data[:2]))
+	got, err = hyperpb.Memo(m, jsonKey{}, toJSON)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"v":1}`, string(got))
+	assert.Equal(t, 6, calls)
+}
+
+func TestAsDynamic(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.MessageMaps)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md)
+	want := &testpb.MessageMaps{
+		Scalars: &testpb.Scalars{A1: 1, A14: "hello"},
+		M1:      map[int32]*testpb.MessageMaps{5: {Scalars: &testpb.Scalars{A2: 2}}},
+	}
+	data, err := proto.Marshal(want)
+	require.NoError(t, err)
+	data = append(data, 0xf8, 0x01, 0x05) // Unknown field 31.
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	dm := hyperpb.AsDynamic(m)
+	assert.Equal(t, md, dm.Descriptor())
+	assert.Equal(t, []byte{0xf8, 0x01, 0x05}, []byte(dm.GetUnknown()))
+
+	got := new(testpb.MessageMaps)
+	proto.Merge(got, dm)
+	got.ProtoReflect().SetUnknown(nil)
+	assert.True(t, proto.Equal(want, got), "got %v", got)
+}
+
+func TestDispatchStats(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Scalars]()
+	data, err := proto.Marshal(&testpb.Scalars{B1: proto.Int32(1), B8: proto.Uint64(8), B15: []byte("x")})
+	require.NoError(t, err)
+	corpus := [][]byte{data, data, data}
+
+	profile := ty.NewProfile()
+	for _, data := range corpus {
+		require.NoError(t, hyperpb.NewMessage(ty).Unmarshal(data, hyperpb.WithRecordProfile(profile, 1)))
+	}
+
+	before := ty.DispatchStats(corpus)
+	after := ty.Recompile(profile).DispatchStats(corpus)
+	t.Logf("before: %+v, after: %+v", before, after)
+
+	assert.Equal(t, 3, before.Messages)
+	assert.Equal(t, 9, before.Fields)
+	assert.Equal(t, before.Fields, after.Fields)
+	assert.Positive(t, before.MissesPerMessage())
+	assert.Less(t, after.ProbesPerMessage()+after.MissesPerMessage(),
+		before.ProbesPerMessage()+before.MissesPerMessage())
+}
+
+func TestDispatchTables(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, number int32) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   descriptorpb.FieldDescriptorProto_TYPE_INT32.Enum(),
+		}
+	}
+	extension := func(name string, number int32) *descriptorpb.FieldDescriptorProto {
+		xd := field(name, number)
+		xd.Extendee = proto.String(".hyperpb.test.Extendable")
+		return xd
+	}
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("extendable.proto"),
+		Package: proto.String("hyperpb.test"),
+		Syntax:  proto.String("proto2"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("Extendable"),
+			Field: []*descriptorpb.FieldDescriptorProto{field("a", 1), field("b", 2)},
+			ExtensionRange: []*descriptorpb.DescriptorProto_ExtensionRange{
+				{Start: proto.Int32(1000), End: proto.Int32(536870912)},
+			},
+		}},
+		Extension: []*descriptorpb.FieldDescriptorProto{extension("x", 100000), extension("y", 100001)},
+	}, nil)
+	require.NoError(t, err)
+
+	types := new(protoregistry.Types)
+	for i := range fd.Extensions().Len() {
+		require.NoError(t, types.RegisterExtension(dynamicpb.NewExtensionType(fd.Extensions().Get(i))))
+	}
+	md := fd.Messages().Get(0)
+	ty := hyperpb.CompileMessageDescriptor(md, hyperpb.WithExtensionsFromTypes(types))
+
+	// Extensions with large numbers do not stop the LUT from being used.
+	assert.Equal(t, hyperpb.DispatchTables{Strategy: "lut", LUTEntries: 2, HashEntries: 4}, ty.DispatchTables())
+
+	var data []byte
+	for _, n := range []protowire.Number{1, 2, 100000, 100001, 5000} {
+		data = protowire.AppendTag(data, n, protowire.VarintType)
+		data = protowire.AppendVarint(data, uint64(n))
+	}
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.Equal(t, int32(2), m.Get(md.Fields().ByName("b")).Interface())
+	assert.Equal(t, int32(100001), m.Get(fd.Extensions().ByName("y")).Interface())
+	assert.Len(t, m.GetUnknown(), 5)
+}
+
+func TestDispatchJump(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:   proto.String(name),
+			Number: proto.Int32(number),
+			Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:   typ.Enum(),
+		}
+	}
+
+	// An event envelope: a oneof with many members, plus a few other fields.
+	envelope := &descriptorpb.DescriptorProto{
+		Name:      proto.String("Envelope"),
+		OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("event")}},
+	}
+	const members = 150
+	for i := range members {
+		typ := descriptorpb.FieldDescriptorProto_TYPE_STRING
+		if i%2 == 0 {
+			typ = descriptorpb.FieldDescriptorProto_TYPE_INT64
+		}
+		fdp := field(fmt.Sprintf("e%d", i), int32(i+1), typ)
+		fdp.OneofIndex = proto.Int32(0)
+		envelope.Field = append(envelope.Field, fdp)
+	}
+	ids := field("ids", members+1, descriptorpb.FieldDescriptorProto_TYPE_INT32)
+	ids.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+	envelope.Field = append(envelope.Field, ids, field("id", members+2, descriptorpb.FieldDescriptorProto_TYPE_STRING))
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("envelope.proto"),
+		Package:     proto.String("hyperpb.test"),
+		Syntax:      proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{envelope},
+	}, nil)
+	require.NoError(t, err)
+	md := fd.Messages().Get(0)
+	ty := hyperpb.CompileMessageDescriptor(md)
+
+	tables := ty.DispatchTables()
+	assert.Equal(t, "jump", tables.Strategy)
+	assert.Equal(t, members+2, tables.JumpEntries)
+
+	for _, n := range []protowire.Number{1, 2, 77, 98, members} {
+		var data []byte
+		data = protowire.AppendTag(data, members+2, protowire.BytesType)
+		data = protowire.AppendString(data, "id")
+		// Both encodings of a repeated field.
+		data = protowire.AppendTag(data, members+1, protowire.VarintType)
+		data = protowire.AppendVarint(data, 1)
+		data = protowire.AppendTag(data, members+1, protowire.BytesType)
+		data = protowire.AppendBytes(data, []byte{2, 3})
+		if n%2 == 1 {
+			data = protowire.AppendTag(data, n, protowire.VarintType)
+			data = protowire.AppendVarint(data, uint64(n))
+		} else {
+			data = protowire.AppendTag(data, n, protowire.BytesType)
+			data = protowire.AppendString(data, "event")
+		}
+		// Unknown fields, in and out of the range of the jump table.
+		data = protowire.AppendTag(data, n, protowire.Fixed32Type)
+		data = protowire.AppendFixed32(data, 0)
+		data = protowire.AppendTag(data, 1000, protowire.VarintType)
+		data = protowire.AppendVarint(data, 1)
+
+		want := dynamicpb.NewMessage(md)
+		require.NoError(t, proto.Unmarshal(data, want))
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data))
+		assert.True(t, proto.Equal(want, m), "field %d: got %v, want %v", n, m, want)
+		assert.Equal(t, md.Fields().ByNumber(n), m.WhichOneof(md.Oneofs().Get(0)))
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	t.Parallel()
+
+	names := func(ty *hyperpb.MessageType) []protoreflect.FullName {
+		var out []protoreflect.FullName
+		for dep := range ty.Dependencies() {
+			out = append(out, dep.Descriptor().FullName())
+		}
+		return out
+	}
+
+	ty := hyperpb.CompileFor[*testpb.DependsOnRequired]()
+	assert.Equal(t,
+		[]protoreflect.FullName{"hyperpb.test.Required", "hyperpb.test.Required.Empty"},
+		names(ty),
+	)
+
+	// The dependencies are the types of parsed submessages.
+	data, err := proto.MarshalOptions{AllowPartial: true}.Marshal(&testpb.DependsOnRequired{A: &testpb.Required{}})
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	sub := m.Get(ty.Descriptor().Fields().ByName("a")).Message().(*hyperpb.Message)
+	for dep := range ty.Dependencies() {
+		assert.Same(t, dep, sub.HyperType())
+		break
+	}
+
+	// Recursive types do not depend on themselves.
+	assert.Empty(t, names(hyperpb.CompileFor[*testpb.Graph]()))
+}
+
+func TestWriteTo(t *testing.T) {
+	t.Parallel()
+
+	testdata.RunAll(t, func(t *testing.T, test *testdata.TestCase) {
+		t.Helper()
+		if debug.Enabled && test.Large {
+			t.Skip("skipping large test because of -tags debug")
+		}
+
+		for _, specimen := range test.Specimens {
+			m := hyperpb.NewMessage(test.Type.Fast)
+			if m.Unmarshal(specimen) != nil {
+				continue
+			}
+
+			want := test.Type.Gencode.New().Interface()
+			require.NoError(t, proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(specimen, want))
+
+			// Use a tiny chunk size, except for large inputs.
+			chunk := 7
+			if len(specimen) > 4096 {
+				chunk = 4096
+			}
+			w := new(chunkRecorder)
+			n, err := m.WriteChunked(w, chunk)
+			require.NoError(t, err)
+			assert.Equal(t, int64(w.Len()), n)
+			assert.Equal(t, proto.Size(m), w.Len())
+
+			got := test.Type.Gencode.New().Interface()
+			require.NoError(t, proto.UnmarshalOptions{AllowPartial: true}.Unmarshal(w.Bytes(), got))
+			assert.True(t, proto.Equal(want, got), "%v\n%v", want, got)
+			for _, size := range w.chunks[:max(0, len(w.chunks)-1)] {
+				assert.Equal(t, chunk, size)
+			}
+		}
+	})
+}
+
+// chunkRecorder is a [bytes.Buffer] that records the size of each write.
+type chunkRecorder struct {
+	bytes.Buffer
+	chunks []int
+}
+
+func (w *chunkRecorder) Write(b []byte) (int, error) {
+	w.chunks = append(w.chunks, len(b))
+	return w.Buffer.Write(b)
+}
+
+func TestWriteToGroups(t *testing.T) {
+	t.Parallel()
+
+	// Groups must be written back as groups, byte-for-byte, so that
+	// signatures over legacy messages survive a round trip.
+	msg := &testpb.Groups{
+		Singular: &testpb.Groups_Singular{
+			A: proto.Int32(1),
+			G: &testpb.Groups{
+				Singular: &testpb.Groups_Singular{
+					Nested: &testpb.Groups_Singular_Nested{A: proto.Int32(2)},
+				},
+				Repeated: []*testpb.Groups_Repeated{{}},
+			},
+			Nested: &testpb.Groups_Singular_Nested{A: proto.Int32(3)},
+		},
+		Repeated: []*testpb.Groups_Repeated{
+			{A: proto.Int32(4), B: proto.Int32(5)},
+			{B: proto.Int32(6)},
+		},
+	}
+	unknown := protowire.AppendTag(nil, 10, protowire.StartGroupType)
+	unknown = protowire.AppendTag(unknown, 1, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 7)
+	unknown = protowire.AppendTag(unknown, 10, protowire.EndGroupType)
+	msg.ProtoReflect().SetUnknown(unknown)
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	require.NoError(t, err)
+
+	m := hyperpb.NewMessage(hyperpb.CompileFor[*testpb.Groups]())
+	require.NoError(t, m.Unmarshal(data))
+
+	var b bytes.Buffer
+	n, err := m.WriteTo(&b)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)), n)
+	assert.Equal(t, data, b.Bytes())
+}
+
+func TestWriteToInvalidUTF8(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Scalars]()
+	data, err := proto.MarshalOptions{}.Marshal(&testpb.Scalars{A1: 1})
+	require.NoError(t, err)
+	data = protowire.AppendTag(data, 14, protowire.BytesType)
+	data = protowire.AppendString(data, "\xff")
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithAllowInvalidUTF8(true)))
+
+	var b bytes.Buffer
+	n, err := m.WriteTo(&b)
+	require.ErrorContains(t, err, "invalid UTF-8")
+	assert.Zero(t, n)
+	assert.Zero(t, b.Len())
+}
@@ -16,7 +16,9 @@ package hyperpb
 
 import (
 	"fmt"
+	"iter"
 	"slices"
+	"sync/atomic"
 	_ "unsafe"
 
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -24,6 +26,7 @@ import (
 	"buf.build/go/hyperpb/internal/tdp"
 	"buf.build/go/hyperpb/internal/tdp/empty"
 	"buf.build/go/hyperpb/internal/tdp/profile"
+	"buf.build/go/hyperpb/internal/tdp/vm"
 	"buf.build/go/hyperpb/internal/xunsafe"
 )
 
@@ -39,13 +42,26 @@ type MessageType struct {
 // to be more efficient.
 //
 // Profile itself is an opaque pointer; it only exists to be passed into
-// different calls to [WithProfile].
+// different calls to [WithProfile]. A Profile may be recorded into by many
+// goroutines at once.
 //
 // See [MessageType.NewProfile].
 type Profile struct {
 	impl profile.Recorder
 }
 
+// Merge adds the information recorded in other to p, so that profiles
+// recorded separately, such as by different goroutines or for different
+// shards of a corpus, can be combined before calling [MessageType.Recompile].
+//
+// other may have been created from a different [MessageType], as long as it
+// was compiled from the same descriptors, such as by recompiling; information
+// about fields that p's types do not have is discarded. Merge may be called
+// concurrently with recording into either profile.
+func (p *Profile) Merge(other *Profile) {
+	p.impl.Merge(&other.impl)
+}
+
 // Descriptor returns the message descriptor.
 //
 // Descriptor implements [protoreflect.MessageType].
@@ -81,6 +97,73 @@ func (t *MessageType) Format(f fmt.State, verb rune) {
 	}
 }
 
+// RetainedSize returns the approximate number of bytes of memory retained by
+// this type.
+//
+// This includes the parser tables and lookup tables of this type and every
+// other type that was compiled alongside it, such as the types of message
+// fields, since they are all allocated together, and the fixed-size metadata
+// for each of those types.
+//
+// It does not include state that those types hold on the Go heap, which is
+// usually much smaller, and some of which grows after compilation:
+//   - the tables of strings interned while parsing, for [WithInternStrings];
+//   - the field lookup tables built on first use, such as by
+//     [MessageType.FieldByName] and [MessageType.FieldByJSONName];
+//   - idle messages held by the pool returned by [MessageType.Pool];
+//   - values cached for [WithCachedOptions];
+//   - the per-type tables for [WithFieldTransform] and [WithSampledFields],
+//     and the functions passed to them;
+//   - the variable-length lists the compiler records for each type, such as
+//     its required fields and its diagnostics;
+//   - the descriptors the types were compiled from.
+func (t *MessageType) RetainedSize() int {
+	return t.impl.Library.Bytes
+}
+
+// Dependencies returns an iterator over the types of every message that a
+// message of this type can contain, such as the types of its message fields,
+// their message fields, and so on. Map fields contribute the type of their
+// values, and extensions that the type was compiled with are included.
+//
+// Each type is yielded once, in depth-first order of field index, and this
+// type is never yielded, even if it is recursive. The order is the same every
+// time for a given type.
+//
+// These types were all compiled alongside this type, so they share its
+// [MessageType.RetainedSize].
+func (t *MessageType) Dependencies() iter.Seq[*MessageType] {
+	return func(yield func(*MessageType) bool) {
+		seen := map[*tdp.Type]struct{}{&t.impl: {}}
+		var walk func(*tdp.Type) bool
+		walk = func(ty *tdp.Type) bool {
+			for sub := range ty.Submessages() {
+				if _, ok := seen[sub]; ok {
+					continue
+				}
+				seen[sub] = struct{}{}
+				if !yield(wrapType(sub)) || !walk(sub) {
+					return false
+				}
+			}
+			return true
+		}
+		walk(&t.impl)
+	}
+}
+
+// ElidedFields returns the deprecated fields of this type that were elided
+// because of [WithElideDeprecatedFields], in field index order. It does not
+// include the fields of other types, such as those of message fields; use
+// [MessageType.Dependencies] to find those.
+func (t *MessageType) ElidedFields() []protoreflect.FieldDescriptor {
+	fields := make([]protoreflect.FieldDescriptor, len(t.impl.Elided))
+	for i, n := range t.impl.Elided {
+		fields[i] = t.impl.FieldDescriptors[n]
+	}
+	return fields
+}
+
 // NewProfile creates a new profiler for this type, which can be used to
 // profile messages of this type when unmarshaling.
 //
@@ -89,15 +172,110 @@ func (t *MessageType) NewProfile() *Profile {
 	return xunsafe.Cast[Profile](profile.NewRecorder(t.impl.Library))
 }
 
-// Recompile recompiles this type with a recorded profile.
+// Recompile recompiles this type with a recorded profile, using the same
+// options this type was compiled with, followed by the given options.
+//
+// Recompiling may change which fields are hot or cold, and therefore the
+// layout of messages and the [FieldInfo.Location] of fields, as well as
+// decisions that only affect parsing, such as how much memory is preallocated
+// for repeated fields and the order in which fields are expected. Pass
+// [WithLayoutOf](t) to keep the layout unchanged, so that only parsing
+// decisions change. The descriptors, and how messages behave when read, never
+// change.
 //
 // Note that this profile cannot be used with the new type; you must create a
 // fresh profile using [MessageType.NewProfile] and begin recording anew.
-func (t *MessageType) Recompile(profile *Profile) *MessageType {
-	options := slices.Clone(t.impl.Library.Metadata.([]CompileOption)) //nolint:errcheck
-	options = append(options, WithProfile(profile))
+func (t *MessageType) Recompile(profile *Profile, options ...CompileOption) *MessageType {
+	all := slices.Clone(t.impl.Library.Metadata.([]CompileOption)) //nolint:errcheck
+	all = append(all, WithProfile(profile))
+	all = append(all, options...)
+
+	return CompileMessageDescriptor(t.Descriptor(), all...)
+}
+
+// FieldAccesses is the number of reflection accesses to a field, as counted by
+// [MessageType.TrackAccesses].
+type FieldAccesses struct {
+	Field protoreflect.FieldDescriptor
+	Count uint64
+}
+
+// TrackAccesses enables or disables counting of reflection accesses to the
+// fields of messages of this type, such as via [Message.Get], [Message.Has]
+// and [Message.Range]. Enabling tracking resets all counts to zero.
+//
+// This does not affect the types of message fields, which must be enabled
+// separately.
+//
+// Fields which are never accessed, but which are frequently present according
+// to a [Profile], are good candidates for removal from the schema.
+//
+// Panics if enable is true and this type was not compiled with
+// [WithTrackAccesses].
+func (t *MessageType) TrackAccesses(enable bool) {
+	if enable && !t.impl.TrackAccesses {
+		panic(fmt.Sprintf("hyperpb: %s was not compiled with WithTrackAccesses", t.Descriptor().FullName()))
+	}
+	if !enable {
+		t.impl.Accesses.Store(nil)
+		return
+	}
+	counts := make([]atomic.Uint64, t.impl.Count)
+	t.impl.Accesses.Store(&counts)
+}
+
+// Accesses returns the number of accesses to each field of this type, in
+// field index order, since [MessageType.TrackAccesses] was last called.
+//
+// Returns nil if tracking is not enabled.
+func (t *MessageType) Accesses() []FieldAccesses {
+	counts := t.impl.Accesses.Load()
+	if counts == nil {
+		return nil
+	}
+	out := make([]FieldAccesses, len(*counts))
+	for i := range out {
+		out[i] = FieldAccesses{
+			Field: t.impl.FieldDescriptors[i],
+			Count: (*counts)[i].Load(),
+		}
+	}
+	return out
+}
+
+// InternStats are statistics about string interning for a [MessageType] or a
+// [Shared]; see [WithInternStrings] and [Shared.InternStrings].
+type InternStats struct {
+	// The number of strings interned, and how many of them were duplicates of
+	// an interned string that was still alive.
+	Strings, Hits uint64
+	// The total sizes of the above, in bytes.
+	Bytes, HitBytes uint64
+}
 
-	return CompileMessageDescriptor(t.Descriptor(), options...)
+// Ratio returns the fraction of interned bytes that were deduplicated.
+func (s InternStats) Ratio() float64 {
+	if s.Bytes == 0 {
+		return 0
+	}
+	return float64(s.HitBytes) / float64(s.Bytes)
+}
+
+// InternStats returns statistics about string interning for fields of this
+// type. These only cover this type, and not the types of its message fields.
+//
+// Returns zero if this type was not compiled with [WithInternStrings].
+func (t *MessageType) InternStats() InternStats {
+	if t.impl.Interner == nil {
+		return InternStats{}
+	}
+	s := t.impl.Interner.Stats()
+	return InternStats{
+		Strings:  s.Strings,
+		Hits:     s.Hits,
+		Bytes:    s.Bytes,
+		HitBytes: s.HitBytes,
+	}
 }
 
 // wrapType wraps an internal Type pointer.
@@ -109,3 +287,90 @@ func wrapType(s *tdp.Type) *MessageType {
 func protoReflectType(m *tdp.Type) protoreflect.MessageType {
 	return wrapType(m)
 }
+
+// DispatchStats are statistics about how well the parser of a [MessageType]
+// predicts the order of fields in some corpus; see [MessageType.DispatchStats].
+type DispatchStats struct {
+	// The number of messages in the corpus, including submessages, and the
+	// number of field tags in them.
+	Messages, Fields int
+	// The number of field parsers that were tried without matching a tag,
+	// and the number of tags that could not be found by trying field
+	// parsers, which must then be looked up in a hash table. Lower is better.
+	Probes, Misses int
+}
+
+// MissesPerMessage returns the average number of misses per message.
+func (s DispatchStats) MissesPerMessage() float64 {
+	if s.Messages == 0 {
+		return 0
+	}
+	return float64(s.Misses) / float64(s.Messages)
+}
+
+// ProbesPerMessage returns the average number of probes per message.
+func (s DispatchStats) ProbesPerMessage() float64 {
+	if s.Messages == 0 {
+		return 0
+	}
+	return float64(s.Probes) / float64(s.Messages)
+}
+
+// DispatchStats estimates how often the parser for this type would fail to
+// predict the next field while unmarshaling each message in corpus, by
+// replaying its field dispatch without parsing anything.
+//
+// This is intended for quantifying whether [MessageType.Recompile] helps for
+// a particular corpus, by comparing the result for the original type with
+// that for the recompiled type. Only [WithMaxDecodeMisses] among options has any
+// effect. Inputs are assumed to be valid; a malformed input is only counted up
+// to where it becomes malformed.
+func (t *MessageType) DispatchStats(corpus [][]byte, options ...UnmarshalOption) DispatchStats {
+	opts := newUnmarshalOptions(options)
+	var stats vm.DispatchStats
+	for _, data := range corpus {
+		stats.Add(vm.SimulateDispatch(&t.impl, data, opts.MaxMisses))
+	}
+	return DispatchStats(stats)
+}
+
+// DispatchTables describes the tables that the parser of a [MessageType] uses
+// to find the parser for a field tag; see [MessageType.DispatchTables].
+type DispatchTables struct {
+	// How the parser searches for a tag that it did not predict: "list" if
+	// it tries a few parsers before falling back to the hash table, "lut" if
+	// one-byte tags missing from the lookup table are known to be unknown,
+	// "hash" if it goes straight to the hash table, and "jump" if it indexes
+	// a jump table by field number first, which is used for messages that are
+	// mostly a oneof with many densely-numbered members.
+	Strategy string
+	// The number of tags in the lookup table for one-byte tags, and in the
+	// hash table of all tags, respectively. There is one tag for each field
+	// parser; repeated scalar fields have two, for the packed and unpacked
+	// encodings.
+	LUTEntries, HashEntries int
+	// The number of entries in the jump table, which has one for every field
+	// number from the smallest to the largest, or zero if there is none.
+	JumpEntries int
+}
+
+// DispatchTables returns the sizes of the tables that the parser for this type
+// uses to find the parser for a field tag.
+//
+// These only depend on the fields and known extensions of the type, and not on
+// the sizes of its extension ranges: tags in those ranges which are not for
+// known extensions are treated like any other unknown field.
+func (t *MessageType) DispatchTables() DispatchTables {
+	p := t.impl.Parser
+	tables := DispatchTables{
+		Strategy:    p.Dispatch.String(),
+		HashEntries: p.Tags.Len(),
+		JumpEntries: int(p.JumpLen),
+	}
+	for _, idx := range p.TagLUT {
+		if idx != 0xff {
+			tables.LUTEntries++
+		}
+	}
+	return tables
+}
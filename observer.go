@@ -0,0 +1,158 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp"
+)
+
+// Observer receives events about compiling and parsing messages, so that they
+// can be instrumented, such as with latency histograms, without wrapping every
+// call site. See [SetObserver].
+//
+// Methods are called synchronously, on the goroutine that is compiling or
+// parsing, so they must be safe to call concurrently, and should be fast.
+type Observer interface {
+	// CompileStart is called when compilation of md starts.
+	CompileStart(md protoreflect.MessageDescriptor)
+	// CompileFinish is called when compilation of a message finishes,
+	// whether or not it succeeded.
+	CompileFinish(CompileEvent)
+
+	// ParseStart is called when parsing size bytes as a message of type ty
+	// starts. For [Shared.UnmarshalBatch], size is the size of all the inputs.
+	ParseStart(ty *MessageType, size int)
+	// ParseFinish is called when a parse finishes, whether or not it
+	// succeeded.
+	ParseFinish(ParseEvent)
+}
+
+// CompileEvent describes a compilation, for [Observer.CompileFinish].
+type CompileEvent struct {
+	// The descriptor that was compiled.
+	Descriptor protoreflect.MessageDescriptor
+	// The compiled type, or nil if compilation failed.
+	Type *MessageType
+	// The memory used by the compiled type and all of its dependencies, in
+	// bytes; see [MemoryBudget].
+	//
+	// For [CompileAll], which compiles many types at once, there is one event
+	// per type, and Bytes and Duration are those of the whole compilation.
+	Bytes int
+	// How long compilation took.
+	Duration time.Duration
+	// Why compilation failed, if it did.
+	Err error
+}
+
+// ParseEvent describes a parse, for [Observer.ParseFinish].
+type ParseEvent struct {
+	// The type of the message that was parsed.
+	Type *MessageType
+	// The size of the input, in bytes. For [Shared.UnmarshalBatch], the size
+	// of all the inputs.
+	Size int
+	// The number of messages parsed. This is one, except for
+	// [Shared.UnmarshalBatch], where it is the number of messages returned.
+	Messages int
+	// How long parsing took, including any checks requested with
+	// [UnmarshalOption]s.
+	Duration time.Duration
+	// Why parsing failed, if it did.
+	Err error
+}
+
+// SetObserver sets the [Observer] that is notified of every compilation and
+// parse, replacing any previous one. A nil Observer disables notifications,
+// which is the default.
+//
+// This is global state, intended to be called once when a program starts.
+// When no Observer is set, the cost to each call is a single atomic load.
+func SetObserver(o Observer) {
+	if o == nil {
+		observer.Store(nil)
+		return
+	}
+	observer.Store(&o)
+}
+
+var observer atomic.Pointer[Observer]
+
+// observation is an in-progress operation being reported to an [Observer].
+// The zero value reports nothing.
+type observation struct {
+	o     Observer
+	start time.Time
+}
+
+// observeCompile notifies the current [Observer], if any, that compilation of
+// md is starting.
+func observeCompile(md protoreflect.MessageDescriptor) observation {
+	p := observer.Load()
+	if p == nil {
+		return observation{}
+	}
+	o := *p
+	o.CompileStart(md)
+	return observation{o: o, start: time.Now()}
+}
+
+// compiled notifies the observer that a compilation has finished.
+func (ob observation) compiled(md protoreflect.MessageDescriptor, ty *tdp.Type, err error) {
+	if ob.o == nil {
+		return
+	}
+	event := CompileEvent{
+		Descriptor: md,
+		Duration:   time.Since(ob.start),
+		Err:        err,
+	}
+	if ty != nil {
+		event.Type = wrapType(ty)
+		event.Bytes = ty.Library.Bytes
+	}
+	ob.o.CompileFinish(event)
+}
+
+// observeParse notifies the current [Observer], if any, that parsing size
+// bytes as a message of type ty is starting.
+func observeParse(ty *tdp.Type, size int) observation {
+	p := observer.Load()
+	if p == nil {
+		return observation{}
+	}
+	o := *p
+	o.ParseStart(wrapType(ty), size)
+	return observation{o: o, start: time.Now()}
+}
+
+// parsed notifies the observer that a parse has finished.
+func (ob observation) parsed(ty *tdp.Type, size, messages int, err error) {
+	if ob.o == nil {
+		return
+	}
+	ob.o.ParseFinish(ParseEvent{
+		Type:     wrapType(ty),
+		Size:     size,
+		Messages: messages,
+		Duration: time.Since(ob.start),
+		Err:      err,
+	})
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+type testObserver struct {
+	mu       sync.Mutex
+	md       protoreflect.MessageDescriptor
+	ty       *hyperpb.MessageType
+	starts   []int
+	compiles []hyperpb.CompileEvent
+	parses   []hyperpb.ParseEvent
+}
+
+func (o *testObserver) CompileStart(protoreflect.MessageDescriptor) {}
+
+func (o *testObserver) CompileFinish(e hyperpb.CompileEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if e.Descriptor == o.md {
+		o.compiles = append(o.compiles, e)
+	}
+}
+
+func (o *testObserver) ParseStart(ty *hyperpb.MessageType, size int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if ty == o.ty {
+		o.starts = append(o.starts, size)
+	}
+}
+
+func (o *testObserver) ParseFinish(e hyperpb.ParseEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if e.Type == o.ty {
+		o.parses = append(o.parses, e)
+	}
+}
+
+func TestObserver(t *testing.T) {
+	// Not parallel: the observer is global.
+
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	o := &testObserver{md: md}
+	hyperpb.SetObserver(o)
+	defer hyperpb.SetObserver(nil)
+
+	// Compile with an option, so that the compilation is not deduplicated with
+	// another test's.
+	o.ty = hyperpb.CompileMessageDescriptor(md, hyperpb.WithInternStrings(false))
+	require.Len(t, o.compiles, 1)
+	assert.Same(t, o.ty, o.compiles[0].Type)
+	assert.Positive(t, o.compiles[0].Bytes)
+	assert.NoError(t, o.compiles[0].Err)
+
+	data, err := proto.Marshal(&testpb.Scalars{A1: 42})
+	require.NoError(t, err)
+
+	s := new(hyperpb.Shared)
+	require.NoError(t, s.NewMessage(o.ty).Unmarshal(data))
+	s.Free()
+	assert.Error(t, s.NewMessage(o.ty).TryUnmarshal(data[:1]).Err)
+	s.Free()
+	_, err = s.UnmarshalBatch(o.ty, [][]byte{data, data})
+	require.NoError(t, err)
+	s.Free()
+
+	assert.Equal(t, []int{len(data), 1, 2 * len(data)}, o.starts)
+	require.Len(t, o.parses, 3)
+	assert.NoError(t, o.parses[0].Err)
+	assert.Equal(t, 1, o.parses[0].Messages)
+	assert.Error(t, o.parses[1].Err)
+	assert.Equal(t, 2, o.parses[2].Messages)
+	assert.Equal(t, 2*len(data), o.parses[2].Size)
+
+	hyperpb.SetObserver(nil)
+	require.NoError(t, s.NewMessage(o.ty).Unmarshal(data))
+	s.Free()
+	assert.Len(t, o.parses, 3)
+}
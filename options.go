@@ -15,10 +15,17 @@
 package hyperpb
 
 import (
+	"fmt"
+	"maps"
 	"math"
+	"reflect"
+	"time"
 
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
 
+	"buf.build/go/hyperpb/internal/tdp"
 	"buf.build/go/hyperpb/internal/tdp/compiler"
 	"buf.build/go/hyperpb/internal/tdp/vm"
 )
@@ -29,7 +36,7 @@ import (
 // be an interface while UnmarshalOption isn't would be weird.
 
 // CompileOption is a configuration setting for [CompileMessageDescriptor].
-type CompileOption struct{ apply func(*compiler.Options) }
+type CompileOption struct{ apply func(*compileOptions) }
 
 // WithExtensions provides an extension resolver for a compiler.
 //
@@ -37,26 +44,293 @@ type CompileOption struct{ apply func(*compiler.Options) }
 // resolution on the fly. Instead, any extensions that should be parsed must
 // be provided up-front.
 func WithExtensions(resolver compiler.ExtensionResolver) CompileOption {
-	return CompileOption{func(c *compiler.Options) { c.Extensions = resolver }}
+	return CompileOption{func(c *compileOptions) { c.Extensions = resolver }}
 }
 
 // WithExtensionsFromTypes uses a type registry to provide extension information
 // about a message type.
 func WithExtensionsFromTypes(types *protoregistry.Types) CompileOption {
-	return CompileOption{func(c *compiler.Options) { c.Extensions = (*compiler.ExtensionsFromRegistry)(types) }}
+	return CompileOption{func(c *compileOptions) { c.Extensions = (*compiler.ExtensionsFromRegistry)(types) }}
 }
 
 // WithExtensionsFromFiles uses a file registry to provide extension information
 // about a message type.
 func WithExtensionsFromFiles(files *protoregistry.Files) CompileOption {
-	return CompileOption{func(c *compiler.Options) { c.Extensions = compiler.ExtensionsFromFile(files) }}
+	return CompileOption{func(c *compileOptions) { c.Extensions = compiler.ExtensionsFromFile(files) }}
 }
 
 // WithProfile provides a profile for profile-guided optimization.
 //
 // Typically, you'll prefer to use [MessageType.Recompile].
 func WithProfile(profile *Profile) CompileOption {
-	return CompileOption{func(c *compiler.Options) { c.Profile = &profile.impl }}
+	return CompileOption{func(c *compileOptions) { c.Profile = &profile.impl }}
+}
+
+// WithMemoryBudget charges the memory retained by the compiled type against
+// budget. See [MessageType.RetainedSize] for what is counted.
+//
+// If the budget would be exceeded, compilation fails: [CompileFileDescriptorSet]
+// returns an error wrapping [ErrMemoryBudgetExceeded], and
+// [CompileMessageDescriptor] panics with it.
+func WithMemoryBudget(budget *MemoryBudget) CompileOption {
+	return CompileOption{func(c *compileOptions) { c.budget = budget }}
+}
+
+// WithCompileKey allows concurrent calls to [CompileMessageDescriptor] for the
+// same descriptor with options to be deduplicated, like calls without options
+// are. Calls for the same descriptor that pass equal keys are assumed to have
+// equivalent options: only one of them compiles the type, and the rest wait
+// for it and return the same [MessageType].
+//
+// key must be comparable; WithCompileKey panics otherwise. A nil key
+// disables deduplication, which is the default for calls with options.
+func WithCompileKey(key any) CompileOption {
+	if key != nil && !reflect.TypeOf(key).Comparable() {
+		panic(fmt.Errorf("hyperpb: compile key of type %T is not comparable", key))
+	}
+	return CompileOption{func(c *compileOptions) { c.key = key }}
+}
+
+// WithFieldTransform registers a function that rewrites the contents of the
+// field with the given full name as it is parsed, such as to decrypt it.
+//
+// fn is called once per occurrence of the field on the wire, with the raw
+// bytes of the field. It must not retain or modify src. Its output is copied
+// into the message's arena and returned by reflective accesses, so fn is not
+// called again when the field is read. If fn returns an error, parsing fails
+// with an error that wraps it. For fields that require UTF-8 validation, the
+// output is validated rather than the input.
+//
+// Only singular and optional string and bytes fields may be transformed;
+// compiling a type that contains any other kind of field named by a transform
+// panics. Transforms for fields that do not occur in the compiled type are
+// ignored.
+func WithFieldTransform(name protoreflect.FullName, fn func(src []byte) ([]byte, error)) CompileOption {
+	return CompileOption{func(c *compileOptions) {
+		if c.Transforms == nil {
+			c.Transforms = make(map[protoreflect.FullName]tdp.Transform)
+		}
+		c.Transforms[name] = fn
+	}}
+}
+
+// WithInternStrings sets whether the values of singular string fields of the
+// compiled types are interned with [unique.Make] as they are parsed.
+//
+// Interned strings do not alias the input, and identical values in different
+// messages, including messages from different parses, share memory. This can
+// greatly reduce memory usage for workloads that retain many copies of a small
+// set of strings, such as labels, at the cost of a hash table lookup for every
+// such field that is parsed; reading them costs nothing extra. Use
+// [MessageType.InternStats] to measure how effective interning is.
+//
+// Repeated and map fields, and fields passed to [WithSecretFields] or
+// [WithFieldTransform], are not interned.
+func WithInternStrings(enable bool) CompileOption {
+	return CompileOption{func(c *compileOptions) { c.InternStrings = enable }}
+}
+
+// WithTrackAccesses sets whether the compiled types support counting
+// reflection accesses to their fields with [MessageType.TrackAccesses].
+//
+// Types compiled without this option never count accesses, and pay nothing
+// for the ability to do so.
+func WithTrackAccesses(enable bool) CompileOption {
+	return CompileOption{func(c *compileOptions) { c.TrackAccesses = enable }}
+}
+
+// WithFieldLocation pins the fields with the given full names to the given
+// region of a message, overriding the compiler's choice, which is otherwise
+// based on a [Profile] if one is provided. The location of a field is reported
+// by [MessageType.Fields].
+//
+// Hot fields are stored inline in the message, which is best for fields that
+// are frequently present or accessed. Cold fields are stored out-of-line, and
+// only cost memory in messages where they are present, at the expense of an
+// extra indirection.
+//
+// The members of a oneof share storage, so pinning any of them pins all of
+// them; if they are pinned to different locations, the oneof is hot. Names
+// that do not refer to fields of the compiled types are ignored. If a field is
+// named by multiple calls, the last one wins.
+func WithFieldLocation(location FieldLocation, names ...protoreflect.FullName) CompileOption {
+	return CompileOption{func(c *compileOptions) {
+		if c.Pinned == nil {
+			c.Pinned = make(map[protoreflect.FullName]bool)
+		}
+		for _, name := range names {
+			c.Pinned[name] = location == LocationHot
+		}
+	}}
+}
+
+// WithLayoutOf places every field hot or cold exactly as it is placed in ty,
+// as if by [WithFieldLocation], overriding any [Profile]. ty must have been
+// compiled from the same descriptors.
+//
+// This is intended for use with [MessageType.Recompile], to keep the layout of
+// messages stable across recompiles, so that information derived from the old
+// type, such as its [MessageType.Fields], remains accurate. Locations pinned
+// by [WithFieldLocation] options that come after this one take precedence.
+func WithLayoutOf(ty *MessageType) CompileOption {
+	pinned := make(map[protoreflect.FullName]bool)
+	for _, t := range ty.impl.Library.Types {
+		for _, f := range wrapType(t).Fields() {
+			pinned[f.Descriptor.FullName()] = f.Location == LocationHot
+		}
+	}
+
+	return CompileOption{func(c *compileOptions) {
+		if c.Pinned == nil {
+			c.Pinned = make(map[protoreflect.FullName]bool)
+		}
+		maps.Copy(c.Pinned, pinned)
+	}}
+}
+
+// WithBitsetBools stores the repeated bool fields with the given full names as
+// bitsets of one bit per element, rather than one byte per element. This
+// reduces the memory used by very long repeated bool fields by a factor of
+// eight, at the cost of always copying them out of the input: by default,
+// packed bool fields that contain only zeros and ones alias the input instead.
+//
+// Compiling a type that contains a field named by this option that is not a
+// repeated bool field panics. Names that do not refer to fields of the
+// compiled types are ignored.
+func WithBitsetBools(names ...protoreflect.FullName) CompileOption {
+	return CompileOption{func(c *compileOptions) {
+		if c.BitsetBools == nil {
+			c.BitsetBools = make(map[protoreflect.FullName]bool)
+		}
+		for _, name := range names {
+			c.BitsetBools[name] = true
+		}
+	}}
+}
+
+// WithSecretFields treats the contents of the singular string and bytes fields
+// with the given full names as secrets, such as credentials.
+//
+// Parsing a secret string field validates it as UTF-8 without the fast paths
+// used for other strings, whose timing depends on the contents, such as
+// skipping over ASCII; the time taken depends only on the length of the field.
+// Secret fields are never interned by [WithInternStrings].
+//
+// When a [Shared] that has parsed a secret field is freed, the memory that may
+// hold its contents is zeroed: the [Shared]'s arena, and its copy of the input
+// buffer. An input buffer parsed with [WithAllowAlias] belongs to the caller,
+// and is not modified; callers must wipe it themselves.
+//
+// Compiling a type that contains a field named by this option that is not a
+// singular string or bytes field panics, as do members of oneofs other than
+// proto3 optional fields, and fields with a transform. Names that do not
+// refer to fields of the compiled types are ignored.
+func WithSecretFields(names ...protoreflect.FullName) CompileOption {
+	return CompileOption{func(c *compileOptions) {
+		if c.Secrets == nil {
+			c.Secrets = make(map[protoreflect.FullName]bool)
+		}
+		for _, name := range names {
+			c.Secrets[name] = true
+		}
+	}}
+}
+
+// WithElideDeprecatedFields sets whether fields marked as deprecated are
+// elided: they are given no storage in messages, and are parsed as unknown
+// fields. This shrinks the messages of types that keep a long tail of
+// deprecated fields for compatibility, which otherwise take up space even
+// though they are never set.
+//
+// Elided fields behave as if they are always unset when read. Required fields
+// and members of oneofs are never elided. Use [MessageType.ElidedFields] to
+// find which fields were elided.
+func WithElideDeprecatedFields(enable bool) CompileOption {
+	return CompileOption{func(c *compileOptions) { c.ElideDeprecated = enable }}
+}
+
+// WithSampledFields parses only a sample of the elements of the repeated
+// message fields with the given full names, for workloads such as dashboards
+// that only need approximate statistics about very long repeated fields: the
+// first element, and every every-th element after it. The other elements are
+// skipped using their length prefixes, and only counted; use
+// [Message.SampledCount] to get the number of elements in the input.
+//
+// Skipped elements are not validated at all, and are not included
+// in the result of marshaling the message.
+//
+// Compiling a type that contains a field named by this option that is not a
+// repeated message field panics; groups and maps cannot be sampled. Values of
+// every less than two disable sampling. Names that do not refer to fields of
+// the compiled types are ignored.
+func WithSampledFields(every int, names ...protoreflect.FullName) CompileOption {
+	return CompileOption{func(c *compileOptions) {
+		if c.Samples == nil {
+			c.Samples = make(map[protoreflect.FullName]int)
+		}
+		for _, name := range names {
+			c.Samples[name] = min(every, math.MaxUint32)
+		}
+	}}
+}
+
+// WithMessageSizeLimit limits the encoded size of messages of the types with
+// the given full names to limit bytes. Parsing fails as soon as the length
+// prefix of a message that is too large is read, with an error that names its
+// type; see [SizeLimitErrorOf]. This allows different nested types to have
+// different limits, such as a megabyte for an attachment but a few kilobytes
+// for its metadata, which [WithMaxSize] cannot express.
+//
+// The limit also applies to the input as a whole, if it is of one of the
+// named types. Groups do not have a length prefix, so they are not limited.
+// Values of limit less than one remove the limit. Names that do not refer to
+// messages of the compiled types are ignored.
+func WithMessageSizeLimit(limit int, names ...protoreflect.FullName) CompileOption {
+	return CompileOption{func(c *compileOptions) {
+		if c.MaxSizes == nil {
+			c.MaxSizes = make(map[protoreflect.FullName]int)
+		}
+		for _, name := range names {
+			if limit < 1 {
+				delete(c.MaxSizes, name)
+				continue
+			}
+			c.MaxSizes[name] = limit
+		}
+	}}
+}
+
+// WithCachedOptions records the values of the given custom options, which
+// must extend google.protobuf.MessageOptions or google.protobuf.FieldOptions,
+// for every message and field of the compiled types. They can then be read
+// with [MessageType.Option] and [FieldInfo.Option] without calling
+// proto.GetExtension on the descriptor's options every time.
+//
+// Options are resolved even if xts are not in [protoregistry.GlobalTypes],
+// such as when the types were compiled with [CompileFileDescriptorSet].
+// Compilation fails if two of xts have the same extendee and number.
+func WithCachedOptions(xts ...protoreflect.ExtensionType) CompileOption {
+	return CompileOption{func(c *compileOptions) { c.options = append(c.options, xts...) }}
+}
+
+// WithUTF8Validator replaces the validation of string fields that require
+// UTF-8, such as those declared in proto3 files, with valid, which reports
+// whether the contents of such a field are acceptable. Contents that valid
+// rejects fail the parse, like invalid UTF-8 does by default. A nil valid
+// restores the built-in validation.
+//
+// This allows substituting another implementation, or accepting a superset of
+// UTF-8, such as WTF-8 produced by JavaScript, while recording what was
+// accepted. valid must be safe to call concurrently, and must not retain its
+// argument, which aliases the input.
+//
+// Validation is still skipped with [WithAllowInvalidUTF8], and
+// [WithRepairUTF8] only repairs strings that are not valid UTF-8. As with
+// WithAllowInvalidUTF8, strings that are not valid UTF-8 cannot be encoded by
+// [Message.WriteTo] or proto.Marshal. Fields named by [WithSecretFields] are
+// validated by valid too, so their validation is no longer constant-time.
+func WithUTF8Validator(valid func(b []byte) bool) CompileOption {
+	return CompileOption{func(c *compileOptions) { c.ValidUTF8 = valid }}
 }
 
 // UnmarshalOption is a configuration setting for [Message.Unmarshal].
@@ -79,6 +353,34 @@ func WithMaxDepth(depth int) UnmarshalOption {
 	return UnmarshalOption{func(opts *vm.Options) { opts.MaxDepth = min(depth, math.MaxUint32) }}
 }
 
+// WithStackDepth sets the number of frames the parser's recursion stack starts
+// out with. The stack is grown on demand, up to the limit set with
+// [WithMaxDepth], and stacks are re-used across calls to [Message.Unmarshal].
+//
+// By default, the stack starts out as deep as the maximum depth, so that it
+// never needs to grow. Services that parse many small, shallow messages may
+// set a small value to reduce the memory held by pooled stacks. See
+// [ReadStackStats].
+func WithStackDepth(depth int) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) { opts.StackDepth = min(depth, math.MaxUint32) }}
+}
+
+// WithMaxSize sets the maximum size of the input to the parser, in bytes.
+// Larger inputs are rejected before parsing begins.
+//
+// Inputs can be at most 4 GiB - 1 bytes, because the parser records the
+// location of strings and bytes fields as 32-bit offsets into the input; sizes
+// larger than this are clamped. Payloads which may exceed this limit should be
+// split into chunks and sent as a repeated bytes field, in several messages if
+// necessary. The default is the maximum, and zero or a negative size restores
+// it, rather than rejecting every input.
+func WithMaxSize(size int) UnmarshalOption {
+	if size < 1 {
+		size = math.MaxInt
+	}
+	return UnmarshalOption{func(opts *vm.Options) { opts.MaxSize = size }}
+}
+
 // WithDiscardUnknown sets whether unknown fields should be discarded while
 // parsing. Analogous to [proto.UnmarshalOptions].
 //
@@ -88,12 +390,157 @@ func WithDiscardUnknown(discard bool) UnmarshalOption {
 	return UnmarshalOption{func(opts *vm.Options) { opts.DiscardUnknown = discard }}
 }
 
+// WithTruncatedGroups sets whether an input which ends inside of a group is
+// treated as truncated, failing with [io.ErrUnexpectedEOF], rather than as
+// having a mismatched end group marker. This distinguishes inputs that were
+// cut short from ones that are malformed. Either way, [GroupErrorOf] reports
+// which group was left unterminated.
+func WithTruncatedGroups(enable bool) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) { opts.TruncatedGroups = enable }}
+}
+
+// ReservedWireTypeMode is a way of handling records with the reserved wire
+// types 6 and 7, which are not valid Protobuf, but which are emitted by some
+// old producers. See [WithReservedWireTypes].
+type ReservedWireTypeMode int8
+
+const (
+	// ReservedFail causes parsing to fail. This is the default, and matches
+	// the behavior of other Protobuf parsers.
+	ReservedFail = ReservedWireTypeMode(vm.ReservedFail)
+	// ReservedSkip skips a fixed number of bytes after the tag, and records
+	// the tag and those bytes as an unknown field.
+	ReservedSkip = ReservedWireTypeMode(vm.ReservedSkipBytes)
+	// ReservedAbortMessage discards the rest of the message that contains the
+	// record, including any unknown fields in it. Since groups are not
+	// length-prefixed, a record inside of a group causes parsing to fail.
+	ReservedAbortMessage = ReservedWireTypeMode(vm.ReservedAbortMessage)
+)
+
+// WithReservedWireTypes sets how records with the reserved wire types 6 and 7
+// are handled. skip is the number of bytes to skip after the tag of such a
+// record with [ReservedSkip], and is otherwise ignored.
+//
+// If observe is not nil, it is called with the field number, wire type and
+// offset of the tag of each such record, in every mode. This can be used to
+// measure how often reserved wire types occur before rejecting them.
+func WithReservedWireTypes(
+	mode ReservedWireTypeMode, skip int,
+	observe func(number protowire.Number, typ protowire.Type, offset int),
+) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) {
+		opts.ReservedWireTypes = vm.ReservedMode(mode)
+		opts.ReservedSkip = max(0, skip)
+		opts.OnReserved = observe
+	}}
+}
+
+// Logger receives a trace of the operations performed by the parser. See
+// [WithLogger].
+type Logger interface {
+	// Log is called with the offset into the input at which the parser is
+	// positioned, a short name for the operation being performed, such as
+	// "varint" or "unknown", and a human-readable description of it.
+	//
+	// The format of op and msg is not stable, and should not be parsed.
+	Log(offset int, op, msg string)
+}
+
+// WithLogger sends a trace of the parser's operations to logger. This is the
+// same trace that hyperpb prints when built with the debug build tag, and is
+// intended for diagnosing surprising parses in tests without rebuilding.
+//
+// Logging makes parsing dramatically slower, and should not be enabled in
+// production. When logger is nil, which is the default, logging costs a
+// single branch per operation.
+func WithLogger(logger Logger) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) {
+		if logger == nil {
+			opts.Logger = nil
+		} else {
+			opts.Logger = logger.Log
+		}
+	}}
+}
+
+// WithMaxUnknown limits the unknown fields retained for each message to the
+// given number of fields and total number of bytes; a limit of zero means no
+// limit. This prevents inputs consisting of many tiny unknown fields from
+// using a disproportionate amount of memory.
+//
+// If truncate is set, unknown fields that would exceed either limit are
+// silently dropped. Otherwise, they cause parsing to fail.
+//
+// This has no effect if unknown fields are discarded; see
+// [WithDiscardUnknown].
+func WithMaxUnknown(fields, bytes int, truncate bool) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) {
+		opts.MaxUnknownFields = fields
+		opts.MaxUnknownBytes = bytes
+		opts.TruncateUnknown = truncate
+	}}
+}
+
+// UnknownAction is what to do with an unknown field, as decided by a filter
+// set with [WithUnknownFilter].
+type UnknownAction int8
+
+const (
+	// UnknownKeep retains the field, subject to [WithDiscardUnknown] and
+	// [WithMaxUnknown].
+	UnknownKeep = UnknownAction(vm.UnknownKeep)
+	// UnknownDrop discards the field.
+	UnknownDrop = UnknownAction(vm.UnknownDrop)
+	// UnknownFail causes parsing to fail, with an error at the offset of the
+	// field.
+	UnknownFail = UnknownAction(vm.UnknownFail)
+)
+
+// WithUnknownFilter sets a callback that decides what to do with each unknown
+// field encountered while parsing, in any message. It is called with the
+// field's number and wire type, and with its encoding, including the tag; for
+// a group, this extends to the end of the group's end tag. A nil filter keeps
+// every field, which is the default.
+//
+// For example, this can be used to retain only unknown fields in an extension
+// range, or to reject unknown groups, without having to post-process the
+// unknown fields of each message after parsing.
+//
+// The encoding aliases the parser's input, and must not be modified. The
+// callback must not access the message being parsed. Unknown fields in map
+// entries, which are preserved with [MapPreserveUnknown], are not filtered.
+func WithUnknownFilter(filter func(number protowire.Number, typ protowire.Type, raw []byte) UnknownAction) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) {
+		if filter == nil {
+			opts.UnknownFilter = nil
+			return
+		}
+		opts.UnknownFilter = func(n protowire.Number, t protowire.Type, raw []byte) vm.UnknownAction {
+			return vm.UnknownAction(filter(n, t, raw))
+		}
+	}}
+}
+
 // WithAllowInvalidUTF8 sets whether UTF-8 is validated when parsing string
 // fields originating from non-proto2 files.
 func WithAllowInvalidUTF8(allow bool) UnmarshalOption {
 	return UnmarshalOption{func(opts *vm.Options) { opts.AllowInvalidUTF8 = allow }}
 }
 
+// WithRepairUTF8 sets whether string fields that contain invalid UTF-8 are
+// repaired, rather than failing the parse. Each run of invalid bytes is
+// replaced with U+FFFD, as [strings.ToValidUTF8] does.
+//
+// Inputs with invalid UTF-8 are parsed twice: once to discover that there is
+// invalid UTF-8, and once more from a repaired copy of the input, which the
+// message then aliases instead of the original input (see
+// [Message.WireBytes]). Valid inputs are parsed at full speed.
+//
+// Has no effect if [WithAllowInvalidUTF8] is set.
+func WithRepairUTF8(repair bool) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) { opts.RepairUTF8 = repair }}
+}
+
 // WithAllowAlias sets whether aliasing the input buffer is allowed. This avoids
 // an expensive copy at the start of parsing.
 //
@@ -102,6 +549,166 @@ func WithAllowAlias(allow bool) UnmarshalOption {
 	return UnmarshalOption{func(opts *vm.Options) { opts.AllowAlias = allow }}
 }
 
+// WithChecksum sets whether to record a checksum of the input buffer when
+// aliasing it with [WithAllowAlias], so that modifications to it can be
+// detected with [Message.Verify].
+//
+// Modifying an aliased input buffer while a message parsed from it is in use
+// causes its fields to silently change. With this option, Verify returns an
+// error wrapping [ErrSourceModified] if the buffer was modified, turning such
+// bugs into loud ones; [Shared.Free] does not check. This costs an extra pass
+// over the input for each parse and for each call to Verify, so it is
+// intended for tests and debugging. It has no effect if the input is copied.
+func WithChecksum(enable bool) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) { opts.Checksum = enable }}
+}
+
+// WithRecordSpills sets whether to record which repeated fields had to be
+// copied out of the input while parsing, so that [Message.FieldStorage] can
+// report them as [StorageSpilled]. Without it, such fields are reported as
+// [StorageArena].
+func WithRecordSpills(enable bool) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) { opts.RecordSpills = enable }}
+}
+
+// WithFingerprint sets whether to compute a 64-bit fingerprint of the input
+// while parsing, which can be retrieved with [Message.Fingerprint].
+//
+// The fingerprint is the XXH64 hash of the input with all unknown fields
+// removed, so two inputs that differ only in fields unknown to the parsed
+// type have the same fingerprint. It is computed in the same pass over the
+// input as the parse, and is intended as a cheap key for deduplication and
+// caching. It is not a cryptographic hash, and it depends on the encoding:
+// inputs that represent equal messages, but encode them differently, such as
+// by ordering fields differently, have different fingerprints.
+func WithFingerprint(enable bool) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) { opts.Fingerprint = enable }}
+}
+
+// FloatMode is a set of transformations applied to float and double fields
+// while parsing. See [WithFloatMode].
+type FloatMode uint8
+
+const (
+	// FloatCanonicalNaN replaces every NaN with the canonical quiet NaN: the
+	// NaN with only the quiet bit set, 0x7fc00000 for float and
+	// 0x7ff8000000000000 for double. Note that this is not the value returned
+	// by [math.NaN], which has a nonzero payload.
+	FloatCanonicalNaN = FloatMode(vm.FloatCanonicalNaN)
+	// FloatRejectSignalingNaN causes parsing to fail if a signaling NaN is
+	// encountered. This takes precedence over FloatCanonicalNaN.
+	FloatRejectSignalingNaN = FloatMode(vm.FloatRejectSignalingNaN)
+	// FloatFlushSubnormal replaces every subnormal value with a zero of the
+	// same sign.
+	FloatFlushSubnormal = FloatMode(vm.FloatFlushSubnormal)
+)
+
+// WithFloatMode sets transformations to apply to float and double fields,
+// including in repeated fields and map values, while parsing. By default, the
+// bits of floating-point values are preserved exactly.
+//
+// Setting this option disables zero-copy parsing of packed float and double
+// fields, since their elements may need to be rewritten.
+func WithFloatMode(mode FloatMode) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) { opts.Floats = vm.FloatMode(mode) }}
+}
+
+// MapEntryMode is a set of behaviors for parsing map entries that are not
+// minimally encoded. See [WithMapEntryMode].
+type MapEntryMode uint8
+
+const (
+	// MapKeepFirst causes the first value for a key that appears more than
+	// once in a map to be kept, rather than the last one.
+	MapKeepFirst = MapEntryMode(vm.MapKeepFirst)
+	// MapPreserveUnknown causes map entries which contain fields other than
+	// the key and value to be recorded, in their entirety, as unknown fields
+	// of the message containing the map, so that they are preserved when
+	// round-tripping. Has no effect when unknown fields are discarded.
+	MapPreserveUnknown = MapEntryMode(vm.MapPreserveUnknown)
+)
+
+// WithMapEntryMode sets behaviors for parsing map entries that are not
+// minimally encoded, such as those with duplicate keys, or with fields other
+// than the key and value.
+//
+// By default, the last value for a duplicate key wins and extra fields in map
+// entries are dropped, matching the behavior of other Protobuf parsers.
+func WithMapEntryMode(mode MapEntryMode) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) { opts.MapEntries = vm.MapEntryMode(mode) }}
+}
+
+// WithDeadline bounds the time spent parsing a message to roughly d, measured
+// from the call to [Message.Unmarshal].
+//
+// If the deadline passes, parsing is aborted with an error that wraps
+// [context.DeadlineExceeded]. This is intended as a defense against
+// pathological inputs, and is checked only periodically, so a parse may
+// overrun its deadline slightly. A non-positive d disables the deadline.
+func WithDeadline(d time.Duration) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) {
+		if d <= 0 {
+			opts.Deadline = 0
+			return
+		}
+		opts.Deadline = time.Now().Add(d).UnixNano()
+	}}
+}
+
+// WithProgress sets a callback that is called periodically during parsing,
+// roughly every interval bytes, with the number of bytes parsed so far and the
+// total size of the input. It is called once more, with offset equal to total,
+// when parsing succeeds.
+//
+// This is intended for displaying the progress of very large parses, and for
+// yielding to other work during them, such as by calling [runtime.Gosched].
+// Progress is only checked between fields, so a single large field may cause
+// the offset to advance by much more than interval between calls. The callback
+// must not access the message being parsed.
+//
+// Checking for progress costs a counter decrement per field, which is only
+// compiled into the parser used when this option or [WithDeadline] is set;
+// other parses do not pay for it.
+func WithProgress(interval int, callback func(offset, total int)) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) {
+		opts.Progress = callback
+		opts.ProgressInterval = interval
+	}}
+}
+
+// WithDedupMessages makes elements of repeated message fields share a single
+// message when their encodings are identical and at most maxSize bytes long,
+// rather than parsing each of them separately. This saves memory when parsing
+// inputs that contain many copies of the same small message, at the cost of
+// hashing each element up to maxSize bytes. Zero disables deduplication,
+// which is the default.
+//
+// Deduplicated elements alias each other: the *[Message] values for them
+// compare equal, and so share values cached with [Memo]. Otherwise, this
+// does not affect what is observed through reflection, since the operations
+// that mutate a parsed message, [Message.SetUnknown] and appending through
+// [Message.Mutable], panic on every message parsed with this option.
+func WithDedupMessages(maxSize int) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) { opts.DedupMessages = max(0, maxSize) }}
+}
+
+// WithWireStats tallies the fields of the parsed input into stats as they are
+// parsed, once the parse succeeds. stats may be nil, in which case nothing
+// will be tallied.
+//
+// This makes a parse slower, since every field is looked up in a hash table,
+// so it is intended for sampling a fraction of traffic. Concurrent parses
+// that share stats do not block each other.
+func WithWireStats(stats *WireStats) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) {
+		if stats == nil {
+			opts.WireStats = nil
+		} else {
+			opts.WireStats = &stats.impl
+		}
+	}}
+}
+
 // WithRecordProfile sets a profiler for an unmarshaling operation. Rate is a
 // value from 0 to 1 that specifies the sampling rate. profile may be nil, in
 // which case nothing will be recorded.
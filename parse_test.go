@@ -15,15 +15,35 @@
 package hyperpb_test
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"math"
+	"reflect"
 	"runtime"
+	"sync/atomic"
 	"testing"
+	"time"
+	"unicode/utf8"
+	"unsafe"
 
+	gogoproto "github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/dynamicpb"
 
 	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/internal/debug"
+	_ "buf.build/go/hyperpb/internal/gen/gogo/test" // Registers gogo types for benchmarks.
+	testpb "buf.build/go/hyperpb/internal/gen/test"
 	"buf.build/go/hyperpb/internal/testdata"
 	"buf.build/go/hyperpb/internal/xflag"
 )
@@ -50,6 +70,101 @@ func TestUnmarshal(t *testing.T) {
 	})
 }
 
+func TestDeadline(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+
+	var known, unknown []byte
+	for range 100000 {
+		known = protowire.AppendTag(known, 1, protowire.VarintType)
+		known = protowire.AppendVarint(known, 42)
+		unknown = protowire.AppendTag(unknown, 1000, protowire.VarintType)
+		unknown = protowire.AppendVarint(unknown, 42)
+	}
+
+	for _, data := range [][]byte{known, unknown} {
+		m := hyperpb.NewMessage(ty)
+		err := m.Unmarshal(data, hyperpb.WithDeadline(time.Nanosecond))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		m = hyperpb.NewMessage(ty)
+		err = m.Unmarshal(data, hyperpb.WithDeadline(time.Hour))
+		require.NoError(t, err)
+	}
+}
+
+func TestProgress(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+
+	var data []byte
+	for range 100000 {
+		data = protowire.AppendTag(data, 1, protowire.VarintType)
+		data = protowire.AppendVarint(data, 42)
+	}
+
+	var offsets []int
+	m := hyperpb.NewMessage(ty)
+	err := m.Unmarshal(data, hyperpb.WithProgress(len(data)/10, func(offset, total int) {
+		assert.Equal(t, len(data), total)
+		offsets = append(offsets, offset)
+	}))
+	require.NoError(t, err)
+
+	require.Len(t, offsets, 10)
+	assert.IsIncreasing(t, offsets)
+	assert.Equal(t, len(data), offsets[len(offsets)-1])
+}
+
+func TestMaxSize(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	data, err := proto.Marshal(&testpb.Scalars{A1: 42, A2: 42})
+	require.NoError(t, err)
+
+	m := hyperpb.NewMessage(ty)
+	require.Error(t, m.Unmarshal(data, hyperpb.WithMaxSize(len(data)-1)))
+
+	m = hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithMaxSize(len(data))))
+
+	m = hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithMaxSize(math.MaxInt)))
+
+	// Sizes below one mean the default, not a limit of zero.
+	for _, size := range []int{0, -1} {
+		m = hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithMaxSize(len(data)-1), hyperpb.WithMaxSize(size)))
+	}
+}
+
+func TestStackDepth(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Graph)(nil).ProtoReflect().Descriptor())
+	want := &testpb.Graph{V: 50}
+	for i := range 50 {
+		want = &testpb.Graph{V: int32(i), S: want, R: []*testpb.Graph{{V: -1}}}
+	}
+	data, err := proto.Marshal(want)
+	require.NoError(t, err)
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithStackDepth(3)))
+	got := new(testpb.Graph)
+	proto.Merge(got, m)
+	assert.True(t, proto.Equal(want, got), "got %v", got)
+	// Whether the stack had to grow depends on which pooled stack this parse
+	// happened to get.
+	assert.Positive(t, hyperpb.ReadStackStats().Allocs)
+
+	m = hyperpb.NewMessage(ty)
+	require.Error(t, m.Unmarshal(data, hyperpb.WithStackDepth(3), hyperpb.WithMaxDepth(20)))
+}
+
 func BenchmarkUnmarshal(b *testing.B) {
 	testdata.RunAll(b, func(b *testing.B, test *testdata.TestCase) {
 		b.Helper()
@@ -82,6 +197,16 @@ func BenchmarkUnmarshal(b *testing.B) {
 					ctx.Free()
 				}
 			})
+			b.Run("deadline", func(b *testing.B) {
+				b.ReportAllocs()
+				b.SetBytes(int64(len(specimen)))
+				ctx := new(hyperpb.Shared)
+				for range b.N {
+					m := ctx.NewMessage(test.Type.Fast)
+					_ = m.Unmarshal(specimen, hyperpb.WithAllowAlias(true), hyperpb.WithDeadline(time.Hour))
+					ctx.Free()
+				}
+			})
 			b.Run("pgo", func(b *testing.B) {
 				b.ReportAllocs()
 				b.SetBytes(int64(len(specimen)))
@@ -128,6 +253,20 @@ func BenchmarkUnmarshal(b *testing.B) {
 					_ = m.UnmarshalVTUnsafe(specimen)
 				}
 			})
+			b.Run("gogo", func(b *testing.B) {
+				type gogoMessage interface{ Unmarshal([]byte) error }
+				ty := gogoproto.MessageType(test.TypeName)
+				if ty == nil {
+					b.SkipNow()
+				}
+
+				b.ReportAllocs()
+				b.SetBytes(int64(len(specimen)))
+				for range b.N {
+					m := reflect.New(ty.Elem()).Interface().(gogoMessage) //nolint:errcheck
+					_ = m.Unmarshal(specimen)
+				}
+			})
 			b.Run("dynamicpb", func(b *testing.B) {
 				b.ReportAllocs()
 				b.SetBytes(int64(len(specimen)))
@@ -148,3 +287,960 @@ func BenchmarkUnmarshal(b *testing.B) {
 		}
 	})
 }
+
+func TestMapEntryMode(t *testing.T) {
+	t.Parallel()
+
+	entry := func(number protowire.Number, key int32, value []byte, extra bool) []byte {
+		var e []byte
+		e = protowire.AppendTag(e, 1, protowire.VarintType)
+		e = protowire.AppendVarint(e, uint64(key))
+		e = append(e, value...)
+		if extra {
+			e = protowire.AppendTag(e, 3, protowire.VarintType)
+			e = protowire.AppendVarint(e, 7)
+		}
+
+		b := protowire.AppendTag(nil, number, protowire.BytesType)
+		return protowire.AppendBytes(b, e)
+	}
+	scalar := func(v uint64) []byte {
+		b := protowire.AppendTag(nil, 2, protowire.VarintType)
+		return protowire.AppendVarint(b, v)
+	}
+	message := func(a1 int32) []byte {
+		m, err := proto.Marshal(&testpb.MessageMaps{Scalars: &testpb.Scalars{A1: a1}})
+		require.NoError(t, err)
+		b := protowire.AppendTag(nil, 2, protowire.BytesType)
+		return protowire.AppendBytes(b, m)
+	}
+
+	t.Run("scalar", func(t *testing.T) {
+		t.Parallel()
+
+		first := entry(16, 1, scalar(10), false)
+		second := entry(16, 1, scalar(20), true)
+		data := append(append([]byte(nil), first...), second...)
+
+		ty := hyperpb.CompileMessageDescriptor((*testpb.Maps)(nil).ProtoReflect().Descriptor())
+		fd := ty.Descriptor().Fields().ByName("m10")
+		get := func(m *hyperpb.Message) int64 {
+			return m.Get(fd).Map().Get(protoreflect.ValueOfInt32(1).MapKey()).Int()
+		}
+
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data))
+		assert.Equal(t, int64(20), get(m))
+		assert.Empty(t, m.GetUnknown())
+
+		m = hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithMapEntryMode(hyperpb.MapKeepFirst)))
+		assert.Equal(t, int64(10), get(m))
+
+		m = hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithMapEntryMode(hyperpb.MapPreserveUnknown)))
+		assert.Equal(t, int64(20), get(m))
+		assert.Equal(t, second, []byte(m.GetUnknown()))
+
+		m = hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data,
+			hyperpb.WithMapEntryMode(hyperpb.MapPreserveUnknown),
+			hyperpb.WithDiscardUnknown(true),
+		))
+		assert.Empty(t, m.GetUnknown())
+	})
+
+	t.Run("message", func(t *testing.T) {
+		t.Parallel()
+
+		data := append(entry(17, 1, message(1), false), entry(17, 1, message(2), true)...)
+
+		ty := hyperpb.CompileMessageDescriptor((*testpb.MessageMaps)(nil).ProtoReflect().Descriptor())
+		fields := ty.Descriptor().Fields()
+		get := func(m *hyperpb.Message) int64 {
+			v := m.Get(fields.ByName("m1")).Map().Get(protoreflect.ValueOfInt32(1).MapKey()).Message()
+			s := v.Get(fields.ByName("scalars")).Message()
+			return s.Get(s.Descriptor().Fields().ByName("a1")).Int()
+		}
+
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data))
+		assert.Equal(t, int64(2), get(m))
+
+		m = hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithMapEntryMode(hyperpb.MapKeepFirst)))
+		assert.Equal(t, int64(1), get(m))
+
+		m = hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithMapEntryMode(hyperpb.MapPreserveUnknown)))
+		assert.Equal(t, int64(2), get(m))
+		assert.NotEmpty(t, m.GetUnknown())
+	})
+}
+
+func TestMaxUnknown(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	var data []byte
+	for i := range 10 {
+		data = protowire.AppendTag(data, 1, protowire.VarintType)
+		data = protowire.AppendVarint(data, uint64(i))
+		data = protowire.AppendTag(data, 100, protowire.VarintType)
+		data = protowire.AppendVarint(data, uint64(i))
+	}
+	unknown := func(n int) []byte { // The first n unknown fields.
+		var out []byte
+		for i := range n {
+			out = protowire.AppendTag(out, 100, protowire.VarintType)
+			out = protowire.AppendVarint(out, uint64(i))
+		}
+		return out
+	}
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithMaxUnknown(10, 30, false)))
+	assert.Equal(t, unknown(10), []byte(m.GetUnknown()))
+
+	m = hyperpb.NewMessage(ty)
+	require.Error(t, m.Unmarshal(data, hyperpb.WithMaxUnknown(9, 0, false)))
+	m = hyperpb.NewMessage(ty)
+	require.Error(t, m.Unmarshal(data, hyperpb.WithMaxUnknown(0, 29, false)))
+
+	m = hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithMaxUnknown(4, 0, true)))
+	assert.Equal(t, unknown(4), []byte(m.GetUnknown()))
+	assert.Equal(t, int64(9), m.Get(ty.Descriptor().Fields().ByName("a1")).Int())
+
+	m = hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithMaxUnknown(0, 9, true)))
+	assert.Equal(t, unknown(3), []byte(m.GetUnknown()))
+}
+
+func TestLargeFieldNumbers(t *testing.T) {
+	t.Parallel()
+
+	// Field numbers from 1<<25 up have five-byte tags.
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	for _, n := range []protowire.Number{1<<25 - 1, 1 << 25, protowire.MaxValidNumber} {
+		data := protowire.AppendTag(nil, n, protowire.VarintType)
+		data = protowire.AppendVarint(data, 42)
+
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data), n)
+		assert.Equal(t, data, []byte(m.GetUnknown()), n)
+	}
+
+	// A tag which does not fit in 32 bits.
+	data := protowire.AppendVarint(nil, 1<<32|uint64(protowire.VarintType))
+	data = protowire.AppendVarint(data, 42)
+	require.Error(t, hyperpb.NewMessage(ty).Unmarshal(data))
+}
+
+func TestUnknownFilter(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	var data, kept []byte
+	data = protowire.AppendTag(data, 1, protowire.VarintType)
+	data = protowire.AppendVarint(data, 42)
+	for _, n := range []protowire.Number{100, 1000, 1500, 2000, 2001} {
+		field := protowire.AppendTag(nil, n, protowire.BytesType)
+		field = protowire.AppendString(field, "xyz")
+		data = append(data, field...)
+		if n >= 1000 && n <= 2000 {
+			kept = append(kept, field...)
+		}
+	}
+	groupAt := len(data)
+	data = protowire.AppendTag(data, 1200, protowire.StartGroupType)
+	data = protowire.AppendTag(data, 1, protowire.VarintType)
+	data = protowire.AppendVarint(data, 1)
+	data = protowire.AppendTag(data, 1200, protowire.EndGroupType)
+
+	var calls int
+	filter := func(n protowire.Number, typ protowire.Type, raw []byte) hyperpb.UnknownAction {
+		calls++
+		switch {
+		case typ == protowire.StartGroupType:
+			assert.Equal(t, data[groupAt:], raw)
+			return hyperpb.UnknownFail
+		case n < 1000 || n > 2000:
+			return hyperpb.UnknownDrop
+		default:
+			assert.Equal(t, protowire.BytesType, typ)
+			return hyperpb.UnknownKeep
+		}
+	}
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data[:groupAt], hyperpb.WithUnknownFilter(filter)))
+	assert.Equal(t, 5, calls)
+	assert.Equal(t, kept, []byte(m.GetUnknown()))
+	assert.Equal(t, int64(42), m.Get(ty.Descriptor().Fields().ByName("a1")).Int())
+
+	m = hyperpb.NewMessage(ty)
+	err := m.Unmarshal(data, hyperpb.WithUnknownFilter(filter))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("offset %d/", groupAt))
+
+	// A nil filter keeps everything.
+	m = hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithUnknownFilter(nil)))
+	assert.Equal(t, data[2:], []byte(m.GetUnknown()))
+}
+
+func TestSetUnknown(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Scalars]()
+	data := protowire.AppendTag(nil, 1, protowire.VarintType)
+	data = protowire.AppendVarint(data, 42)
+	known := len(data)
+	data = protowire.AppendTag(data, 100, protowire.BytesType)
+	data = protowire.AppendString(data, "xyz")
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.Equal(t, data[known:], []byte(m.GetUnknown()))
+
+	// Clearing unknown fields strips them from the serialized message.
+	m.SetUnknown(nil)
+	assert.Empty(t, m.GetUnknown())
+	out, err := proto.Marshal(m)
+	require.NoError(t, err)
+	assert.Equal(t, data[:known], out)
+
+	// Setting unknown fields copies them.
+	raw := protowire.AppendTag(nil, 200, protowire.VarintType)
+	raw = protowire.AppendVarint(raw, 7)
+	m.SetUnknown(raw)
+	want := append(data[:known:known], raw...)
+	raw[0] = 0
+	assert.Equal(t, want[known:], []byte(m.GetUnknown()))
+	out, err = proto.Marshal(m)
+	require.NoError(t, err)
+	assert.Equal(t, want, out)
+}
+
+func TestReservedWireTypes(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Graph]()
+	reserved := append(protowire.AppendTag(nil, 1, 6), 0xff, 0xff, 0xff, 0xff)
+	var inner []byte
+	inner = protowire.AppendTag(inner, 1, protowire.VarintType)
+	inner = protowire.AppendVarint(inner, 2)
+	inner = append(inner, reserved...)
+	inner = protowire.AppendTag(inner, 1, protowire.VarintType)
+	inner = protowire.AppendVarint(inner, 3)
+
+	var data []byte
+	data = protowire.AppendTag(data, 1, protowire.VarintType)
+	data = protowire.AppendVarint(data, 1)
+	data = protowire.AppendTag(data, 2, protowire.BytesType)
+	data = protowire.AppendBytes(data, inner)
+	at := bytes.Index(data, reserved)
+	data = protowire.AppendTag(data, 3, protowire.BytesType)
+	data = protowire.AppendBytes(data, []byte{0x08, 0x04})
+
+	var seen []int
+	observe := func(n protowire.Number, typ protowire.Type, offset int) {
+		assert.Equal(t, protowire.Number(1), n)
+		assert.Equal(t, protowire.Type(6), typ)
+		seen = append(seen, offset)
+	}
+	parse := func(data []byte, mode hyperpb.ReservedWireTypeMode, skip int) (*testpb.Graph, []byte, error) {
+		m := hyperpb.NewMessage(ty)
+		err := m.Unmarshal(data, hyperpb.WithReservedWireTypes(mode, skip, observe))
+		if err != nil {
+			return nil, nil, err
+		}
+		out := new(testpb.Graph)
+		proto.Merge(out, m)
+		s := m.Get(ty.Descriptor().Fields().ByName("s")).Message()
+		return out, s.GetUnknown(), nil
+	}
+
+	_, _, err := parse(data, hyperpb.ReservedFail, 0)
+	require.Error(t, err)
+	assert.Equal(t, []int{at}, seen)
+
+	g, unknown, err := parse(data, hyperpb.ReservedSkip, 4)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), g.GetS().GetV())
+	assert.Equal(t, reserved, unknown)
+	assert.Equal(t, int32(4), g.GetR()[0].GetV())
+
+	_, _, err = parse(data, hyperpb.ReservedSkip, 100)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	g, unknown, err = parse(data, hyperpb.ReservedAbortMessage, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), g.GetV())
+	assert.Equal(t, int32(2), g.GetS().GetV())
+	assert.Empty(t, unknown)
+	assert.Equal(t, int32(4), g.GetR()[0].GetV())
+	assert.Len(t, seen, 4)
+
+	// Groups cannot be aborted.
+	var group []byte
+	group = protowire.AppendTag(group, 9, protowire.StartGroupType)
+	group = append(group, reserved...)
+	group = protowire.AppendTag(group, 9, protowire.EndGroupType)
+	_, _, err = parse(group, hyperpb.ReservedAbortMessage, 0)
+	require.Error(t, err)
+	_, _, err = parse(group, hyperpb.ReservedSkip, 4)
+	require.NoError(t, err)
+}
+
+func TestDedupMessages(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Graph)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md)
+	want := &testpb.Graph{V: 1}
+	for i := range 40 {
+		switch {
+		case i%10 == 9:
+			want.R = append(want.R, &testpb.Graph{V: int32(i)})
+		case i%2 == 0:
+			want.R = append(want.R, &testpb.Graph{V: 5, S: &testpb.Graph{V: 6}})
+		default:
+			want.R = append(want.R, &testpb.Graph{})
+		}
+	}
+	// Identical bytes in a different field, of the same type.
+	want.S = &testpb.Graph{V: 5, S: &testpb.Graph{V: 6}, R: []*testpb.Graph{{}, {V: 5, S: &testpb.Graph{V: 6}}}}
+	data, err := proto.Marshal(want)
+	require.NoError(t, err)
+
+	r := md.Fields().ByName("r")
+	elem := func(m *hyperpb.Message, i int) *hyperpb.Message {
+		return m.Get(r).List().Get(i).Message().(*hyperpb.Message) //nolint:errcheck
+	}
+	for _, size := range []int{0, 2, 64} {
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithDedupMessages(size)))
+		got := new(testpb.Graph)
+		proto.Merge(got, m)
+		assert.True(t, proto.Equal(want, got), "got %v", got)
+
+		// Elements 1 and 3 are empty, and 0 and 2 are six bytes long.
+		assert.Equal(t, size > 0, elem(m, 1) == elem(m, 3), "size %d", size)
+		assert.Equal(t, size >= 6, elem(m, 0) == elem(m, 2), "size %d", size)
+		assert.NotSame(t, elem(m, 0), elem(m, 9))
+
+		// Mutating an element would mutate the elements it is shared with.
+		if size > 0 {
+			assert.Panics(t, func() { elem(m, 1).SetUnknown([]byte{0xf8, 0x01, 0x05}) })
+			assert.Panics(t, func() { elem(m, 1).SetUnknown(nil) })
+			assert.Panics(t, func() { m.SetUnknown(nil) })
+			assert.Empty(t, elem(m, 3).GetUnknown())
+		} else {
+			elem(m, 1).SetUnknown([]byte{0xf8, 0x01, 0x05})
+			assert.Empty(t, elem(m, 3).GetUnknown())
+		}
+	}
+
+	// So would appending to a repeated scalar field of one.
+	m := hyperpb.NewMessage(hyperpb.CompileFor[*testpb.Repeated]())
+	require.NoError(t, m.Unmarshal([]byte{0x08, 0x01}, hyperpb.WithDedupMessages(64)))
+	assert.Panics(t, func() { m.Mutable(m.Descriptor().Fields().ByName("r1")) })
+}
+
+//nolint:paralleltest // AllocsPerRun panics in parallel tests.
+func TestTryUnmarshal(t *testing.T) {
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	bad := []byte{0x08, 0x01, 0x72, 0x05, 0x61} // Field 14 is truncated.
+
+	m := hyperpb.NewMessage(ty)
+	result := m.TryUnmarshal(bad)
+	require.ErrorIs(t, result.Err, io.ErrUnexpectedEOF)
+	assert.Equal(t, 4, result.Offset)
+
+	err := hyperpb.NewMessage(ty).Unmarshal(bad)
+	require.ErrorIs(t, err, result.Err)
+	assert.Equal(t, result.Offset, err.(interface{ Offset() int }).Offset()) //nolint:errcheck,errorlint
+
+	m = hyperpb.NewMessage(ty)
+	assert.Equal(t, hyperpb.UnmarshalResult{}, m.TryUnmarshal(bad[:2]))
+
+	if debug.Enabled {
+		t.Skip("debug mode allocates when logging")
+	}
+	s := new(hyperpb.Shared)
+	allocs := testing.AllocsPerRun(100, func() {
+		s.NewMessage(ty).TryUnmarshal(bad, hyperpb.WithAllowAlias(true))
+		s.Free()
+	})
+	assert.Zero(t, allocs)
+}
+
+func TestGroupErrors(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Groups]()
+	tests := []struct {
+		name      string
+		data      []byte
+		truncated bool
+		want      hyperpb.GroupError
+	}{
+		{
+			name: "mismatch",
+			data: []byte{0x0b, 0x08, 0x01, 0x14},
+			want: hyperpb.GroupError{Offset: 4, Start: 0, Expected: 1, Actual: 2},
+		},
+		{
+			name: "nested",
+			data: []byte{0x0b, 0x08, 0x01, 0x23, 0x08, 0x01, 0x0c},
+			want: hyperpb.GroupError{Offset: 7, Start: 3, Expected: 4, Actual: 1},
+		},
+		{
+			name: "eof",
+			data: []byte{0x0b, 0x08, 0x01},
+			want: hyperpb.GroupError{Offset: 3, Start: 0, Expected: 1},
+		},
+		{
+			name: "stray",
+			data: []byte{0x08, 0x01, 0x14},
+			want: hyperpb.GroupError{Offset: 3, Start: -1, Actual: 2},
+		},
+		{
+			name: "unknown",
+			data: []byte{0x0b, 0x2b, 0x08, 0x01, 0x14},
+			want: hyperpb.GroupError{Offset: 5, Start: 1, Expected: 5, Actual: 2},
+		},
+		{
+			name:      "unknown-eof",
+			data:      []byte{0x2b, 0x08, 0x01},
+			truncated: true,
+			want:      hyperpb.GroupError{Offset: 3, Start: 0, Expected: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := hyperpb.NewMessage(ty).Unmarshal(tt.data)
+			require.Error(t, err)
+			assert.Equal(t, tt.truncated, errors.Is(err, io.ErrUnexpectedEOF), "%v", err)
+			got, ok := hyperpb.GroupErrorOf(err)
+			require.True(t, ok, "%v", err)
+			assert.Equal(t, tt.want, got)
+
+			// Protobuf-go agrees that this input is invalid.
+			assert.Error(t, proto.Unmarshal(tt.data, new(testpb.Groups)))
+		})
+	}
+
+	// Unterminated known groups can be reported as truncation instead.
+	err := hyperpb.NewMessage(ty).Unmarshal(tests[2].data, hyperpb.WithTruncatedGroups(true))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.ErrorContains(t, err, "input ended inside of group 1 starting at offset 0")
+	got, ok := hyperpb.GroupErrorOf(err)
+	assert.True(t, ok)
+	assert.Equal(t, tests[2].want, got)
+
+	err = hyperpb.NewMessage(ty).Unmarshal([]byte{0x08, 0x01})
+	require.NoError(t, err)
+	err = hyperpb.NewMessage(ty).Unmarshal([]byte{0x0b, 0x1a, 0x05})
+	require.Error(t, err)
+	_, ok = hyperpb.GroupErrorOf(err)
+	assert.False(t, ok)
+}
+
+func TestWireStats(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Graph)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md)
+	data, err := proto.Marshal(&testpb.Graph{V: 1, S: &testpb.Graph{V: 2}, R: []*testpb.Graph{{V: 3}, {V: 4}}})
+	require.NoError(t, err)
+	data = append(data, 0xf8, 0x01, 0x05) // Unknown field 31.
+
+	stats := new(hyperpb.WireStats)
+	for range 2 {
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithWireStats(stats)))
+	}
+	require.Error(t, hyperpb.NewMessage(ty).Unmarshal([]byte{0x08}, hyperpb.WithWireStats(stats)))
+
+	var fields []hyperpb.WireField
+	var got []hyperpb.WireFieldStats
+	for f, s := range stats.All() {
+		fields = append(fields, f)
+		got = append(got, s)
+	}
+	assert.Equal(t, []hyperpb.WireField{
+		{Message: md.FullName(), Number: 1},
+		{Message: md.FullName(), Number: 2},
+		{Message: md.FullName(), Number: 3},
+		{Message: md.FullName(), Number: 31},
+	}, fields)
+	assert.Equal(t, []hyperpb.WireFieldStats{
+		{Count: 8, Bytes: 16}, // Including submessages.
+		{Count: 2, Bytes: 8},
+		{Count: 4, Bytes: 16},
+		{Count: 2, Bytes: 6},
+	}, got)
+
+	// Map values are tallied as fields of their own type.
+	stats.Reset()
+	assert.Zero(t, stats.Get(md.FullName(), 1))
+	mmd := (*testpb.MessageMaps)(nil).ProtoReflect().Descriptor()
+	data, err = proto.Marshal(&testpb.MessageMaps{
+		M1: map[int32]*testpb.MessageMaps{5: {Scalars: &testpb.Scalars{A1: 1}}},
+	})
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(mmd))
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithWireStats(stats)))
+	assert.Equal(t, hyperpb.WireFieldStats{Count: 1, Bytes: len(data)}, stats.Get(mmd.FullName(), 17))
+	assert.Equal(t, 1, stats.Get(mmd.FullName(), 1).Count)
+	assert.Equal(t, hyperpb.WireFieldStats{Count: 1, Bytes: 2}, stats.Get("hyperpb.test.Scalars", 1))
+
+	// Groups are tallied along with their contents and end tag.
+	stats.Reset()
+	data, err = proto.Marshal(&testpb.Groups{Singular: &testpb.Groups_Singular{
+		A: proto.Int32(1), Nested: &testpb.Groups_Singular_Nested{A: proto.Int32(2)},
+	}})
+	require.NoError(t, err)
+	m = hyperpb.NewMessage(hyperpb.CompileFor[*testpb.Groups]())
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithWireStats(stats)))
+	assert.Equal(t, hyperpb.WireFieldStats{Count: 1, Bytes: len(data)}, stats.Get("hyperpb.test.Groups", 1))
+	assert.Equal(t, hyperpb.WireFieldStats{Count: 1, Bytes: 4}, stats.Get("hyperpb.test.Groups.Singular", 4))
+	assert.Equal(t, hyperpb.WireFieldStats{Count: 1, Bytes: 2}, stats.Get("hyperpb.test.Groups.Singular.Nested", 1))
+}
+
+func TestRepairUTF8(t *testing.T) {
+	t.Parallel()
+
+	str := func(n protowire.Number, v string) []byte {
+		return protowire.AppendBytes(protowire.AppendTag(nil, n, protowire.BytesType), []byte(v))
+	}
+
+	t.Run("nested", func(t *testing.T) {
+		t.Parallel()
+
+		ty := hyperpb.CompileMessageDescriptor((*testpb.Oneof)(nil).ProtoReflect().Descriptor())
+		data := str(20, string(str(18, "a\xffb")))
+		require.Error(t, hyperpb.NewMessage(ty).Unmarshal(data))
+
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithRepairUTF8(true)))
+		got := new(testpb.Oneof)
+		proto.Merge(got, m)
+		assert.Equal(t, "a\uFFFDb", got.GetM10().GetM8())
+	})
+
+	t.Run("repeated", func(t *testing.T) {
+		t.Parallel()
+
+		ty := hyperpb.CompileMessageDescriptor((*testpb.Repeated)(nil).ProtoReflect().Descriptor())
+		data := append(str(7, "ok"), str(7, "\xff\xfe")...)
+		data = append(data, str(8, "\xff")...)
+		data = append(data, str(1, "\x01")...) // Packed.
+
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithRepairUTF8(true)))
+		got := new(testpb.Repeated)
+		proto.Merge(got, m)
+		assert.Equal(t, []string{"ok", "\uFFFD"}, got.R7)
+		assert.Equal(t, [][]byte{{0xff}}, got.R8)
+		assert.Equal(t, []int32{1}, got.R1)
+		assert.NotEqual(t, data, m.WireBytes())
+	})
+
+	t.Run("map", func(t *testing.T) {
+		t.Parallel()
+
+		ty := hyperpb.CompileMessageDescriptor((*testpb.Maps)(nil).ProtoReflect().Descriptor())
+		data := str(0xce, string(append(str(1, "k\xff"), str(2, "\xc0v")...)))
+
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data, hyperpb.WithRepairUTF8(true)))
+		got := new(testpb.Maps)
+		proto.Merge(got, m)
+		assert.Equal(t, map[string]string{"k\uFFFD": "\uFFFDv"}, got.Mce)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		t.Parallel()
+
+		ty := hyperpb.CompileMessageDescriptor((*testpb.Repeated)(nil).ProtoReflect().Descriptor())
+		data := append(str(7, "\xff"), 0x3a, 0x05)
+		require.Error(t, hyperpb.NewMessage(ty).Unmarshal(data, hyperpb.WithRepairUTF8(true)))
+	})
+}
+
+func TestUTF8Validator(t *testing.T) {
+	t.Parallel()
+
+	str := func(n protowire.Number, v string) []byte {
+		return protowire.AppendBytes(protowire.AppendTag(nil, n, protowire.BytesType), []byte(v))
+	}
+
+	// A permissive validator that accepts lone surrogates, as in WTF-8, and
+	// counts them.
+	var surrogates atomic.Int32
+	wtf8 := func(b []byte) bool {
+		if utf8.Valid(b) {
+			return true
+		}
+		for len(b) > 0 {
+			if len(b) >= 3 && b[0] == 0xed && b[1] >= 0xa0 && b[1] <= 0xbf && b[2]&0xc0 == 0x80 {
+				surrogates.Add(1)
+				b = b[3:]
+				continue
+			}
+			r, n := utf8.DecodeRune(b)
+			if r == utf8.RuneError && n <= 1 {
+				return false
+			}
+			b = b[n:]
+		}
+		return true
+	}
+
+	ty := hyperpb.CompileFor[*testpb.Repeated](hyperpb.WithUTF8Validator(wtf8))
+	data := append(str(7, "ok"), str(7, "a\xed\xa0\x80b")...)
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	r7 := m.Get(ty.Descriptor().Fields().ByName("r7")).List()
+	assert.Equal(t, "a\xed\xa0\x80b", r7.Get(1).String())
+	assert.Equal(t, int32(1), surrogates.Load())
+
+	require.Error(t, hyperpb.NewMessage(ty).Unmarshal(str(7, "\xff")))
+	require.Error(t, hyperpb.NewMessage(hyperpb.CompileFor[*testpb.Repeated]()).Unmarshal(data))
+
+	// A stricter validator rejects strings that are valid UTF-8.
+	ascii := func(b []byte) bool { return !bytes.ContainsFunc(b, func(r rune) bool { return r >= utf8.RuneSelf }) }
+	ty = hyperpb.CompileFor[*testpb.Scalars](hyperpb.WithUTF8Validator(ascii))
+	require.NoError(t, hyperpb.NewMessage(ty).Unmarshal(str(14, "abc")))
+	require.Error(t, hyperpb.NewMessage(ty).Unmarshal(str(14, "\u00e9")))
+}
+
+func TestBitsetBools(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, n int32, packed bool) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:    proto.String(name),
+			Number:  proto.Int32(n),
+			Label:   descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+			Type:    descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum(),
+			Options: &descriptorpb.FieldOptions{Packed: proto.Bool(packed)},
+		}
+	}
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name: proto.String("flags.proto"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("Flags"),
+			Field: []*descriptorpb.FieldDescriptorProto{field("packed", 1, true), field("unpacked", 2, false), field("plain", 3, true)},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	md := file.Messages().Get(0)
+	fields := md.Fields()
+
+	dm := dynamicpb.NewMessage(md)
+	want := make([]bool, 200)
+	for i := range want {
+		want[i] = i%3 == 0 || i%7 == 0
+		for j := range fields.Len() {
+			dm.Mutable(fields.Get(j)).List().Append(protoreflect.ValueOfBool(want[i]))
+		}
+	}
+	data, err := proto.Marshal(dm)
+	require.NoError(t, err)
+	data = append(data, data...) // Each field is split into two records.
+	want = append(want, want...)
+
+	ty := hyperpb.CompileMessageDescriptor(md, hyperpb.WithBitsetBools(
+		fields.ByName("packed").FullName(), fields.ByName("unpacked").FullName(), "unknown.field",
+	))
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithRecordSpills(true)))
+	for i := range fields.Len() {
+		list := m.Get(fields.Get(i)).List()
+		got := make([]bool, list.Len())
+		for j := range got {
+			got[j] = list.Get(j).Bool()
+		}
+		assert.Equal(t, want, got, "%v", fields.Get(i).Name())
+	}
+	assert.Equal(t, hyperpb.StorageArena, m.FieldStorage(fields.ByName("packed")))
+	assert.Equal(t, hyperpb.StorageSpilled, m.FieldStorage(fields.ByName("plain")))
+
+	got := dynamicpb.NewMessage(md)
+	proto.Merge(got, m)
+	assert.Equal(t, len(want), got.Get(fields.ByName("unpacked")).List().Len())
+	assert.Zero(t, hyperpb.NewMessage(ty).Get(fields.ByName("packed")).List().Len())
+
+	assert.Panics(t, func() {
+		hyperpb.CompileMessageDescriptor((*testpb.Repeated)(nil).ProtoReflect().Descriptor(),
+			hyperpb.WithBitsetBools("hyperpb.test.Repeated.r1"))
+	})
+}
+
+func TestFieldTransform(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	fields := md.Fields()
+	a14, a15, b14, b15 := fields.ByName("a14"), fields.ByName("a15"), fields.ByName("b14"), fields.ByName("b15")
+
+	errBad := errors.New("bad input")
+	var calls int
+	rot13 := func(src []byte) ([]byte, error) {
+		calls++
+		out := make([]byte, len(src))
+		for i, b := range src {
+			switch {
+			case b >= 'a' && b <= 'z':
+				b = 'a' + (b-'a'+13)%26
+			case b == '!':
+				return nil, errBad
+			}
+			out[i] = b
+		}
+		return out, nil
+	}
+	drop := func([]byte) ([]byte, error) { return nil, nil }
+	ty := hyperpb.CompileMessageDescriptor(md,
+		hyperpb.WithFieldTransform(a14.FullName(), rot13),
+		hyperpb.WithFieldTransform(a15.FullName(), rot13),
+		hyperpb.WithFieldTransform(b14.FullName(), drop),
+		hyperpb.WithFieldTransform(b15.FullName(), rot13),
+	)
+
+	empty := ""
+	data, err := proto.Marshal(&testpb.Scalars{
+		A1: 42, A14: "uryyb", A15: []byte("jbeyq"), B14: &empty,
+	})
+	require.NoError(t, err)
+
+	for _, ty := range []*hyperpb.MessageType{ty, ty.Recompile(ty.NewProfile())} {
+		calls = 0
+		m := hyperpb.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data))
+		assert.Equal(t, 2, calls)
+
+		assert.Equal(t, int64(42), m.Get(fields.ByName("a1")).Int())
+		assert.Equal(t, "hello", m.Get(a14).String())
+		assert.Equal(t, []byte("world"), m.Get(a15).Bytes())
+		assert.Equal(t, hyperpb.StorageArena, m.FieldStorage(a15))
+		assert.True(t, m.Has(b14))
+		assert.Empty(t, m.Get(b14).String())
+		assert.False(t, m.Has(b15))
+		assert.Equal(t, 2, calls)
+
+		detached, err := m.Detach()
+		require.NoError(t, err)
+		assert.Equal(t, "hello", detached.Get(a14).String())
+		assert.Equal(t, 2, calls)
+	}
+
+	data, err = proto.Marshal(&testpb.Scalars{A14: "oops!"})
+	require.NoError(t, err)
+	require.ErrorIs(t, hyperpb.NewMessage(ty).Unmarshal(data), errBad)
+
+	assert.Panics(t, func() {
+		hyperpb.CompileMessageDescriptor(md, hyperpb.WithFieldTransform(fields.ByName("a1").FullName(), rot13))
+	})
+}
+
+func TestSecretFields(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	a14, a15, b14 := md.Fields().ByName("a14"), md.Fields().ByName("a15"), md.Fields().ByName("b14")
+	ty := hyperpb.CompileMessageDescriptor(md, hyperpb.WithSecretFields(
+		a14.FullName(), a15.FullName(), b14.FullName(),
+	), hyperpb.WithInternStrings(true))
+
+	// Secret strings are validated exactly like other strings.
+	for _, s := range []string{
+		"", "hunter2", "κλειδί", "🔑🔑", "\x00\x7f",
+		"\xc0\x80", "\xc2", "\xe0\x80\x80", "\xed\xa0\x80", "\xf0\x8f\xbf\xbf",
+		"\xf4\x90\x80\x80", "\xf5\x80\x80\x80", "\x80", "ok\xffok", "\xef\xbf\xbd",
+		"\xf4\x8f\xbf\xbf", "\xed\x9f\xbf", "\xe0\xa0\x80",
+	} {
+		data := protowire.AppendString(protowire.AppendTag(nil, 14, protowire.BytesType), s)
+		m := hyperpb.NewMessage(ty)
+		err := m.Unmarshal(data)
+		if !utf8.ValidString(s) {
+			require.Error(t, err, "%q", s)
+			continue
+		}
+		require.NoError(t, err, "%q", s)
+		assert.Equal(t, s, m.Get(a14).String())
+	}
+
+	data, err := proto.Marshal(&testpb.Scalars{A14: "password", A15: []byte("token"), B14: proto.String("pin")})
+	require.NoError(t, err)
+
+	s := new(hyperpb.Shared)
+	m := s.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	password := m.Get(a14).String()
+	token := m.Get(a15).Bytes()
+	assert.Equal(t, "password", password)
+	assert.Equal(t, "token", string(token))
+	assert.Equal(t, "pin", m.Get(b14).String())
+
+	// Freeing wipes the copy of the input that the values alias.
+	s.Free()
+	assert.Equal(t, make([]byte, len(password)), unsafe.Slice(unsafe.StringData(password), len(password)))
+	assert.Equal(t, make([]byte, len(token)), token)
+
+	// Aliased inputs belong to the caller, and are left alone.
+	m = s.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithAllowAlias(true)))
+	s.Free()
+	assert.True(t, bytes.Contains(data, []byte("password")))
+
+	assert.Panics(t, func() {
+		hyperpb.CompileFor[*testpb.Repeated](hyperpb.WithSecretFields("hyperpb.test.Repeated.r7"))
+	})
+}
+
+func TestLogger(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	var data []byte
+	data = protowire.AppendTag(data, 1, protowire.VarintType)
+	data = protowire.AppendVarint(data, 42)
+	data = protowire.AppendTag(data, 100, protowire.BytesType)
+	data = protowire.AppendString(data, "xyz")
+
+	var ops []string
+	var unknown []int
+	logger := testLogger(func(offset int, op, msg string) {
+		assert.GreaterOrEqual(t, offset, 0)
+		assert.LessOrEqual(t, offset, len(data))
+		ops = append(ops, op)
+		if op == "unknown" {
+			unknown = append(unknown, offset)
+		}
+	})
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithLogger(logger)))
+	assert.Contains(t, ops, "start")
+	assert.Equal(t, []int{len(data)}, unknown) // Logged after skipping it.
+
+	// A nil logger disables logging again.
+	ops = nil
+	m = hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithLogger(logger), hyperpb.WithLogger(nil)))
+	assert.Empty(t, ops)
+}
+
+type testLogger func(offset int, op, msg string)
+
+func (l testLogger) Log(offset int, op, msg string) { l(offset, op, msg) }
+
+func TestPprofLabels(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	data, err := proto.Marshal(&testpb.Scalars{A1: 42})
+	require.NoError(t, err)
+	a1 := ty.Descriptor().Fields().ByName("a1")
+
+	opt := hyperpb.WithPprofLabels(context.Background(), "tenant", "test")
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, opt))
+	assert.Equal(t, int64(42), m.Get(a1).Int())
+
+	m = hyperpb.NewMessage(ty)
+	res := m.TryUnmarshal(data[:1], hyperpb.WithPprofLabels(nil)) //nolint:staticcheck // Testing a nil context.
+	require.Error(t, res.Err)
+
+	msgs, err := new(hyperpb.Shared).UnmarshalBatch(ty, [][]byte{data, data}, opt)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), msgs[1].Get(a1).Int())
+
+	assert.Panics(t, func() { hyperpb.WithPprofLabels(context.Background(), "tenant") })
+}
+
+func TestSampledFields(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Graph)(nil).ProtoReflect().Descriptor()
+	r, s := md.Fields().ByName("r"), md.Fields().ByName("s")
+	ty := hyperpb.CompileMessageDescriptor(md, hyperpb.WithSampledFields(3, r.FullName()))
+
+	graph := &testpb.Graph{S: &testpb.Graph{R: []*testpb.Graph{{V: 1}, {V: 2}}}}
+	for i := range 10 {
+		graph.R = append(graph.R, &testpb.Graph{V: int32(i)})
+	}
+	data, err := proto.Marshal(graph)
+	require.NoError(t, err)
+
+	// Skipped elements are not parsed at all.
+	data = protowire.AppendTag(data, 3, protowire.BytesType)
+	data = protowire.AppendBytes(data, []byte{0xff})
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	var got []int32
+	for e := range hyperpb.Messages(m.Get(r).List()) {
+		got = append(got, int32(e.Get(md.Fields().ByName("v")).Int()))
+	}
+	assert.Equal(t, []int32{0, 3, 6, 9}, got)
+	assert.Equal(t, 11, m.SampledCount(r))
+
+	// The field is sampled wherever it occurs.
+	sub := m.Get(s).Message().Interface().(*hyperpb.Message)
+	assert.Equal(t, 1, sub.Get(r).List().Len())
+	assert.Equal(t, 2, sub.SampledCount(r))
+
+	assert.Panics(t, func() {
+		hyperpb.CompileMessageDescriptor(md, hyperpb.WithSampledFields(3, s.FullName()))
+	})
+}
+
+func TestMessageSizeLimit(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.MessageMaps)(nil).ProtoReflect().Descriptor()
+	scalars := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md, hyperpb.WithMessageSizeLimit(16, scalars.FullName()))
+
+	small := &testpb.MessageMaps{Scalars: &testpb.Scalars{A14: "small"}}
+	data, err := proto.Marshal(small)
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.True(t, proto.Equal(small, m))
+
+	large := &testpb.MessageMaps{M1: map[int32]*testpb.MessageMaps{
+		1: {Scalars: &testpb.Scalars{A14: "much too large for the limit"}},
+	}}
+	data, err = proto.Marshal(large)
+	require.NoError(t, err)
+	err = hyperpb.NewMessage(ty).Unmarshal(data)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "hyperpb.test.Scalars is 30 bytes, limit is 16")
+	got, ok := hyperpb.SizeLimitErrorOf(err)
+	require.True(t, ok, "%v", err)
+	assert.Equal(t, scalars, got.Type.Descriptor())
+	assert.Equal(t, 30, got.Size)
+	assert.Equal(t, 16, got.Limit)
+	assert.Equal(t, len(data)-30, got.Offset)
+
+	// The limit applies to the input as a whole, too.
+	ty = hyperpb.CompileMessageDescriptor(md, hyperpb.WithMessageSizeLimit(8, md.FullName()))
+	err = hyperpb.NewMessage(ty).Unmarshal(data)
+	got, ok = hyperpb.SizeLimitErrorOf(err)
+	require.True(t, ok, "%v", err)
+	assert.Equal(t, md, got.Type.Descriptor())
+
+	_, ok = hyperpb.SizeLimitErrorOf(hyperpb.NewMessage(ty).Unmarshal([]byte{0x0a, 0x05}))
+	assert.False(t, ok)
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// UnmarshalPath parses only the submessage of data, a message of type ty, at
+// the given dot-separated path of field names, such as "a.b.c". Each field in
+// the path must be a singular message or group field.
+//
+// This locates the submessage by skipping over records using their length
+// prefixes, without parsing anything outside of the path; only the
+// submessage itself is parsed, with the given options. This is much faster
+// than a full parse when only a small part of a large message is needed.
+//
+// If a field on the path occurs several times, its occurrences are merged, as
+// they would be by a full parse. If a field is absent, the returned message is
+// empty. Records outside of the path are not validated, so UnmarshalPath may
+// succeed on inputs that [Message.Unmarshal] would reject.
+//
+// An empty path parses all of data.
+func UnmarshalPath(ty *MessageType, data []byte, path string, options ...UnmarshalOption) (*Message, error) {
+	if path != "" {
+		for name := range strings.SplitSeq(path, ".") {
+			fd := ty.Descriptor().Fields().ByName(protoreflect.Name(name))
+			if fd == nil || fd.Message() == nil || fd.Cardinality() == protoreflect.Repeated {
+				return nil, fmt.Errorf("hyperpb: %s is not a singular message field of %s", name, ty.Descriptor().FullName())
+			}
+
+			sub, ok := ty.impl.Library.Type(fd.Message())
+			if !ok {
+				return nil, fmt.Errorf("hyperpb: %s has no compiled type", fd.FullName())
+			}
+
+			var err error
+			if data, err = findField(data, fd); err != nil {
+				return nil, fmt.Errorf("hyperpb: malformed %s in path %q: %w", ty.Descriptor().FullName(), path, err)
+			}
+			ty = wrapType(sub)
+		}
+	}
+
+	m := NewMessage(ty)
+	if err := m.Unmarshal(data, options...); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// findField returns the contents of fd in data, which is the encoding of the
+// message containing it. Several occurrences of fd are concatenated, unless a
+// different member of its oneof occurs after them.
+//
+// Only allocates if fd occurs more than once.
+func findField(data []byte, fd protoreflect.FieldDescriptor) ([]byte, error) {
+	want := protowire.BytesType
+	if fd.Kind() == protoreflect.GroupKind {
+		want = protowire.StartGroupType
+	}
+	oneof := fd.ContainingOneof()
+
+	var out []byte
+	var found, copied bool
+	for offset := 0; offset < len(data); {
+		start := offset
+		num, typ, n := protowire.ConsumeTag(data[offset:])
+		if n < 0 {
+			return nil, fmt.Errorf("record at offset %d: %w", start, protowire.ParseError(n))
+		}
+		offset += n
+
+		var value []byte
+		switch typ {
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(data[offset:])
+		case protowire.StartGroupType:
+			value, n = protowire.ConsumeGroup(num, data[offset:])
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data[offset:])
+		}
+		if n < 0 {
+			return nil, fmt.Errorf("record at offset %d: %w", start, protowire.ParseError(n))
+		}
+		offset += n
+
+		switch {
+		case num == fd.Number() && typ == want:
+			switch {
+			case !found:
+				out, found = value, true
+			case !copied:
+				out, copied = append(out[:len(out):len(out)], value...), true
+			default:
+				out = append(out, value...)
+			}
+		case oneof != nil && num != fd.Number():
+			if other := oneof.Fields().ByNumber(num); other != nil {
+				out, found, copied = nil, false, false
+			}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestUnmarshalPath(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Graph]()
+	graph := &testpb.Graph{
+		V: 1,
+		R: []*testpb.Graph{{V: 10}, {V: 11}},
+		S: &testpb.Graph{
+			V: 2,
+			S: &testpb.Graph{V: 3, R: []*testpb.Graph{{V: 4}}},
+		},
+	}
+	data, err := proto.Marshal(graph)
+	require.NoError(t, err)
+
+	m, err := hyperpb.UnmarshalPath(ty, data, "s.s")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(graph.S.S, m), "%v", m)
+
+	m, err = hyperpb.UnmarshalPath(ty, data, "")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(graph, m), "%v", m)
+
+	m, err = hyperpb.UnmarshalPath(ty, data, "s.s.s")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&testpb.Graph{}, m), "%v", m)
+
+	// Repeated occurrences are merged.
+	more, err := proto.Marshal(&testpb.Graph{S: &testpb.Graph{S: &testpb.Graph{V: 5}}})
+	require.NoError(t, err)
+	m, err = hyperpb.UnmarshalPath(ty, append(data, more...), "s.s")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&testpb.Graph{V: 5, R: []*testpb.Graph{{V: 4}}}, m), "%v", m)
+
+	// Records outside of the path are not parsed.
+	bad := protowire.AppendTag(nil, 3, protowire.BytesType)
+	bad = protowire.AppendBytes(bad, []byte{0xff})
+	m, err = hyperpb.UnmarshalPath(ty, append(bad, data...), "s")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(graph.S, m), "%v", m)
+
+	_, err = hyperpb.UnmarshalPath(ty, data, "s.r")
+	require.Error(t, err)
+	_, err = hyperpb.UnmarshalPath(ty, data, "s.v")
+	require.Error(t, err)
+	_, err = hyperpb.UnmarshalPath(ty, data[:len(data)-1], "s")
+	require.Error(t, err)
+}
+
+func TestUnmarshalPathOneof(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Oneof]()
+	a, err := proto.Marshal(&testpb.Oneof{Multi: &testpb.Oneof_M10{M10: &testpb.Oneof{Tail: 1}}})
+	require.NoError(t, err)
+	b, err := proto.Marshal(&testpb.Oneof{Multi: &testpb.Oneof_M1{M1: 2}})
+	require.NoError(t, err)
+
+	m, err := hyperpb.UnmarshalPath(ty, a, "m10")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&testpb.Oneof{Tail: 1}, m), "%v", m)
+
+	// A later member of the same oneof replaces the submessage.
+	m, err = hyperpb.UnmarshalPath(ty, append(a, b...), "m10")
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&testpb.Oneof{}, m), "%v", m)
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import "sync"
+
+// DefaultPoolMaxIdle is the default limit on the number of idle messages held
+// by a [MessagePool].
+const DefaultPoolMaxIdle = 64
+
+// MessagePool is a pool of messages of a single type, backed by recycled
+// memory.
+//
+// Because each pool only holds messages of one type, the memory it recycles
+// converges to the footprint of a typical message of that type, which makes
+// it a better fit for servers that parse many different types than a single
+// pool of [Shared] values.
+//
+// A MessagePool is safe to use from multiple goroutines.
+type MessagePool struct {
+	ty *MessageType
+
+	mu       sync.Mutex
+	idle     []*Shared
+	maxIdle  int
+	maxBytes int
+}
+
+// NewMessagePool returns a new, empty pool for messages of the given type.
+//
+// Most callers should use the pool returned by [MessageType.Pool] instead.
+func NewMessagePool(ty *MessageType) *MessagePool {
+	return &MessagePool{ty: ty, maxIdle: DefaultPoolMaxIdle}
+}
+
+// Pool returns the message pool for this type, creating it on first use.
+func (t *MessageType) Pool() *MessagePool {
+	if p, ok := t.impl.Pool.Load().(*MessagePool); ok {
+		return p
+	}
+	t.impl.Pool.CompareAndSwap(nil, NewMessagePool(t))
+	return t.impl.Pool.Load().(*MessagePool) //nolint:errcheck // Always a *MessagePool.
+}
+
+// SetLimits sets hard limits on the memory retained by this pool.
+//
+// maxIdle is the maximum number of idle messages held by the pool; the default
+// is [DefaultPoolMaxIdle]. maxBytes is the maximum size of the memory that may
+// be recycled for any one message, in bytes; messages which required more
+// memory than this are dropped when they are put back, rather than retained.
+// A non-positive maxBytes means no limit, which is the default.
+func (p *MessagePool) SetLimits(maxIdle, maxBytes int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.maxIdle = max(maxIdle, 0)
+	p.maxBytes = maxBytes
+	if len(p.idle) > p.maxIdle {
+		clear(p.idle[p.maxIdle:])
+		p.idle = p.idle[:p.maxIdle]
+	}
+}
+
+// Get returns an empty message from this pool, ready to be unmarshaled into.
+//
+// Once the caller is done with it, the message should be returned to the pool
+// with [MessagePool.Put].
+func (p *MessagePool) Get() *Message {
+	p.mu.Lock()
+	var s *Shared
+	if n := len(p.idle); n > 0 {
+		s = p.idle[n-1]
+		p.idle[n-1] = nil
+		p.idle = p.idle[:n-1]
+	}
+	p.mu.Unlock()
+
+	if s == nil {
+		s = new(Shared)
+	}
+	return s.NewMessage(p.ty)
+}
+
+// Put returns a message obtained from [MessagePool.Get] to this pool.
+//
+// Neither m nor any value obtained from it, including other messages
+// allocated from the same [Shared], may be used after calling this function.
+func (p *MessagePool) Put(m *Message) {
+	if m.HyperType() != p.ty {
+		panic("hyperpb: MessagePool.Put called with message of type " + string(m.Descriptor().FullName()) +
+			", expected " + string(p.ty.Descriptor().FullName()))
+	}
+
+	s := m.Shared()
+	s.Free()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.idle) >= p.maxIdle || (p.maxBytes > 0 && s.impl.Arena().Cap > p.maxBytes) {
+		return
+	}
+	p.idle = append(p.idle, s)
+}
+
+// Idle returns the number of idle messages currently held by this pool.
+func (p *MessagePool) Idle() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.idle)
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestMessagePool(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	pool := ty.Pool()
+	assert.Same(t, pool, ty.Pool())
+
+	data, err := proto.Marshal(&testpb.Scalars{A1: 42, A14: "hello"})
+	require.NoError(t, err)
+
+	for range 3 {
+		m := pool.Get()
+		require.NoError(t, m.Unmarshal(data))
+		assert.Equal(t, int32(42), m.Get(m.Descriptor().Fields().ByName("a1")).Interface())
+		pool.Put(m)
+		assert.Equal(t, 1, pool.Idle())
+	}
+
+	pool.SetLimits(0, 0)
+	assert.Equal(t, 0, pool.Idle())
+	pool.Put(pool.Get())
+	assert.Equal(t, 0, pool.Idle())
+
+	pool.SetLimits(1, 1)
+	pool.Put(pool.Get())
+	assert.Equal(t, 0, pool.Idle())
+
+	other := hyperpb.CompileMessageDescriptor((*testpb.Repeated)(nil).ProtoReflect().Descriptor())
+	assert.Panics(t, func() { pool.Put(other.Pool().Get()) })
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"fmt"
+	"slices"
+	"unsafe"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp"
+)
+
+// Column is a destination for the values of one field of each element of a
+// repeated message field. See [Project].
+type Column struct {
+	// The field to extract, which must be a singular scalar field other than a
+	// string or bytes field.
+	Field protoreflect.FieldDescriptor
+
+	// The slice to append values to, depending on the field's kind. The other
+	// slices are not touched.
+	Ints   []int64   // Signed integer and enum fields.
+	Uints  []uint64  // Unsigned integer fields.
+	Floats []float64 // Float and double fields.
+	Bools  []bool    // Bool fields.
+}
+
+// Project extracts the values of some fields of each message in list, which
+// must be a list returned by [Message.Get] for a repeated message field, into
+// parallel slices, one for each column, in order. Unset fields contribute
+// their default values, so every column gains one value for each element.
+//
+// This is much faster than calling [Message.Get] for each element and each
+// field, because the fields are only looked up once, rather than once per
+// element; this makes it suitable for turning long repeated fields of small
+// messages into feature vectors.
+//
+// Returns an error if a column's field is not a field of the messages in
+// list, or is of an unsupported kind. In that case, no values are appended.
+func Project(list protoreflect.List, columns ...*Column) error {
+	n := list.Len()
+	if n == 0 {
+		return nil
+	}
+
+	var md protoreflect.MessageDescriptor
+	for m := range Messages(list) {
+		md = m.Descriptor()
+		break
+	}
+	for _, c := range columns {
+		fd := c.Field
+		switch {
+		case fd.ContainingMessage() != md || fd.IsExtension():
+			return fmt.Errorf("hyperpb: cannot project %s out of %s: not a field of that message", fd.FullName(), md.FullName())
+		case fd.Cardinality() == protoreflect.Repeated || projectKind(fd.Kind()) == 0:
+			return fmt.Errorf("hyperpb: cannot project %s: only singular numeric and bool fields can be projected", fd.FullName())
+		}
+		switch projectKind(fd.Kind()) {
+		case projectInt:
+			c.Ints = slices.Grow(c.Ints, n)
+		case projectUint:
+			c.Uints = slices.Grow(c.Uints, n)
+		case projectFloat:
+			c.Floats = slices.Grow(c.Floats, n)
+		case projectBool:
+			c.Bools = slices.Grow(c.Bools, n)
+		}
+	}
+
+	// All of the elements have the same type, so the fields only need to be
+	// looked up once.
+	var fields []*tdp.Field
+	for m := range Messages(list) {
+		if fields == nil {
+			ty := m.impl.Type()
+			fields = make([]*tdp.Field, len(columns))
+			for i, c := range columns {
+				fields[i] = ty.ByIndex(c.Field.Index())
+			}
+		}
+
+		for i, c := range columns {
+			fd := c.Field
+			v := fields[i].Get(unsafe.Pointer(&m.impl))
+			if !v.IsValid() {
+				v = fields[i].Default.Value(fd)
+			}
+			switch projectKind(fd.Kind()) {
+			case projectInt:
+				if fd.Kind() == protoreflect.EnumKind {
+					c.Ints = append(c.Ints, int64(v.Enum()))
+				} else {
+					c.Ints = append(c.Ints, v.Int())
+				}
+			case projectUint:
+				c.Uints = append(c.Uints, v.Uint())
+			case projectFloat:
+				c.Floats = append(c.Floats, v.Float())
+			case projectBool:
+				c.Bools = append(c.Bools, v.Bool())
+			}
+		}
+	}
+	return nil
+}
+
+// Kinds of [Column] for [projectKind].
+const (
+	projectInt = 1 + iota
+	projectUint
+	projectFloat
+	projectBool
+)
+
+// projectKind returns which slice of a [Column] values of kind k go into, or
+// zero if they cannot be projected.
+func projectKind(k protoreflect.Kind) int {
+	switch k {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind,
+		protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind,
+		protoreflect.EnumKind:
+		return projectInt
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind,
+		protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return projectUint
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return projectFloat
+	case protoreflect.BoolKind:
+		return projectBool
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestProject(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Graph]()
+	fields := ty.Descriptor().Fields()
+	graph := &testpb.Graph{R: []*testpb.Graph{{V: 1}, {V: -2, S: &testpb.Graph{}}, {}, {V: 4}}}
+	data, err := proto.Marshal(graph)
+	require.NoError(t, err)
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	list := m.Get(fields.ByName("r")).List()
+
+	v := &hyperpb.Column{Field: fields.ByName("v"), Ints: []int64{0}}
+	require.NoError(t, hyperpb.Project(list, v))
+	assert.Equal(t, []int64{0, 1, -2, 0, 4}, v.Ints)
+	assert.Nil(t, v.Floats)
+
+	empty := &hyperpb.Column{Field: fields.ByName("v")}
+	require.NoError(t, hyperpb.Project(hyperpb.NewMessage(ty).Get(fields.ByName("r")).List(), empty))
+	assert.Empty(t, empty.Ints)
+
+	v.Ints = nil
+	require.Error(t, hyperpb.Project(list, v, &hyperpb.Column{Field: fields.ByName("s")}))
+	assert.Empty(t, v.Ints)
+
+	a1 := (*testpb.Scalars)(nil).ProtoReflect().Descriptor().Fields().ByName("a1")
+	require.Error(t, hyperpb.Project(list, &hyperpb.Column{Field: a1}))
+}
+
+func BenchmarkProject(b *testing.B) {
+	ty := hyperpb.CompileFor[*testpb.Graph]()
+	fields := ty.Descriptor().Fields()
+	graph := new(testpb.Graph)
+	for i := range 1000 {
+		graph.R = append(graph.R, &testpb.Graph{V: int32(i)})
+	}
+	data, err := proto.Marshal(graph)
+	require.NoError(b, err)
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(b, m.Unmarshal(data))
+	list := m.Get(fields.ByName("r")).List()
+	v := &hyperpb.Column{Field: fields.ByName("v")}
+
+	b.Run("project", func(b *testing.B) {
+		for range b.N {
+			v.Ints = v.Ints[:0]
+			_ = hyperpb.Project(list, v)
+		}
+	})
+	b.Run("get", func(b *testing.B) {
+		for range b.N {
+			v.Ints = v.Ints[:0]
+			for i := range list.Len() {
+				v.Ints = append(v.Ints, list.Get(i).Message().Get(v.Field).Int())
+			}
+		}
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Registry is an immutable set of compiled message types, keyed by the full
+// names of their messages.
+//
+// Its lookup methods mirror those of [protoregistry.Types], so that it can be
+// used to resolve the type URLs of google.protobuf.Any messages.
+type Registry struct {
+	types map[protoreflect.FullName]*MessageType
+}
+
+// newRegistry builds a registry out of the given types.
+func newRegistry(types ...*MessageType) *Registry {
+	r := &Registry{types: make(map[protoreflect.FullName]*MessageType, len(types))}
+	for _, ty := range types {
+		r.types[ty.Descriptor().FullName()] = ty
+	}
+	return r
+}
+
+// Len returns the number of types in this registry.
+func (r *Registry) Len() int {
+	return len(r.types)
+}
+
+// All yields the types in this registry, in order of their full names.
+func (r *Registry) All() iter.Seq[*MessageType] {
+	return func(yield func(*MessageType) bool) {
+		for _, name := range slices.Sorted(maps.Keys(r.types)) {
+			if !yield(r.types[name]) {
+				return
+			}
+		}
+	}
+}
+
+// FindMessageByName looks up a type by the full name of its message.
+//
+// Returns an error wrapping [protoregistry.NotFound] if there is no such type.
+func (r *Registry) FindMessageByName(name protoreflect.FullName) (*MessageType, error) {
+	if ty := r.types[name]; ty != nil {
+		return ty, nil
+	}
+	return nil, fmt.Errorf("hyperpb: %s: %w", name, protoregistry.NotFound)
+}
+
+// FindMessageByURL looks up a type by a URL identifying its message, such as
+// the type URL of a google.protobuf.Any. The full name of the message is the
+// part of the URL after its last slash, if any.
+//
+// Returns an error wrapping [protoregistry.NotFound] if there is no such type.
+func (r *Registry) FindMessageByURL(url string) (*MessageType, error) {
+	name := url
+	if i := strings.LastIndexByte(url, '/'); i >= 0 {
+		name = url[i+1:]
+	}
+	return r.FindMessageByName(protoreflect.FullName(name))
+}
@@ -15,8 +15,16 @@
 package hyperpb
 
 import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"buf.build/go/hyperpb/internal/arena"
+	"buf.build/go/hyperpb/internal/intern"
 	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/vm"
 	"buf.build/go/hyperpb/internal/xunsafe"
+	"buf.build/go/hyperpb/internal/xunsafe/layout"
 )
 
 // Shared is state that is shared by all messages in a particular tree of
@@ -46,13 +54,335 @@ func (s *Shared) NewMessage(msgType *MessageType) *Message {
 	// It is now redundant, because Context stores msgType.Library(). The comment is
 	// kept for posterity about a nasty bug.
 
-	return wrapMessage(s.impl.New(&msgType.impl))
+	m := wrapMessage(s.impl.New(&msgType.impl))
+	if s.impl.Tracking {
+		s.impl.Live.Add(1)
+	}
+	return m
+}
+
+// UnmarshalBatch parses each element of data as a message of type ty, using
+// this value's resources. This is equivalent to calling [Message.Unmarshal] on
+// a new message for each input, except that setting up the parser is only
+// done once, which is significantly faster when the inputs are small.
+//
+// The inputs are always copied, into a single buffer; [WithAllowAlias],
+// [WithChecksum], [WithFingerprint] and [WithRepairUTF8] have no effect.
+// Because the messages share that buffer, none of them is the root of this
+// value: [Message.WireBytes] returns nil for them, and this value cannot be
+// used to parse anything else until [Shared.Free] is called.
+//
+// Parsing stops at the first input that fails to parse. In that case, the
+// messages for the inputs before it are returned along with an error that
+// wraps the parse error, whose offset is relative to the failing input. If
+// tracking is enabled with [Shared.TrackMessages], only the returned messages
+// need to be released.
+func (s *Shared) UnmarshalBatch(ty *MessageType, data [][]byte, options ...UnmarshalOption) ([]*Message, error) {
+	opts := newUnmarshalOptions(options)
+	var size int
+	var ob observation
+	if observer.Load() != nil {
+		for _, d := range data {
+			size += len(d)
+		}
+		ob = observeParse(&ty.impl, size)
+	}
+
+	msgs := make([]*Message, len(data))
+	for i := range msgs {
+		msgs[i] = s.NewMessage(ty)
+	}
+
+	// A *Message is a *dynamic.Message, so there is no need to allocate a
+	// second slice to pass to the parser.
+	impls := unsafe.Slice(xunsafe.Cast[*dynamic.Message](unsafe.SliceData(msgs)), len(msgs))
+	var failed int
+	var perr vm.ParseError
+	if opts.Labels != nil {
+		withLabels(opts.Labels, &ty.impl, func() { failed, perr = vm.RunBatch(impls, data, opts) })
+	} else {
+		failed, perr = vm.RunBatch(impls, data, opts)
+	}
+
+	var err error
+	n := len(msgs)
+	if perr.Code() != vm.ErrorOk {
+		e := perr
+		err, n = &e, failed
+	}
+	if opts.Verify != nil {
+		for i, m := range msgs[:n] {
+			opts.Verify(&m.impl, data[i], nil, xunsafe.NoEscape(&opts))
+		}
+		if err != nil {
+			opts.Verify(&msgs[n].impl, data[n], err, xunsafe.NoEscape(&opts))
+		}
+	}
+	if opts.RejectUnknownEnums {
+		for i, m := range msgs[:n] {
+			if e := checkEnums(m); e != nil {
+				err, n = e, i
+				break
+			}
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("hyperpb: batch input %d: %w", n, err)
+	}
+	if s.impl.Tracking {
+		// The messages for the inputs that are not returned can never be
+		// released, so they must not count as outstanding.
+		s.impl.Live.Add(-int64(len(msgs) - n))
+	}
+	ob.parsed(&ty.impl, size, n, err)
+	return msgs[:n], err
+}
+
+// Alloc allocates size bytes of zeroed scratch memory, aligned to align bytes,
+// from the same arena as the messages allocated by this value. This is
+// intended for building derived data while walking those messages, keeping it
+// close to them in memory and freeing it along with them.
+//
+// The returned memory belongs to this value: it must not be used after
+// [Shared.Free] is called. The garbage collector does not scan it, so it must
+// not be used to store Go pointers, which would be collected while still in
+// use.
+//
+// Panics if size is negative or align is not a positive power of two.
+func (s *Shared) Alloc(size, align int) []byte {
+	if size < 0 {
+		panic(fmt.Sprintf("hyperpb: negative allocation size %d", size))
+	}
+	if align <= 0 || align&(align-1) != 0 {
+		panic(fmt.Sprintf("hyperpb: allocation alignment %d is not a power of two", align))
+	}
+	if size == 0 {
+		return []byte{}
+	}
+
+	s.impl.Lock.Lock()
+	defer s.impl.Lock.Unlock()
+
+	// The arena only guarantees pointer alignment, so over-allocate to make
+	// room for any extra alignment.
+	pad := max(align-arena.Align, 0)
+	p := s.impl.Arena().Alloc(size + pad)
+	p = xunsafe.Add(p, layout.Padding(int(uintptr(unsafe.Pointer(p))), align))
+
+	buf := unsafe.Slice(p, size)
+	clear(buf)
+	return buf
+}
+
+// Available returns the number of bytes that can be allocated for messages
+// by this value before it needs to allocate more memory. After [Shared.Free],
+// this is the size of the largest block of memory that is retained.
+func (s *Shared) Available() int {
+	s.impl.Lock.Lock()
+	defer s.impl.Lock.Unlock()
+
+	return s.impl.Arena().Available()
+}
+
+// Grow ensures that at least n bytes can be allocated for messages by this
+// value before it needs to allocate more memory, allocating a new block of
+// memory now if necessary. Any memory left over in the current block is not
+// used once a new block is allocated.
+//
+// This allows latency-sensitive code to pay for allocating memory ahead of
+// time, such as while waiting for input to arrive, rather than in the middle
+// of parsing it. The size of the block is subject to [ArenaPolicy], except
+// that it is always at least n bytes.
+//
+// Panics if n is negative.
+func (s *Shared) Grow(n int) {
+	if n < 0 {
+		panic(fmt.Sprintf("hyperpb: negative growth size %d", n))
+	}
+
+	s.impl.Lock.Lock()
+	defer s.impl.Lock.Unlock()
+
+	s.impl.Arena().Reserve(n)
+}
+
+// ArenaPolicy controls how a [Shared] allocates the memory that backs its
+// messages. The zero value is the default policy.
+//
+// Memory is allocated in blocks, whose sizes are rounded up to a power of two.
+// When [Shared.Free] is called, only the largest block is retained for re-use.
+type ArenaPolicy struct {
+	// The minimum size of the first block, in bytes. Large values allocate
+	// memory up-front, avoiding repeated allocations while parsing large
+	// messages.
+	InitialBlock int
+	// The factor by which each block is larger than the previous one. The
+	// default is 2; 1 makes all blocks the same size.
+	GrowthFactor int
+	// If positive, the maximum size of a block, in bytes, except for blocks
+	// that must fit a single larger value. Small values avoid large, sudden
+	// allocations, at the cost of more frequent ones.
+	MaxBlock int
+}
+
+// SetArenaPolicy sets the policy for allocating memory for messages allocated
+// by this value. It only affects allocations made after it is called.
+func (s *Shared) SetArenaPolicy(policy ArenaPolicy) {
+	s.impl.Arena().Policy = arena.Policy{
+		Initial: policy.InitialBlock,
+		Growth:  policy.GrowthFactor,
+		Max:     policy.MaxBlock,
+	}
+}
+
+// TrackMessages sets whether this value tracks the messages allocated with
+// [Shared.NewMessage], to catch use-after-free bugs.
+//
+// While tracking is enabled, each message must be released with
+// [Message.Release] once the caller is done with it, and [Shared.Free] will
+// panic if any messages have not been released.
+//
+// Tracking is cheap, but it requires callers to release every message, so it
+// is intended for use in tests and debugging. Changing whether tracking is
+// enabled resets the count of outstanding messages.
+func (s *Shared) TrackMessages(enable bool) {
+	s.impl.Tracking = enable
+	s.impl.Live.Store(0)
+}
+
+// Outstanding returns the number of messages allocated by this value that
+// have not been released with [Message.Release].
+//
+// Always returns zero if tracking is not enabled with [Shared.TrackMessages].
+// Includes messages allocated by this value's shards; see [Shared.Shard].
+func (s *Shared) Outstanding() int {
+	n := s.impl.Live.Load()
+	for _, shard := range s.impl.Shards() {
+		n += shard.Live.Load()
+	}
+	return int(n)
+}
+
+// InternStrings sets whether the values of singular string fields parsed by
+// this value are deduplicated.
+//
+// While enabled, identical strings in the input of one parse, such as all of
+// the inputs of a call to [Shared.UnmarshalBatch], share memory. This is
+// intended for batches of messages where most strings repeat across messages,
+// such as log entries. Strings are deduplicated once, as they are parsed, so
+// reading them costs nothing extra; in turn, this only affects parses that
+// begin after it is called. A shard returned by [Shared.Shard] deduplicates
+// the strings of its own input separately.
+//
+// Unlike [WithInternStrings], interned strings still alias this value's
+// memory, so they must not be used after [Shared.Free] is called; however,
+// only a single copy of each needs to be kept, or cloned, to retain it.
+// Use [Shared.InternStats] to measure how effective interning is.
+func (s *Shared) InternStrings(enable bool) {
+	switch {
+	case !enable:
+		s.impl.Strings = nil
+	case s.impl.Strings == nil:
+		s.impl.Strings = new(intern.Local)
+	}
 }
 
-// Free releases any resources held by this value, allowing them to be re-used.
+// InternStats returns statistics about string interning for messages parsed
+// by this value and its shards since the last call to [Shared.Free].
 //
-// Any messages previously parsed using this value must not be reused.
-func (s *Shared) Free() { s.impl.Free() }
+// Returns zero if interning is not enabled with [Shared.InternStrings]. Must
+// not be called concurrently with a parse that uses this value or its shards.
+func (s *Shared) InternStats() InternStats {
+	var stats InternStats
+	for _, s := range append([]*dynamic.Shared{&s.impl}, s.impl.Shards()...) {
+		if s.Strings == nil {
+			continue
+		}
+		st := s.Strings.Stats()
+		stats.Strings += st.Strings
+		stats.Hits += st.Hits
+		stats.Bytes += st.Bytes
+		stats.HitBytes += st.HitBytes
+	}
+	return stats
+}
+
+// Shard returns a new value whose lifetime is tied to this one, for parsing
+// messages concurrently with it.
+//
+// A single Shared can only be used by one parse at a time. Instead, each
+// goroutine that parses part of a batch can obtain its own shard, which it
+// uses like any other Shared, and once they are all done the whole batch is
+// freed at once by calling [Shared.Free] on this value. Shards must not be
+// freed directly.
+//
+// Shard may be called concurrently with itself and with any use of this
+// value's shards, but not with [Shared.Free]. A shard inherits this value's
+// [ArenaPolicy] and the settings of [Shared.TrackMessages] and
+// [Shared.InternStrings] at the time it is returned. Calling Shard on a shard
+// is equivalent to calling it on that shard's parent. Shards are retained
+// across calls to [Shared.Free], so that their memory is re-used.
+func (s *Shared) Shard() *Shared {
+	return wrapShared(s.impl.Shard())
+}
+
+// Free releases any resources held by this value and its shards, allowing
+// them to be re-used.
+//
+// Any messages previously parsed using this value must not be reused. If
+// tracking is enabled with [Shared.TrackMessages], this will panic if any of
+// those messages have not been released with [Message.Release].
+//
+// Panics if this value is a shard returned by [Shared.Shard].
+func (s *Shared) Free() {
+	if s.impl.Parent != nil {
+		panic("hyperpb: Shared.Free called on a shard; free its parent instead")
+	}
+	if n := s.Outstanding(); n != 0 {
+		panic(fmt.Sprintf("hyperpb: Shared.Free called with %d outstanding messages", n))
+	}
+	s.impl.Free()
+}
+
+// FreeSecure is like [Shared.Free], but it additionally zeroes all of the
+// memory held by this value and its shards before releasing it, for values
+// that have held sensitive data, such as credentials or personal information.
+//
+// This wipes the whole arena, including blocks that Free would leave for the
+// garbage collector without clearing, and any copy of the input buffer made
+// by the parser. An input buffer parsed with [WithAllowAlias] belongs to the
+// caller, and is not modified; callers must wipe it themselves.
+//
+// To wipe only [Shared] values that have parsed specific fields, use
+// [WithSecretFields] instead.
+func (s *Shared) FreeSecure() {
+	s.impl.Secrets = true
+	for _, shard := range s.impl.Shards() {
+		shard.Secrets = true
+	}
+	s.Free()
+}
+
+// Verify checks that the input buffers aliased by messages parsed using this
+// value and its shards have not been modified since parsing, if checksums of
+// them were recorded with [WithChecksum]. Returns an error wrapping
+// [ErrSourceModified] if one has.
+//
+// [Shared.Free] does not check, since it is often called where a panic would
+// take down a whole server, such as when returning a value to a pool. Call
+// Verify before Free to detect modifications.
+func (s *Shared) Verify() error {
+	for _, s := range append([]*dynamic.Shared{&s.impl}, s.impl.Shards()...) {
+		if !s.Verify() {
+			return fmt.Errorf("%w: input buffer was modified after parsing", ErrSourceModified)
+		}
+	}
+	return nil
+}
+
+// ErrSourceModified is returned by [Shared.Verify] when an input buffer
+// aliased by a message was modified.
+var ErrSourceModified = errors.New("hyperpb: aliased source modified")
 
 // wrapShared wraps an internal Shared pointer.
 func wrapShared(s *dynamic.Shared) *Shared {
@@ -0,0 +1,401 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestTrackMessages(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+
+	s := new(hyperpb.Shared)
+	m1 := s.NewMessage(ty)
+	assert.Zero(t, s.Outstanding(), "tracking is off by default")
+	m1.Release()
+	s.Free()
+
+	s.TrackMessages(true)
+	m1 = s.NewMessage(ty)
+	m2 := s.NewMessage(ty)
+	assert.Equal(t, 2, s.Outstanding())
+
+	m1.Release()
+	assert.Panics(t, s.Free)
+
+	m2.Release()
+	assert.Zero(t, s.Outstanding())
+	assert.Panics(t, m2.Release, "double release")
+	s.Free()
+}
+
+func TestArenaPolicy(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Graph)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md)
+
+	want := new(testpb.Graph)
+	for i := range 300 {
+		want.R = append(want.R, &testpb.Graph{V: int32(i), S: &testpb.Graph{V: int32(i)}})
+	}
+	data, err := proto.Marshal(want)
+	require.NoError(t, err)
+
+	for _, policy := range []hyperpb.ArenaPolicy{
+		{},
+		{InitialBlock: 1 << 20},
+		{GrowthFactor: 1, MaxBlock: 256},
+		{GrowthFactor: 4, MaxBlock: 4096},
+	} {
+		s := new(hyperpb.Shared)
+		s.SetArenaPolicy(policy)
+		for range 3 {
+			m := s.NewMessage(ty)
+			require.NoError(t, m.Unmarshal(data))
+			assert.True(t, proto.Equal(want, m), "%+v", policy)
+			s.Free()
+		}
+	}
+}
+
+func TestGrow(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Graph]()
+	want := &testpb.Graph{V: 42, S: &testpb.Graph{V: 43}}
+	data, err := proto.Marshal(want)
+	require.NoError(t, err)
+
+	s := new(hyperpb.Shared)
+	assert.Zero(t, s.Available())
+	s.Grow(0)
+	assert.Zero(t, s.Available())
+
+	s.Grow(1 << 16)
+	avail := s.Available()
+	assert.GreaterOrEqual(t, avail, 1<<16)
+	s.Grow(avail) // Already available, so this is a no-op.
+	assert.Equal(t, avail, s.Available())
+
+	m := s.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.True(t, proto.Equal(want, m))
+	assert.Less(t, s.Available(), avail)
+
+	// The grown block is retained for re-use.
+	s.Free()
+	assert.Equal(t, avail, s.Available())
+
+	assert.Panics(t, func() { s.Grow(-1) })
+}
+
+func TestAlloc(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileFor[*testpb.Graph]()
+	data, err := proto.Marshal(&testpb.Graph{V: 42, S: &testpb.Graph{V: 43}})
+	require.NoError(t, err)
+
+	s := new(hyperpb.Shared)
+	s.SetArenaPolicy(hyperpb.ArenaPolicy{GrowthFactor: 1, MaxBlock: 64})
+	for range 2 {
+		m := s.NewMessage(ty)
+		require.NoError(t, m.Unmarshal(data))
+
+		var bufs [][]byte
+		for i, align := range []int{1, 2, 8, 16, 64, 1, 4096} {
+			buf := s.Alloc(i*7+1, align)
+			assert.Len(t, buf, i*7+1)
+			assert.Equal(t, len(buf), cap(buf))
+			assert.Zero(t, uintptr(unsafe.Pointer(unsafe.SliceData(buf)))%uintptr(align), align)
+			assert.Equal(t, make([]byte, len(buf)), buf)
+			for j := range buf {
+				buf[j] = byte(i + 1)
+			}
+			bufs = append(bufs, buf)
+		}
+
+		// Scratch memory does not overlap, either with itself or with messages.
+		for i, buf := range bufs {
+			assert.Equal(t, bytes.Repeat([]byte{byte(i + 1)}, len(buf)), buf)
+		}
+		assert.Equal(t, int32(42), m.Get(ty.Descriptor().Fields().ByName("v")).Interface())
+
+		assert.Empty(t, s.Alloc(0, 1))
+		assert.Panics(t, func() { s.Alloc(-1, 1) })
+		assert.Panics(t, func() { s.Alloc(1, 3) })
+		s.Free()
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	data, err := proto.Marshal(&testpb.Scalars{A1: 42})
+	require.NoError(t, err)
+
+	s := new(hyperpb.Shared)
+	m := s.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithAllowAlias(true), hyperpb.WithChecksum(true)))
+	require.NoError(t, m.Verify())
+	s.Free()
+
+	// Modifying the buffer is caught by Verify, including through the parent
+	// of a shard, but not by Free, which must not panic.
+	m = s.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithAllowAlias(true), hyperpb.WithChecksum(true)))
+	data[1]++
+	require.ErrorIs(t, m.Verify(), hyperpb.ErrSourceModified)
+	assert.NotPanics(t, s.Free)
+	data[1]--
+
+	m = s.Shard().NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithAllowAlias(true), hyperpb.WithChecksum(true)))
+	data[1]++
+	require.ErrorIs(t, s.Verify(), hyperpb.ErrSourceModified)
+	data[1]--
+	require.NoError(t, s.Verify())
+	s.Free()
+
+	// Without aliasing, the input is copied, so there is nothing to check.
+	m = s.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithChecksum(true)))
+	data[1]++
+	require.NoError(t, m.Verify())
+	s.Free()
+}
+
+func TestUnmarshalBatch(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+
+	var want []*testpb.Scalars
+	var data [][]byte
+	for i := range 10 {
+		msg := &testpb.Scalars{A1: int32(i), A14: fmt.Sprint("str", i), A15: []byte{byte(i)}}
+		if i == 5 {
+			msg = new(testpb.Scalars) // Empty inputs are allowed.
+		}
+		b, err := proto.Marshal(msg)
+		require.NoError(t, err)
+		want = append(want, msg)
+		data = append(data, b)
+	}
+
+	s := new(hyperpb.Shared)
+	msgs, err := s.UnmarshalBatch(ty, data)
+	require.NoError(t, err)
+	require.Len(t, msgs, len(want))
+	for i, m := range msgs {
+		assert.True(t, proto.Equal(want[i], m), "%d: %v", i, m)
+		assert.Nil(t, m.WireBytes())
+	}
+	assert.Panics(t, func() { _ = s.NewMessage(ty).Unmarshal(data[0]) })
+	s.Free()
+
+	// Parsing stops at the first bad input, with an offset relative to it.
+	bad := append(slices.Clone(data[:3]), []byte{0x0a, 0x05}, data[3])
+	msgs, err = s.UnmarshalBatch(ty, bad)
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	assert.Len(t, msgs, 3)
+	for i, m := range msgs {
+		assert.True(t, proto.Equal(want[i], m))
+	}
+	var offset interface{ Offset() int }
+	require.ErrorAs(t, err, &offset)
+	assert.Equal(t, 2, offset.Offset())
+	s.Free()
+
+	// Inputs past the size limit are not parsed.
+	msgs, err = s.UnmarshalBatch(ty, data, hyperpb.WithMaxSize(len(data[0])))
+	require.Error(t, err)
+	assert.Len(t, msgs, 1)
+	s.Free()
+
+	// With tracking, only the messages that were returned are outstanding.
+	s.TrackMessages(true)
+	msgs, err = s.UnmarshalBatch(ty, bad)
+	require.Error(t, err)
+	assert.Equal(t, len(msgs), s.Outstanding())
+	for _, m := range msgs {
+		m.Release()
+	}
+	assert.NotPanics(t, s.Free)
+}
+
+func BenchmarkUnmarshalBatch(b *testing.B) {
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	data := make([][]byte, 100)
+	for i := range data {
+		var err error
+		data[i], err = proto.Marshal(&testpb.Scalars{A1: int32(i), A14: fmt.Sprint("str", i)})
+		require.NoError(b, err)
+	}
+
+	s := new(hyperpb.Shared)
+	b.Run("loop", func(b *testing.B) {
+		for range b.N {
+			for _, d := range data {
+				_ = s.NewMessage(ty).Unmarshal(d)
+				s.Free()
+			}
+		}
+	})
+	b.Run("batch", func(b *testing.B) {
+		for range b.N {
+			_, _ = s.UnmarshalBatch(ty, data)
+			s.Free()
+		}
+	})
+}
+
+func TestSharedInternStrings(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	a14 := md.Fields().ByName("a14")
+	ty := hyperpb.CompileMessageDescriptor(md)
+
+	var data [][]byte
+	for i := range 4 {
+		b, err := proto.Marshal(&testpb.Scalars{A14: fmt.Sprint("level=", i%2)})
+		require.NoError(t, err)
+		data = append(data, b)
+	}
+
+	s := new(hyperpb.Shared)
+	s.InternStrings(true)
+	msgs, err := s.UnmarshalBatch(ty, data)
+	require.NoError(t, err)
+
+	// Strings are interned once, while parsing, so reading them repeatedly
+	// does not count against the statistics.
+	var strs []string
+	for range 2 {
+		strs = strs[:0]
+		for _, m := range msgs {
+			strs = append(strs, m.Get(a14).String())
+		}
+	}
+	assert.Equal(t, []string{"level=0", "level=1", "level=0", "level=1"}, strs)
+	assert.Same(t, unsafe.StringData(strs[0]), unsafe.StringData(strs[2]))
+	assert.Same(t, unsafe.StringData(strs[1]), unsafe.StringData(strs[3]))
+
+	stats := s.InternStats()
+	assert.Equal(t, uint64(4), stats.Strings)
+	assert.Equal(t, uint64(2), stats.Hits)
+	assert.InDelta(t, 0.5, stats.Ratio(), 0.001)
+
+	// Shards intern their own inputs, and are included in the statistics.
+	shard := s.Shard()
+	_, err = shard.UnmarshalBatch(ty, data)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(8), s.InternStats().Strings)
+
+	// Freeing resets the table, since its strings alias freed memory.
+	s.Free()
+	assert.Zero(t, s.InternStats())
+
+	s.InternStrings(false)
+	assert.Zero(t, s.InternStats())
+}
+
+func TestShard(t *testing.T) {
+	t.Parallel()
+
+	ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+	want := make([]*testpb.Scalars, 8)
+	data := make([][]byte, len(want))
+	for i := range want {
+		want[i] = &testpb.Scalars{A1: int32(i), A14: fmt.Sprint("str", i)}
+		var err error
+		data[i], err = proto.Marshal(want[i])
+		require.NoError(t, err)
+	}
+
+	s := new(hyperpb.Shared)
+	s.TrackMessages(true)
+	for range 2 { // Shards are re-used after Free.
+		msgs := make([]*hyperpb.Message, len(data))
+		var wg sync.WaitGroup
+		for i := range data {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				m := s.Shard().NewMessage(ty)
+				assert.NoError(t, m.Unmarshal(data[i]))
+				msgs[i] = m
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, len(data), s.Outstanding())
+		for i, m := range msgs {
+			assert.True(t, proto.Equal(want[i], m), "%d: %v", i, m)
+			assert.NotSame(t, s, m.Shared())
+			assert.Panics(t, m.Shared().Free, "shards cannot be freed directly")
+			m.Release()
+		}
+		s.Free()
+	}
+}
+
+func TestFreeSecure(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md)
+	data, err := proto.Marshal(&testpb.Scalars{A14: "ssn=078-05-1120"})
+	require.NoError(t, err)
+
+	s := new(hyperpb.Shared)
+	m := s.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	str := m.Get(md.Fields().ByName("a14")).String()
+	assert.Equal(t, "ssn=078-05-1120", str)
+
+	// Force the arena to discard a block, which Free would not clear.
+	small := s.Alloc(16, 1)
+	copy(small, "credit card")
+	_ = s.Alloc(1<<16, 1)
+
+	s.FreeSecure()
+	assert.Equal(t, make([]byte, len(str)), unsafe.Slice(unsafe.StringData(str), len(str)))
+	assert.Equal(t, make([]byte, len(small)), small)
+
+	// The value is ready for re-use.
+	m = s.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.Equal(t, "ssn=078-05-1120", m.Get(md.Fields().ByName("a14")).String())
+	s.Free()
+}
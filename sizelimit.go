@@ -0,0 +1,57 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"errors"
+
+	"buf.build/go/hyperpb/internal/tdp/vm"
+)
+
+// SizeLimitError describes a message which exceeded the size limit for its
+// type set with [WithMessageSizeLimit] while unmarshaling. See
+// [SizeLimitErrorOf].
+type SizeLimitError struct {
+	// The offset of the start of the message that was too large, just past
+	// its length prefix.
+	Offset int
+	// The type of the message, its size in bytes, and the limit for its
+	// type.
+	Type        *MessageType
+	Size, Limit int
+}
+
+// SizeLimitErrorOf returns information about the message which caused err, an
+// error returned by [Message.Unmarshal], by exceeding the size limit for its
+// type.
+//
+// Returns false if err was not caused by a size limit set with
+// [WithMessageSizeLimit].
+func SizeLimitErrorOf(err error) (SizeLimitError, bool) {
+	var perr *vm.ParseError
+	if !errors.As(err, &perr) {
+		return SizeLimitError{}, false
+	}
+	ty, size, ok := perr.SizeLimit()
+	if !ok {
+		return SizeLimitError{}, false
+	}
+	return SizeLimitError{
+		Offset: perr.Offset(),
+		Type:   wrapType(ty),
+		Size:   size,
+		Limit:  int(ty.MaxSize),
+	}, true
+}
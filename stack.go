@@ -0,0 +1,38 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import "buf.build/go/hyperpb/internal/tdp/vm"
+
+// StackStats are statistics about the recursion stacks used by
+// [Message.Unmarshal], which are pooled and shared by all messages. See
+// [WithStackDepth].
+type StackStats struct {
+	// The number of stacks that were allocated, because there was no pooled
+	// stack that was deep enough.
+	Allocs int64
+	// The number of times a stack was grown, because a message was nested
+	// deeper than the stack's depth.
+	Grows int64
+	// The total number of bytes allocated for stacks, including by growth.
+	Bytes int64
+}
+
+// ReadStackStats returns statistics about parser stacks, accumulated since
+// the program started.
+func ReadStackStats() StackStats {
+	allocs, grows, bytes := vm.StackStats()
+	return StackStats{Allocs: allocs, Grows: grows, Bytes: bytes}
+}
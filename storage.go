@@ -0,0 +1,108 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp/repeated"
+)
+
+// FieldStorage describes where the value of a field is stored. See
+// [Message.FieldStorage].
+type FieldStorage int
+
+const (
+	// StorageNone means that the field is not set.
+	StorageNone FieldStorage = iota
+	// StorageInline means that the value is stored directly in the message,
+	// as for singular scalar fields.
+	StorageInline
+	// StorageSource means that the value aliases the input to
+	// [Message.Unmarshal]. Unless [WithAllowAlias] was set, this is a private
+	// copy of the input made before parsing began.
+	StorageSource
+	// StorageArena means that the value was copied into the message's arena,
+	// for example because it needed to be decoded.
+	StorageArena
+	// StorageSpilled means that the value of a repeated field was initially
+	// aliasing the input, but was copied into the arena when more elements
+	// were parsed. This is the slowest way to parse a repeated field.
+	//
+	// This is only reported for messages parsed with [WithRecordSpills].
+	StorageSpilled
+)
+
+// String implements [fmt.Stringer].
+func (s FieldStorage) String() string {
+	switch s {
+	case StorageNone:
+		return "none"
+	case StorageInline:
+		return "inline"
+	case StorageSource:
+		return "source"
+	case StorageArena:
+		return "arena"
+	case StorageSpilled:
+		return "spilled"
+	default:
+		return "unknown"
+	}
+}
+
+// FieldStorage reports where the value of a field is stored. This is
+// intended for debugging performance problems, such as repeated fields that
+// could not be parsed without copying.
+//
+// For repeated and map fields, this describes the storage of the elements.
+// Repeated strings and bytes report [StorageSource], since their elements
+// alias the input, even though the list itself is stored in the arena.
+func (m *Message) FieldStorage(fd protoreflect.FieldDescriptor) FieldStorage {
+	if !m.IsValid() || !m.impl.Has(fd) {
+		return StorageNone
+	}
+
+	switch {
+	case fd.IsMap():
+		return StorageArena
+
+	case fd.Kind() == protoreflect.StringKind, fd.Kind() == protoreflect.BytesKind:
+		if m.impl.Type().Transforms[int32(fd.Number())] != nil {
+			// See WithFieldTransform.
+			return StorageArena
+		}
+		return StorageSource
+
+	case fd.Message() != nil:
+		return StorageArena
+
+	case fd.IsList():
+		field, zc, ok := repeated.ScalarStorage(m.impl.Get(fd).List())
+		switch {
+		case !ok:
+			return StorageArena
+		case zc:
+			return StorageSource
+		case m.impl.Shared.Spilled(field):
+			return StorageSpilled
+		default:
+			return StorageArena
+		}
+
+	default:
+		return StorageInline
+	}
+}
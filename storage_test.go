@@ -0,0 +1,68 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+)
+
+func TestFieldStorage(t *testing.T) {
+	t.Parallel()
+
+	md := (*testpb.Repeated)(nil).ProtoReflect().Descriptor()
+	ty := hyperpb.CompileMessageDescriptor(md)
+	field := md.Fields().ByName
+
+	data, err := proto.Marshal(&testpb.Repeated{
+		R1: []int32{1, 2, 3},
+		R2: []int64{1000, 2000},
+		R5: []uint32{1, 2},
+		R7: []string{"a"},
+	})
+	require.NoError(t, err)
+	// A second packed record for r5 forces it to be copied out of the input.
+	spill, err := proto.Marshal(&testpb.Repeated{R5: []uint32{3}})
+	require.NoError(t, err)
+	data = append(data, spill...)
+
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data))
+	assert.Equal(t, hyperpb.StorageArena, m.FieldStorage(field("r5")))
+
+	m = hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(data, hyperpb.WithRecordSpills(true)))
+
+	assert.Equal(t, hyperpb.StorageSource, m.FieldStorage(field("r1")))
+	assert.Equal(t, hyperpb.StorageArena, m.FieldStorage(field("r2")))
+	assert.Equal(t, hyperpb.StorageSpilled, m.FieldStorage(field("r5")))
+	assert.Equal(t, hyperpb.StorageSource, m.FieldStorage(field("r7")))
+	assert.Equal(t, hyperpb.StorageNone, m.FieldStorage(field("r6")))
+	assert.Equal(t, 3, m.Get(field("r5")).List().Len())
+
+	md = (*testpb.Scalars)(nil).ProtoReflect().Descriptor()
+	data, err = proto.Marshal(&testpb.Scalars{A1: 42})
+	require.NoError(t, err)
+	m = hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(md))
+	require.NoError(t, m.Unmarshal(data))
+	assert.Equal(t, hyperpb.StorageInline, m.FieldStorage(md.Fields().ByName("a1")))
+	assert.Equal(t, "inline", hyperpb.StorageInline.String())
+}
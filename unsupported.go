@@ -0,0 +1,80 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp"
+	"buf.build/go/hyperpb/internal/tdp/compiler"
+)
+
+// UnsupportedError is returned when compiling a message type which uses
+// features that hyperpb does not support.
+//
+// Compilation visits every message reachable from the one being compiled, so
+// this lists every unsupported field at once, rather than just the first one
+// found. This allows checking a schema for compatibility at startup.
+type UnsupportedError struct {
+	// The unsupported fields, in the order they were encountered.
+	Fields []UnsupportedField
+}
+
+// UnsupportedField is a field listed in an [UnsupportedError].
+type UnsupportedField struct {
+	Field protoreflect.FieldDescriptor
+	// A description of why the field is not supported.
+	Reason string
+}
+
+// Error implements [error].
+func (e *UnsupportedError) Error() string {
+	var b strings.Builder
+	b.WriteString("hyperpb: descriptor uses unsupported features: ")
+	for i, f := range e.Fields {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		fmt.Fprintf(&b, "%s: %s", f.Field.FullName(), f.Reason)
+	}
+	return b.String()
+}
+
+// compileChecked calls [compiler.CompileLibrary], converting the error it
+// returns for unsupported fields into an [*UnsupportedError].
+func compileChecked(mds []protoreflect.MessageDescriptor, opts compiler.Options) (*tdp.Library, error) {
+	lib, err := compiler.CompileLibrary(mds, opts)
+	unsupported, ok := err.(*compiler.UnsupportedError)
+	if !ok {
+		return lib, err
+	}
+
+	e := &UnsupportedError{Fields: make([]UnsupportedField, len(unsupported.Fields))}
+	for i, fd := range unsupported.Fields {
+		e.Fields[i] = UnsupportedField{Field: fd, Reason: unsupportedReason(fd)}
+	}
+	return nil, e
+}
+
+// unsupportedReason describes why fd is not supported.
+func unsupportedReason(fd protoreflect.FieldDescriptor) string {
+	if fd.IsMap() && fd.MapValue().Kind() == protoreflect.GroupKind {
+		return "map values with delimited encoding are not supported"
+	}
+	return fmt.Sprintf("%s fields are not supported", fd.Kind())
+}
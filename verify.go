@@ -0,0 +1,133 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"bytes"
+	"math/rand/v2"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/vm"
+)
+
+// Divergence describes a payload for which hyperpb and [dynamicpb] produced
+// different results.
+//
+// See [WithVerifyAgainstDynamicpb].
+type Divergence struct {
+	// The type being parsed.
+	Type *MessageType
+	// A copy of the payload that was parsed.
+	Data []byte
+
+	// The results of parsing with hyperpb.
+	Message *Message
+	Err     error
+
+	// The results of parsing with dynamicpb.
+	Reference    *dynamicpb.Message
+	ReferenceErr error
+}
+
+// WithVerifyAgainstDynamicpb enables differential checking of parses against
+// [dynamicpb], for gaining confidence in hyperpb before switching production
+// traffic over to it.
+//
+// Rate is a value from 0 to 1 that specifies the fraction of parses to check.
+// Each checked payload is parsed a second time with [dynamicpb], and the two
+// results are compared with [proto.Equal]. If they differ, or if only one of
+// them failed to parse, report is called synchronously, before
+// [Message.Unmarshal] returns. report may be nil, in which case nothing is
+// checked.
+//
+// Extensions are resolved for the reference parse using resolver, which should
+// provide the same extensions that the type was compiled with. If nil, the
+// reference parse will treat all extensions as unknown fields.
+//
+// The reference parse honors [WithDiscardUnknown] and [WithMaxDepth], but
+// always validates UTF-8, so [WithAllowInvalidUTF8] may cause spurious
+// divergences.
+//
+// Checking is expensive: in addition to the reference parse, it allocates
+// and compares a whole second message. Use a low sampling rate for
+// high-volume traffic.
+func WithVerifyAgainstDynamicpb(
+	rate float64,
+	resolver protoregistry.ExtensionTypeResolver,
+	report func(*Divergence),
+) UnmarshalOption {
+	return UnmarshalOption{func(opts *vm.Options) {
+		if report == nil || rate <= 0 {
+			opts.Verify = nil
+			return
+		}
+
+		opts.Verify = func(m *dynamic.Message, data []byte, err error, opts *vm.Options) {
+			if rand.Float64() >= rate {
+				return
+			}
+			if d := verify(wrapMessage(m), data, err, opts, resolver); d != nil {
+				report(d)
+			}
+		}
+	}}
+}
+
+// verify performs a reference parse of data with dynamicpb, and returns a
+// [Divergence] if it does not match the result of parsing it into m.
+func verify(
+	m *Message,
+	data []byte,
+	err error,
+	opts *vm.Options,
+	resolver protoregistry.ExtensionTypeResolver,
+) *Divergence {
+	if resolver == nil {
+		resolver = (*protoregistry.Types)(nil)
+	}
+
+	ref := dynamicpb.NewMessage(m.Descriptor())
+	refErr := proto.UnmarshalOptions{
+		Merge:          true,
+		AllowPartial:   true,
+		DiscardUnknown: opts.DiscardUnknown,
+		RecursionLimit: opts.MaxDepth,
+		Resolver:       resolver,
+	}.Unmarshal(data, ref)
+
+	switch {
+	case (err == nil) != (refErr == nil):
+	case err != nil:
+		return nil // Both failed; the errors need not agree.
+	case proto.Equal(ref, m):
+		// The order of the arguments matters: dynamicpb panics if asked for an
+		// extension whose descriptor is not an ExtensionTypeDescriptor, which
+		// is what hyperpb's Range yields.
+		return nil
+	}
+
+	return &Divergence{
+		Type:         m.HyperType(),
+		Data:         bytes.Clone(data),
+		Message:      m,
+		Err:          err,
+		Reference:    ref,
+		ReferenceErr: refErr,
+	}
+}
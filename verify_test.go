@@ -0,0 +1,68 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"buf.build/go/hyperpb"
+	testpb "buf.build/go/hyperpb/internal/gen/test"
+	"buf.build/go/hyperpb/internal/testdata"
+)
+
+func TestVerifyAgainstDynamicpb(t *testing.T) {
+	t.Parallel()
+
+	t.Run("corpus", func(t *testing.T) {
+		t.Parallel()
+		testdata.RunAll(t, func(t *testing.T, test *testdata.TestCase) {
+			t.Helper()
+			for _, specimen := range test.Specimens {
+				m := hyperpb.NewMessage(test.Type.Fast)
+				_ = m.Unmarshal(specimen, hyperpb.WithVerifyAgainstDynamicpb(
+					1, protoregistry.GlobalTypes,
+					func(d *hyperpb.Divergence) {
+						t.Errorf("divergence: %v vs %v, %v vs %v", d.Err, d.ReferenceErr, d.Message, d.Reference)
+					},
+				))
+			}
+		})
+	})
+
+	t.Run("invalid-utf8", func(t *testing.T) {
+		t.Parallel()
+
+		ty := hyperpb.CompileMessageDescriptor((*testpb.Scalars)(nil).ProtoReflect().Descriptor())
+		data := protowire.AppendTag(nil, 14, protowire.BytesType)
+		data = protowire.AppendString(data, "\xff")
+
+		var got *hyperpb.Divergence
+		m := hyperpb.NewMessage(ty)
+		err := m.Unmarshal(data,
+			hyperpb.WithAllowInvalidUTF8(true),
+			hyperpb.WithVerifyAgainstDynamicpb(1, nil, func(d *hyperpb.Divergence) { got = d }),
+		)
+		require.NoError(t, err)
+		require.NotNil(t, got)
+		assert.Equal(t, data, got.Data)
+		assert.Same(t, m, got.Message)
+		assert.Error(t, got.ReferenceErr)
+	})
+}
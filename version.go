@@ -0,0 +1,147 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"fmt"
+	"runtime"
+	rtdebug "runtime/debug"
+	"strings"
+	"sync"
+
+	"buf.build/go/hyperpb/internal/debug"
+	"buf.build/go/hyperpb/internal/tdp/vm"
+	"buf.build/go/hyperpb/internal/xunsafe/support"
+)
+
+// modulePath is the path of this module, for finding it in build information.
+const modulePath = "buf.build/go/hyperpb"
+
+// Version returns the version of hyperpb that this program was built with,
+// such as "v0.1.3", as recorded in the program's build information.
+//
+// If hyperpb is replaced with another module, this is the version of the
+// replacement. Returns "(devel)" if the version is not known, such as when
+// hyperpb is replaced with a local directory, or when testing hyperpb itself.
+func Version() string {
+	return buildInfo().version
+}
+
+// BuildFeatures describes how hyperpb was built into this program; see
+// [Features].
+type BuildFeatures struct {
+	// The target operating system and architecture, as in [runtime.GOOS] and
+	// [runtime.GOARCH].
+	GOOS, GOARCH string
+	// The instruction set level that the program was compiled for, such as
+	// "v3" for GOAMD64=v3, if it is recorded in the build information.
+	ArchLevel string
+
+	// Build tags which change how hyperpb behaves that were set, in sorted
+	// order: any of "debug", "hyperpb.safe" and "hyperpb.unsupported".
+	Tags []string
+
+	// Whether GOARCH is one of the architectures that hyperpb is tuned for;
+	// other architectures can only be built with the hyperpb.safe or
+	// hyperpb.unsupported tags.
+	Supported bool
+	// Whether the parser uses its fast paths that read past the end of the
+	// input instead of bounds checking; these are disabled in safe mode.
+	FastLoads bool
+}
+
+// String implements [fmt.Stringer]. The result is a single line, suitable for
+// bug reports and logs.
+func (f BuildFeatures) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s/%s", f.GOOS, f.GOARCH)
+	if f.ArchLevel != "" {
+		fmt.Fprintf(&b, " (%s)", f.ArchLevel)
+	}
+	if !f.Supported {
+		b.WriteString(" unsupported-arch")
+	}
+	if f.FastLoads {
+		b.WriteString(" fast-loads")
+	}
+	if len(f.Tags) > 0 {
+		fmt.Fprintf(&b, " tags=%s", strings.Join(f.Tags, ","))
+	}
+	return b.String()
+}
+
+// Features returns how hyperpb was built into this program: the build tags it
+// was built with, and the architecture-specific optimizations in effect. This
+// is intended for including in bug reports and telemetry, along with
+// [Version].
+func Features() BuildFeatures {
+	f := BuildFeatures{
+		GOOS:      runtime.GOOS,
+		GOARCH:    runtime.GOARCH,
+		ArchLevel: buildInfo().archLevel,
+		FastLoads: !vm.Safe,
+	}
+	switch runtime.GOARCH {
+	case "amd64", "arm64", "wasm":
+		f.Supported = true
+	}
+
+	if debug.Enabled {
+		f.Tags = append(f.Tags, "debug")
+	}
+	if vm.Safe {
+		f.Tags = append(f.Tags, "hyperpb.safe")
+	}
+	if support.UnsupportedTag {
+		f.Tags = append(f.Tags, "hyperpb.unsupported")
+	}
+	return f
+}
+
+// buildInfo extracts what Version and Features need from the program's build
+// information, which is only read once.
+var buildInfo = sync.OnceValue(func() (info struct{ version, archLevel string }) {
+	info.version = "(devel)"
+
+	bi, ok := rtdebug.ReadBuildInfo()
+	if !ok {
+		return info
+	}
+
+	var mod *rtdebug.Module
+	if bi.Main.Path == modulePath {
+		mod = &bi.Main
+	}
+	for _, dep := range bi.Deps {
+		if dep.Path == modulePath {
+			mod = dep
+			break
+		}
+	}
+	if mod != nil && mod.Replace != nil {
+		mod = mod.Replace
+	}
+	if mod != nil && mod.Version != "" {
+		info.version = mod.Version
+	}
+
+	key := "GO" + strings.ToUpper(runtime.GOARCH)
+	for _, s := range bi.Settings {
+		if s.Key == key {
+			info.archLevel = s.Value
+		}
+	}
+	return info
+})
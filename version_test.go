@@ -0,0 +1,39 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"buf.build/go/hyperpb"
+	"buf.build/go/hyperpb/internal/debug"
+)
+
+func TestFeatures(t *testing.T) {
+	t.Parallel()
+
+	assert.NotEmpty(t, hyperpb.Version())
+
+	f := hyperpb.Features()
+	assert.Equal(t, runtime.GOOS, f.GOOS)
+	assert.Equal(t, runtime.GOARCH, f.GOARCH)
+	assert.Equal(t, debug.Enabled, slices.Contains(f.Tags, "debug"))
+	assert.Equal(t, slices.Contains(f.Tags, "hyperpb.safe"), !f.FastLoads)
+	assert.Contains(t, f.String(), runtime.GOOS+"/"+runtime.GOARCH)
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp/vm"
+)
+
+// WireStats collects statistics about the fields in the wire format of
+// messages parsed with [WithWireStats], such as for finding out which fields
+// of a schema are actually used.
+//
+// Fields are tallied by the parser as it encounters them, and are only added
+// to the statistics once a parse succeeds. Fields of submessages are tallied
+// too, under their own message type, and unknown fields are tallied like any
+// other field. The fields of repeated message elements that are deduplicated
+// with [WithDedupMessages] are only tallied for the first such element.
+//
+// A zero WireStats is ready to use. It is safe to share between goroutines.
+type WireStats struct {
+	impl vm.WireStats
+}
+
+// WireField identifies a field number within a particular message type.
+type WireField struct {
+	Message protoreflect.FullName
+	Number  protowire.Number
+}
+
+// WireFieldStats are the statistics for a particular [WireField].
+type WireFieldStats struct {
+	// The number of records with this field number. A packed repeated field
+	// is one record.
+	Count int
+	// The total encoded size of those records, including their tags.
+	Bytes int
+}
+
+// Get returns the statistics for the given field number of the given message.
+func (s *WireStats) Get(message protoreflect.FullName, number protowire.Number) WireFieldStats {
+	return WireFieldStats(s.impl.Get(vm.WireField{Message: message, Number: number}))
+}
+
+// All returns an iterator over the statistics for every field seen so far,
+// ordered by message name and then by field number.
+func (s *WireStats) All() iter.Seq2[WireField, WireFieldStats] {
+	return func(yield func(WireField, WireFieldStats) bool) {
+		// Take a snapshot, so that yield is not called with s locked.
+		type entry struct {
+			field WireField
+			stats WireFieldStats
+		}
+		var entries []entry
+		for f, c := range s.impl.All {
+			entries = append(entries, entry{WireField(f), WireFieldStats(c)})
+		}
+		slices.SortFunc(entries, func(a, b entry) int {
+			return cmp.Or(
+				cmp.Compare(a.field.Message, b.field.Message),
+				cmp.Compare(a.field.Number, b.field.Number),
+			)
+		})
+
+		for _, e := range entries {
+			if !yield(e.field, e.stats) {
+				return
+			}
+		}
+	}
+}
+
+// Reset discards all statistics collected so far.
+func (s *WireStats) Reset() {
+	s.impl.Reset()
+}
@@ -0,0 +1,294 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"buf.build/go/hyperpb/internal/tdp/dynamic"
+	"buf.build/go/hyperpb/internal/tdp/maps"
+	"buf.build/go/hyperpb/internal/tdp/repeated"
+	"buf.build/go/hyperpb/internal/xunsafe"
+)
+
+// Field numbers of google.protobuf.Value.
+const (
+	valueNull protoreflect.FieldNumber = iota + 1
+	valueNumber
+	valueString
+	valueBool
+	valueStruct
+	valueList
+)
+
+// Field indices of google.protobuf.Timestamp and google.protobuf.Duration,
+// which have the same fields.
+const (
+	secondsIndex = iota
+	nanosIndex
+)
+
+var wellKnownTypes = sync.OnceValue(func() *Registry {
+	var types []*MessageType
+	for _, m := range []proto.Message{
+		(*anypb.Any)(nil),
+		(*durationpb.Duration)(nil),
+		(*emptypb.Empty)(nil),
+		(*fieldmaskpb.FieldMask)(nil),
+		(*structpb.Struct)(nil),
+		(*structpb.Value)(nil),
+		(*structpb.ListValue)(nil),
+		(*timestamppb.Timestamp)(nil),
+		(*wrapperspb.BoolValue)(nil),
+		(*wrapperspb.BytesValue)(nil),
+		(*wrapperspb.DoubleValue)(nil),
+		(*wrapperspb.FloatValue)(nil),
+		(*wrapperspb.Int32Value)(nil),
+		(*wrapperspb.Int64Value)(nil),
+		(*wrapperspb.StringValue)(nil),
+		(*wrapperspb.UInt32Value)(nil),
+		(*wrapperspb.UInt64Value)(nil),
+	} {
+		types = append(types, CompileMessageDescriptor(m.ProtoReflect().Descriptor()))
+	}
+	return newRegistry(types...)
+})
+
+// WellKnownTypes returns compiled types for the commonly used well-known types:
+// google.protobuf.Any, Duration, Empty, FieldMask, Struct, Value, ListValue,
+// Timestamp, and the wrapper types.
+//
+// The types are compiled once, the first time this function is called, and
+// are shared by all callers, so there is no need to compile them separately.
+// They can be used as a fallback when resolving the type URLs of
+// google.protobuf.Any messages.
+//
+// As with [FileDescriptorSetType], the descriptors of these types are those of
+// the packages under google.golang.org/protobuf/types/known.
+func WellKnownTypes() *Registry {
+	return wellKnownTypes()
+}
+
+// StructToMap converts a google.protobuf.Struct into a map, like
+// structpb.Struct.AsMap does.
+//
+// This operates directly on the parsed representation of the message, avoiding
+// the overhead of reflection. As with [Message.Get], strings in the result
+// alias memory owned by the message.
+func StructToMap(m *Message) (map[string]any, error) {
+	if err := checkWKT(m, "google.protobuf.Struct"); err != nil {
+		return nil, err
+	}
+	return structToMap(&m.impl), nil
+}
+
+// ListValueToSlice converts a google.protobuf.ListValue into a slice, like
+// structpb.ListValue.AsSlice does.
+//
+// See [StructToMap].
+func ListValueToSlice(m *Message) ([]any, error) {
+	if err := checkWKT(m, "google.protobuf.ListValue"); err != nil {
+		return nil, err
+	}
+	return listToSlice(&m.impl), nil
+}
+
+// ValueToAny converts a google.protobuf.Value into a Go value, like
+// structpb.Value.AsInterface does.
+//
+// See [StructToMap].
+func ValueToAny(m *Message) (any, error) {
+	if err := checkWKT(m, "google.protobuf.Value"); err != nil {
+		return nil, err
+	}
+	return valueToAny(&m.impl), nil
+}
+
+// GetTime reads a google.protobuf.Timestamp field of m as a [time.Time], like
+// timestamppb.Timestamp.AsTime does. Returns false if the field is not set.
+//
+// This reads the timestamp directly from the parsed representation of the
+// submessage, avoiding the overhead of wrapping it in a [protoreflect.Message].
+//
+// Panics if fd is not a singular google.protobuf.Timestamp field of m.
+func GetTime(m *Message, fd protoreflect.FieldDescriptor) (time.Time, bool) {
+	secs, nanos, ok := getSecondsNanos(m, fd, "google.protobuf.Timestamp")
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(secs, nanos).UTC(), true
+}
+
+// GetDuration reads a google.protobuf.Duration field of m as a
+// [time.Duration], like durationpb.Duration.AsDuration does, saturating on
+// overflow. Returns false if the field is not set.
+//
+// See [GetTime].
+func GetDuration(m *Message, fd protoreflect.FieldDescriptor) (time.Duration, bool) {
+	secs, nanos, ok := getSecondsNanos(m, fd, "google.protobuf.Duration")
+	if !ok {
+		return 0, false
+	}
+
+	d := time.Duration(secs) * time.Second
+	overflow := d/time.Second != time.Duration(secs)
+	d += time.Duration(nanos)
+	overflow = overflow || (secs < 0 && nanos < 0 && d > 0)
+	overflow = overflow || (secs > 0 && nanos > 0 && d < 0)
+	switch {
+	case !overflow:
+		return d, true
+	case secs < 0:
+		return math.MinInt64, true
+	default:
+		return math.MaxInt64, true
+	}
+}
+
+// getSecondsNanos reads the seconds and nanos of the Timestamp or Duration
+// field fd of m, whose type must be name.
+func getSecondsNanos(m *Message, fd protoreflect.FieldDescriptor, name protoreflect.FullName) (secs, nanos int64, ok bool) {
+	ty := m.impl.Type()
+	f := ty.ByDescriptor(fd)
+	if f == nil || !f.IsValid() || fd.IsList() || fd.Message() == nil || fd.Message().FullName() != name {
+		panic(fmt.Sprintf("hyperpb: %s is not a singular %s field of %s", fd.FullName(), name, ty.Descriptor.FullName()))
+	}
+	n := ty.IndexOf(f)
+	ty.CountAccess(n)
+
+	sub := m.impl.MessageByIndex(n)
+	if sub == nil {
+		return 0, 0, false
+	}
+	// Unset scalars are represented by an invalid value.
+	if v := sub.GetByIndexUnchecked(secondsIndex); v.IsValid() {
+		secs = v.Int()
+	}
+	if v := sub.GetByIndexUnchecked(nanosIndex); v.IsValid() {
+		nanos = v.Int()
+	}
+	return secs, nanos, true
+}
+
+func checkWKT(m *Message, name protoreflect.FullName) error {
+	if !m.IsValid() {
+		return errInvalid
+	}
+	if got := m.Descriptor().FullName(); got != name {
+		return fmt.Errorf("hyperpb: expected %s, got %s", name, got)
+	}
+	return nil
+}
+
+func structToMap(m *dynamic.Message) map[string]any {
+	if m == nil {
+		return map[string]any{}
+	}
+
+	fields := m.GetByIndexUnchecked(0).Map()
+	raw, ok := maps.StringToMessages(fields)
+	if !ok {
+		// Unset maps are represented by an empty map of a different type.
+		out := make(map[string]any, fields.Len())
+		fields.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			out[k.String()] = valueToAny(unwrapMessage(v))
+			return true
+		})
+		return out
+	}
+
+	out := make(map[string]any, raw.Len())
+	for k, v := range raw.Range {
+		out[k] = valueToAny(v)
+	}
+	return out
+}
+
+func listToSlice(m *dynamic.Message) []any {
+	if m == nil {
+		return []any{}
+	}
+
+	values := m.GetByIndexUnchecked(0).List()
+	raw, ok := repeated.MessageList(values)
+	if !ok {
+		out := make([]any, values.Len())
+		for i := range out {
+			out[i] = valueToAny(unwrapMessage(values.Get(i)))
+		}
+		return out
+	}
+
+	out := make([]any, 0, raw.Len())
+	for v := range raw.Values() {
+		out = append(out, valueToAny(v))
+	}
+	return out
+}
+
+func valueToAny(m *dynamic.Message) any {
+	if m == nil {
+		return nil
+	}
+
+	var which protoreflect.FieldNumber
+	if f := m.Type().ByIndex(0); f.Offset.Number != 0 {
+		which = protoreflect.FieldNumber(xunsafe.ByteLoad[uint32](m, f.Offset.Bit))
+	} else if fd := wrapMessage(m).WhichOneof(m.Type().Descriptor.Oneofs().Get(0)); fd != nil {
+		which = fd.Number()
+	}
+
+	if which == 0 || which == valueNull {
+		return nil
+	}
+	v := m.GetByIndexUnchecked(int(which - 1))
+	switch which {
+	case valueNumber:
+		return v.Float()
+	case valueString:
+		return v.String()
+	case valueBool:
+		return v.Bool()
+	case valueStruct:
+		return structToMap(unwrapMessage(v))
+	case valueList:
+		return listToSlice(unwrapMessage(v))
+	default:
+		return nil
+	}
+}
+
+// unwrapMessage returns the hyperpb message in v, or nil if v contains an
+// empty message.
+func unwrapMessage(v protoreflect.Value) *dynamic.Message {
+	m, _ := v.Message().(*Message)
+	if m == nil {
+		return nil
+	}
+	return &m.impl
+}
@@ -0,0 +1,188 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"buf.build/go/hyperpb"
+)
+
+func TestStructToMap(t *testing.T) {
+	t.Parallel()
+
+	want := map[string]any{
+		"null":   nil,
+		"number": 4.5,
+		"string": "hello",
+		"bool":   true,
+		"struct": map[string]any{
+			"nested": "value",
+			"empty":  map[string]any{},
+		},
+		"list": []any{1.0, "two", false, nil, []any{}, map[string]any{"x": 3.0}},
+	}
+	s, err := structpb.NewStruct(want)
+	require.NoError(t, err)
+	data, err := proto.Marshal(s)
+	require.NoError(t, err)
+
+	m := hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(s.ProtoReflect().Descriptor()))
+	require.NoError(t, m.Unmarshal(data))
+	got, err := hyperpb.StructToMap(m)
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	l := structpb.NewListValue(s.Fields["list"].GetListValue())
+	data, err = proto.Marshal(l)
+	require.NoError(t, err)
+	m = hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(l.ProtoReflect().Descriptor()))
+	require.NoError(t, m.Unmarshal(data))
+	gotValue, err := hyperpb.ValueToAny(m)
+	require.NoError(t, err)
+	assert.Equal(t, want["list"], gotValue)
+
+	_, err = hyperpb.ListValueToSlice(m)
+	require.Error(t, err)
+
+	m = hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(l.GetListValue().ProtoReflect().Descriptor()))
+	require.NoError(t, m.Unmarshal(data[2:])) // Strip the Value.list_value tag and length.
+	gotList, err := hyperpb.ListValueToSlice(m)
+	require.NoError(t, err)
+	assert.Equal(t, want["list"], gotList)
+
+	m = hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(s.ProtoReflect().Descriptor()))
+	require.NoError(t, m.Unmarshal(nil))
+	got, err = hyperpb.StructToMap(m)
+	require.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func TestGetTime(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, n int32, ty string, oneof bool) *descriptorpb.FieldDescriptorProto {
+		fd := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			Number:   proto.Int32(n),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(ty),
+		}
+		if oneof {
+			fd.OneofIndex = proto.Int32(0)
+		}
+		return fd
+	}
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("event.proto"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{"google/protobuf/timestamp.proto", "google/protobuf/duration.proto"},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Event"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("at", 1, ".google.protobuf.Timestamp", false),
+				field("took", 2, ".google.protobuf.Duration", false),
+				field("unset", 3, ".google.protobuf.Timestamp", false),
+				field("one_at", 4, ".google.protobuf.Timestamp", true),
+				field("one_took", 5, ".google.protobuf.Duration", true),
+			},
+			OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("o")}},
+		}},
+	}
+	file, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	require.NoError(t, err)
+	md := file.Messages().Get(0)
+	fields := md.Fields()
+
+	at := time.Date(2024, 2, 29, 12, 30, 0, 123456789, time.UTC)
+	took := -90*time.Second - 5*time.Millisecond
+	dm := dynamicpb.NewMessage(md)
+	set := func(name protoreflect.Name, v proto.Message) {
+		dm.Set(fields.ByName(name), protoreflect.ValueOfMessage(v.ProtoReflect()))
+	}
+	set("at", timestamppb.New(at))
+	set("took", durationpb.New(took))
+	set("one_took", &durationpb.Duration{Seconds: math.MaxInt64})
+	data, err := proto.Marshal(dm)
+	require.NoError(t, err)
+
+	m := hyperpb.NewMessage(hyperpb.CompileMessageDescriptor(md))
+	require.NoError(t, m.Unmarshal(data))
+
+	gotTime, ok := hyperpb.GetTime(m, fields.ByName("at"))
+	assert.True(t, ok)
+	assert.Equal(t, at, gotTime)
+	gotDuration, ok := hyperpb.GetDuration(m, fields.ByName("took"))
+	assert.True(t, ok)
+	assert.Equal(t, took, gotDuration)
+	gotDuration, ok = hyperpb.GetDuration(m, fields.ByName("one_took"))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(math.MaxInt64), gotDuration)
+
+	_, ok = hyperpb.GetTime(m, fields.ByName("unset"))
+	assert.False(t, ok)
+	_, ok = hyperpb.GetTime(m, fields.ByName("one_at"))
+	assert.False(t, ok)
+
+	assert.Panics(t, func() { hyperpb.GetTime(m, fields.ByName("took")) })
+	assert.Panics(t, func() { hyperpb.GetDuration(m, fields.ByName("at")) })
+}
+
+func TestWellKnownTypes(t *testing.T) {
+	t.Parallel()
+
+	wkt := hyperpb.WellKnownTypes()
+	assert.Same(t, wkt, hyperpb.WellKnownTypes())
+	assert.Equal(t, 17, wkt.Len())
+
+	var names []protoreflect.FullName
+	for ty := range wkt.All() {
+		names = append(names, ty.Descriptor().FullName())
+	}
+	assert.IsIncreasing(t, names)
+
+	ty, err := wkt.FindMessageByName("google.protobuf.Timestamp")
+	require.NoError(t, err)
+	assert.Equal(t, (*timestamppb.Timestamp)(nil).ProtoReflect().Descriptor(), ty.Descriptor())
+
+	// Resolve the contents of an Any.
+	at := time.Date(2024, 2, 29, 12, 30, 0, 0, time.UTC)
+	packed, err := anypb.New(timestamppb.New(at))
+	require.NoError(t, err)
+	ty, err = wkt.FindMessageByURL(packed.GetTypeUrl())
+	require.NoError(t, err)
+	m := hyperpb.NewMessage(ty)
+	require.NoError(t, m.Unmarshal(packed.GetValue()))
+	assert.True(t, proto.Equal(timestamppb.New(at), m))
+
+	_, err = wkt.FindMessageByURL("type.googleapis.com/google.protobuf.Api")
+	require.ErrorIs(t, err, protoregistry.NotFound)
+}
@@ -0,0 +1,326 @@
+// Copyright 2025 Buf Technologies, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hyperpb
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"buf.build/go/hyperpb/internal/tdp/vm"
+	"buf.build/go/hyperpb/internal/xunsafe"
+)
+
+// DefaultChunkSize is the chunk size used by [Message.WriteTo].
+const DefaultChunkSize = 64 * 1024
+
+// WriteTo implements [io.WriterTo] by writing the wire format encoding of m
+// to w, in chunks of [DefaultChunkSize] bytes.
+//
+// Unlike [proto.Marshal], this never holds the whole encoding in memory, so it
+// is suitable for streaming very large messages directly to a file or the
+// network. Instead, m is walked twice: once to compute the length of every
+// submessage, which requires four bytes of memory per submessage, and once to
+// write it.
+//
+// Fields are written in field number order, followed by unknown fields, and
+// map entries are written in key order. Groups, and message fields that use
+// delimited encoding in editions, are written between start and end group
+// tags, exactly as they are encoded by [proto.Marshal].
+//
+// Like [proto.Marshal], this fails if a string field that requires valid
+// UTF-8 contains invalid UTF-8, which is only possible if it was parsed with
+// [WithAllowInvalidUTF8]; in that case, nothing is written. Required fields
+// are not checked. If w returns an error, part of the message may have
+// already been written to it.
+func (m *Message) WriteTo(w io.Writer) (int64, error) {
+	return m.WriteChunked(w, DefaultChunkSize)
+}
+
+// WriteChunked is like [Message.WriteTo], but buffers up to chunkSize bytes
+// before each call to w.Write, rather than [DefaultChunkSize]. Every call
+// is passed exactly chunkSize bytes, except the last.
+func (m *Message) WriteChunked(w io.Writer, chunkSize int) (int64, error) {
+	if !m.IsValid() {
+		return 0, errInvalid
+	}
+	if chunkSize <= 0 {
+		return 0, fmt.Errorf("hyperpb: invalid chunk size %d", chunkSize)
+	}
+	return new(encoder).encode(m, w, chunkSize)
+}
+
+// encoder writes the wire format of a message to an [io.Writer].
+//
+// If w is nil, it instead counts the bytes it would write, and records the
+// length of each length-prefixed record in the order they are encountered.
+type encoder struct {
+	w   io.Writer
+	buf []byte
+	n   int64 // Bytes written or counted so far.
+	err error
+
+	lens []uint32
+	next int // Index of the next length to use while writing.
+
+	// If set, strings which require valid UTF-8 are written even if they
+	// contain invalid UTF-8, rather than failing.
+	allowInvalidUTF8 bool
+
+	scratch [binary.MaxVarintLen64]byte
+}
+
+// encode writes m to w, in chunks of chunkSize bytes.
+func (e *encoder) encode(m *Message, w io.Writer, chunkSize int) (int64, error) {
+	// First, compute the length of every length-prefixed record.
+	e.message(m)
+	if e.err != nil {
+		return 0, e.err
+	}
+	if e.n > math.MaxInt32 {
+		return 0, fmt.Errorf("hyperpb: message too large to encode: %d bytes", e.n)
+	}
+
+	// Then, write them out, consuming the lengths in the same order.
+	e.w, e.buf, e.n = w, make([]byte, 0, chunkSize), 0
+	e.message(m)
+	e.flush()
+	return e.n, e.err
+}
+
+// write writes b, flushing whenever the buffer fills up.
+func (e *encoder) write(b []byte) {
+	if e.err != nil {
+		return
+	}
+	if e.w == nil {
+		e.n += int64(len(b))
+		return
+	}
+	for len(b) > 0 {
+		n := copy(e.buf[len(e.buf):cap(e.buf)], b)
+		e.buf = e.buf[:len(e.buf)+n]
+		b = b[n:]
+		if len(e.buf) == cap(e.buf) {
+			e.flush()
+			if e.err != nil {
+				return
+			}
+		}
+	}
+}
+
+// flush writes out the buffer.
+func (e *encoder) flush() {
+	if e.w == nil || e.err != nil || len(e.buf) == 0 {
+		return
+	}
+	n, err := e.w.Write(e.buf)
+	e.n += int64(n)
+	if err == nil && n < len(e.buf) {
+		err = io.ErrShortWrite
+	}
+	e.err = err
+	e.buf = e.buf[:0]
+}
+
+func (e *encoder) tag(n protowire.Number, t protowire.Type) {
+	e.write(protowire.AppendTag(e.scratch[:0], n, t))
+}
+
+func (e *encoder) varint(v uint64) {
+	e.write(protowire.AppendVarint(e.scratch[:0], v))
+}
+
+// delimited writes a length-prefixed record, whose contents are written by
+// body.
+func (e *encoder) delimited(n protowire.Number, body func()) {
+	e.tag(n, protowire.BytesType)
+	if e.w != nil {
+		e.varint(uint64(e.lens[e.next]))
+		e.next++
+		body()
+		return
+	}
+
+	i := len(e.lens)
+	e.lens = append(e.lens, 0)
+	start := e.n
+	body()
+	len := e.n - start
+	e.lens[i] = uint32(len)
+	e.n += int64(protowire.SizeVarint(uint64(len)))
+}
+
+// message writes the fields of m.
+func (e *encoder) message(m protoreflect.Message) {
+	type entry struct {
+		fd protoreflect.FieldDescriptor
+		v  protoreflect.Value
+	}
+	var fields []entry
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fields = append(fields, entry{fd, v})
+		return true
+	})
+	slices.SortFunc(fields, func(a, b entry) int {
+		return cmp.Compare(a.fd.Number(), b.fd.Number())
+	})
+
+	for _, f := range fields {
+		if e.err != nil {
+			return
+		}
+		e.field(f.fd, f.v)
+	}
+	e.write(m.GetUnknown())
+}
+
+// field writes every record of the field fd, whose value is v.
+func (e *encoder) field(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	switch {
+	case fd.IsMap():
+		m := v.Map()
+		keys := make([]protoreflect.MapKey, 0, m.Len())
+		m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			keys = append(keys, k)
+			return true
+		})
+		slices.SortFunc(keys, compareMapKeys)
+
+		for _, k := range keys {
+			e.delimited(fd.Number(), func() {
+				e.singular(fd.MapKey(), k.Value())
+				e.singular(fd.MapValue(), m.Get(k))
+			})
+		}
+
+	case fd.IsList():
+		list := v.List()
+		if fd.IsPacked() {
+			e.delimited(fd.Number(), func() {
+				for i := range list.Len() {
+					e.value(fd, list.Get(i))
+				}
+			})
+			return
+		}
+		for i := range list.Len() {
+			e.singular(fd, list.Get(i))
+		}
+
+	default:
+		e.singular(fd, v)
+	}
+}
+
+// singular writes a single record for fd.
+func (e *encoder) singular(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	switch fd.Kind() {
+	case protoreflect.GroupKind:
+		e.tag(fd.Number(), protowire.StartGroupType)
+		e.message(v.Message())
+		e.tag(fd.Number(), protowire.EndGroupType)
+	case protoreflect.MessageKind:
+		e.delimited(fd.Number(), func() { e.message(v.Message()) })
+	default:
+		e.tag(fd.Number(), wireType(fd.Kind()))
+		e.value(fd, v)
+	}
+}
+
+// value writes a scalar value, without a tag.
+func (e *encoder) value(fd protoreflect.FieldDescriptor, v protoreflect.Value) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		e.varint(protowire.EncodeBool(v.Bool()))
+	case protoreflect.EnumKind:
+		e.varint(uint64(v.Enum()))
+	case protoreflect.Int32Kind, protoreflect.Int64Kind:
+		e.varint(uint64(v.Int()))
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind:
+		e.varint(v.Uint())
+	case protoreflect.Sint32Kind, protoreflect.Sint64Kind:
+		e.varint(protowire.EncodeZigZag(v.Int()))
+	case protoreflect.Fixed32Kind:
+		e.write(protowire.AppendFixed32(e.scratch[:0], uint32(v.Uint())))
+	case protoreflect.Sfixed32Kind:
+		e.write(protowire.AppendFixed32(e.scratch[:0], uint32(v.Int())))
+	case protoreflect.FloatKind:
+		e.write(protowire.AppendFixed32(e.scratch[:0], math.Float32bits(float32(v.Float()))))
+	case protoreflect.Fixed64Kind:
+		e.write(protowire.AppendFixed64(e.scratch[:0], v.Uint()))
+	case protoreflect.Sfixed64Kind:
+		e.write(protowire.AppendFixed64(e.scratch[:0], uint64(v.Int())))
+	case protoreflect.DoubleKind:
+		e.write(protowire.AppendFixed64(e.scratch[:0], math.Float64bits(v.Float())))
+	case protoreflect.StringKind:
+		s := v.String()
+		if !e.allowInvalidUTF8 && vm.RequiresUTF8(fd) && !utf8.ValidString(s) {
+			if e.err == nil {
+				e.err = fmt.Errorf("hyperpb: field %s contains invalid UTF-8", fd.FullName())
+			}
+			return
+		}
+		e.varint(uint64(len(s)))
+		// write only reads from its argument, so this is safe.
+		e.write(xunsafe.StringToSlice[[]byte](s))
+	case protoreflect.BytesKind:
+		b := v.Bytes()
+		e.varint(uint64(len(b)))
+		e.write(b)
+	}
+}
+
+// wireType returns the wire type for a scalar kind.
+func wireType(k protoreflect.Kind) protowire.Type {
+	switch k {
+	case protoreflect.Fixed32Kind, protoreflect.Sfixed32Kind, protoreflect.FloatKind:
+		return protowire.Fixed32Type
+	case protoreflect.Fixed64Kind, protoreflect.Sfixed64Kind, protoreflect.DoubleKind:
+		return protowire.Fixed64Type
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return protowire.BytesType
+	default:
+		return protowire.VarintType
+	}
+}
+
+// compareMapKeys orders map keys of the same kind.
+func compareMapKeys(a, b protoreflect.MapKey) int {
+	switch v := a.Interface().(type) {
+	case bool:
+		if v == b.Bool() {
+			return 0
+		}
+		if v {
+			return 1
+		}
+		return -1
+	case int32, int64:
+		return cmp.Compare(a.Int(), b.Int())
+	case uint32, uint64:
+		return cmp.Compare(a.Uint(), b.Uint())
+	default:
+		return cmp.Compare(a.String(), b.String())
+	}
+}